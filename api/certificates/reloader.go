@@ -0,0 +1,143 @@
+package certificates
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+var log = logger.GetOrCreate("api/certificates")
+
+// certificateReloader holds the TLS certificate used by the API servers and reloads it from disk whenever the
+// certificate or the key file is changed, so the certificate can be rotated without restarting the node
+type certificateReloader struct {
+	certFile        string
+	keyFile         string
+	mutCertificate  sync.RWMutex
+	certificate     *tls.Certificate
+	certFileModTime time.Time
+	keyFileModTime  time.Time
+}
+
+// NewCertificateReloader creates a new instance of certificateReloader, loading the certificate from the provided files
+func NewCertificateReloader(certFile string, keyFile string) (*certificateReloader, error) {
+	if len(certFile) == 0 || len(keyFile) == 0 {
+		return nil, apiErrors.ErrEmptyTLSFiles
+	}
+
+	cr := &certificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	_, err := cr.reloadIfChanged()
+	if err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// reloadIfChanged loads the certificate again if any of the files was modified since the last load. Returns true if
+// the certificate was reloaded
+func (cr *certificateReloader) reloadIfChanged() (bool, error) {
+	certFileModTime, err := getModTime(cr.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyFileModTime, err := getModTime(cr.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	cr.mutCertificate.RLock()
+	isUnchanged := cr.certificate != nil &&
+		certFileModTime.Equal(cr.certFileModTime) &&
+		keyFileModTime.Equal(cr.keyFileModTime)
+	cr.mutCertificate.RUnlock()
+	if isUnchanged {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	cr.mutCertificate.Lock()
+	cr.certificate = &certificate
+	cr.certFileModTime = certFileModTime
+	cr.keyFileModTime = keyFileModTime
+	cr.mutCertificate.Unlock()
+
+	return true, nil
+}
+
+// getCertificate returns the current certificate. It is used as the GetCertificate handler of the tls.Config
+func (cr *certificateReloader) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// TLSConfig returns a TLS configuration that always serves the latest loaded certificate
+func (cr *certificateReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cr.getCertificate,
+	}
+}
+
+// Watch periodically checks the certificate files until the context is done
+func (cr *certificateReloader) Watch(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			reloaded, err := cr.reloadIfChanged()
+			if err != nil {
+				log.Warn("could not reload the TLS certificate, keeping the previous one", "error", err.Error())
+				continue
+			}
+			if reloaded {
+				log.Info("reloaded the TLS certificate", "certificate file", cr.certFile)
+			}
+		case <-ctx.Done():
+			log.Debug("closing certificateReloader.Watch go routine")
+			return
+		}
+	}
+}
+
+// CreateTLSConfig returns the TLS configuration described by the provided config, whose certificate is reloaded until
+// the context is done
+func CreateTLSConfig(ctx context.Context, tlsConfig config.ApiTLSConfig) (*tls.Config, error) {
+	if tlsConfig.ReloadIntervalInSec == 0 {
+		return nil, apiErrors.ErrInvalidTLSReloadInterval
+	}
+
+	reloader, err := NewCertificateReloader(tlsConfig.CertificateFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	reloadInterval := time.Second * time.Duration(tlsConfig.ReloadIntervalInSec)
+	go reloader.Watch(ctx, reloadInterval)
+
+	return reloader.TLSConfig(), nil
+}
+
+func getModTime(file string) (time.Time, error) {
+	fileInfo, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return fileInfo.ModTime(), nil
+}
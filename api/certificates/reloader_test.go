@@ -0,0 +1,167 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSelfSignedCertificate(t *testing.T, certFile string, keyFile string, serialNumber int64, modTime time.Time) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	require.Nil(t, err)
+
+	require.Nil(t, os.Chtimes(certFile, modTime, modTime))
+	require.Nil(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func getSerialNumber(t *testing.T, cr *certificateReloader) int64 {
+	certificate, err := cr.getCertificate(nil)
+	require.Nil(t, err)
+	parsed, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.Nil(t, err)
+
+	return parsed.SerialNumber.Int64()
+}
+
+func TestNewCertificateReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	cr, err := NewCertificateReloader("", keyFile)
+	assert.Nil(t, cr)
+	assert.Equal(t, errors.ErrEmptyTLSFiles, err)
+
+	cr, err = NewCertificateReloader(certFile, keyFile)
+	assert.Nil(t, cr)
+	assert.NotNil(t, err)
+
+	writeSelfSignedCertificate(t, certFile, keyFile, 1, time.Now())
+	cr, err = NewCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), getSerialNumber(t, cr))
+	assert.NotNil(t, cr.TLSConfig().GetCertificate)
+}
+
+func TestCertificateReloader_ReloadIfChanged(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	initialModTime := time.Now().Add(-time.Minute)
+	writeSelfSignedCertificate(t, certFile, keyFile, 1, initialModTime)
+
+	cr, err := NewCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+
+	reloaded, err := cr.reloadIfChanged()
+	assert.Nil(t, err)
+	assert.False(t, reloaded)
+
+	writeSelfSignedCertificate(t, certFile, keyFile, 2, time.Now())
+	reloaded, err = cr.reloadIfChanged()
+	assert.Nil(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, int64(2), getSerialNumber(t, cr))
+
+	// a broken pair should keep the previous certificate
+	require.Nil(t, ioutil.WriteFile(keyFile, []byte("not a key"), 0600))
+	reloaded, err = cr.reloadIfChanged()
+	assert.NotNil(t, err)
+	assert.False(t, reloaded)
+	assert.Equal(t, int64(2), getSerialNumber(t, cr))
+}
+
+func TestCertificateReloader_WatchShouldReloadAndStopOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	writeSelfSignedCertificate(t, certFile, keyFile, 1, time.Now().Add(-time.Minute))
+
+	cr, err := NewCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chDone := make(chan struct{})
+	go func() {
+		cr.Watch(ctx, time.Millisecond*10)
+		close(chDone)
+	}()
+
+	writeSelfSignedCertificate(t, certFile, keyFile, 2, time.Now())
+	assert.Eventually(t, func() bool {
+		return getSerialNumber(t, cr) == 2
+	}, time.Second, time.Millisecond*10)
+
+	cancel()
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "watch should have stopped")
+	}
+}
+
+func TestCreateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tlsConfig := config.ApiTLSConfig{
+		Enabled:             true,
+		CertificateFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:             filepath.Join(dir, "key.pem"),
+		ReloadIntervalInSec: 0,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, err := CreateTLSConfig(ctx, tlsConfig)
+	assert.Nil(t, cfg)
+	assert.Equal(t, errors.ErrInvalidTLSReloadInterval, err)
+
+	tlsConfig.ReloadIntervalInSec = 1
+	cfg, err = CreateTLSConfig(ctx, tlsConfig)
+	assert.Nil(t, cfg)
+	assert.NotNil(t, err)
+
+	writeSelfSignedCertificate(t, tlsConfig.CertificateFile, tlsConfig.KeyFile, 1, time.Now())
+	cfg, err = CreateTLSConfig(ctx, tlsConfig)
+	require.Nil(t, err)
+	assert.NotNil(t, cfg.GetCertificate)
+}
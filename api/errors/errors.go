@@ -37,6 +37,36 @@ var ErrGetRolesForAccount = errors.New("get roles for account error")
 // ErrGetESDTNFTData signals an error in getting esdt nft data for given address, tokenID and nonce
 var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
 
+// ErrGetAccountTransactions signals an error in getting the indexed transactions of a given address
+var ErrGetAccountTransactions = errors.New("get account transactions error")
+
+// ErrGetTokenHolders signals an error in getting the holders of a token
+var ErrGetTokenHolders = errors.New("get token holders error")
+
+// ErrGetGovernanceProposals signals an error in getting the governance proposals
+var ErrGetGovernanceProposals = errors.New("get governance proposals error")
+
+// ErrGetGovernanceProposal signals an error in getting a governance proposal
+var ErrGetGovernanceProposal = errors.New("get governance proposal error")
+
+// ErrGetDelegationSnapshot signals an error in getting the snapshot of a delegation contract
+var ErrGetDelegationSnapshot = errors.New("get delegation snapshot error")
+
+// ErrGetRewardsBreakdown signals an error in getting the rewards breakdown
+var ErrGetRewardsBreakdown = errors.New("get rewards breakdown error")
+
+// ErrPreviewNodesShuffling signals an error in previewing the nodes shuffling
+var ErrPreviewNodesShuffling = errors.New("preview nodes shuffling error")
+
+// ErrGetConsensusGroups signals an error in getting the historical consensus groups
+var ErrGetConsensusGroups = errors.New("get consensus groups error")
+
+// ErrValidationEmptyProposalReference signals that an empty governance proposal reference was provided
+var ErrValidationEmptyProposalReference = errors.New("proposal reference is empty")
+
+// ErrGetESDTNoncesSupplies signals an error in getting the supplies of a collection's nonces
+var ErrGetESDTNoncesSupplies = errors.New("get esdt nonces supplies error")
+
 // ErrEmptyAddress signals that an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -70,6 +100,12 @@ var ErrInvalidBlockRound = errors.New("invalid block round")
 // ErrInvalidEpoch signals that an invalid epoch parameter was provided
 var ErrInvalidEpoch = errors.New("invalid epoch parameter")
 
+// ErrInvalidShardID signals that an invalid shard ID parameter was provided
+var ErrInvalidShardID = errors.New("invalid shard ID parameter")
+
+// ErrGetValidatorPerformance signals an error in getting the performance report of a validator
+var ErrGetValidatorPerformance = errors.New("get validator performance error")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
@@ -91,6 +127,9 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetPeersRatings signals that an error occurred while getting the peers ratings
+var ErrGetPeersRatings = errors.New("error getting peers ratings")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
@@ -123,3 +162,30 @@ var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
 
 // ErrGetGenesisNodes signals that an error happened when trying to feth genesis nodes config
 var ErrGetGenesisNodes = errors.New("getting genesis nodes failed")
+
+// ErrEmptyTLSFiles signals that TLS was enabled without providing the certificate or the key file
+var ErrEmptyTLSFiles = errors.New("empty TLS certificate or key file")
+
+// ErrInvalidTLSReloadInterval signals that an invalid TLS certificate reload interval was provided
+var ErrInvalidTLSReloadInterval = errors.New("invalid TLS certificate reload interval")
+
+// ErrRpcMethodNotFound signals that an unknown JSON-RPC method was requested
+var ErrRpcMethodNotFound = errors.New("method not found")
+
+// ErrRpcMethodDisabled signals that a JSON-RPC method mapped onto a closed REST route was requested
+var ErrRpcMethodDisabled = errors.New("method is disabled")
+
+// ErrRpcInvalidRequest signals that a request not conforming to the JSON-RPC 2.0 specification was received
+var ErrRpcInvalidRequest = errors.New("invalid JSON-RPC request")
+
+// ErrRpcParseError signals that the JSON-RPC request could not be parsed
+var ErrRpcParseError = errors.New("parse error")
+
+// ErrRpcInvalidParams signals that invalid parameters were provided to a JSON-RPC method
+var ErrRpcInvalidParams = errors.New("invalid params")
+
+// ErrRpcBatchTooLarge signals that a JSON-RPC batch holding too many requests was received
+var ErrRpcBatchTooLarge = errors.New("batch too large")
+
+// ErrNilRequestsThrottlers signals that nil requests throttlers were provided
+var ErrNilRequestsThrottlers = errors.New("nil requests throttlers")
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -16,6 +17,11 @@ import (
 	"gopkg.in/go-playground/validator.v8"
 )
 
+const (
+	apiKeysPackage   = "api-keys"
+	apiKeysUsagePath = "/usage"
+)
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
@@ -42,18 +48,31 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return errHandler("nil facade")
 	}
+	if check.IfNil(args.RequestsThrottlers) {
+		return errHandler("nil requests throttlers")
+	}
 
 	return nil
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRouteOpen(routesConfig, "log", "/log")
+}
+
+// isApiKeysUsageRouteEnabled returns true if the API keys usage route is open and the API keys are enabled. As the
+// usage of all the keys is exposed, the route is also required to be protected by the requests authentication
+func isApiKeysUsageRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return routesConfig.ApiKeys.Enabled && routesConfig.Auth.Enabled && isRouteOpen(routesConfig, apiKeysPackage, apiKeysUsagePath)
+}
+
+func isRouteOpen(routesConfig config.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == routeName && cfg.Open {
 			return true
 		}
 	}
@@ -103,3 +122,16 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerApiKeysUsageRoute(ws *gin.Engine, usageHandler apiKeysUsageHandler) {
+	ws.GET(fmt.Sprintf("/%s%s", apiKeysPackage, apiKeysUsagePath), func(c *gin.Context) {
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"usage": usageHandler.GetUsage()},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	})
+}
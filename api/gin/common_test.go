@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
 	"github.com/stretchr/testify/require"
@@ -23,6 +24,10 @@ func TestCommon_checkArgs(t *testing.T) {
 
 	args.Facade = initial.NewInitialNodeFacade("api interface", false)
 	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.RequestsThrottlers = &mock.RequestsThrottlersStub{}
+	err = checkArgs(args)
 	require.NoError(t, err)
 }
 
@@ -45,3 +50,24 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	}
 	require.True(t, isLogRouteEnabled(routesConfig))
 }
+
+func TestCommon_isApiKeysUsageRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			apiKeysPackage: {
+				Routes: []config.RouteConfig{
+					{Name: apiKeysUsagePath, Open: true},
+				},
+			},
+		},
+	}
+	require.False(t, isApiKeysUsageRouteEnabled(routesConfig))
+
+	routesConfig.ApiKeys.Enabled = true
+	require.False(t, isApiKeysUsageRouteEnabled(routesConfig))
+
+	routesConfig.Auth.Enabled = true
+	require.True(t, isApiKeysUsageRouteEnabled(routesConfig))
+}
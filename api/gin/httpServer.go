@@ -24,9 +24,15 @@ func NewHttpServer(server *http.Server) (*httpServer, error) {
 }
 
 // Start will handle the starting of the gin web server. This call is blocking and it should be
-// called on a go routine (different than the main one)
+// called on a go routine (different than the main one). If the server holds a TLS configuration, the requests will be
+// served over TLS, using the certificates provided by that configuration
 func (h *httpServer) Start() {
-	err := h.server.ListenAndServe()
+	var err error
+	if h.server.TLSConfig != nil {
+		err = h.server.ListenAndServeTLS("", "")
+	} else {
+		err = h.server.ListenAndServe()
+	}
 	if err != nil {
 		if err != http.ErrServerClosed {
 			log.Error("could not start webserver",
@@ -1,6 +1,9 @@
 package gin
 
-type resetHandler interface {
-	Reset()
+import "github.com/ElrondNetwork/elrond-go/api/middleware"
+
+type apiKeysUsageHandler interface {
+	GetUsage() map[string]map[string]middleware.ApiKeyGroupUsage
+	SaveUsage() error
 	IsInterfaceNil() bool
 }
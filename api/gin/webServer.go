@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/certificates"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -24,19 +25,23 @@ var log = logger.GetOrCreate("api/gin")
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
-	Facade          shared.FacadeHandler
-	ApiConfig       config.ApiRoutesConfig
-	AntiFloodConfig config.WebServerAntifloodConfig
+	Facade             shared.FacadeHandler
+	ApiConfig          config.ApiRoutesConfig
+	AntiFloodConfig    config.WebServerAntifloodConfig
+	RequestsThrottlers shared.RequestsThrottlersHandler
 }
 
 type webServer struct {
 	sync.RWMutex
-	facade          shared.FacadeHandler
-	apiConfig       config.ApiRoutesConfig
-	antiFloodConfig config.WebServerAntifloodConfig
-	httpServer      shared.HttpServerCloser
-	groups          map[string]shared.GroupHandler
-	cancelFunc      func()
+	facade             shared.FacadeHandler
+	apiConfig          config.ApiRoutesConfig
+	antiFloodConfig    config.WebServerAntifloodConfig
+	requestsThrottlers shared.RequestsThrottlersHandler
+	httpServer         shared.HttpServerCloser
+	groups             map[string]shared.GroupHandler
+	apiKeysUsage       apiKeysUsageHandler
+	ctx                context.Context
+	cancelFunc         func()
 }
 
 // NewGinWebServerHandler returns a new instance of webServer
@@ -47,9 +52,10 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 	}
 
 	gws := &webServer{
-		facade:          args.Facade,
-		antiFloodConfig: args.AntiFloodConfig,
-		apiConfig:       args.ApiConfig,
+		facade:             args.Facade,
+		antiFloodConfig:    args.AntiFloodConfig,
+		apiConfig:          args.ApiConfig,
+		requestsThrottlers: args.RequestsThrottlers,
 	}
 
 	return gws, nil
@@ -120,7 +126,15 @@ func (ws *webServer) StartHttpServer() error {
 	ws.registerRoutes(engine)
 
 	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+	if ws.apiConfig.TLS.Enabled {
+		// the context is created along with the middlewares, before the TLS config
+		server.TLSConfig, err = certificates.CreateTLSConfig(ws.ctx, ws.apiConfig.TLS)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface(), "TLS", ws.apiConfig.TLS.Enabled)
 	ws.httpServer, err = NewHttpServer(server)
 	if err != nil {
 		return err
@@ -181,6 +195,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	rpcGroup, err := groups.NewRpcGroup(ws.facade, ws.requestsThrottlers)
+	if err != nil {
+		return err
+	}
+	groupsMap["rpc"] = rpcGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -211,6 +231,12 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
 	}
 
+	if isApiKeysUsageRouteEnabled(ws.apiConfig) && !check.IfNil(ws.apiKeysUsage) {
+		registerApiKeysUsageRoute(ginRouter, ws.apiKeysUsage)
+	} else if ws.apiConfig.ApiKeys.Enabled && !ws.apiConfig.Auth.Enabled {
+		log.Warn("the API keys usage route is not registered because the API requests authentication is disabled")
+	}
+
 	if isLogRouteEnabled(ws.apiConfig) {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
@@ -229,42 +255,69 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
-	sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
-	if err != nil {
-		return nil, err
-	}
-
-	var ctx context.Context
-	ctx, ws.cancelFunc = context.WithCancel(context.Background())
+	ws.ctx, ws.cancelFunc = context.WithCancel(context.Background())
 
-	go ws.sourceLimiterReset(ctx, sourceLimiter)
+	// the API keys throttler goes first, as the requests carrying a known key are only limited by the budgets of
+	// that key and not by the source throttler
+	if ws.apiConfig.ApiKeys.Enabled {
+		apiKeyThrottler, errCreate := middleware.NewApiKeyThrottler(middleware.ArgsApiKeyThrottler{
+			Config: ws.apiConfig.ApiKeys,
+		})
+		if errCreate != nil {
+			return nil, errCreate
+		}
 
-	middlewares = append(middlewares, sourceLimiter)
+		ws.apiKeysUsage = apiKeyThrottler
+		go ws.apiKeysUsagePersister(ws.ctx)
 
-	globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
-	if err != nil {
-		return nil, err
+		middlewares = append(middlewares, apiKeyThrottler)
 	}
 
-	middlewares = append(middlewares, globalLimiter)
+	// the throttlers are shared with the gRPC API, so the limits apply to the requests of both APIs together
+	middlewares = append(middlewares, ws.requestsThrottlers.SourceThrottler())
+	middlewares = append(middlewares, ws.requestsThrottlers.GlobalThrottler())
+
+	if ws.apiConfig.Auth.Enabled {
+		routeAuthenticator, errCreate := middleware.NewRouteAuthenticator(middleware.ArgsRouteAuthenticator{
+			AuthConfig:  ws.apiConfig.Auth,
+			APIPackages: ws.apiConfig.APIPackages,
+		})
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		middlewares = append(middlewares, routeAuthenticator)
+	} else {
+		log.Debug("API requests authentication is disabled, the roles of the routes will not be enforced")
+	}
 
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+func (ws *webServer) apiKeysUsagePersister(ctx context.Context) {
+	if ws.apiConfig.ApiKeys.UsagePersistIntervalInSec == 0 {
+		return
+	}
+
+	betweenPersistsDuration := time.Second * time.Duration(ws.apiConfig.ApiKeys.UsagePersistIntervalInSec)
 	for {
 		select {
-		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
-			reset.Reset()
+		case <-time.After(betweenPersistsDuration):
+			ws.saveApiKeysUsage()
 		case <-ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing webServer.apiKeysUsagePersister go routine")
 			return
 		}
 	}
 }
 
+func (ws *webServer) saveApiKeysUsage() {
+	err := ws.apiKeysUsage.SaveUsage()
+	if err != nil {
+		log.Warn("could not save the API keys usage", "error", err.Error())
+	}
+}
+
 // Close will handle the closing of inner components
 func (ws *webServer) Close() error {
 	if ws.cancelFunc != nil {
@@ -272,6 +325,9 @@ func (ws *webServer) Close() error {
 	}
 
 	ws.Lock()
+	if !check.IfNil(ws.apiKeysUsage) {
+		ws.saveApiKeysUsage()
+	}
 	err := ws.httpServer.Close()
 	ws.Unlock()
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,21 +14,23 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getAccountPath            = "/:address"
-	getBalancePath            = "/:address/balance"
-	getUsernamePath           = "/:address/username"
-	getKeysPath               = "/:address/keys"
-	getKeyPath                = "/:address/key/:key"
-	getESDTTokensPath         = "/:address/esdt"
-	getESDTBalancePath        = "/:address/esdt/:tokenIdentifier"
-	getESDTTokensWithRolePath = "/:address/esdts-with-role/:role"
-	getESDTsRolesPath         = "/:address/esdts/roles"
-	getRegisteredNFTsPath     = "/:address/registered-nfts"
-	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getAccountPath             = "/:address"
+	getBalancePath             = "/:address/balance"
+	getUsernamePath            = "/:address/username"
+	getKeysPath                = "/:address/keys"
+	getKeyPath                 = "/:address/key/:key"
+	getESDTTokensPath          = "/:address/esdt"
+	getESDTBalancePath         = "/:address/esdt/:tokenIdentifier"
+	getESDTTokensWithRolePath  = "/:address/esdts-with-role/:role"
+	getESDTsRolesPath          = "/:address/esdts/roles"
+	getRegisteredNFTsPath      = "/:address/registered-nfts"
+	getESDTNFTDataPath         = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getAccountTransactionsPath = "/:address/transactions"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -42,6 +45,7 @@ type addressFacadeHandler interface {
 	GetESDTsWithRole(address string, role string) ([]string, error)
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
 	GetKeyValuePairs(address string) (map[string]string, error)
+	GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -137,6 +141,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getAccountTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountTransactions,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -673,6 +682,92 @@ func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalT
 	return tokenData
 }
 
+// getAccountTransactions returns the indexed transactions of the given address, most recent first
+func (ag *addressGroup) getAccountTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getAccountTransactionsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	response, err := ag.getFacade().GetAccountTransactions(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAccountTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  response,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getAccountTransactionsQueryOptions(c *gin.Context) (common.AccountTransactionsQueryOptions, error) {
+	options := common.AccountTransactionsQueryOptions{}
+
+	page, err := getQueryParamUint64(c, "page", 32)
+	if err != nil {
+		return options, err
+	}
+	size, err := getQueryParamUint64(c, "size", 32)
+	if err != nil {
+		return options, err
+	}
+
+	options.Page = uint32(page)
+	options.Size = uint32(size)
+	options.FromNonce, err = getQueryParamUint64(c, "fromNonce", 64)
+	if err != nil {
+		return options, err
+	}
+	options.ToNonce, err = getQueryParamUint64(c, "toNonce", 64)
+	if err != nil {
+		return options, err
+	}
+	options.FromTimestamp, err = getQueryParamUint64(c, "fromTimestamp", 64)
+	if err != nil {
+		return options, err
+	}
+	options.ToTimestamp, err = getQueryParamUint64(c, "toTimestamp", 64)
+
+	return options, err
+}
+
+func getQueryParamUint64(c *gin.Context, name string, bitSize int) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(valueStr, 10, bitSize)
+}
+
 func (ag *addressGroup) getFacade() addressFacadeHandler {
 	ag.mutFacade.RLock()
 	defer ag.mutFacade.RUnlock()
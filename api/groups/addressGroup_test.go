@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -913,6 +914,96 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetAccountTransactions_InvalidQueryParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions?page=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetAccountTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAccountTransactionsCalled: func(_ string, _ common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAccountTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedOptions := common.AccountTransactionsQueryOptions{
+		Page:          2,
+		Size:          10,
+		FromNonce:     5,
+		ToNonce:       50,
+		FromTimestamp: 100,
+		ToTimestamp:   1000,
+	}
+	expectedResponse := &common.AccountTransactionsAPIResponse{
+		Transactions: []*common.AccountTransactionAPIResponse{
+			{Hash: "aa", Type: "normal", Roles: []string{"sender"}, BlockNonce: 7},
+		},
+		NumTransactions: 21,
+	}
+	facade := mock.FacadeStub{
+		GetAccountTransactionsCalled: func(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+			require.Equal(t, testAddress, address)
+			require.Equal(t, expectedOptions, options)
+			return expectedResponse, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	url := fmt.Sprintf("/address/%s/transactions?page=2&size=10&fromNonce=5&toNonce=50&fromTimestamp=100&toTimestamp=1000", testAddress)
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data  common.AccountTransactionsAPIResponse `json:"data"`
+		Error string                                `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedResponse, response.Data)
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -976,6 +1067,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -10,7 +10,7 @@ const ExecBroadcastTrigger = execBroadcastTrigger
 
 // CreateSCQuery -
 func (vvg *vmValuesGroup) CreateSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	return vvg.createSCQuery(request)
+	return createSCQuery(vvg.getFacade(), request)
 }
 
 // VmValuesFacadeHandler exported the vm values facade handler interface for testing purposes
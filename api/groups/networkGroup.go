@@ -18,19 +18,24 @@ import (
 )
 
 const (
-	getConfigPath          = "/config"
-	getStatusPath          = "/status"
-	economicsPath          = "/economics"
-	enableEpochsPath       = "/enable-epochs"
-	getESDTsPath           = "/esdts"
-	getFFTsPath            = "/esdt/fungible-tokens"
-	getSFTsPath            = "/esdt/semi-fungible-tokens"
-	getNFTsPath            = "/esdt/non-fungible-tokens"
-	getESDTSupplyPath      = "/esdt/supply/:token"
-	directStakedInfoPath   = "/direct-staked-info"
-	delegatedInfoPath      = "/delegated-info"
-	ratingsPath            = "/ratings"
-	genesisNodesConfigPath = "/genesis-nodes"
+	getConfigPath           = "/config"
+	getStatusPath           = "/status"
+	economicsPath           = "/economics"
+	enableEpochsPath        = "/enable-epochs"
+	getESDTsPath            = "/esdts"
+	getFFTsPath             = "/esdt/fungible-tokens"
+	getSFTsPath             = "/esdt/semi-fungible-tokens"
+	getNFTsPath             = "/esdt/non-fungible-tokens"
+	getESDTSupplyPath       = "/esdt/supply/:token"
+	getESDTNoncesPath       = "/esdt/:token/nonces"
+	getESDTHoldersPath      = "/esdt/holders/:token"
+	governanceProposalsPath = "/governance/proposals"
+	governanceProposalPath  = "/governance/proposals/:id"
+	delegationSnapshotPath  = "/delegation/:contract"
+	directStakedInfoPath    = "/direct-staked-info"
+	delegatedInfoPath       = "/delegated-info"
+	ratingsPath             = "/ratings"
+	genesisNodesConfigPath  = "/genesis-nodes"
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -38,9 +43,14 @@ type networkFacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetTokenHolders(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshot(contractAddress string) (*common.DelegationSnapshotAPIResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error)
+	GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	IsInterfaceNil() bool
 }
@@ -124,6 +134,31 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
 		},
+		{
+			Path:    getESDTNoncesPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTNoncesSupplies,
+		},
+		{
+			Path:    getESDTHoldersPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTHolders,
+		},
+		{
+			Path:    governanceProposalsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposals,
+		},
+		{
+			Path:    governanceProposalPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposal,
+		},
+		{
+			Path:    delegationSnapshotPath,
+			Method:  http.MethodGet,
+			Handler: ng.getDelegationSnapshot,
+		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
@@ -364,6 +399,188 @@ func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	)
 }
 
+// getESDTNoncesSupplies returns the supplies of a collection's nonces, page by page
+func (ng *networkGroup) getESDTNoncesSupplies(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	page, err := getQueryParamUint64(c, "page", 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+	size, err := getQueryParamUint64(c, "size", 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	response, err := ng.getFacade().GetTokenNoncesSupplies(token, uint32(page), uint32(size))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNoncesSupplies.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  response,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getESDTHolders returns the holders of a token (or of a token's nonce, if provided) and their balances
+func (ng *networkGroup) getESDTHolders(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	nonce, err := getQueryParamUint64(c, "nonce", 64)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	response, err := ng.getFacade().GetTokenHolders(token, nonce)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTokenHolders.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  response,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceProposals returns the governance proposals along with their tallies and voters
+func (ng *networkGroup) getGovernanceProposals(c *gin.Context) {
+	proposals, err := ng.getFacade().GetGovernanceProposals()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposals.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposals": proposals},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceProposal returns the governance proposal identified by its commit hash or, for whitelist proposals, by
+// the address of the proposer
+func (ng *networkGroup) getGovernanceProposal(c *gin.Context) {
+	reference := c.Param("id")
+	if reference == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyProposalReference.Error()),
+		)
+		return
+	}
+
+	proposal, err := ng.getFacade().GetGovernanceProposal(reference)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposal.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposal": proposal},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getDelegationSnapshot returns the decoded snapshot of a delegation contract: configuration, service fee history,
+// nodes, totals, delegators with their stakes and claimable rewards and the rewards history
+func (ng *networkGroup) getDelegationSnapshot(c *gin.Context) {
+	contract := c.Param("contract")
+	if contract == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	snapshot, err := ng.getFacade().GetDelegationSnapshot(contract)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetDelegationSnapshot.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"snapshot": snapshot},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // getRatingsConfig returns metrics related to ratings configuration
 func (ng *networkGroup) getRatingsConfig(c *gin.Context) {
 	ratingsConfig, err := ng.getFacade().StatusMetrics().RatingsMetrics()
@@ -555,7 +555,7 @@ func TestGetESDTTotalSupply_InternalError(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetTokenSupplyCalled: func(token string) (*api.ESDTSupply, error) {
+		GetTokenSupplyCalled: func(token string) (*common.ESDTSupplyAPIResponse, error) {
 			return nil, expectedErr
 		},
 	}
@@ -643,16 +643,20 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	t.Parallel()
 
 	type supplyResponse struct {
-		Data *api.ESDTSupply `json:"data"`
+		Data *common.ESDTSupplyAPIResponse `json:"data"`
 	}
 
+	expectedSupply := &common.ESDTSupplyAPIResponse{
+		Supply:               "900",
+		Burned:               "500",
+		Minted:               "1500",
+		Wiped:                "100",
+		NumHolders:           7,
+		LastUpdateBlockNonce: 37,
+	}
 	facade := mock.FacadeStub{
-		GetTokenSupplyCalled: func(token string) (*api.ESDTSupply, error) {
-			return &api.ESDTSupply{
-				Supply: "1000",
-				Burned: "500",
-				Minted: "1500",
-			}, nil
+		GetTokenSupplyCalled: func(token string) (*common.ESDTSupplyAPIResponse, error) {
+			return expectedSupply, nil
 		},
 	}
 
@@ -672,11 +676,461 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	err = json.Unmarshal(respBytes, respSupply)
 	require.Nil(t, err)
 
-	require.Equal(t, &supplyResponse{Data: &api.ESDTSupply{
-		Supply: "1000",
-		Burned: "500",
-		Minted: "1500",
-	}}, respSupply)
+	require.Equal(t, &supplyResponse{Data: expectedSupply}, respSupply)
+}
+
+type esdtNoncesSuppliesResponse struct {
+	Data  *common.ESDTNoncesSuppliesAPIResponse `json:"data"`
+	Error string                                `json:"error"`
+	Code  string                                `json:"code"`
+}
+
+func TestGetESDTNoncesSupplies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query parameter, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/NFT-abcdef/nonces?page=-1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtNoncesSuppliesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTokenNoncesSuppliesCalled: func(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/NFT-abcdef/nonces", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtNoncesSuppliesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTNoncesSupplies.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &common.ESDTNoncesSuppliesAPIResponse{
+			Nonces: []*common.ESDTNonceSupplyAPIResponse{
+				{
+					Nonce: 3,
+					ESDTSupplyAPIResponse: common.ESDTSupplyAPIResponse{
+						Supply:     "10",
+						Minted:     "10",
+						Burned:     "0",
+						Wiped:      "0",
+						NumHolders: 1,
+					},
+				},
+			},
+			NumNonces: 21,
+		}
+		facade := mock.FacadeStub{
+			GetTokenNoncesSuppliesCalled: func(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+				assert.Equal(t, "NFT-abcdef", collection)
+				assert.Equal(t, uint32(2), page)
+				assert.Equal(t, uint32(10), size)
+
+				return expectedResponse, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/NFT-abcdef/nonces?page=2&size=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtNoncesSuppliesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResponse, response.Data)
+	})
+}
+
+type tokenHoldersResponse struct {
+	Data  *common.TokenHoldersAPIResponse `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/TKN-abcdef?nonce=abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTokenHoldersCalled: func(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/TKN-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTokenHolders.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &common.TokenHoldersAPIResponse{
+			Token:    "NFT-abcdef",
+			Nonce:    5,
+			RootHash: "aabb",
+			Holders: []*common.TokenHolderAPIResponse{
+				{Address: "erd1alice", Balance: "10"},
+				{Address: "erd1bob", Balance: "3"},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTokenHoldersCalled: func(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error) {
+				assert.Equal(t, "NFT-abcdef", tokenIdentifier)
+				assert.Equal(t, uint64(5), nonce)
+
+				return expectedResponse, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/NFT-abcdef?nonce=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResponse, response.Data)
+	})
+}
+
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*common.GovernanceProposalAPIResponse `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *common.GovernanceProposalAPIResponse `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposals.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProposals := []*common.GovernanceProposalAPIResponse{
+			{
+				Reference:       "3b5d1fe4fbbd4ab3d4bbd0d8a1a70bb3ab0c9a5e",
+				CommitHash:      "3b5d1fe4fbbd4ab3d4bbd0d8a1a70bb3ab0c9a5e",
+				Issuer:          "erd1alice",
+				StartVoteNonce:  10,
+				EndVoteNonce:    100,
+				RemainingRounds: 40,
+				Yes:             "50",
+				No:              "10",
+				Veto:            "0",
+				TotalVotes:      "60",
+				QuorumReached:   true,
+				Voters: []*common.GovernanceVoterAPIResponse{
+					{
+						Address:   "erd1bob",
+						UsedPower: "50",
+						TotalYes:  "50",
+						TotalNo:   "0",
+						TotalVeto: "0",
+						Votes: []*common.GovernanceVoteAPIResponse{
+							{Value: "yes", Power: "50", Balance: "2500"},
+						},
+					},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposalAPIResponse, error) {
+				return expectedProposals, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedProposals, response.Data.Proposals)
+	})
+}
+
+func TestGetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposal.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProposal := &common.GovernanceProposalAPIResponse{
+			Reference:  "erd1alice",
+			CommitHash: "3b5d1fe4fbbd4ab3d4bbd0d8a1a70bb3ab0c9a5e",
+			Issuer:     "erd1alice",
+			Passed:     true,
+			Closed:     true,
+			Voters:     []*common.GovernanceVoterAPIResponse{},
+		}
+		facade := mock.FacadeStub{
+			GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposalAPIResponse, error) {
+				assert.Equal(t, "erd1alice", reference)
+
+				return expectedProposal, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedProposal, response.Data.Proposal)
+	})
+}
+
+type delegationSnapshotResponse struct {
+	Data struct {
+		Snapshot *common.DelegationSnapshotAPIResponse `json:"snapshot"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetDelegationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetDelegationSnapshotCalled: func(contractAddress string) (*common.DelegationSnapshotAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/erd1contract", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationSnapshotResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationSnapshot.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSnapshot := &common.DelegationSnapshotAPIResponse{
+			Contract:   "erd1contract",
+			Epoch:      10,
+			Owner:      "erd1owner",
+			ServiceFee: 1000,
+			Config: &common.DelegationConfigAPIResponse{
+				MaxDelegationCap:     "0",
+				InitialOwnerFunds:    "1250",
+				UnBondPeriodInEpochs: 10,
+			},
+			ServiceFeeHistory: []*common.DelegationServiceFeeAPIResponse{{Epoch: 2, ServiceFee: 1000}},
+			Nodes:             []*common.DelegationNodeAPIResponse{{BLSKey: "abcd", Status: "staked"}},
+			NumUsers:          1,
+			TotalActiveStake:  "1250",
+			TotalUnStaked:     "0",
+			TotalUnBondable:   "0",
+			Delegators: []*common.DelegationDelegatorAPIResponse{
+				{
+					Address:               "erd1owner",
+					ActiveStake:           "1250",
+					UnStaked:              "0",
+					UnBondable:            "0",
+					ClaimableRewards:      "12",
+					TotalCumulatedRewards: "20",
+				},
+			},
+			RewardsHistory: []*common.DelegationRewardAPIResponse{
+				{Epoch: 2, RewardsToDistribute: "12", TotalActive: "1250", ServiceFee: 1000},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetDelegationSnapshotCalled: func(contractAddress string) (*common.DelegationSnapshotAPIResponse, error) {
+				assert.Equal(t, "erd1contract", contractAddress)
+
+				return expectedSnapshot, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/erd1contract", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationSnapshotResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedSnapshot, response.Data.Snapshot)
+	})
 }
 
 func TestGetGenesisNodes(t *testing.T) {
@@ -761,6 +1215,11 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/esdt/:token/nonces", Open: true},
+					{Name: "/esdt/holders/:token", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposals/:id", Open: true},
+					{Name: "/delegation/:contract", Open: true},
 					{Name: "/genesis-nodes", Open: true},
 					{Name: "/ratings", Open: true},
 				},
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -22,6 +23,7 @@ const (
 	metricsPath         = "/metrics"
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
+	peersRatingsPath    = "/peers/ratings"
 	statusPath          = "/status"
 )
 
@@ -31,6 +33,8 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatings() (map[string]int32, error)
+	GetManagedKeysStatus() []*common.ManagedKeyStatus
 	IsInterfaceNil() bool
 }
 
@@ -88,6 +92,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    peersRatingsPath,
+			Method:  http.MethodGet,
+			Handler: ng.peersRatings,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -138,7 +147,10 @@ func (ng *nodeGroup) statusMetrics(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"metrics": metrics},
+			Data: gin.H{
+				"metrics":     metrics,
+				"managedKeys": nodeFacade.GetManagedKeysStatus(),
+			},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -241,6 +253,31 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// peersRatings returns the ratings of the known peers
+func (ng *nodeGroup) peersRatings(c *gin.Context) {
+	ratings, err := ng.getFacade().GetPeersRatings()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPeersRatings.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"ratings": ratings},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -217,6 +218,48 @@ func TestStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	assert.False(t, strings.Contains(respStr, p2pKey))
 }
 
+func TestStatusMetrics_ShouldDisplayManagedKeysStatus(t *testing.T) {
+	managedKeysStatus := []*common.ManagedKeyStatus{
+		{
+			PublicKey:            "pk1",
+			NumRoundsInConsensus: 10,
+			NumProposedBlocks:    2,
+		},
+		{
+			PublicKey:     "pk2",
+			NumSignatures: 7,
+		},
+	}
+	facade := mock.FacadeStub{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusHandler.NewStatusMetrics()
+	}
+	facade.GetManagedKeysStatusCalled = func() []*common.ManagedKeyStatus {
+		return managedKeysStatus
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/status", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type managedKeysResponse struct {
+		Data struct {
+			ManagedKeys []*common.ManagedKeyStatus `json:"managedKeys"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	response := &managedKeysResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, managedKeysStatus, response.Data.ManagedKeys)
+}
+
 func TestP2PStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-details-key"
@@ -387,6 +430,62 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestPeersRatings_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetPeersRatingsCalled: func() (map[string]int32, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/peers/ratings", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestPeersRatings_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetPeersRatingsCalled: func() (map[string]int32, error) {
+			return map[string]int32{"pid1": 10, "pid2": -3}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/peers/ratings", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	expectedRatings := map[string]interface{}{"pid1": float64(10), "pid2": float64(-3)}
+	assert.Equal(t, expectedRatings, responseData["ratings"])
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -466,6 +565,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/peers/ratings", Open: true},
 				},
 			},
 		},
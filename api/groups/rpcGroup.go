@@ -0,0 +1,639 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// the JSON-RPC requests are served directly on the group's path, /rpc
+	rpcPath    = ""
+	rpcPackage = "rpc"
+	rpcVersion = "2.0"
+)
+
+// the error codes defined by the JSON-RPC 2.0 specification. The -32000 to -32099 range is reserved for the server errors
+const (
+	rpcCodeParseError      = -32700
+	rpcCodeInvalidRequest  = -32600
+	rpcCodeMethodNotFound  = -32601
+	rpcCodeInvalidParams   = -32602
+	rpcCodeInternalError   = -32603
+	rpcCodeTooManyRequests = -32000
+)
+
+// rpcFacadeHandler defines the methods to be implemented by a facade for JSON-RPC requests
+type rpcFacadeHandler interface {
+	GetAccount(address string) (api.AccountResponse, error)
+	GetBalance(address string) (*big.Int, error)
+	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type rpcMethodHandler func(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError)
+
+// rpcMethod maps a JSON-RPC method onto the REST route exposing the same data, so the method follows the route's
+// open/closed state and role
+type rpcMethod struct {
+	packageName string
+	route       string
+	handler     rpcMethodHandler
+}
+
+var rpcMethods = map[string]rpcMethod{
+	"address_getAccount": {packageName: "address", route: getAccountPath, handler: rpcGetAccount},
+	"address_getBalance": {packageName: "address", route: getBalancePath, handler: rpcGetBalance},
+	"tx_send":            {packageName: "transaction", route: sendTransactionPath, handler: rpcSendTransaction},
+	"tx_get":             {packageName: "transaction", route: getTransactionPath, handler: rpcGetTransaction},
+	"block_byNonce":      {packageName: "block", route: getBlockByNoncePath, handler: rpcGetBlockByNonce},
+	"block_byHash":       {packageName: "block", route: getBlockByHashPath, handler: rpcGetBlockByHash},
+	"vm_query":           {packageName: "vm-values", route: queryPath, handler: rpcQueryVmValues},
+	"network_getConfig":  {packageName: "network", route: getConfigPath, handler: rpcGetNetworkConfig},
+	"network_getStatus":  {packageName: "network", route: getStatusPath, handler: rpcGetNetworkStatus},
+}
+
+// RpcRequest represents a JSON-RPC 2.0 request. A request without id is a notification and receives no response
+type RpcRequest struct {
+	JsonRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// RpcError represents the error object of a JSON-RPC 2.0 response
+type RpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// RpcResponse represents a JSON-RPC 2.0 response
+type RpcResponse struct {
+	JsonRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RpcError       `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type rpcGroup struct {
+	*baseGroup
+	facade             rpcFacadeHandler
+	mutFacade          sync.RWMutex
+	requestsThrottlers shared.RequestsThrottlersHandler
+	enabledMethods     map[string]struct{}
+	maxBatchSize       int
+	mutMethods         sync.RWMutex
+}
+
+// NewRpcGroup returns a new instance of rpcGroup. The requests throttlers are the ones used by the middlewares, as the
+// requests of a batch are accounted separately
+func NewRpcGroup(facade rpcFacadeHandler, requestsThrottlers shared.RequestsThrottlersHandler) (*rpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for rpc group", errors.ErrNilFacadeHandler)
+	}
+	if check.IfNil(requestsThrottlers) {
+		return nil, fmt.Errorf("%w for rpc group", errors.ErrNilRequestsThrottlers)
+	}
+
+	rg := &rpcGroup{
+		facade:             facade,
+		baseGroup:          &baseGroup{},
+		requestsThrottlers: requestsThrottlers,
+		enabledMethods:     make(map[string]struct{}),
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    rpcPath,
+			Method:  http.MethodPost,
+			Handler: rg.handleRequests,
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// RegisterRoutes will register the JSON-RPC endpoint and will enable the methods whose REST routes are open
+func (rg *rpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	rg.mutMethods.Lock()
+	rg.enabledMethods = getEnabledRpcMethods(apiConfig)
+	rg.maxBatchSize = int(apiConfig.RPC.MaxBatchSize)
+	rg.mutMethods.Unlock()
+
+	rg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// getEnabledRpcMethods returns the methods mapped onto open REST routes. When the authentication is enabled, the
+// methods whose REST routes require a higher role than the /rpc route are disabled as well, as only the role of the
+// /rpc route is checked by the authentication middleware
+func getEnabledRpcMethods(apiConfig config.ApiRoutesConfig) map[string]struct{} {
+	rpcRoute, _ := getRouteConfig(apiConfig, rpcPackage, rpcPath)
+
+	enabledMethods := make(map[string]struct{})
+	for name, method := range rpcMethods {
+		route, found := getRouteConfig(apiConfig, method.packageName, method.route)
+		if !found || !route.Open {
+			log.Debug("rpc method is disabled", "method", name)
+			continue
+		}
+		if apiConfig.Auth.Enabled && !middleware.IsRoleCovered(rpcRoute.Role, route.Role) {
+			log.Debug("rpc method is disabled as it requires a higher role", "method", name, "role", route.Role)
+			continue
+		}
+
+		enabledMethods[name] = struct{}{}
+	}
+
+	return enabledMethods
+}
+
+func getRouteConfig(apiConfig config.ApiRoutesConfig, packageName string, routeName string) (config.RouteConfig, bool) {
+	for _, route := range apiConfig.APIPackages[packageName].Routes {
+		if route.Name == routeName {
+			return route, true
+		}
+	}
+
+	return config.RouteConfig{}, false
+}
+
+// handleRequests will process a single JSON-RPC request or a batch of requests
+func (rg *rpcGroup) handleRequests(c *gin.Context) {
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusOK, newRpcErrorResponse(nil, rpcCodeParseError, errors.ErrRpcParseError, err))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+	if !isBatch {
+		response := rg.processRequest(body)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var rawRequests []json.RawMessage
+	err = json.Unmarshal(body, &rawRequests)
+	if err != nil {
+		c.JSON(http.StatusOK, newRpcErrorResponse(nil, rpcCodeParseError, errors.ErrRpcParseError, err))
+		return
+	}
+	if len(rawRequests) == 0 {
+		c.JSON(http.StatusOK, newRpcErrorResponse(nil, rpcCodeInvalidRequest, errors.ErrRpcInvalidRequest, nil))
+		return
+	}
+
+	rg.mutMethods.RLock()
+	maxBatchSize := rg.maxBatchSize
+	rg.mutMethods.RUnlock()
+	if len(rawRequests) > maxBatchSize {
+		err = fmt.Errorf("%w: maximum %d requests", errors.ErrRpcBatchTooLarge, maxBatchSize)
+		c.JSON(http.StatusOK, newRpcErrorResponse(nil, rpcCodeInvalidRequest, errors.ErrRpcInvalidRequest, err))
+		return
+	}
+
+	endProcessing, err := rg.startBatchProcessing(c, len(rawRequests))
+	if err != nil {
+		c.JSON(http.StatusTooManyRequests, newRpcErrorResponse(nil, rpcCodeTooManyRequests, errors.ErrTooManyRequests, err))
+		return
+	}
+	defer endProcessing()
+
+	responses := make([]*RpcResponse, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		response := rg.processRequest(rawRequest)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// startBatchProcessing accounts the requests of a batch in the API key budgets or in the source limit, and in the
+// simultaneous requests limit, as the middlewares only accounted the batch as a single request. It returns the
+// function that should be called when the processing of the batch ends
+func (rg *rpcGroup) startBatchProcessing(c *gin.Context, numRequests int) (func(), error) {
+	numAdditionalRequests := numRequests - 1
+
+	// the requests authorized by an API key are only limited by the budgets of that key
+	value, _ := c.Get(middleware.ApiKeyRequestsKey)
+	apiKeyRequests, hasApiKey := value.(shared.ApiKeyRequestsHandler)
+	abortApiKeyProcessing := func(numStarted int) {
+		for i := 0; i < numStarted; i++ {
+			apiKeyRequests.AbortProcessing()
+		}
+	}
+	if hasApiKey {
+		for i := 0; i < numAdditionalRequests; i++ {
+			err := apiKeyRequests.StartProcessing()
+			if err != nil {
+				abortApiKeyProcessing(i)
+				return nil, fmt.Errorf("%w for the API key of a batch of %d requests", err, numRequests)
+			}
+		}
+	} else {
+		source, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceThrottler := rg.requestsThrottlers.SourceThrottler()
+		for i := 0; i < numAdditionalRequests; i++ {
+			if !sourceThrottler.CanProcessRequest(source) {
+				return nil, fmt.Errorf("for address %s", source)
+			}
+		}
+	}
+
+	path := c.Request.URL.Path
+	globalThrottler := rg.requestsThrottlers.GlobalThrottler()
+	endProcessing := func(numStarted int) {
+		for i := 0; i < numStarted; i++ {
+			globalThrottler.EndProcessing(path)
+		}
+	}
+	for i := 0; i < numAdditionalRequests; i++ {
+		if !globalThrottler.StartProcessing(path) {
+			endProcessing(i)
+			if hasApiKey {
+				abortApiKeyProcessing(numAdditionalRequests)
+			}
+			return nil, fmt.Errorf("for a batch of %d requests", numRequests)
+		}
+	}
+
+	return func() {
+		endProcessing(numAdditionalRequests)
+		if hasApiKey {
+			for i := 0; i < numAdditionalRequests; i++ {
+				apiKeyRequests.EndProcessing()
+			}
+		}
+	}, nil
+}
+
+// processRequest executes the provided request and returns its response, or nil if the request is a notification
+func (rg *rpcGroup) processRequest(rawRequest json.RawMessage) *RpcResponse {
+	request := RpcRequest{}
+	err := json.Unmarshal(rawRequest, &request)
+	if err != nil {
+		if !json.Valid(rawRequest) {
+			return newRpcErrorResponse(nil, rpcCodeParseError, errors.ErrRpcParseError, err)
+		}
+
+		return newRpcErrorResponse(nil, rpcCodeInvalidRequest, errors.ErrRpcInvalidRequest, err)
+	}
+	if request.JsonRPC != rpcVersion || len(request.Method) == 0 {
+		return newRpcErrorResponse(request.ID, rpcCodeInvalidRequest, errors.ErrRpcInvalidRequest, nil)
+	}
+
+	result, rpcErr := rg.executeMethod(request.Method, request.Params)
+
+	isNotification := len(request.ID) == 0
+	if isNotification {
+		return nil
+	}
+	if rpcErr != nil {
+		return &RpcResponse{
+			JsonRPC: rpcVersion,
+			Error:   rpcErr,
+			ID:      request.ID,
+		}
+	}
+
+	return &RpcResponse{
+		JsonRPC: rpcVersion,
+		Result:  result,
+		ID:      request.ID,
+	}
+}
+
+func (rg *rpcGroup) executeMethod(name string, params json.RawMessage) (interface{}, *RpcError) {
+	method, found := rpcMethods[name]
+	if !found {
+		return nil, newRpcError(rpcCodeMethodNotFound, errors.ErrRpcMethodNotFound, fmt.Errorf("%s", name))
+	}
+
+	rg.mutMethods.RLock()
+	_, isEnabled := rg.enabledMethods[name]
+	rg.mutMethods.RUnlock()
+	if !isEnabled {
+		return nil, newRpcError(rpcCodeMethodNotFound, errors.ErrRpcMethodDisabled, fmt.Errorf("%s", name))
+	}
+
+	return method.handler(rg.getFacade(), params)
+}
+
+func rpcGetAccount(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := struct {
+		Address string `json:"address"`
+	}{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(request.Address) == 0 {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrCouldNotGetAccount, errors.ErrEmptyAddress)
+	}
+
+	account, err := facade.GetAccount(request.Address)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrCouldNotGetAccount, err)
+	}
+
+	return gin.H{"account": account}, nil
+}
+
+func rpcGetBalance(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := struct {
+		Address string `json:"address"`
+	}{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(request.Address) == 0 {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrGetBalance, errors.ErrEmptyAddress)
+	}
+
+	balance, err := facade.GetBalance(request.Address)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrGetBalance, err)
+	}
+
+	return gin.H{"balance": balance.String()}, nil
+}
+
+func rpcSendTransaction(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := SendTxRequest{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	endProcessing, rpcErr := startRpcEndpointProcessing(facade, sendTransactionEndpoint)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	defer endProcessing()
+
+	tx, txHash, err := facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.ReceiverUsername,
+		request.Sender,
+		request.SenderUsername,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrTxGenerationFailed, err)
+	}
+
+	err = facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrTxGenerationFailed, err)
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, &RpcError{Code: rpcCodeInternalError, Message: err.Error()}
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func rpcGetTransaction(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := struct {
+		Hash        string `json:"hash"`
+		WithResults bool   `json:"withResults"`
+	}{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(request.Hash) == 0 {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+	}
+
+	endProcessing, rpcErr := startRpcEndpointProcessing(facade, getTransactionEndpoint)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	defer endProcessing()
+
+	tx, err := facade.GetTransaction(request.Hash, request.WithResults)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func rpcGetBlockByNonce(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := struct {
+		Nonce   uint64 `json:"nonce"`
+		WithTxs bool   `json:"withTxs"`
+	}{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	block, err := facade.GetBlockByNonce(request.Nonce, request.WithTxs)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func rpcGetBlockByHash(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := struct {
+		Hash    string `json:"hash"`
+		WithTxs bool   `json:"withTxs"`
+	}{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(request.Hash) == 0 {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrValidation, errors.ErrValidationEmptyBlockHash)
+	}
+
+	block, err := facade.GetBlockByHash(request.Hash, request.WithTxs)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func rpcQueryVmValues(facade rpcFacadeHandler, params json.RawMessage) (interface{}, *RpcError) {
+	request := VMValueRequest{}
+	rpcErr := decodeRpcParams(params, &request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	scQuery, err := createSCQuery(facade, &request)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInvalidParams, errors.ErrQueryError, err)
+	}
+
+	vmOutput, err := facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, newRpcError(rpcCodeInternalError, errors.ErrQueryError, err)
+	}
+
+	return gin.H{"data": vmOutput}, nil
+}
+
+func rpcGetNetworkConfig(facade rpcFacadeHandler, _ json.RawMessage) (interface{}, *RpcError) {
+	configMetrics, err := facade.StatusMetrics().ConfigMetrics()
+	if err != nil {
+		return nil, &RpcError{Code: rpcCodeInternalError, Message: err.Error()}
+	}
+
+	return gin.H{"config": configMetrics}, nil
+}
+
+func rpcGetNetworkStatus(facade rpcFacadeHandler, _ json.RawMessage) (interface{}, *RpcError) {
+	networkMetrics, err := facade.StatusMetrics().NetworkMetrics()
+	if err != nil {
+		return nil, &RpcError{Code: rpcCodeInternalError, Message: err.Error()}
+	}
+
+	return gin.H{"status": networkMetrics}, nil
+}
+
+// decodeRpcParams decodes the params of a method. Only named params, provided as an object, are supported
+func decodeRpcParams(params json.RawMessage, destination interface{}) *RpcError {
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		return nil
+	}
+	if params[0] != '{' {
+		return newRpcError(rpcCodeInvalidParams, errors.ErrRpcInvalidParams, fmt.Errorf("params should be an object"))
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return newRpcError(rpcCodeInvalidParams, errors.ErrRpcInvalidParams, err)
+	}
+
+	return nil
+}
+
+// startRpcEndpointProcessing applies the throttler of the equivalent REST endpoint, if any, and returns the function
+// that should be called when the processing ends
+func startRpcEndpointProcessing(facade rpcFacadeHandler, endpoint string) (func(), *RpcError) {
+	throttler, ok := facade.GetThrottlerForEndpoint(endpoint)
+	if !ok {
+		return func() {}, nil
+	}
+
+	if !throttler.CanProcess() {
+		return nil, newRpcError(rpcCodeTooManyRequests, errors.ErrTooManyRequests, fmt.Errorf("endpoint %s", endpoint))
+	}
+
+	throttler.StartProcessing()
+
+	return throttler.EndProcessing, nil
+}
+
+func newRpcError(code int, scope error, err error) *RpcError {
+	if err == nil {
+		return &RpcError{Code: code, Message: scope.Error()}
+	}
+
+	return &RpcError{
+		Code:    code,
+		Message: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+	}
+}
+
+func newRpcErrorResponse(id json.RawMessage, code int, scope error, err error) *RpcResponse {
+	return &RpcResponse{
+		JsonRPC: rpcVersion,
+		Error:   newRpcError(code, scope, err),
+		ID:      id,
+	}
+}
+
+func (rg *rpcGroup) getFacade() rpcFacadeHandler {
+	rg.mutFacade.RLock()
+	defer rg.mutFacade.RUnlock()
+
+	return rg.facade
+}
+
+// UpdateFacade will update the facade
+func (rg *rpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(rpcFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	rg.mutFacade.Lock()
+	rg.facade = castFacade
+	rg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *rpcGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
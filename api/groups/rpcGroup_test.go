@@ -0,0 +1,404 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rpcResponse struct {
+	JsonRPC string                     `json:"jsonrpc"`
+	Result  map[string]json.RawMessage `json:"result"`
+	Error   *groups.RpcError           `json:"error"`
+	ID      json.RawMessage            `json:"id"`
+}
+
+func getRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		RPC: config.ApiRPCConfig{
+			MaxBatchSize: 10,
+		},
+		APIPackages: map[string]config.APIPackageConfig{
+			"rpc": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+					{Name: "/:address", Open: false},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true},
+				},
+			},
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true, Role: "admin"},
+				},
+			},
+		},
+	}
+}
+
+func createRpcFacade() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		BalanceHandler: func(address string) (*big.Int, error) {
+			if address == "unknown" {
+				return nil, errors.New("unknown account")
+			}
+
+			return big.NewInt(37), nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error) {
+			return &transaction.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			return uint64(len(txs)), nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+}
+
+func sendRpcRequest(ws *gin.Engine, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/rpc", bytes.NewBufferString(body))
+	req.RemoteAddr = "127.0.0.1:8080"
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func createRequestsThrottlers(maxSourceRequests uint32, maxSimultaneousRequests uint32) *mock.RequestsThrottlersStub {
+	sourceThrottler, _ := middleware.NewSourceThrottler(maxSourceRequests)
+	globalThrottler, _ := middleware.NewGlobalThrottler(maxSimultaneousRequests)
+
+	return &mock.RequestsThrottlersStub{
+		SourceThrottlerCalled: func() shared.SourceThrottler {
+			return sourceThrottler
+		},
+		GlobalThrottlerCalled: func() shared.GlobalThrottler {
+			return globalThrottler
+		},
+	}
+}
+
+func startRpcWebServer(t *testing.T, apiConfig config.ApiRoutesConfig) *gin.Engine {
+	return startThrottledRpcWebServer(t, apiConfig, createRequestsThrottlers(100, 100))
+}
+
+func startThrottledRpcWebServer(
+	t *testing.T,
+	apiConfig config.ApiRoutesConfig,
+	requestsThrottlers shared.RequestsThrottlersHandler,
+) *gin.Engine {
+	rpcGroup, err := groups.NewRpcGroup(createRpcFacade(), requestsThrottlers)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(requestsThrottlers.SourceThrottler().MiddlewareHandlerFunc())
+	ws.Use(requestsThrottlers.GlobalThrottler().MiddlewareHandlerFunc())
+	rpcGroup.RegisterRoutes(ws.Group("rpc"), apiConfig)
+
+	return ws
+}
+
+func startApiKeyRpcWebServer(
+	t *testing.T,
+	requestsThrottlers shared.RequestsThrottlersHandler,
+	limits config.ApiKeyLimitsConfig,
+) *gin.Engine {
+	apiKeyThrottler, err := middleware.NewApiKeyThrottler(middleware.ArgsApiKeyThrottler{
+		Config: config.ApiKeysConfig{
+			Enabled: true,
+			Keys: []config.ApiKeyConfig{
+				{Name: "consumer", Key: "consumer key", Limits: []config.ApiKeyLimitsConfig{limits}},
+			},
+		},
+	})
+	require.Nil(t, err)
+	rpcGroup, err := groups.NewRpcGroup(createRpcFacade(), requestsThrottlers)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(apiKeyThrottler.MiddlewareHandlerFunc())
+	ws.Use(requestsThrottlers.SourceThrottler().MiddlewareHandlerFunc())
+	ws.Use(requestsThrottlers.GlobalThrottler().MiddlewareHandlerFunc())
+	rpcGroup.RegisterRoutes(ws.Group("rpc"), getRpcRoutesConfig())
+
+	return ws
+}
+
+func sendKeyedRpcRequest(ws *gin.Engine, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/rpc", bytes.NewBufferString(body))
+	req.RemoteAddr = "127.0.0.1:8080"
+	req.Header.Set(middleware.HeaderAccessKey, "consumer key")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		rg, err := groups.NewRpcGroup(nil, &mock.RequestsThrottlersStub{})
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, rg)
+	})
+
+	t.Run("nil requests throttlers", func(t *testing.T) {
+		rg, err := groups.NewRpcGroup(&mock.FacadeStub{}, nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilRequestsThrottlers))
+		require.Nil(t, rg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		rg, err := groups.NewRpcGroup(&mock.FacadeStub{}, &mock.RequestsThrottlersStub{})
+		require.NoError(t, err)
+		require.NotNil(t, rg)
+	})
+}
+
+func TestRpcGroup_SingleRequest(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	resp := sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := rpcResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, "2.0", response.JsonRPC)
+	assert.Equal(t, json.RawMessage("1"), response.ID)
+	assert.Equal(t, json.RawMessage(`"37"`), response.Result["balance"])
+}
+
+func TestRpcGroup_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	resp := sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"tx_send","params":{"nonce":7,"value":"1"},"id":"a"}`)
+
+	response := rpcResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, json.RawMessage(`"a"`), response.ID)
+	assert.Equal(t, json.RawMessage(`"68617368"`), response.Result["txHash"])
+}
+
+func TestRpcGroup_Errors(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	testErrorCode := func(body string, expectedCode int) {
+		response := rpcResponse{}
+		loadResponse(sendRpcRequest(ws, body).Body, &response)
+		require.NotNil(t, response.Error, body)
+		assert.Equal(t, expectedCode, response.Error.Code, body)
+	}
+
+	testErrorCode(`{"jsonrpc":"2.0","method":`, -32700)
+	testErrorCode(`{"jsonrpc":"1.0","method":"address_getBalance","id":1}`, -32600)
+	testErrorCode(`{"jsonrpc":"2.0","method":"unknown_method","id":1}`, -32601)
+	testErrorCode(`{"jsonrpc":"2.0","method":"address_getBalance","params":["erd1"],"id":1}`, -32602)
+	testErrorCode(`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":""},"id":1}`, -32602)
+	testErrorCode(`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"unknown"},"id":1}`, -32603)
+	testErrorCode(`[]`, -32600)
+}
+
+func TestRpcGroup_ClosedRoutesShouldDisableMethods(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	response := rpcResponse{}
+	loadResponse(sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"address_getAccount","params":{"address":"erd1"},"id":1}`).Body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, -32601, response.Error.Code)
+	assert.Contains(t, response.Error.Message, apiErrors.ErrRpcMethodDisabled.Error())
+
+	// the roles are enforced only when the authentication is enabled
+	response = rpcResponse{}
+	loadResponse(sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"block_byNonce","params":{"nonce":3},"id":1}`).Body, &response)
+	assert.Nil(t, response.Error)
+
+	apiConfig := getRpcRoutesConfig()
+	apiConfig.Auth.Enabled = true
+	ws = startRpcWebServer(t, apiConfig)
+	response = rpcResponse{}
+	loadResponse(sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"block_byNonce","params":{"nonce":3},"id":1}`).Body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, -32601, response.Error.Code)
+
+	apiConfig.APIPackages["rpc"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "", Open: true, Role: "admin"},
+		},
+	}
+	ws = startRpcWebServer(t, apiConfig)
+	response = rpcResponse{}
+	loadResponse(sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"block_byNonce","params":{"nonce":3},"id":1}`).Body, &response)
+	assert.Nil(t, response.Error)
+}
+
+func TestRpcGroup_Batch(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	body := `[
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1},
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"}},
+		{"jsonrpc":"2.0","method":"unknown_method","id":2},
+		1,
+		{"jsonrpc":"2.0","method":"block_byNonce","params":{"nonce":5},"id":3}
+	]`
+	resp := sendRpcRequest(ws, body)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	responses := make([]rpcResponse, 0)
+	loadResponse(resp.Body, &responses)
+	require.Equal(t, 4, len(responses))
+
+	assert.Nil(t, responses[0].Error)
+	assert.Equal(t, json.RawMessage("1"), responses[0].ID)
+
+	require.NotNil(t, responses[1].Error)
+	assert.Equal(t, -32601, responses[1].Error.Code)
+	assert.Equal(t, json.RawMessage("2"), responses[1].ID)
+
+	require.NotNil(t, responses[2].Error)
+	assert.Equal(t, -32600, responses[2].Error.Code)
+	assert.Equal(t, json.RawMessage("null"), responses[2].ID)
+
+	assert.Nil(t, responses[3].Error)
+	assert.Equal(t, json.RawMessage("3"), responses[3].ID)
+}
+
+func TestRpcGroup_BatchTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	request := `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1}`
+	apiConfig := getRpcRoutesConfig()
+	apiConfig.RPC.MaxBatchSize = 2
+	ws := startRpcWebServer(t, apiConfig)
+
+	response := rpcResponse{}
+	loadResponse(sendRpcRequest(ws, "["+request+","+request+","+request+"]").Body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, -32600, response.Error.Code)
+	assert.Contains(t, response.Error.Message, apiErrors.ErrRpcBatchTooLarge.Error())
+
+	responses := make([]rpcResponse, 0)
+	loadResponse(sendRpcRequest(ws, "["+request+","+request+"]").Body, &responses)
+	assert.Equal(t, 2, len(responses))
+}
+
+func TestRpcGroup_BatchShouldBeAccountedPerRequest(t *testing.T) {
+	t.Parallel()
+
+	request := `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1}`
+	batch := "[" + request + "," + request + "," + request + "]"
+
+	t.Run("same source limit", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startThrottledRpcWebServer(t, getRpcRoutesConfig(), createRequestsThrottlers(4, 100))
+
+		resp := sendRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		// only one request is left for the source
+		resp = sendRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		response := rpcResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32000, response.Error.Code)
+	})
+	t.Run("simultaneous requests limit", func(t *testing.T) {
+		t.Parallel()
+
+		throttlers := createRequestsThrottlers(100, 3)
+		ws := startThrottledRpcWebServer(t, getRpcRoutesConfig(), throttlers)
+
+		resp := sendRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		// all the slots taken by the batch are released
+		for i := 0; i < 3; i++ {
+			require.True(t, throttlers.GlobalThrottler().StartProcessing("/test"))
+		}
+		throttlers.GlobalThrottler().EndProcessing("/test")
+		throttlers.GlobalThrottler().EndProcessing("/test")
+
+		resp = sendRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		throttlers.GlobalThrottler().EndProcessing("/test")
+
+		resp = sendRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("requests with API key should be accounted by the key instead of the source", func(t *testing.T) {
+		t.Parallel()
+
+		throttlers := createRequestsThrottlers(1, 100)
+		ws := startApiKeyRpcWebServer(t, throttlers, config.ApiKeyLimitsConfig{Group: "*", MaxConcurrentRequests: 2})
+
+		resp := sendKeyedRpcRequest(ws, batch)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+		resp = sendKeyedRpcRequest(ws, "["+request+","+request+"]")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, throttlers.SourceThrottler().CanProcessRequest("127.0.0.1"))
+	})
+	t.Run("rejected batch should give back the requests of the key", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startApiKeyRpcWebServer(t, createRequestsThrottlers(100, 100), config.ApiKeyLimitsConfig{Group: "*", RequestsPerSecond: 3})
+
+		resp := sendKeyedRpcRequest(ws, "["+request+","+request+","+request+","+request+"]")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+		// only the rejected batch itself stays accounted
+		resp = sendKeyedRpcRequest(ws, "["+request+","+request+"]")
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestRpcGroup_NotificationsShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	ws := startRpcWebServer(t, getRpcRoutesConfig())
+	resp := sendRpcRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"}}`)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, 0, resp.Body.Len())
+
+	resp = sendRpcRequest(ws, `[{"jsonrpc":"2.0","method":"tx_send","params":{"nonce":1}}]`)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
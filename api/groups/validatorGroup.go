@@ -3,20 +3,34 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath           = "/statistics"
+	rewardsBreakdownPath     = "/rewards/:epoch"
+	nodeRewardsBreakdownPath = "/rewards/:epoch/:blsKey"
+	shufflingPreviewPath     = "/shuffling/preview"
+	consensusGroupsPath      = "/consensus-groups"
+	performancePath          = "/performance/:blsKey"
+)
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -43,6 +57,36 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
 		},
+		{
+			Path:    rewardsBreakdownPath,
+			Method:  http.MethodGet,
+			Handler: ng.rewardsBreakdown,
+		},
+		{
+			Path:    nodeRewardsBreakdownPath,
+			Method:  http.MethodGet,
+			Handler: ng.nodeRewardsBreakdown,
+		},
+		{
+			Path:    shufflingPreviewPath,
+			Method:  http.MethodGet,
+			Handler: ng.shufflingPreview,
+		},
+		{
+			Path:    shufflingPreviewPath,
+			Method:  http.MethodPost,
+			Handler: ng.shufflingWhatIf,
+		},
+		{
+			Path:    consensusGroupsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusGroups,
+		},
+		{
+			Path:    performancePath,
+			Method:  http.MethodGet,
+			Handler: ng.performance,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -74,6 +118,228 @@ func (vg *validatorGroup) statistics(c *gin.Context) {
 	)
 }
 
+// rewardsBreakdown will return the breakdown of the rewards distributed at the start of the provided epoch
+func (vg *validatorGroup) rewardsBreakdown(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	breakdown, err := vg.getFacade().GetRewardsBreakdown(epoch)
+	if err != nil {
+		respondWithRewardsBreakdownError(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rewards": breakdown},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// nodeRewardsBreakdown will return the breakdown of the rewards distributed at the start of the provided epoch for
+// the provided node
+func (vg *validatorGroup) nodeRewardsBreakdown(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	breakdown, err := vg.getFacade().GetNodeRewardsBreakdown(epoch, c.Param("blsKey"))
+	if err != nil {
+		respondWithRewardsBreakdownError(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rewards": breakdown},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// shufflingPreview will return the previewed nodes lists of the next epoch
+func (vg *validatorGroup) shufflingPreview(c *gin.Context) {
+	vg.previewNodesShuffling(c, &common.NodesShufflingPreviewRequest{})
+}
+
+// shufflingWhatIf will return the previewed nodes lists of the next epoch after applying the hypothetical stake and
+// unstake sets provided in the request
+func (vg *validatorGroup) shufflingWhatIf(c *gin.Context) {
+	request := &common.NodesShufflingPreviewRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	vg.previewNodesShuffling(c, request)
+}
+
+func (vg *validatorGroup) previewNodesShuffling(c *gin.Context, request *common.NodesShufflingPreviewRequest) {
+	preview, err := vg.getFacade().PreviewNodesShuffling(request)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrPreviewNodesShuffling.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"preview": preview},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// consensusGroups will return the nodes lists of a past epoch and, when a round is provided, the consensus group of
+// that round
+func (vg *validatorGroup) consensusGroups(c *gin.Context) {
+	request, err := getConsensusGroupsRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	consensusGroups, err := vg.getFacade().GetConsensusGroups(request)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetConsensusGroups.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"consensusGroups": consensusGroups},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getConsensusGroupsRequest(c *gin.Context) (*common.ConsensusGroupsRequest, error) {
+	request := &common.ConsensusGroupsRequest{}
+
+	epoch, isSet, err := getOptionalQueryParamUint64(c, "epoch", 32)
+	if err != nil {
+		return nil, errors.ErrInvalidEpoch
+	}
+	if isSet {
+		epoch32 := uint32(epoch)
+		request.Epoch = &epoch32
+	}
+
+	round, isSet, err := getOptionalQueryParamUint64(c, "round", 64)
+	if err != nil {
+		return nil, errors.ErrInvalidBlockRound
+	}
+	if isSet {
+		request.Round = &round
+	}
+
+	shardID, isSet, err := getOptionalQueryParamUint64(c, "shard", 32)
+	if err != nil {
+		return nil, errors.ErrInvalidShardID
+	}
+	if isSet {
+		shardID32 := uint32(shardID)
+		request.ShardID = &shardID32
+	}
+
+	return request, nil
+}
+
+// performance will return the performance report of a validator in the requested epoch or, if no epoch is
+// provided, in the current epoch
+func (vg *validatorGroup) performance(c *gin.Context) {
+	request := &common.ValidatorPerformanceRequest{
+		BLSKey: c.Param("blsKey"),
+	}
+
+	epoch, isSet, err := getOptionalQueryParamUint64(c, "epoch", 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+	if isSet {
+		epoch32 := uint32(epoch)
+		request.Epoch = &epoch32
+	}
+
+	performance, err := vg.getFacade().GetValidatorPerformance(request)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValidatorPerformance.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"performance": performance},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getOptionalQueryParamUint64(c *gin.Context, name string, bitSize int) (uint64, bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, bitSize)
+	return value, true, err
+}
+
+func respondWithRewardsBreakdownError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrGetRewardsBreakdown.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
@@ -106,12 +108,517 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type rewardsBreakdownResponseData struct {
+	Rewards *common.RewardsBreakdownAPIResponse `json:"rewards"`
+}
+
+type rewardsBreakdownResponse struct {
+	Data  rewardsBreakdownResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+type nodeRewardsBreakdownResponseData struct {
+	Rewards *common.NodeRewardsBreakdownAPIResponse `json:"rewards"`
+}
+
+type nodeRewardsBreakdownResponse struct {
+	Data  nodeRewardsBreakdownResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+func TestValidatorGroup_RewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetRewardsBreakdown.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBreakdown := &common.RewardsBreakdownAPIResponse{
+			Epoch:       5,
+			BaseRewards: "1000",
+			Nodes: []*common.NodeRewardsBreakdownAPIResponse{
+				{BLSKey: "blsKey", BaseReward: "1000", Rewarded: true},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+				assert.Equal(t, uint32(5), epoch)
+				return expectedBreakdown, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedBreakdown, response.Data.Rewards)
+	})
+}
+
+func TestValidatorGroup_NodeRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/-1/blsKey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nodeRewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBreakdown := &common.NodeRewardsBreakdownAPIResponse{
+			BLSKey:      "blsKey",
+			TopUpReward: "20",
+		}
+		facade := &mock.FacadeStub{
+			GetNodeRewardsBreakdownCalled: func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+				assert.Equal(t, uint32(5), epoch)
+				assert.Equal(t, "blsKey", blsKey)
+				return expectedBreakdown, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/5/blsKey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nodeRewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedBreakdown, response.Data.Rewards)
+	})
+}
+
+type shufflingPreviewResponseData struct {
+	Preview *common.NodesShufflingPreviewAPIResponse `json:"preview"`
+}
+
+type shufflingPreviewResponse struct {
+	Data  shufflingPreviewResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+func TestValidatorGroup_ShufflingPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			PreviewNodesShufflingCalled: func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shufflingPreviewResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrPreviewNodesShuffling.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPreview := &common.NodesShufflingPreviewAPIResponse{
+			CurrentEpoch: 3,
+			Epoch:        4,
+			Eligible:     map[uint32][]string{0: {"blsKey"}},
+			Nodes: []*common.NodeShufflingPreviewAPIResponse{
+				{BLSKey: "blsKey", PreviousList: "waiting", List: "eligible"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			PreviewNodesShufflingCalled: func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+				assert.Equal(t, &common.NodesShufflingPreviewRequest{}, request)
+				return expectedPreview, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shufflingPreviewResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPreview, response.Data.Preview)
+	})
+}
+
+func TestValidatorGroup_ShufflingWhatIf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/shuffling/preview", bytes.NewBuffer([]byte("wrong buffer")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shufflingPreviewResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRequest := &common.NodesShufflingPreviewRequest{
+			Stake:      []string{"staked"},
+			Unstake:    []string{"unstaked"},
+			Randomness: "aabb",
+		}
+		expectedPreview := &common.NodesShufflingPreviewAPIResponse{
+			Epoch:  4,
+			WhatIf: true,
+		}
+		facade := &mock.FacadeStub{
+			PreviewNodesShufflingCalled: func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+				assert.Equal(t, expectedRequest, request)
+				return expectedPreview, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		buff, _ := json.Marshal(expectedRequest)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/shuffling/preview", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shufflingPreviewResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPreview, response.Data.Preview)
+	})
+}
+
+type consensusGroupsResponseData struct {
+	ConsensusGroups *common.ConsensusGroupsAPIResponse `json:"consensusGroups"`
+}
+
+type consensusGroupsResponse struct {
+	Data  consensusGroupsResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+func TestValidatorGroup_ConsensusGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/consensus-groups?epoch=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("invalid round should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/consensus-groups?round=-1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidBlockRound.Error())
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/consensus-groups?epoch=1&shard=meta", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidShardID.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetConsensusGroupsCalled: func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/consensus-groups?epoch=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetConsensusGroups.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &common.ConsensusGroupsAPIResponse{
+			Epoch:    4,
+			Eligible: map[uint32][]string{0: {"blsKey"}},
+			ConsensusGroup: &common.ConsensusGroupAPIResponse{
+				Round:          120,
+				Epoch:          4,
+				BlockProposed:  true,
+				Leader:         "blsKey",
+				ConsensusGroup: []string{"blsKey"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetConsensusGroupsCalled: func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+				require.NotNil(t, request.Epoch)
+				require.NotNil(t, request.Round)
+				require.NotNil(t, request.ShardID)
+				assert.Equal(t, uint32(4), *request.Epoch)
+				assert.Equal(t, uint64(120), *request.Round)
+				assert.Equal(t, uint32(0), *request.ShardID)
+				return expectedResponse, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/consensus-groups?epoch=4&round=120&shard=0", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResponse, response.Data.ConsensusGroups)
+	})
+}
+
+type validatorPerformanceResponseData struct {
+	Performance *common.ValidatorPerformanceAPIResponse `json:"performance"`
+}
+
+type validatorPerformanceResponse struct {
+	Data  validatorPerformanceResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+func TestValidatorGroup_Performance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/performance/blsKey?epoch=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorPerformanceResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetValidatorPerformanceCalled: func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/performance/blsKey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorPerformanceResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetValidatorPerformance.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("current epoch should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPerformance := &common.ValidatorPerformanceAPIResponse{
+			BLSKey:             "blsKey",
+			Epoch:              4,
+			NumMissedProposals: 1,
+			MissedProposals: []*common.MissedProposalAPIResponse{
+				{Round: 120, PreviousBlockRound: 119, PreviousBlockHash: "hash"},
+			},
+			MissedSignatures: []*common.MissedSignatureAPIResponse{},
+			Rating: &common.ValidatorRatingAPIResponse{
+				StartRating: 50,
+				TempRating:  49,
+				Trend:       []*common.RatingTrendPointAPIResponse{{Round: 120, Rating: 49}},
+				JailingRisk: "medium",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetValidatorPerformanceCalled: func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+				assert.Equal(t, &common.ValidatorPerformanceRequest{BLSKey: "blsKey"}, request)
+				return expectedPerformance, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/performance/blsKey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorPerformanceResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPerformance, response.Data.Performance)
+	})
+	t.Run("provided epoch should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetValidatorPerformanceCalled: func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+				require.NotNil(t, request.Epoch)
+				assert.Equal(t, uint32(3), *request.Epoch)
+				return &common.ValidatorPerformanceAPIResponse{Epoch: 3}, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/performance/blsKey?epoch=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorPerformanceResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint32(3), response.Data.Performance.Epoch)
+	})
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/rewards/:epoch", Open: true},
+					{Name: "/rewards/:epoch/:blsKey", Open: true},
+					{Name: "/shuffling/preview", Open: true},
+					{Name: "/consensus-groups", Open: true},
+					{Name: "/performance/:blsKey", Open: true},
 				},
 			},
 		},
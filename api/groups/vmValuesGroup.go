@@ -134,7 +134,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", errors.ErrInvalidJSONRequest
 	}
 
-	command, err := vvg.createSCQuery(&request)
+	command, err := createSCQuery(vvg.getFacade(), &request)
 	if err != nil {
 		return nil, "", err
 	}
@@ -152,8 +152,13 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, nil
 }
 
-func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+// addressDecoder defines the component able to decode the bech32 addresses provided in requests
+type addressDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
+func createSCQuery(decoder addressDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -178,7 +183,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
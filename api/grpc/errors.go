@@ -0,0 +1,27 @@
+package grpc
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade was provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilRequestsThrottlers signals that nil requests throttlers were provided
+var ErrNilRequestsThrottlers = errors.New("nil requests throttlers")
+
+// ErrInvalidMaxBlockStreams signals that an invalid maximum number of block streams was provided
+var ErrInvalidMaxBlockStreams = errors.New("invalid max number of block streams")
+
+// ErrInvalidBlocksPollInterval signals that an invalid blocks poll interval was provided
+var ErrInvalidBlocksPollInterval = errors.New("invalid blocks poll interval")
+
+// ErrTooManyRequests signals that too many requests were simultaneously received
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrTooManyBlockStreams signals that too many block streams are simultaneously opened
+var ErrTooManyBlockStreams = errors.New("too many block streams")
+
+// ErrClosedRoute signals that the REST route of the requested method is closed
+var ErrClosedRoute = errors.New("the route of the method is closed")
+
+// ErrUnknownRequestSource signals that the address from which the request originated is unknown
+var ErrUnknownRequestSource = errors.New("unknown request source")
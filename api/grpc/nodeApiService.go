@@ -0,0 +1,346 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the endpoint names of the REST API are used so both APIs share the same endpoint throttlers
+const (
+	sendTransactionEndpoint = "/transaction/send"
+	getTransactionEndpoint  = "/transaction/:hash"
+)
+
+// GetAccount returns the account of the provided address
+func (gs *grpcServer) GetAccount(_ context.Context, request *AccountRequest) (*Account, error) {
+	account, err := gs.getFacade().GetAccount(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrCouldNotGetAccount.Error(), err.Error())
+	}
+
+	return &Account{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+	}, nil
+}
+
+// GetTransaction returns the transaction with the provided hash
+func (gs *grpcServer) GetTransaction(_ context.Context, request *TransactionRequest) (*Transaction, error) {
+	endProcessing, err := gs.startEndpointProcessing(getTransactionEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer endProcessing()
+
+	tx, err := gs.getFacade().GetTransaction(request.Hash, request.WithResults)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetTransaction.Error(), err.Error())
+	}
+
+	return convertTransaction(tx), nil
+}
+
+// SendTransaction validates the provided transaction and propagates it for processing
+func (gs *grpcServer) SendTransaction(_ context.Context, request *SendTransactionRequest) (*SendTransactionResponse, error) {
+	endProcessing, err := gs.startEndpointProcessing(sendTransactionEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer endProcessing()
+
+	facade := gs.getFacade()
+	tx, txHash, err := facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.ReceiverUsername,
+		request.Sender,
+		request.SenderUsername,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	err = facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SendTransactionResponse{TxHash: hex.EncodeToString(txHash)}, nil
+}
+
+// GetBlock returns the block with the provided hash or, if no hash is provided, with the provided nonce
+func (gs *grpcServer) GetBlock(_ context.Context, request *BlockRequest) (*Block, error) {
+	var block *api.Block
+	var err error
+	if len(request.Hash) > 0 {
+		block, err = gs.getFacade().GetBlockByHash(request.Hash, request.WithTxs)
+	} else {
+		block, err = gs.getFacade().GetBlockByNonce(request.Nonce, request.WithTxs)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())
+	}
+
+	return convertBlock(block), nil
+}
+
+// QueryVmValues executes a read-only smart contract query
+func (gs *grpcServer) QueryVmValues(_ context.Context, request *VmValuesRequest) (*VmValuesResponse, error) {
+	scQuery, err := gs.createSCQuery(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vmOutput, err := gs.getFacade().ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &VmValuesResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+	}, nil
+}
+
+func (gs *grpcServer) createSCQuery(request *VmValuesRequest) (*process.SCQuery, error) {
+	facade := gs.getFacade()
+	decodedAddress, err := facade.DecodeAddressPubkey(request.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
+	}
+
+	arguments := make([][]byte, len(request.Args))
+	for i, arg := range request.Args {
+		arguments[i], err = hex.DecodeString(arg)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex string: %s", arg, err.Error())
+		}
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress:      decodedAddress,
+		FuncName:       request.FuncName,
+		Arguments:      arguments,
+		SameScState:    request.SameScState,
+		ShouldBeSynced: request.ShouldBeSynced,
+	}
+
+	if len(request.CallerAddr) > 0 {
+		scQuery.CallerAddr, err = facade.DecodeAddressPubkey(request.CallerAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(request.CallValue) > 0 {
+		callValue, ok := big.NewInt(0).SetString(request.CallValue, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", request.CallValue)
+		}
+		scQuery.CallValue = callValue
+	}
+
+	return scQuery, nil
+}
+
+// GetNetworkConfig returns the metrics related to the network configuration
+func (gs *grpcServer) GetNetworkConfig(_ context.Context, _ *NetworkRequest) (*NetworkMetrics, error) {
+	metrics, err := gs.getFacade().StatusMetrics().ConfigMetrics()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return convertMetrics(metrics), nil
+}
+
+// GetNetworkStatus returns the metrics related to the network status, as seen from the node's shard
+func (gs *grpcServer) GetNetworkStatus(_ context.Context, _ *NetworkRequest) (*NetworkMetrics, error) {
+	metrics, err := gs.getFacade().StatusMetrics().NetworkMetrics()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return convertMetrics(metrics), nil
+}
+
+// StreamBlocks streams the blocks starting with the provided nonce. The node is polled for the next block until the
+// client closes the stream. The stream ends with NotFound if a block that was already committed can not be fetched,
+// for example because the node no longer stores it
+func (gs *grpcServer) StreamBlocks(request *StreamBlocksRequest, stream NodeApi_StreamBlocksServer) error {
+	nonce := request.FromNonce
+	for {
+		// the last committed nonce is read before fetching the block, so a block committed in between is not
+		// mistaken for a missing one
+		lastCommittedNonce, isNonceKnown := gs.getLastCommittedNonce()
+		block, err := gs.fetchBlock(nonce, request.WithTxs)
+		if err == nil {
+			err = stream.Send(convertBlock(block))
+			if err != nil {
+				return err
+			}
+
+			nonce++
+			continue
+		}
+
+		isBlockMissing := err != ErrTooManyRequests && isNonceKnown && nonce <= lastCommittedNonce
+		if isBlockMissing {
+			return status.Errorf(codes.NotFound, "%s for nonce %d: %s", apiErrors.ErrGetBlock.Error(), nonce, err.Error())
+		}
+
+		select {
+		case <-time.After(gs.blocksPollInterval):
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// fetchBlock gets the block with the provided nonce under the global throttler, as a REST request would
+func (gs *grpcServer) fetchBlock(nonce uint64, withTxs bool) (*api.Block, error) {
+	path := blockByNonceRoute.path()
+	if !gs.globalThrottler.StartProcessing(path) {
+		return nil, ErrTooManyRequests
+	}
+	defer gs.globalThrottler.EndProcessing(path)
+
+	return gs.getFacade().GetBlockByNonce(nonce, withTxs)
+}
+
+func (gs *grpcServer) getLastCommittedNonce() (uint64, bool) {
+	statusMetrics := gs.getFacade().StatusMetrics()
+	if check.IfNil(statusMetrics) {
+		return 0, false
+	}
+
+	metrics, err := statusMetrics.NetworkMetrics()
+	if err != nil {
+		return 0, false
+	}
+
+	nonce, ok := metrics[common.MetricNonce].(uint64)
+
+	return nonce, ok
+}
+
+// startEndpointProcessing applies the endpoint throttler, if any, and returns the function that should be called
+// when the processing ends
+func (gs *grpcServer) startEndpointProcessing(endpoint string) (func(), error) {
+	throttler, ok := gs.getFacade().GetThrottlerForEndpoint(endpoint)
+	if !ok {
+		return func() {}, nil
+	}
+
+	if !throttler.CanProcess() {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s for endpoint %s", apiErrors.ErrTooManyRequests.Error(), endpoint)
+	}
+
+	throttler.StartProcessing()
+
+	return throttler.EndProcessing, nil
+}
+
+func convertTransaction(tx *transaction.ApiTransactionResult) *Transaction {
+	return &Transaction{
+		Hash:             tx.Hash,
+		Type:             tx.Type,
+		Nonce:            tx.Nonce,
+		Round:            tx.Round,
+		Epoch:            tx.Epoch,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		Signature:        tx.Signature,
+		SourceShard:      tx.SourceShard,
+		DestinationShard: tx.DestinationShard,
+		BlockNonce:       tx.BlockNonce,
+		BlockHash:        tx.BlockHash,
+		MiniBlockType:    tx.MiniBlockType,
+		MiniBlockHash:    tx.MiniBlockHash,
+		Timestamp:        tx.Timestamp,
+		Status:           string(tx.Status),
+		ReturnMessage:    tx.ReturnMessage,
+		Operation:        tx.Operation,
+		Function:         tx.Function,
+	}
+}
+
+func convertBlock(block *api.Block) *Block {
+	miniBlocks := make([]*MiniBlock, 0, len(block.MiniBlocks))
+	for _, mb := range block.MiniBlocks {
+		transactions := make([]*Transaction, 0, len(mb.Transactions))
+		for _, tx := range mb.Transactions {
+			transactions = append(transactions, convertTransaction(tx))
+		}
+
+		miniBlocks = append(miniBlocks, &MiniBlock{
+			Hash:             mb.Hash,
+			Type:             mb.Type,
+			SourceShard:      mb.SourceShard,
+			DestinationShard: mb.DestinationShard,
+			Transactions:     transactions,
+		})
+	}
+
+	return &Block{
+		Nonce:           block.Nonce,
+		Round:           block.Round,
+		Hash:            block.Hash,
+		PrevBlockHash:   block.PrevBlockHash,
+		Epoch:           block.Epoch,
+		Shard:           block.Shard,
+		NumTxs:          block.NumTxs,
+		Timestamp:       int64(block.Timestamp),
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		Status:          block.Status,
+		MiniBlocks:      miniBlocks,
+	}
+}
+
+func convertMetrics(metrics map[string]interface{}) *NetworkMetrics {
+	converted := make(map[string]string, len(metrics))
+	for key, value := range metrics {
+		converted[key] = fmt.Sprintf("%v", value)
+	}
+
+	return &NetworkMetrics{Metrics: converted}
+}
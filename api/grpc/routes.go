@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// apiRoute is the REST route whose configuration, from the api.toml file, applies to a gRPC method as well. Its path
+// is also used for the global throttler accounting
+type apiRoute struct {
+	packageName string
+	routeName   string
+}
+
+func (route apiRoute) path() string {
+	return fmt.Sprintf("/%s%s", route.packageName, route.routeName)
+}
+
+var (
+	blockByNonceRoute = apiRoute{packageName: "block", routeName: "/by-nonce/:nonce"}
+	blockByHashRoute  = apiRoute{packageName: "block", routeName: "/by-hash/:hash"}
+)
+
+// methodsRoutes holds the REST route of each gRPC method. GetBlock is resolved depending on the request
+var methodsRoutes = map[string]apiRoute{
+	"/nodeApi.NodeApi/GetAccount":       {packageName: "address", routeName: "/:address"},
+	"/nodeApi.NodeApi/GetTransaction":   {packageName: "transaction", routeName: "/:txhash"},
+	"/nodeApi.NodeApi/SendTransaction":  {packageName: "transaction", routeName: "/send"},
+	"/nodeApi.NodeApi/QueryVmValues":    {packageName: "vm-values", routeName: "/query"},
+	"/nodeApi.NodeApi/GetNetworkConfig": {packageName: "network", routeName: "/config"},
+	"/nodeApi.NodeApi/GetNetworkStatus": {packageName: "network", routeName: "/status"},
+	"/nodeApi.NodeApi/StreamBlocks":     blockByNonceRoute,
+}
+
+const getBlockMethod = "/nodeApi.NodeApi/GetBlock"
+
+func getRoute(fullMethod string, request interface{}) (apiRoute, bool) {
+	if fullMethod == getBlockMethod {
+		blockRequest, ok := request.(*BlockRequest)
+		if ok && len(blockRequest.Hash) > 0 {
+			return blockByHashRoute, true
+		}
+
+		return blockByNonceRoute, true
+	}
+
+	route, ok := methodsRoutes[fullMethod]
+
+	return route, ok
+}
+
+func isRouteOpen(apiPackages map[string]config.APIPackageConfig, route apiRoute) bool {
+	packageConfig, ok := apiPackages[route.packageName]
+	if !ok {
+		return false
+	}
+
+	for _, routeConfig := range packageConfig.Routes {
+		if routeConfig.Name == route.routeName && routeConfig.Open {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,300 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf --gogoslick_out=plugins=grpc:. nodeApi.proto
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/certificates"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("api/grpc")
+
+const bearerPrefix = "Bearer "
+
+// ArgsNewGrpcServer holds the arguments needed to create a new instance of grpcServer
+type ArgsNewGrpcServer struct {
+	Facade             FacadeHandler
+	ApiConfig          config.ApiRoutesConfig
+	RequestsThrottlers shared.RequestsThrottlersHandler
+}
+
+// grpcServer exposes the NodeApi service. Each method is served under the configuration of its REST route: it is
+// rejected if the route is closed, it requires the role of the route when the authentication is enabled and it is
+// throttled with the same source, global and endpoint throttlers as the REST API
+type grpcServer struct {
+	mutFacade          sync.RWMutex
+	facade             FacadeHandler
+	apiConfig          config.ApiRoutesConfig
+	sourceThrottler    shared.SourceThrottler
+	globalThrottler    shared.GlobalThrottler
+	authorizer         routeAuthorizer
+	streamsQueue       chan struct{}
+	blocksPollInterval time.Duration
+	mutServer          sync.Mutex
+	server             *grpc.Server
+	cancelFunc         func()
+}
+
+// NewGrpcServer returns a new instance of grpcServer
+func NewGrpcServer(args ArgsNewGrpcServer) (*grpcServer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &grpcServer{
+		facade:             args.Facade,
+		apiConfig:          args.ApiConfig,
+		sourceThrottler:    args.RequestsThrottlers.SourceThrottler(),
+		globalThrottler:    args.RequestsThrottlers.GlobalThrottler(),
+		streamsQueue:       make(chan struct{}, args.ApiConfig.GRPC.MaxBlockStreams),
+		blocksPollInterval: time.Millisecond * time.Duration(args.ApiConfig.GRPC.BlocksPollIntervalInMilliseconds),
+	}
+
+	if args.ApiConfig.GRPC.Enabled && args.ApiConfig.Auth.Enabled {
+		gs.authorizer, err = middleware.NewRouteAuthenticator(middleware.ArgsRouteAuthenticator{
+			AuthConfig:  args.ApiConfig.Auth,
+			APIPackages: args.ApiConfig.APIPackages,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gs, nil
+}
+
+func checkArgs(args ArgsNewGrpcServer) error {
+	if check.IfNil(args.Facade) {
+		return ErrNilFacade
+	}
+	if check.IfNil(args.RequestsThrottlers) {
+		return ErrNilRequestsThrottlers
+	}
+	if !args.ApiConfig.GRPC.Enabled {
+		return nil
+	}
+	if args.ApiConfig.GRPC.MaxBlockStreams == 0 {
+		return ErrInvalidMaxBlockStreams
+	}
+	if args.ApiConfig.GRPC.BlocksPollIntervalInMilliseconds == 0 {
+		return ErrInvalidBlocksPollInterval
+	}
+
+	return nil
+}
+
+// Start will start serving the gRPC requests on the configured interface, if the gRPC API is enabled. The requests
+// are served over TLS, with the certificate of the REST API, if the TLS is enabled
+func (gs *grpcServer) Start() error {
+	if !gs.apiConfig.GRPC.Enabled {
+		return nil
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	options := make([]grpc.ServerOption, 0)
+	if gs.apiConfig.TLS.Enabled {
+		tlsConfig, err := certificates.CreateTLSConfig(ctx, gs.apiConfig.TLS)
+		if err != nil {
+			cancelFunc()
+			return err
+		}
+
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	listener, err := net.Listen("tcp", gs.apiConfig.GRPC.Interface)
+	if err != nil {
+		cancelFunc()
+		return err
+	}
+
+	gs.serve(listener, cancelFunc, options...)
+
+	return nil
+}
+
+func (gs *grpcServer) serve(listener net.Listener, cancelFunc func(), options ...grpc.ServerOption) {
+	options = append(options,
+		grpc.UnaryInterceptor(gs.unaryInterceptor),
+		grpc.StreamInterceptor(gs.streamInterceptor),
+	)
+	server := grpc.NewServer(options...)
+	RegisterNodeApiServer(server, gs)
+
+	gs.mutServer.Lock()
+	gs.server = server
+	gs.cancelFunc = cancelFunc
+	gs.mutServer.Unlock()
+
+	log.Debug("starting gRPC server", "interface", listener.Addr().String(), "TLS", gs.apiConfig.TLS.Enabled)
+
+	go func() {
+		errServe := server.Serve(listener)
+		if errServe != nil {
+			log.Error("could not start gRPC server", "error", errServe.Error())
+		}
+	}()
+}
+
+// UpdateFacade updates the facade used when processing the requests
+func (gs *grpcServer) UpdateFacade(facade shared.FacadeHandler) error {
+	if check.IfNil(facade) {
+		return ErrNilFacade
+	}
+
+	gs.mutFacade.Lock()
+	gs.facade = facade
+	gs.mutFacade.Unlock()
+
+	return nil
+}
+
+func (gs *grpcServer) getFacade() FacadeHandler {
+	gs.mutFacade.RLock()
+	defer gs.mutFacade.RUnlock()
+
+	return gs.facade
+}
+
+func (gs *grpcServer) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	route, err := gs.checkRequest(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !gs.globalThrottler.StartProcessing(route.path()) {
+		return nil, status.Error(codes.ResourceExhausted, ErrTooManyRequests.Error())
+	}
+	defer gs.globalThrottler.EndProcessing(route.path())
+
+	return handler(ctx, req)
+}
+
+// streamInterceptor checks the stream request as a unary one. The stream does not hold a slot of the global throttler
+// while it is opened, each block it fetches takes one instead
+func (gs *grpcServer) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	_, err := gs.checkRequest(stream.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case gs.streamsQueue <- struct{}{}:
+	default:
+		return status.Error(codes.ResourceExhausted, ErrTooManyBlockStreams.Error())
+	}
+	defer func() {
+		<-gs.streamsQueue
+	}()
+
+	return handler(srv, stream)
+}
+
+// checkRequest returns the REST route of the method, after checking that the route is open, that the source of the
+// request did not reach its quota and that the request carries the credentials required by the route
+func (gs *grpcServer) checkRequest(ctx context.Context, fullMethod string, req interface{}) (apiRoute, error) {
+	route, ok := getRoute(fullMethod, req)
+	if !ok || !isRouteOpen(gs.apiConfig.APIPackages, route) {
+		return apiRoute{}, status.Errorf(codes.Unimplemented, "%s: %s", ErrClosedRoute.Error(), fullMethod)
+	}
+
+	source, err := getRequestSource(ctx)
+	if err != nil {
+		return apiRoute{}, status.Error(codes.Internal, err.Error())
+	}
+	if !gs.sourceThrottler.CanProcessRequest(source) {
+		return apiRoute{}, status.Errorf(codes.ResourceExhausted, "%s for address %s", ErrTooManyRequests.Error(), source)
+	}
+
+	if gs.authorizer == nil {
+		return route, nil
+	}
+
+	_, err = gs.authorizer.AuthorizeBearerToken(route.packageName, route.routeName, getBearerToken(ctx))
+	if errors.Is(err, middleware.ErrForbiddenRequest) {
+		return apiRoute{}, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return apiRoute{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return route, nil
+}
+
+func getRequestSource(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", ErrUnknownRequestSource
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		// addresses without a port, such as the unix sockets ones, are used as they are
+		return p.Addr.String(), nil
+	}
+
+	return host, nil
+}
+
+func getBearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, authorization := range md.Get("authorization") {
+		if strings.HasPrefix(authorization, bearerPrefix) {
+			return strings.TrimPrefix(authorization, bearerPrefix)
+		}
+	}
+
+	return ""
+}
+
+// Close will stop the gRPC server, closing the opened streams
+func (gs *grpcServer) Close() error {
+	gs.mutServer.Lock()
+	defer gs.mutServer.Unlock()
+
+	if gs.server != nil {
+		gs.server.Stop()
+	}
+	if gs.cancelFunc != nil {
+		gs.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gs *grpcServer) IsInterfaceNil() bool {
+	return gs == nil
+}
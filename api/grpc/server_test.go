@@ -0,0 +1,527 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func createMockApiConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		GRPC: config.ApiGRPCConfig{
+			Enabled:                          true,
+			Interface:                        "localhost:0",
+			MaxBlockStreams:                  1,
+			BlocksPollIntervalInMilliseconds: 10,
+		},
+		APIPackages: map[string]config.APIPackageConfig{
+			"address":     {Routes: []config.RouteConfig{{Name: "/:address", Open: true}}},
+			"transaction": {Routes: []config.RouteConfig{{Name: "/:txhash", Open: true}, {Name: "/send", Open: true}}},
+			"block":       {Routes: []config.RouteConfig{{Name: "/by-nonce/:nonce", Open: true}, {Name: "/by-hash/:hash", Open: true}}},
+			"vm-values":   {Routes: []config.RouteConfig{{Name: "/query", Open: true}}},
+			"network":     {Routes: []config.RouteConfig{{Name: "/config", Open: true}, {Name: "/status", Open: true}}},
+		},
+	}
+}
+
+func createRequestsThrottlers(simultaneousRequests uint32, sameSourceRequests uint32) *mock.RequestsThrottlersStub {
+	sourceThrottler, _ := middleware.NewSourceThrottler(sameSourceRequests)
+	globalThrottler, _ := middleware.NewGlobalThrottler(simultaneousRequests)
+
+	return &mock.RequestsThrottlersStub{
+		SourceThrottlerCalled: func() shared.SourceThrottler {
+			return sourceThrottler
+		},
+		GlobalThrottlerCalled: func() shared.GlobalThrottler {
+			return globalThrottler
+		},
+	}
+}
+
+func createMockArgsGrpcServer(facade FacadeHandler) ArgsNewGrpcServer {
+	return ArgsNewGrpcServer{
+		Facade:             facade,
+		ApiConfig:          createMockApiConfig(),
+		RequestsThrottlers: createRequestsThrottlers(10, 100),
+	}
+}
+
+func startTestServer(t *testing.T, args ArgsNewGrpcServer) (NodeApiClient, *grpcServer) {
+	gs, err := NewGrpcServer(args)
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	gs.serve(listener, nil)
+	t.Cleanup(func() {
+		_ = gs.Close()
+	})
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return NewNodeApiClient(conn), gs
+}
+
+func TestNewGrpcServer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGrpcServer(nil)
+	gs, err := NewGrpcServer(args)
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, ErrNilFacade, err)
+
+	args = createMockArgsGrpcServer(&mock.FacadeStub{})
+	args.RequestsThrottlers = nil
+	gs, err = NewGrpcServer(args)
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, ErrNilRequestsThrottlers, err)
+
+	args = createMockArgsGrpcServer(&mock.FacadeStub{})
+	args.ApiConfig.GRPC.MaxBlockStreams = 0
+	gs, err = NewGrpcServer(args)
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, ErrInvalidMaxBlockStreams, err)
+
+	args = createMockArgsGrpcServer(&mock.FacadeStub{})
+	args.ApiConfig.GRPC.BlocksPollIntervalInMilliseconds = 0
+	gs, err = NewGrpcServer(args)
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, ErrInvalidBlocksPollInterval, err)
+
+	args = createMockArgsGrpcServer(&mock.FacadeStub{})
+	args.ApiConfig.Auth = config.ApiAuthConfig{
+		Enabled:     true,
+		Credentials: []config.ApiCredentialConfig{{Name: "name", Type: "invalid", Secret: "secret"}},
+	}
+	gs, err = NewGrpcServer(args)
+	assert.True(t, check.IfNil(gs))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidCredentialType))
+
+	args = createMockArgsGrpcServer(&mock.FacadeStub{})
+	args.ApiConfig.GRPC = config.ApiGRPCConfig{}
+	gs, err = NewGrpcServer(args)
+	assert.False(t, check.IfNil(gs))
+	assert.Nil(t, err)
+	assert.Nil(t, gs.Start())
+	assert.Nil(t, gs.Close())
+
+	gs, err = NewGrpcServer(createMockArgsGrpcServer(&mock.FacadeStub{}))
+	assert.False(t, check.IfNil(gs))
+	assert.Nil(t, err)
+	assert.Nil(t, gs.Start())
+	assert.Nil(t, gs.Close())
+}
+
+func TestGrpcServer_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	client, gs := startTestServer(t, createMockArgsGrpcServer(&mock.FacadeStub{
+		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+			return api.AccountResponse{}, errors.New("node is starting")
+		},
+	}))
+
+	_, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	assert.Equal(t, ErrNilFacade, gs.UpdateFacade(nil))
+	err = gs.UpdateFacade(&mock.FacadeStub{
+		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: address, Nonce: 7, Balance: "100"}, nil
+		},
+	})
+	require.Nil(t, err)
+
+	account, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+	require.Nil(t, err)
+	assert.Equal(t, &Account{Address: "erd1", Nonce: 7, Balance: "100"}, account)
+}
+
+func TestGrpcServer_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validation error should return invalid argument", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(_ uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _ uint32, _ uint32) (*transaction.Transaction, []byte, error) {
+				return &transaction.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+				return errors.New("invalid signature")
+			},
+		}
+		client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+		_, err := client.SendTransaction(context.Background(), &SendTransactionRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "invalid signature")
+	})
+	t.Run("throttled endpoint should return resource exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return false
+					},
+				}, endpoint == sendTransactionEndpoint
+			},
+		}
+		client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+		_, err := client.SendTransaction(context.Background(), &SendTransactionRequest{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := &mock.ThrottlerStub{}
+		numSent := uint32(0)
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return throttler, endpoint == sendTransactionEndpoint
+			},
+			CreateTransactionHandler: func(nonce uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _ uint32, _ uint32) (*transaction.Transaction, []byte, error) {
+				return &transaction.Transaction{Nonce: nonce}, []byte("hash"), nil
+			},
+			ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+				return nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				atomic.AddUint32(&numSent, uint32(len(txs)))
+				return uint64(len(txs)), nil
+			},
+		}
+		client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+		response, err := client.SendTransaction(context.Background(), &SendTransactionRequest{Nonce: 1})
+		require.Nil(t, err)
+		assert.Equal(t, "68617368", response.TxHash)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numSent))
+		assert.True(t, throttler.StartWasCalled)
+		assert.True(t, throttler.EndWasCalled)
+	})
+}
+
+func TestGrpcServer_GetBlockAndTransaction(t *testing.T) {
+	t.Parallel()
+
+	apiTx := &transaction.ApiTransactionResult{Hash: "aa", Nonce: 3, Status: transaction.TxStatusSuccess}
+	facade := &mock.FacadeStub{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*api.Block, error) {
+			return &api.Block{Hash: hash, Nonce: 10}, nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{
+				Nonce:      nonce,
+				MiniBlocks: []*api.MiniBlock{{Hash: "mb", Transactions: []*transaction.ApiTransactionResult{apiTx}}},
+			}, nil
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return apiTx, nil
+		},
+	}
+	client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+	block, err := client.GetBlock(context.Background(), &BlockRequest{Hash: "bb"})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), block.Nonce)
+	assert.Equal(t, "bb", block.Hash)
+
+	block, err = client.GetBlock(context.Background(), &BlockRequest{Nonce: 5, WithTxs: true})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), block.Nonce)
+	require.Equal(t, 1, len(block.MiniBlocks))
+	assert.Equal(t, "success", block.MiniBlocks[0].Transactions[0].Status)
+
+	tx, err := client.GetTransaction(context.Background(), &TransactionRequest{Hash: "aa"})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), tx.Nonce)
+}
+
+func TestGrpcServer_QueryVmValues(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{query.Arguments[0]},
+				ReturnCode: "ok",
+			}, nil
+		},
+	}
+	client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+	_, err := client.QueryVmValues(context.Background(), &VmValuesRequest{ScAddress: "not hex"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.QueryVmValues(context.Background(), &VmValuesRequest{ScAddress: "aa", Args: []string{"zz"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	response, err := client.QueryVmValues(context.Background(), &VmValuesRequest{ScAddress: "aa", Args: []string{"0102"}})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{1, 2}}, response.ReturnData)
+	assert.Equal(t, "ok", response.ReturnCode)
+}
+
+func TestGrpcServer_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				ConfigMetricsCalled: func() (map[string]interface{}, error) {
+					return map[string]interface{}{"erd_num_shards_without_meta": 3}, nil
+				},
+			}
+		},
+	}
+	client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+	metrics, err := client.GetNetworkConfig(context.Background(), &NetworkRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"erd_num_shards_without_meta": "3"}, metrics.Metrics)
+}
+
+func TestGrpcServer_StreamBlocks(t *testing.T) {
+	t.Parallel()
+
+	lastCommittedNonce := uint64(2)
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			if nonce > atomic.LoadUint64(&lastCommittedNonce) {
+				return nil, errors.New("block not found")
+			}
+
+			return &api.Block{Nonce: nonce}, nil
+		},
+		StatusMetricsHandler: createStatusMetricsWithNonce(&lastCommittedNonce),
+	}
+	client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	stream, err := client.StreamBlocks(ctx, &StreamBlocksRequest{FromNonce: 1})
+	require.Nil(t, err)
+
+	for expectedNonce := uint64(1); expectedNonce <= 4; expectedNonce++ {
+		if expectedNonce == 3 {
+			// the next blocks are committed while the stream waits for them
+			atomic.StoreUint64(&lastCommittedNonce, 4)
+		}
+
+		block, errRecv := stream.Recv()
+		require.Nil(t, errRecv)
+		assert.Equal(t, expectedNonce, block.Nonce)
+	}
+
+	// only one stream is allowed at a time
+	secondStream, err := client.StreamBlocks(ctx, &StreamBlocksRequest{FromNonce: 1})
+	require.Nil(t, err)
+	_, err = secondStream.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func createStatusMetricsWithNonce(lastCommittedNonce *uint64) func() external.StatusMetricsHandler {
+	return func() external.StatusMetricsHandler {
+		return &testscommon.StatusMetricsStub{
+			NetworkMetricsCalled: func() (map[string]interface{}, error) {
+				return map[string]interface{}{common.MetricNonce: atomic.LoadUint64(lastCommittedNonce)}, nil
+			},
+		}
+	}
+}
+
+func TestGrpcServer_StreamBlocksShouldEndWhenACommittedBlockIsMissing(t *testing.T) {
+	t.Parallel()
+
+	oldestStoredNonce := uint64(5)
+	lastCommittedNonce := uint64(10)
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			if nonce < oldestStoredNonce || nonce > lastCommittedNonce {
+				return nil, errors.New("block not found")
+			}
+
+			return &api.Block{Nonce: nonce}, nil
+		},
+		StatusMetricsHandler: createStatusMetricsWithNonce(&lastCommittedNonce),
+	}
+	client, _ := startTestServer(t, createMockArgsGrpcServer(facade))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	stream, err := client.StreamBlocks(ctx, &StreamBlocksRequest{FromNonce: 1})
+	require.Nil(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGrpcServer_ClosedRouteShouldNotBeServed(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: address}, nil
+		},
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*api.Block, error) {
+			return &api.Block{Hash: hash}, nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+	args := createMockArgsGrpcServer(facade)
+	args.ApiConfig.APIPackages["address"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{{Name: "/:address", Open: false}},
+	}
+	args.ApiConfig.APIPackages["block"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{{Name: "/by-hash/:hash", Open: true}},
+	}
+	client, _ := startTestServer(t, args)
+
+	_, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = client.GetBlock(context.Background(), &BlockRequest{Nonce: 1})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	block, err := client.GetBlock(context.Background(), &BlockRequest{Hash: "aa"})
+	require.Nil(t, err)
+	assert.Equal(t, "aa", block.Hash)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	stream, err := client.StreamBlocks(ctx, &StreamBlocksRequest{FromNonce: 1})
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestGrpcServer_ShouldUseTheSharedThrottlers(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: address}, nil
+		},
+	}
+
+	t.Run("source throttler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGrpcServer(facade)
+		args.RequestsThrottlers = createRequestsThrottlers(10, 1)
+		client, _ := startTestServer(t, args)
+
+		_, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Nil(t, err)
+		_, err = client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+	t.Run("global throttler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGrpcServer(facade)
+		args.RequestsThrottlers = createRequestsThrottlers(1, 10)
+		client, _ := startTestServer(t, args)
+
+		// a REST request holds the only slot
+		globalThrottler := args.RequestsThrottlers.GlobalThrottler()
+		require.True(t, globalThrottler.StartProcessing("/address/:address"))
+		_, err := client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		globalThrottler.EndProcessing("/address/:address")
+		_, err = client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+		assert.Nil(t, err)
+	})
+}
+
+func TestGrpcServer_ShouldEnforceTheRolesOfTheRoutes(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+			return api.AccountResponse{Address: address}, nil
+		},
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				ConfigMetricsCalled: func() (map[string]interface{}, error) {
+					return make(map[string]interface{}), nil
+				},
+			}
+		},
+	}
+	args := createMockArgsGrpcServer(facade)
+	args.ApiConfig.Auth = config.ApiAuthConfig{
+		Enabled: true,
+		Credentials: []config.ApiCredentialConfig{
+			{Name: "operator", Type: middleware.CredentialTypeBearer, Secret: "operator token", Role: middleware.RoleOperator},
+			{Name: "admin", Type: middleware.CredentialTypeBearer, Secret: "admin token", Role: middleware.RoleAdmin},
+		},
+	}
+	args.ApiConfig.APIPackages["address"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{{Name: "/:address", Open: true, Role: middleware.RoleAdmin}},
+	}
+	client, _ := startTestServer(t, args)
+
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+
+	_, err := client.GetNetworkConfig(context.Background(), &NetworkRequest{})
+	assert.Nil(t, err)
+
+	_, err = client.GetAccount(context.Background(), &AccountRequest{Address: "erd1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetAccount(withToken("invalid token"), &AccountRequest{Address: "erd1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetAccount(withToken("operator token"), &AccountRequest{Address: "erd1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	account, err := client.GetAccount(withToken("admin token"), &AccountRequest{Address: "erd1"})
+	require.Nil(t, err)
+	assert.Equal(t, "erd1", account.Address)
+}
@@ -0,0 +1,351 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderAccessKey is the header holding the API key of the request
+	HeaderAccessKey = "X-Access-Key"
+
+	// ApiKeyNameKey is the key under which the name of the API key used by the request is stored in the gin context
+	ApiKeyNameKey = "apiKeyName"
+
+	// ApiKeyRequestsKey is the key under which the handler accounting additional requests in the budgets of the API key
+	// used by the request is stored in the gin context
+	ApiKeyRequestsKey = "apiKeyRequests"
+
+	allGroups = "*"
+
+	// unknownGroup is the group of all the requests not matching any route
+	unknownGroup = "unknown"
+)
+
+// ApiKeyGroupUsage holds the usage counters of an API key for a route group
+type ApiKeyGroupUsage struct {
+	NumRequests uint64 `json:"numRequests"`
+	NumRejected uint64 `json:"numRejected"`
+}
+
+type groupLimiter struct {
+	requestsPerSecond     uint32
+	maxConcurrentRequests uint32
+	currentSecond         int64
+	numInCurrentSecond    uint32
+	numConcurrent         uint32
+}
+
+type apiKey struct {
+	name     string
+	limits   map[string]config.ApiKeyLimitsConfig
+	limiters map[string]*groupLimiter
+	usage    map[string]*ApiKeyGroupUsage
+}
+
+type apiKeyRequests struct {
+	throttler *apiKeyThrottler
+	key       *apiKey
+	group     string
+}
+
+// StartProcessing accounts one more request in the budgets of the API key on the route group
+func (akr *apiKeyRequests) StartProcessing() error {
+	return akr.throttler.startProcessing(akr.key, akr.group)
+}
+
+// EndProcessing releases a request previously started with StartProcessing
+func (akr *apiKeyRequests) EndProcessing() {
+	akr.throttler.endProcessing(akr.key, akr.group)
+}
+
+// AbortProcessing releases a request previously started with StartProcessing that was not served, giving back its
+// requests per second unit as well
+func (akr *apiKeyRequests) AbortProcessing() {
+	akr.throttler.abortProcessing(akr.key, akr.group)
+}
+
+// ArgsApiKeyThrottler holds the arguments needed to create a new instance of apiKeyThrottler
+type ArgsApiKeyThrottler struct {
+	Config config.ApiKeysConfig
+}
+
+// apiKeyThrottler is a middleware that applies, for each API key, the requests per second and the concurrent requests
+// budgets of the accessed route group, while counting the requests of each key
+type apiKeyThrottler struct {
+	requireKey     bool
+	usageFilePath  string
+	mutKeys        sync.Mutex
+	keys           map[string]*apiKey
+	getTimeHandler func() time.Time
+}
+
+// NewApiKeyThrottler creates a new instance of an apiKeyThrottler. The previously saved usage, if any, is loaded
+func NewApiKeyThrottler(args ArgsApiKeyThrottler) (*apiKeyThrottler, error) {
+	akt := &apiKeyThrottler{
+		requireKey:     args.Config.RequireKey,
+		usageFilePath:  args.Config.UsageFilePath,
+		keys:           make(map[string]*apiKey),
+		getTimeHandler: time.Now,
+	}
+
+	err := akt.addKeys(args.Config.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	err = akt.loadUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	return akt, nil
+}
+
+func (akt *apiKeyThrottler) addKeys(keys []config.ApiKeyConfig) error {
+	names := make(map[string]struct{})
+	for _, keyConfig := range keys {
+		if len(keyConfig.Name) == 0 || len(keyConfig.Key) == 0 {
+			return ErrEmptyApiKey
+		}
+
+		_, nameExists := names[keyConfig.Name]
+		_, keyExists := akt.keys[keyConfig.Key]
+		if nameExists || keyExists {
+			return fmt.Errorf("%w: %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+		names[keyConfig.Name] = struct{}{}
+
+		limits := make(map[string]config.ApiKeyLimitsConfig)
+		for _, limitsConfig := range keyConfig.Limits {
+			_, groupExists := limits[limitsConfig.Group]
+			if len(limitsConfig.Group) == 0 || groupExists {
+				return fmt.Errorf("%w for key %s, group %s", ErrInvalidApiKeyLimits, keyConfig.Name, limitsConfig.Group)
+			}
+
+			limits[limitsConfig.Group] = limitsConfig
+		}
+
+		akt.keys[keyConfig.Key] = &apiKey{
+			name:     keyConfig.Name,
+			limits:   limits,
+			limiters: make(map[string]*groupLimiter),
+			usage:    make(map[string]*ApiKeyGroupUsage),
+		}
+	}
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (akt *apiKeyThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, found := akt.keys[c.GetHeader(HeaderAccessKey)]
+		if !found {
+			if akt.requireKey {
+				abortWithUnauthorized(c, http.StatusUnauthorized, ErrMissingApiKey)
+				return
+			}
+
+			c.Next()
+			return
+		}
+
+		group := getRouteGroup(c)
+		err := akt.startProcessing(key, group)
+		if err != nil {
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for API key %s on group %s", err.Error(), key.name, group),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		defer akt.endProcessing(key, group)
+
+		c.Set(ApiKeyNameKey, key.name)
+		c.Set(ApiKeyRequestsKey, shared.ApiKeyRequestsHandler(&apiKeyRequests{
+			throttler: akt,
+			key:       key,
+			group:     group,
+		}))
+		c.Next()
+	}
+}
+
+func (akt *apiKeyThrottler) startProcessing(key *apiKey, group string) error {
+	akt.mutKeys.Lock()
+	defer akt.mutKeys.Unlock()
+
+	usage := key.getUsage(group)
+	usage.NumRequests++
+
+	limiter := key.getLimiter(group)
+	currentSecond := akt.getTimeHandler().Unix()
+	if limiter.currentSecond != currentSecond {
+		limiter.currentSecond = currentSecond
+		limiter.numInCurrentSecond = 0
+	}
+
+	isRateExceeded := limiter.requestsPerSecond > 0 && limiter.numInCurrentSecond >= limiter.requestsPerSecond
+	if isRateExceeded {
+		usage.NumRejected++
+		return ErrTooManyRequests
+	}
+
+	isConcurrencyExceeded := limiter.maxConcurrentRequests > 0 && limiter.numConcurrent >= limiter.maxConcurrentRequests
+	if isConcurrencyExceeded {
+		usage.NumRejected++
+		return ErrTooManyConcurrentRequests
+	}
+
+	limiter.numInCurrentSecond++
+	limiter.numConcurrent++
+
+	return nil
+}
+
+func (akt *apiKeyThrottler) endProcessing(key *apiKey, group string) {
+	akt.mutKeys.Lock()
+	key.getLimiter(group).numConcurrent--
+	akt.mutKeys.Unlock()
+}
+
+func (akt *apiKeyThrottler) abortProcessing(key *apiKey, group string) {
+	akt.mutKeys.Lock()
+	defer akt.mutKeys.Unlock()
+
+	limiter := key.getLimiter(group)
+	limiter.numConcurrent--
+	if limiter.currentSecond == akt.getTimeHandler().Unix() && limiter.numInCurrentSecond > 0 {
+		limiter.numInCurrentSecond--
+	}
+}
+
+func (key *apiKey) getLimiter(group string) *groupLimiter {
+	limiter, found := key.limiters[group]
+	if found {
+		return limiter
+	}
+
+	limits, found := key.limits[group]
+	if !found {
+		limits = key.limits[allGroups]
+	}
+
+	limiter = &groupLimiter{
+		requestsPerSecond:     limits.RequestsPerSecond,
+		maxConcurrentRequests: limits.MaxConcurrentRequests,
+	}
+	key.limiters[group] = limiter
+
+	return limiter
+}
+
+func (key *apiKey) getUsage(group string) *ApiKeyGroupUsage {
+	usage, found := key.usage[group]
+	if !found {
+		usage = &ApiKeyGroupUsage{}
+		key.usage[group] = usage
+	}
+
+	return usage
+}
+
+// getRouteGroup returns the first segment of the matched route. The requests not matching any route share the same
+// group, so the requested paths cannot grow the usage counters
+func getRouteGroup(c *gin.Context) string {
+	path := c.FullPath()
+	if len(path) == 0 {
+		return unknownGroup
+	}
+
+	return strings.Split(strings.TrimPrefix(path, "/"), "/")[0]
+}
+
+// GetUsage returns a copy of the usage counters, for each API key name and route group
+func (akt *apiKeyThrottler) GetUsage() map[string]map[string]ApiKeyGroupUsage {
+	akt.mutKeys.Lock()
+	defer akt.mutKeys.Unlock()
+
+	usage := make(map[string]map[string]ApiKeyGroupUsage, len(akt.keys))
+	for _, key := range akt.keys {
+		keyUsage := make(map[string]ApiKeyGroupUsage, len(key.usage))
+		for group, groupUsage := range key.usage {
+			keyUsage[group] = *groupUsage
+		}
+
+		usage[key.name] = keyUsage
+	}
+
+	return usage
+}
+
+// SaveUsage writes the usage counters in the configured usage file. It does nothing if no file was configured
+func (akt *apiKeyThrottler) SaveUsage() error {
+	if len(akt.usageFilePath) == 0 {
+		return nil
+	}
+
+	usageBytes, err := json.MarshalIndent(akt.GetUsage(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the usage is written in a temporary file first so a crash while writing will not corrupt the previous usage
+	tmpFilePath := akt.usageFilePath + ".tmp"
+	err = ioutil.WriteFile(tmpFilePath, usageBytes, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, akt.usageFilePath)
+}
+
+func (akt *apiKeyThrottler) loadUsage() error {
+	if len(akt.usageFilePath) == 0 {
+		return nil
+	}
+
+	usageBytes, err := ioutil.ReadFile(akt.usageFilePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	savedUsage := make(map[string]map[string]ApiKeyGroupUsage)
+	err = json.Unmarshal(usageBytes, &savedUsage)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range akt.keys {
+		for group, groupUsage := range savedUsage[key.name] {
+			usage := groupUsage
+			key.usage[group] = &usage
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akt *apiKeyThrottler) IsInterfaceNil() bool {
+	return akt == nil
+}
@@ -0,0 +1,216 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	partnerKey = "partner key"
+	limitedKey = "limited key"
+)
+
+func createMockArgsApiKeyThrottler() middleware.ArgsApiKeyThrottler {
+	return middleware.ArgsApiKeyThrottler{
+		Config: config.ApiKeysConfig{
+			Enabled: true,
+			Keys: []config.ApiKeyConfig{
+				{
+					Name: "partner",
+					Key:  partnerKey,
+				},
+				{
+					Name: "limited",
+					Key:  limitedKey,
+					Limits: []config.ApiKeyLimitsConfig{
+						{Group: "*", RequestsPerSecond: 2},
+						{Group: "transaction", MaxConcurrentRequests: 1},
+					},
+				},
+			},
+		},
+	}
+}
+
+func startNodeServerApiKeyThrottler(throttler gin.HandlerFunc, handler func(c *gin.Context)) *gin.Engine {
+	ws := gin.New()
+	ws.Use(throttler)
+
+	addressRoutes := ws.Group("/address")
+	addressRoutes.Handle(http.MethodGet, "/:address/balance", handler)
+	transactionRoutes := ws.Group("/transaction")
+	transactionRoutes.Handle(http.MethodPost, "/send", handler)
+
+	return ws
+}
+
+func sendRequestWithApiKey(ws *gin.Engine, method string, path string, key string) int {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(key) > 0 {
+		req.Header.Set(middleware.HeaderAccessKey, key)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewApiKeyThrottler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeyThrottler()
+		args.Config.Keys[0].Key = ""
+		akt, err := middleware.NewApiKeyThrottler(args)
+		assert.True(t, check.IfNil(akt))
+		assert.Equal(t, middleware.ErrEmptyApiKey, err)
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeyThrottler()
+		args.Config.Keys[1].Name = args.Config.Keys[0].Name
+		akt, err := middleware.NewApiKeyThrottler(args)
+		assert.True(t, check.IfNil(akt))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+	})
+	t.Run("duplicated group limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeyThrottler()
+		args.Config.Keys[1].Limits[1].Group = "*"
+		akt, err := middleware.NewApiKeyThrottler(args)
+		assert.True(t, check.IfNil(akt))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidApiKeyLimits))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		akt, err := middleware.NewApiKeyThrottler(createMockArgsApiKeyThrottler())
+		assert.False(t, check.IfNil(akt))
+		assert.Nil(t, err)
+	})
+}
+
+func TestApiKeyThrottler_MissingKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsApiKeyThrottler()
+	akt, _ := middleware.NewApiKeyThrottler(args)
+	ws := startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {})
+	assert.Equal(t, http.StatusOK, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", ""))
+	assert.Equal(t, http.StatusOK, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", "unknown"))
+
+	args.Config.RequireKey = true
+	akt, _ = middleware.NewApiKeyThrottler(args)
+	ws = startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {})
+	assert.Equal(t, http.StatusUnauthorized, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", ""))
+	assert.Equal(t, http.StatusUnauthorized, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", "unknown"))
+	assert.Equal(t, http.StatusOK, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", partnerKey))
+}
+
+func TestApiKeyThrottler_RequestsPerSecondShouldLimitOnlyTheKey(t *testing.T) {
+	t.Parallel()
+
+	akt, _ := middleware.NewApiKeyThrottler(createMockArgsApiKeyThrottler())
+	ws := startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {
+		name, _ := c.Get(middleware.ApiKeyNameKey)
+		c.String(http.StatusOK, "%v", name)
+	})
+
+	// the requests might be spread over 2 one-second windows, so at most 4 of them can pass
+	numRequests := 5
+	numLimited := 0
+	for i := 0; i < numRequests; i++ {
+		if sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", limitedKey) == http.StatusTooManyRequests {
+			numLimited++
+		}
+		assert.Equal(t, http.StatusOK, sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", partnerKey))
+	}
+	assert.True(t, numLimited > 0)
+
+	usage := akt.GetUsage()
+	assert.Equal(t, middleware.ApiKeyGroupUsage{NumRequests: uint64(numRequests), NumRejected: uint64(numLimited)}, usage["limited"]["address"])
+	assert.Equal(t, middleware.ApiKeyGroupUsage{NumRequests: uint64(numRequests)}, usage["partner"]["address"])
+}
+
+func TestApiKeyThrottler_ConcurrentRequestsShouldLimit(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	chStarted := make(chan struct{}, 1)
+	akt, _ := middleware.NewApiKeyThrottler(createMockArgsApiKeyThrottler())
+	ws := startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {
+		chStarted <- struct{}{}
+		<-chRelease
+	})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Equal(t, http.StatusOK, sendRequestWithApiKey(ws, http.MethodPost, "/transaction/send", limitedKey))
+	}()
+
+	select {
+	case <-chStarted:
+	case <-time.After(time.Second):
+		require.Fail(t, "first request should have started")
+	}
+	assert.Equal(t, http.StatusTooManyRequests, sendRequestWithApiKey(ws, http.MethodPost, "/transaction/send", limitedKey))
+
+	close(chRelease)
+	wg.Wait()
+
+	assert.Equal(t, uint64(1), akt.GetUsage()["limited"]["transaction"].NumRejected)
+}
+
+func TestApiKeyThrottler_SaveAndLoadUsage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsApiKeyThrottler()
+	args.Config.UsageFilePath = filepath.Join(t.TempDir(), "usage.json")
+	akt, err := middleware.NewApiKeyThrottler(args)
+	require.Nil(t, err)
+
+	ws := startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {})
+	sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", partnerKey)
+	sendRequestWithApiKey(ws, http.MethodPost, "/transaction/send", partnerKey)
+	require.Nil(t, akt.SaveUsage())
+
+	reloaded, err := middleware.NewApiKeyThrottler(args)
+	require.Nil(t, err)
+	assert.Equal(t, akt.GetUsage(), reloaded.GetUsage())
+	assert.Equal(t, uint64(1), reloaded.GetUsage()["partner"]["transaction"].NumRequests)
+}
+
+func TestApiKeyThrottler_UnmatchedRoutesShouldShareTheSameGroup(t *testing.T) {
+	t.Parallel()
+
+	akt, err := middleware.NewApiKeyThrottler(createMockArgsApiKeyThrottler())
+	require.Nil(t, err)
+
+	ws := startNodeServerApiKeyThrottler(akt.MiddlewareHandlerFunc(), func(c *gin.Context) {})
+	sendRequestWithApiKey(ws, http.MethodGet, "/first/path", partnerKey)
+	sendRequestWithApiKey(ws, http.MethodGet, "/second/path", partnerKey)
+	sendRequestWithApiKey(ws, http.MethodGet, "/address/erd1/balance", partnerKey)
+
+	usage := akt.GetUsage()["partner"]
+	assert.Equal(t, 2, len(usage))
+	assert.Equal(t, uint64(2), usage["unknown"].NumRequests)
+	assert.Equal(t, uint64(1), usage["address"].NumRequests)
+}
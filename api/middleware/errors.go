@@ -7,3 +7,45 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidRole signals that an unknown role was provided
+var ErrInvalidRole = errors.New("invalid role")
+
+// ErrInvalidCredentialType signals that an unknown credential type was provided
+var ErrInvalidCredentialType = errors.New("invalid credential type")
+
+// ErrEmptyCredentialName signals that a credential without a name was provided
+var ErrEmptyCredentialName = errors.New("empty credential name")
+
+// ErrEmptyCredentialSecret signals that a credential without a secret was provided
+var ErrEmptyCredentialSecret = errors.New("empty credential secret")
+
+// ErrDuplicatedCredential signals that the same credential name or secret was provided more than once
+var ErrDuplicatedCredential = errors.New("duplicated credential")
+
+// ErrInvalidMaxClockSkew signals that an invalid maximum clock skew was provided
+var ErrInvalidMaxClockSkew = errors.New("invalid max clock skew")
+
+// ErrUnauthenticatedRequest signals that the request did not carry valid credentials
+var ErrUnauthenticatedRequest = errors.New("missing or invalid credentials")
+
+// ErrForbiddenRequest signals that the provided credentials do not grant access to the requested route
+var ErrForbiddenRequest = errors.New("insufficient role for the requested route")
+
+// ErrEmptyApiKey signals that an API key without a name or a value was provided
+var ErrEmptyApiKey = errors.New("empty API key")
+
+// ErrDuplicatedApiKey signals that the same API key name or value was provided more than once
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrInvalidApiKeyLimits signals that invalid limits were provided for an API key
+var ErrInvalidApiKeyLimits = errors.New("invalid API key limits")
+
+// ErrMissingApiKey signals that the request did not carry a known API key
+var ErrMissingApiKey = errors.New("missing or unknown API key")
+
+// ErrTooManyConcurrentRequests signals that the concurrent requests budget of an API key was exhausted
+var ErrTooManyConcurrentRequests = errors.New("too many concurrent requests")
+
+// ErrInvalidResetInterval signals that an invalid reset interval was provided
+var ErrInvalidResetInterval = errors.New("invalid reset interval")
@@ -112,3 +112,15 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_StartAndEndProcessing(t *testing.T) {
+	t.Parallel()
+
+	gt, _ := middleware.NewGlobalThrottler(1)
+
+	assert.True(t, gt.StartProcessing("path 1"))
+	assert.False(t, gt.StartProcessing("path 2"))
+
+	gt.EndProcessing("path 1")
+	assert.True(t, gt.StartProcessing("path 2"))
+}
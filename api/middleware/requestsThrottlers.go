@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// requestsThrottlers holds the source and the global throttlers shared by the REST and the gRPC APIs, and resets the
+// source throttler periodically
+type requestsThrottlers struct {
+	sourceThrottler *sourceThrottler
+	globalThrottler *globalThrottler
+	resetInterval   time.Duration
+	cancelFunc      func()
+}
+
+// NewRequestsThrottlers creates the throttlers described by the provided antiflood config
+func NewRequestsThrottlers(antiFloodConfig config.WebServerAntifloodConfig) (*requestsThrottlers, error) {
+	if antiFloodConfig.SameSourceResetIntervalInSec == 0 {
+		return nil, ErrInvalidResetInterval
+	}
+
+	sourceLimiter, err := NewSourceThrottler(antiFloodConfig.SameSourceRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	globalLimiter, err := NewGlobalThrottler(antiFloodConfig.SimultaneousRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &requestsThrottlers{
+		sourceThrottler: sourceLimiter,
+		globalThrottler: globalLimiter,
+		resetInterval:   time.Second * time.Duration(antiFloodConfig.SameSourceResetIntervalInSec),
+	}
+
+	var ctx context.Context
+	ctx, rt.cancelFunc = context.WithCancel(context.Background())
+	go rt.sourceLimiterReset(ctx)
+
+	return rt, nil
+}
+
+func (rt *requestsThrottlers) sourceLimiterReset(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(rt.resetInterval):
+			log.Trace("calling reset on API source limiter")
+			rt.sourceThrottler.Reset()
+		case <-ctx.Done():
+			log.Debug("closing requestsThrottlers.sourceLimiterReset go routine")
+			return
+		}
+	}
+}
+
+// SourceThrottler returns the throttler limiting the number of requests originating from the same source
+func (rt *requestsThrottlers) SourceThrottler() shared.SourceThrottler {
+	return rt.sourceThrottler
+}
+
+// GlobalThrottler returns the throttler limiting the number of simultaneous requests
+func (rt *requestsThrottlers) GlobalThrottler() shared.GlobalThrottler {
+	return rt.globalThrottler
+}
+
+// Close stops resetting the source throttler
+func (rt *requestsThrottlers) Close() error {
+	rt.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *requestsThrottlers) IsInterfaceNil() bool {
+	return rt == nil
+}
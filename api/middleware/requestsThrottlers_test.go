@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockAntiFloodConfig() config.WebServerAntifloodConfig {
+	return config.WebServerAntifloodConfig{
+		SimultaneousRequests:         1,
+		SameSourceRequests:           1,
+		SameSourceResetIntervalInSec: 1,
+	}
+}
+
+func TestNewRequestsThrottlers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid reset interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAntiFloodConfig()
+		cfg.SameSourceResetIntervalInSec = 0
+		rt, err := NewRequestsThrottlers(cfg)
+		assert.Equal(t, ErrInvalidResetInterval, err)
+		assert.True(t, check.IfNil(rt))
+	})
+	t.Run("invalid same source requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAntiFloodConfig()
+		cfg.SameSourceRequests = 0
+		rt, err := NewRequestsThrottlers(cfg)
+		assert.Equal(t, ErrInvalidMaxNumRequests, err)
+		assert.True(t, check.IfNil(rt))
+	})
+	t.Run("invalid simultaneous requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAntiFloodConfig()
+		cfg.SimultaneousRequests = 0
+		rt, err := NewRequestsThrottlers(cfg)
+		assert.Equal(t, ErrInvalidMaxNumRequests, err)
+		assert.True(t, check.IfNil(rt))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rt, err := NewRequestsThrottlers(createMockAntiFloodConfig())
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(rt))
+		assert.True(t, rt.SourceThrottler() == rt.sourceThrottler)
+		assert.True(t, rt.GlobalThrottler() == rt.globalThrottler)
+		assert.Nil(t, rt.Close())
+	})
+}
+
+func TestRequestsThrottlers_ShouldResetTheSourceThrottler(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewRequestsThrottlers(createMockAntiFloodConfig())
+	require.Nil(t, err)
+	defer func() {
+		_ = rt.Close()
+	}()
+
+	assert.True(t, rt.SourceThrottler().CanProcessRequest("source"))
+	assert.False(t, rt.SourceThrottler().CanProcessRequest("source"))
+	assert.Eventually(t, func() bool {
+		return rt.SourceThrottler().CanProcessRequest("source")
+	}, time.Second*5, time.Millisecond*10)
+}
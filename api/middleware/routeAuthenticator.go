@@ -0,0 +1,354 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// RolePublic is the role of the routes that can be accessed without credentials
+	RolePublic = "public"
+	// RoleOperator is the role of the routes that can be accessed by operators and admins
+	RoleOperator = "operator"
+	// RoleAdmin is the role of the routes that can be accessed only by admins
+	RoleAdmin = "admin"
+
+	// CredentialTypeBearer defines the credentials sent as a bearer token in the Authorization header
+	CredentialTypeBearer = "bearer"
+	// CredentialTypeHMAC defines the credentials used to sign the request with HMAC-SHA256
+	CredentialTypeHMAC = "hmac"
+
+	// HeaderApiKey is the header holding the name of the credential used to sign the request
+	HeaderApiKey = "X-Api-Key"
+	// HeaderApiTimestamp is the header holding the unix timestamp, in seconds, at which the request was signed
+	HeaderApiTimestamp = "X-Api-Timestamp"
+	// HeaderApiSignature is the header holding the hex encoded HMAC-SHA256 signature of the request
+	HeaderApiSignature = "X-Api-Signature"
+
+	// AuthenticatedCredentialKey is the key under which the name of the credential used by the request is stored in
+	// the gin context
+	AuthenticatedCredentialKey = "authenticatedCredential"
+
+	bearerPrefix = "Bearer "
+)
+
+var rolesLevels = map[string]int{
+	RolePublic:   0,
+	RoleOperator: 1,
+	RoleAdmin:    2,
+}
+
+// rootPackages holds the API packages whose routes are registered directly on the engine, not under a group
+var rootPackages = map[string]struct{}{
+	"log": {},
+}
+
+type credential struct {
+	name   string
+	secret []byte
+	level  int
+}
+
+// ArgsRouteAuthenticator holds the arguments needed to create a new instance of routeAuthenticator
+type ArgsRouteAuthenticator struct {
+	AuthConfig  config.ApiAuthConfig
+	APIPackages map[string]config.APIPackageConfig
+}
+
+// routeAuthenticator is a middleware that enforces the roles required by the routes, as declared in the api.toml
+// file, by authenticating the requests either with bearer tokens or with HMAC signatures
+type routeAuthenticator struct {
+	routesLevels      map[string]int
+	bearerCredentials []*credential
+	hmacCredentials   map[string]*credential
+	maxClockSkew      time.Duration
+	getTimeHandler    func() time.Time
+}
+
+// NewRouteAuthenticator creates a new instance of a routeAuthenticator
+func NewRouteAuthenticator(args ArgsRouteAuthenticator) (*routeAuthenticator, error) {
+	routesLevels, err := createRoutesLevels(args.APIPackages)
+	if err != nil {
+		return nil, err
+	}
+
+	ra := &routeAuthenticator{
+		routesLevels:      routesLevels,
+		bearerCredentials: make([]*credential, 0),
+		hmacCredentials:   make(map[string]*credential),
+		maxClockSkew:      time.Duration(args.AuthConfig.MaxClockSkewInSec) * time.Second,
+		getTimeHandler:    time.Now,
+	}
+
+	err = ra.addCredentials(args.AuthConfig.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	if len(ra.hmacCredentials) > 0 && ra.maxClockSkew == 0 {
+		return nil, ErrInvalidMaxClockSkew
+	}
+
+	return ra, nil
+}
+
+func createRoutesLevels(apiPackages map[string]config.APIPackageConfig) (map[string]int, error) {
+	routesLevels := make(map[string]int)
+	for packageName, packageConfig := range apiPackages {
+		for _, route := range packageConfig.Routes {
+			level, err := getRoleLevel(route.Role)
+			if err != nil {
+				return nil, fmt.Errorf("%w for route %s of package %s", err, route.Name, packageName)
+			}
+
+			routesLevels[computeRoutePath(packageName, route.Name)] = level
+		}
+	}
+
+	return routesLevels, nil
+}
+
+func computeRoutePath(packageName string, routeName string) string {
+	_, isRootPackage := rootPackages[packageName]
+	if isRootPackage {
+		return routeName
+	}
+
+	return fmt.Sprintf("/%s%s", packageName, routeName)
+}
+
+func getRoleLevel(role string) (int, error) {
+	if len(role) == 0 {
+		return rolesLevels[RolePublic], nil
+	}
+
+	level, ok := rolesLevels[strings.ToLower(role)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidRole, role)
+	}
+
+	return level, nil
+}
+
+// IsRoleCovered returns true if the provided role grants access to the routes requiring the required role. Invalid
+// roles do not cover anything
+func IsRoleCovered(role string, requiredRole string) bool {
+	level, err := getRoleLevel(role)
+	if err != nil {
+		return false
+	}
+
+	requiredLevel, err := getRoleLevel(requiredRole)
+	if err != nil {
+		return false
+	}
+
+	return level >= requiredLevel
+}
+
+func (ra *routeAuthenticator) addCredentials(credentials []config.ApiCredentialConfig) error {
+	names := make(map[string]struct{})
+	secrets := make(map[string]struct{})
+	for _, credentialConfig := range credentials {
+		if len(credentialConfig.Name) == 0 {
+			return ErrEmptyCredentialName
+		}
+		if len(credentialConfig.Secret) == 0 {
+			return fmt.Errorf("%w for credential %s", ErrEmptyCredentialSecret, credentialConfig.Name)
+		}
+
+		_, nameExists := names[credentialConfig.Name]
+		_, secretExists := secrets[credentialConfig.Secret]
+		if nameExists || secretExists {
+			return fmt.Errorf("%w: %s", ErrDuplicatedCredential, credentialConfig.Name)
+		}
+		names[credentialConfig.Name] = struct{}{}
+		secrets[credentialConfig.Secret] = struct{}{}
+
+		level, err := getRoleLevel(credentialConfig.Role)
+		if err != nil {
+			return fmt.Errorf("%w for credential %s", err, credentialConfig.Name)
+		}
+
+		cred := &credential{
+			name:   credentialConfig.Name,
+			secret: []byte(credentialConfig.Secret),
+			level:  level,
+		}
+
+		switch strings.ToLower(credentialConfig.Type) {
+		case CredentialTypeBearer:
+			ra.bearerCredentials = append(ra.bearerCredentials, cred)
+		case CredentialTypeHMAC:
+			ra.hmacCredentials[cred.name] = cred
+		default:
+			return fmt.Errorf("%w %s for credential %s", ErrInvalidCredentialType, credentialConfig.Type, credentialConfig.Name)
+		}
+	}
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (ra *routeAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requiredLevel := ra.routesLevels[c.FullPath()]
+		if requiredLevel == rolesLevels[RolePublic] {
+			c.Next()
+			return
+		}
+
+		cred, err := ra.authenticate(c.Request)
+		if err != nil {
+			log.Debug("unauthenticated API request", "path", c.Request.URL.Path, "error", err.Error())
+			abortWithUnauthorized(c, http.StatusUnauthorized, ErrUnauthenticatedRequest)
+			return
+		}
+
+		if cred.level < requiredLevel {
+			log.Debug("forbidden API request", "path", c.Request.URL.Path, "credential", cred.name)
+			abortWithUnauthorized(c, http.StatusForbidden, ErrForbiddenRequest)
+			return
+		}
+
+		c.Set(AuthenticatedCredentialKey, cred.name)
+		c.Next()
+	}
+}
+
+// AuthorizeBearerToken checks that the provided bearer token grants access to the route of the provided package. It is
+// used by the gRPC API, whose requests can only be authenticated with bearer tokens. Returns the name of the credential
+// used, which is empty for the public routes
+func (ra *routeAuthenticator) AuthorizeBearerToken(packageName string, routeName string, token string) (string, error) {
+	requiredLevel := ra.routesLevels[computeRoutePath(packageName, routeName)]
+	if requiredLevel == rolesLevels[RolePublic] {
+		return "", nil
+	}
+
+	cred, err := ra.authenticateBearer([]byte(token))
+	if err != nil {
+		return "", err
+	}
+	if cred.level < requiredLevel {
+		return "", ErrForbiddenRequest
+	}
+
+	return cred.name, nil
+}
+
+func (ra *routeAuthenticator) authenticate(request *http.Request) (*credential, error) {
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		return ra.authenticateBearer([]byte(strings.TrimPrefix(authorization, bearerPrefix)))
+	}
+
+	if len(request.Header.Get(HeaderApiKey)) > 0 {
+		return ra.authenticateHMAC(request)
+	}
+
+	return nil, ErrUnauthenticatedRequest
+}
+
+func (ra *routeAuthenticator) authenticateBearer(token []byte) (*credential, error) {
+	var found *credential
+	for _, cred := range ra.bearerCredentials {
+		// all the tokens are compared so the duration of the check does not leak which one matched
+		if subtle.ConstantTimeCompare(cred.secret, token) == 1 {
+			found = cred
+		}
+	}
+	if found == nil {
+		return nil, ErrUnauthenticatedRequest
+	}
+
+	return found, nil
+}
+
+func (ra *routeAuthenticator) authenticateHMAC(request *http.Request) (*credential, error) {
+	cred, ok := ra.hmacCredentials[request.Header.Get(HeaderApiKey)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown api key", ErrUnauthenticatedRequest)
+	}
+
+	timestamp := request.Header.Get(HeaderApiTimestamp)
+	unixTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp", ErrUnauthenticatedRequest)
+	}
+
+	skew := ra.getTimeHandler().Sub(time.Unix(unixTimestamp, 0))
+	if skew > ra.maxClockSkew || skew < -ra.maxClockSkew {
+		return nil, fmt.Errorf("%w: timestamp out of the accepted range", ErrUnauthenticatedRequest)
+	}
+
+	signature, err := hex.DecodeString(request.Header.Get(HeaderApiSignature))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding", ErrUnauthenticatedRequest)
+	}
+
+	body, err := readAndRestoreBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedSignature := ComputeRequestSignature(cred.secret, request.Method, request.URL.RequestURI(), timestamp, body)
+	if !hmac.Equal(expectedSignature, signature) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrUnauthenticatedRequest)
+	}
+
+	return cred, nil
+}
+
+func readAndRestoreBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return make([]byte, 0), nil
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = request.Body.Close()
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// ComputeRequestSignature returns the HMAC-SHA256 signature, computed with the provided secret, over the method, the
+// request URI, the timestamp and the SHA256 hash of the body of a request, each on a separate line
+func ComputeRequestSignature(secret []byte, method string, requestURI string, timestamp string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(strings.Join([]string{method, requestURI, timestamp, hex.EncodeToString(bodyHash[:])}, "\n")))
+
+	return mac.Sum(nil)
+}
+
+func abortWithUnauthorized(c *gin.Context, status int, err error) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeUnauthorized,
+		},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ra *routeAuthenticator) IsInterfaceNil() bool {
+	return ra == nil
+}
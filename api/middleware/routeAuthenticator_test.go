@@ -0,0 +1,285 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operatorToken = "operator token"
+	adminToken    = "admin token"
+	hmacKeyName   = "hmac admin"
+	hmacSecret    = "hmac secret"
+)
+
+func createMockArgsRouteAuthenticator() middleware.ArgsRouteAuthenticator {
+	return middleware.ArgsRouteAuthenticator{
+		AuthConfig: config.ApiAuthConfig{
+			Enabled:           true,
+			MaxClockSkewInSec: 30,
+			Credentials: []config.ApiCredentialConfig{
+				{Name: "operator", Type: middleware.CredentialTypeBearer, Secret: operatorToken, Role: middleware.RoleOperator},
+				{Name: "admin", Type: middleware.CredentialTypeBearer, Secret: adminToken, Role: middleware.RoleAdmin},
+				{Name: hmacKeyName, Type: middleware.CredentialTypeHMAC, Secret: hmacSecret, Role: middleware.RoleAdmin},
+			},
+		},
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {
+				Routes: []config.RouteConfig{
+					{Name: "/status", Open: true},
+					{Name: "/debug", Open: true, Role: middleware.RoleOperator},
+				},
+			},
+			"hardfork": {
+				Routes: []config.RouteConfig{
+					{Name: "/trigger", Open: true, Role: middleware.RoleAdmin},
+				},
+			},
+			"log": {
+				Routes: []config.RouteConfig{
+					{Name: "/log", Open: true, Role: middleware.RoleAdmin},
+				},
+			},
+		},
+	}
+}
+
+func startNodeServerRouteAuthenticator(args middleware.ArgsRouteAuthenticator, handler func(c *gin.Context)) *gin.Engine {
+	ws := gin.New()
+	routeAuthenticator, _ := middleware.NewRouteAuthenticator(args)
+	ws.Use(routeAuthenticator.MiddlewareHandlerFunc())
+
+	nodeRoutes := ws.Group("/node")
+	nodeRoutes.Handle(http.MethodGet, "/status", handler)
+	nodeRoutes.Handle(http.MethodPost, "/debug", handler)
+	hardforkRoutes := ws.Group("/hardfork")
+	hardforkRoutes.Handle(http.MethodPost, "/trigger", handler)
+	ws.GET("/log", handler)
+
+	return ws
+}
+
+func signRequest(req *http.Request, keyName string, secret string, timestamp time.Time, body []byte) {
+	timestampString := fmt.Sprintf("%d", timestamp.Unix())
+	signature := middleware.ComputeRequestSignature([]byte(secret), req.Method, req.URL.RequestURI(), timestampString, body)
+
+	req.Header.Set(middleware.HeaderApiKey, keyName)
+	req.Header.Set(middleware.HeaderApiTimestamp, timestampString)
+	req.Header.Set(middleware.HeaderApiSignature, hex.EncodeToString(signature))
+}
+
+func TestNewRouteAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid route role should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.APIPackages["node"] = config.APIPackageConfig{Routes: []config.RouteConfig{{Name: "/status", Role: "root"}}}
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRole))
+	})
+	t.Run("invalid credential type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.AuthConfig.Credentials[0].Type = "basic"
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidCredentialType))
+	})
+	t.Run("empty credential name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.AuthConfig.Credentials[0].Name = ""
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.Equal(t, middleware.ErrEmptyCredentialName, err)
+	})
+	t.Run("empty credential secret should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.AuthConfig.Credentials[0].Secret = ""
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.True(t, errors.Is(err, middleware.ErrEmptyCredentialSecret))
+	})
+	t.Run("duplicated credential should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.AuthConfig.Credentials[1].Secret = operatorToken
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedCredential))
+	})
+	t.Run("hmac credentials without clock skew should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRouteAuthenticator()
+		args.AuthConfig.MaxClockSkewInSec = 0
+		ra, err := middleware.NewRouteAuthenticator(args)
+		assert.True(t, check.IfNil(ra))
+		assert.Equal(t, middleware.ErrInvalidMaxClockSkew, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ra, err := middleware.NewRouteAuthenticator(createMockArgsRouteAuthenticator())
+		assert.False(t, check.IfNil(ra))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRouteAuthenticator_BearerTokens(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerRouteAuthenticator(createMockArgsRouteAuthenticator(), func(c *gin.Context) {
+		credential, _ := c.Get(middleware.AuthenticatedCredentialKey)
+		c.String(http.StatusOK, "%v", credential)
+	})
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+	}{
+		{name: "public route without token", method: http.MethodGet, path: "/node/status", expectedStatus: http.StatusOK},
+		{name: "operator route without token", method: http.MethodPost, path: "/node/debug", expectedStatus: http.StatusUnauthorized},
+		{name: "operator route with invalid token", method: http.MethodPost, path: "/node/debug", token: "invalid", expectedStatus: http.StatusUnauthorized},
+		{name: "operator route with operator token", method: http.MethodPost, path: "/node/debug", token: operatorToken, expectedStatus: http.StatusOK},
+		{name: "operator route with admin token", method: http.MethodPost, path: "/node/debug", token: adminToken, expectedStatus: http.StatusOK},
+		{name: "admin route with operator token", method: http.MethodPost, path: "/hardfork/trigger", token: operatorToken, expectedStatus: http.StatusForbidden},
+		{name: "admin route with admin token", method: http.MethodPost, path: "/hardfork/trigger", token: adminToken, expectedStatus: http.StatusOK},
+		{name: "root admin route with operator token", method: http.MethodGet, path: "/log", token: operatorToken, expectedStatus: http.StatusForbidden},
+		{name: "unknown route", method: http.MethodGet, path: "/unknown", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		req, _ := http.NewRequest(tc.method, tc.path, nil)
+		if len(tc.token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, tc.expectedStatus, resp.Code, tc.name)
+	}
+}
+
+func TestRouteAuthenticator_HMACSignatures(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"epoch":1}`)
+	var receivedBody []byte
+	ws := startNodeServerRouteAuthenticator(createMockArgsRouteAuthenticator(), func(c *gin.Context) {
+		receivedBody, _ = c.GetRawData()
+		c.Status(http.StatusOK)
+	})
+
+	sendRequest := func(sign func(req *http.Request)) int {
+		req, _ := http.NewRequest(http.MethodPost, "/hardfork/trigger?force=true", bytes.NewReader(body))
+		sign(req)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	t.Run("valid signature should work and keep the body", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, hmacKeyName, hmacSecret, time.Now(), body)
+		})
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, body, receivedBody)
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, "unknown", hmacSecret, time.Now(), body)
+		})
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+	t.Run("wrong secret should error", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, hmacKeyName, "wrong secret", time.Now(), body)
+		})
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+	t.Run("tampered body should error", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, hmacKeyName, hmacSecret, time.Now(), []byte(`{"epoch":2}`))
+		})
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+	t.Run("expired timestamp should error", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, hmacKeyName, hmacSecret, time.Now().Add(-time.Minute), body)
+		})
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+	t.Run("invalid signature encoding should error", func(t *testing.T) {
+		code := sendRequest(func(req *http.Request) {
+			signRequest(req, hmacKeyName, hmacSecret, time.Now(), body)
+			req.Header.Set(middleware.HeaderApiSignature, strings.Repeat("z", 64))
+		})
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+}
+
+func TestRouteAuthenticator_AuthorizeBearerToken(t *testing.T) {
+	t.Parallel()
+
+	routeAuthenticator, err := middleware.NewRouteAuthenticator(createMockArgsRouteAuthenticator())
+	require.Nil(t, err)
+
+	name, err := routeAuthenticator.AuthorizeBearerToken("node", "/status", "")
+	assert.Nil(t, err)
+	assert.Empty(t, name)
+
+	_, err = routeAuthenticator.AuthorizeBearerToken("node", "/debug", "")
+	assert.Equal(t, middleware.ErrUnauthenticatedRequest, err)
+
+	_, err = routeAuthenticator.AuthorizeBearerToken("node", "/debug", hmacSecret)
+	assert.Equal(t, middleware.ErrUnauthenticatedRequest, err)
+
+	_, err = routeAuthenticator.AuthorizeBearerToken("hardfork", "/trigger", operatorToken)
+	assert.Equal(t, middleware.ErrForbiddenRequest, err)
+
+	name, err = routeAuthenticator.AuthorizeBearerToken("node", "/debug", operatorToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "operator", name)
+
+	name, err = routeAuthenticator.AuthorizeBearerToken("log", "/log", adminToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", name)
+}
+
+func TestIsRoleCovered(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, middleware.IsRoleCovered("", ""))
+	assert.True(t, middleware.IsRoleCovered(middleware.RoleAdmin, middleware.RoleOperator))
+	assert.True(t, middleware.IsRoleCovered(middleware.RoleOperator, middleware.RolePublic))
+	assert.False(t, middleware.IsRoleCovered(middleware.RolePublic, middleware.RoleOperator))
+	assert.False(t, middleware.IsRoleCovered("", middleware.RoleAdmin))
+	assert.False(t, middleware.IsRoleCovered("invalid", middleware.RolePublic))
+}
@@ -33,6 +33,13 @@ func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// the requests authorized by an API key were already limited with the budgets of that key
+		_, hasApiKey := c.Get(ApiKeyNameKey)
+		if hasApiKey {
+			c.Next()
+			return
+		}
+
 		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
 		if err != nil {
 			c.AbortWithStatusJSON(
@@ -46,13 +53,7 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		st.mutRequests.Lock()
-		requests := st.sourceRequests[remoteAddr]
-		isQuotaReached := requests >= st.maxNumRequests
-		st.sourceRequests[remoteAddr]++
-		st.mutRequests.Unlock()
-
-		if isQuotaReached {
+		if !st.CanProcessRequest(remoteAddr) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -68,6 +69,18 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// CanProcessRequest accounts a new request from the provided source and returns false if the source already reached
+// its quota
+func (st *sourceThrottler) CanProcessRequest(source string) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	requests := st.sourceRequests[source]
+	st.sourceRequests[source]++
+
+	return requests < st.maxNumRequests
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
@@ -132,3 +132,48 @@ func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestSourceThrottler_CanProcessRequest(t *testing.T) {
+	t.Parallel()
+
+	st, _ := middleware.NewSourceThrottler(2)
+
+	assert.True(t, st.CanProcessRequest("source 1"))
+	assert.True(t, st.CanProcessRequest("source 1"))
+	assert.False(t, st.CanProcessRequest("source 1"))
+	assert.True(t, st.CanProcessRequest("source 2"))
+
+	st.Reset()
+	assert.True(t, st.CanProcessRequest("source 1"))
+}
+
+func TestSourceThrottler_RequestWithApiKeyShouldNotBeLimited(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		if c.GetHeader(middleware.HeaderAccessKey) == "key" {
+			c.Set(middleware.ApiKeyNameKey, "consumer")
+		}
+	})
+	sourceThrottler, _ := middleware.NewSourceThrottler(1)
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	ws.Handle(http.MethodGet, "/address/:address/balance", func(c *gin.Context) {})
+
+	sendRequest := func(withApiKey bool) int {
+		req, _ := http.NewRequest("GET", "/address/testAddress/balance", nil)
+		req.RemoteAddr = "127.0.0.1:8080"
+		if withApiKey {
+			req.Header.Set(middleware.HeaderAccessKey, "key")
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	assert.Equal(t, http.StatusOK, sendRequest(false))
+	assert.Equal(t, http.StatusTooManyRequests, sendRequest(false))
+	assert.Equal(t, http.StatusOK, sendRequest(true))
+	assert.Equal(t, http.StatusOK, sendRequest(true))
+}
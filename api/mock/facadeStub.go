@@ -40,6 +40,8 @@ type FacadeStub struct {
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatingsCalled                   func() (map[string]int32, error)
+	GetManagedKeysStatusCalled              func() []*common.ManagedKeyStatus
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
@@ -64,17 +66,37 @@ type FacadeStub struct {
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetTokenHoldersCalled                   func(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposalsCalled            func() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled             func(reference string) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshotCalled             func(contractAddress string) (*common.DelegationSnapshotAPIResponse, error)
+	GetRewardsBreakdownCalled               func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdownCalled           func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShufflingCalled             func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroupsCalled                func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformanceCalled           func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	GetProofCalled                          func(string, string) (*common.GetProofResponse, error)
 	GetProofCurrentRootHashCalled           func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
-	GetTokenSupplyCalled                    func(token string) (*api.ESDTSupply, error)
+	GetTokenSupplyCalled                    func(token string) (*common.ESDTSupplyAPIResponse, error)
+	GetTokenNoncesSuppliesCalled            func(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
 	GetGenesisNodesPubKeysCalled            func() (map[uint32][]string, map[uint32][]string, error)
 	GetTransactionsPoolCalled               func() (*common.TransactionsPoolAPIResponse, error)
+	GetAccountTransactionsCalled            func(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
+}
+
+// GetAccountTransactions -
+func (f *FacadeStub) GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+	if f.GetAccountTransactionsCalled != nil {
+		return f.GetAccountTransactionsCalled(address, options)
+	}
+
+	return nil, nil
 }
 
 // GetTokenSupply -
-func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
+func (f *FacadeStub) GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error) {
 	if f.GetTokenSupplyCalled != nil {
 		return f.GetTokenSupplyCalled(token)
 	}
@@ -82,6 +104,15 @@ func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// GetTokenNoncesSupplies -
+func (f *FacadeStub) GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+	if f.GetTokenNoncesSuppliesCalled != nil {
+		return f.GetTokenNoncesSuppliesCalled(collection, page, size)
+	}
+
+	return nil, nil
+}
+
 // GetProof -
 func (f *FacadeStub) GetProof(rootHash string, address string) (*common.GetProofResponse, error) {
 	if f.GetProofCalled != nil {
@@ -312,6 +343,87 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return f.GetDelegatorsListHandler()
 }
 
+// GetTokenHolders -
+func (f *FacadeStub) GetTokenHolders(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error) {
+	if f.GetTokenHoldersCalled != nil {
+		return f.GetTokenHoldersCalled(tokenIdentifier, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(reference string) (*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationSnapshot -
+func (f *FacadeStub) GetDelegationSnapshot(contractAddress string) (*common.DelegationSnapshotAPIResponse, error) {
+	if f.GetDelegationSnapshotCalled != nil {
+		return f.GetDelegationSnapshotCalled(contractAddress)
+	}
+
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (f *FacadeStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if f.GetRewardsBreakdownCalled != nil {
+		return f.GetRewardsBreakdownCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetNodeRewardsBreakdown -
+func (f *FacadeStub) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	if f.GetNodeRewardsBreakdownCalled != nil {
+		return f.GetNodeRewardsBreakdownCalled(epoch, blsKey)
+	}
+
+	return nil, nil
+}
+
+// PreviewNodesShuffling -
+func (f *FacadeStub) PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	if f.PreviewNodesShufflingCalled != nil {
+		return f.PreviewNodesShufflingCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusGroups -
+func (f *FacadeStub) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	if f.GetConsensusGroupsCalled != nil {
+		return f.GetConsensusGroupsCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorPerformance -
+func (f *FacadeStub) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	if f.GetValidatorPerformanceCalled != nil {
+		return f.GetValidatorPerformanceCalled(request)
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -342,6 +454,24 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetPeersRatings -
+func (f *FacadeStub) GetPeersRatings() (map[string]int32, error) {
+	if f.GetPeersRatingsCalled != nil {
+		return f.GetPeersRatingsCalled()
+	}
+
+	return make(map[string]int32), nil
+}
+
+// GetManagedKeysStatus -
+func (f *FacadeStub) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	if f.GetManagedKeysStatusCalled != nil {
+		return f.GetManagedKeysStatusCalled()
+	}
+
+	return make([]*common.ManagedKeyStatus, 0)
+}
+
 // GetBlockByNonce -
 func (f *FacadeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByNonceCalled(nonce, withTxs)
@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/api/shared"
+
+// RequestsThrottlersStub -
+type RequestsThrottlersStub struct {
+	SourceThrottlerCalled func() shared.SourceThrottler
+	GlobalThrottlerCalled func() shared.GlobalThrottler
+	CloseCalled           func() error
+}
+
+// SourceThrottler -
+func (stub *RequestsThrottlersStub) SourceThrottler() shared.SourceThrottler {
+	if stub.SourceThrottlerCalled != nil {
+		return stub.SourceThrottlerCalled()
+	}
+
+	return nil
+}
+
+// GlobalThrottler -
+func (stub *RequestsThrottlersStub) GlobalThrottler() shared.GlobalThrottler {
+	if stub.GlobalThrottlerCalled != nil {
+		return stub.GlobalThrottlerCalled()
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *RequestsThrottlersStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RequestsThrottlersStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -32,6 +32,36 @@ type MiddlewareProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// SourceThrottler defines a middleware that limits the number of requests originating from the same source
+type SourceThrottler interface {
+	MiddlewareProcessor
+	CanProcessRequest(source string) bool
+}
+
+// GlobalThrottler defines a middleware that limits the number of simultaneous requests
+type GlobalThrottler interface {
+	MiddlewareProcessor
+	StartProcessing(path string) bool
+	EndProcessing(path string)
+}
+
+// ApiKeyRequestsHandler accounts additional requests in the budgets of the API key and route group of a request, for
+// the handlers serving more than one request at once
+type ApiKeyRequestsHandler interface {
+	StartProcessing() error
+	EndProcessing()
+	AbortProcessing()
+}
+
+// RequestsThrottlersHandler holds the throttlers shared by all the APIs served by the node, so the limits apply to
+// the requests of all the APIs together
+type RequestsThrottlersHandler interface {
+	SourceThrottler() SourceThrottler
+	GlobalThrottler() GlobalThrottler
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ApiFacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type ApiFacadeHandler interface {
 	RestApiInterface() string
@@ -48,6 +78,14 @@ type UpgradeableHttpServerHandler interface {
 	IsInterfaceNil() bool
 }
 
+// UpgradeableGrpcServerHandler defines the actions that an upgradeable gRPC server need to do
+type UpgradeableGrpcServerHandler interface {
+	Start() error
+	UpdateFacade(facade FacadeHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // GroupHandler defines the actions needed to be performed by an gin API group
 type GroupHandler interface {
 	UpdateFacade(newFacade interface{}) error
@@ -70,6 +108,7 @@ type FacadeHandler interface {
 	GetESDTsWithRole(address string, role string) ([]string, error)
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
 	GetKeyValuePairs(address string) (map[string]string, error)
+	GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
@@ -86,12 +125,24 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetTokenHolders(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshot(contractAddress string) (*common.DelegationSnapshotAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error)
+	GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatings() (map[string]int32, error)
+	GetManagedKeysStatus() []*common.ManagedKeyStatus
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
@@ -53,6 +53,10 @@ const ReturnCodeRequestError ReturnCode = "bad_request"
 // ReturnCodeSystemBusy defines a request which hasn't been executed successfully due to too many requests
 const ReturnCodeSystemBusy ReturnCode = "system_busy"
 
+// ReturnCodeUnauthorized defines a request which hasn't been executed because it was not properly authenticated or
+// the provided credentials do not grant access to the requested route
+const ReturnCodeUnauthorized ReturnCode = "unauthorized"
+
 // RespondWith will respond with the generic API response
 func RespondWith(c *gin.Context, status int, dataField interface{}, err string, code ReturnCode) {
 	c.JSON(
@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForEconomicsSim
+    generateForRemoteSigner
+    generateForTxBuilder
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForEconomicsSim() {
+    HELP="
+# Elrond Economics Simulator CLI
+
+The **Elrond Economics Simulator** exposes the following Command Line Interface:
+$(code)
+\$ economicssim --help
+
+$(./economicssim/economicssim --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./economicssim/CLI.md
+}
+
+generateForRemoteSigner() {
+    HELP="
+# Elrond Remote Signer CLI
+
+The **Elrond Remote Signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
+generateForTxBuilder() {
+    HELP="
+# Elrond Transaction Builder CLI
+
+The **Elrond Transaction Builder** exposes the following Command Line Interface:
+$(code)
+\$ txbuilder --help
+
+$(./txbuilder/txbuilder --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./txbuilder/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
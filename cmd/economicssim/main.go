@@ -0,0 +1,310 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/economicssim/simulator"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+type config struct {
+	dbPath                   string
+	epoch                    uint
+	generalConfigFile        string
+	economicsConfigFile      string
+	alternateEconomicsConfig string
+	epochConfigFile          string
+	nodesSetupFile           string
+	outputFormat             string
+	outputDir                string
+	logLevel                 string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the database directory of the metachain node
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `directory` of the stopped metachain node's database that contains the epochs and the static " +
+			"databases, for example ./db/1. The node should have run with the rewards breakdown enabled.",
+		Destination: &argsConfig.dbPath,
+	}
+	// epoch defines a flag for the simulated epoch
+	epoch = cli.UintFlag{
+		Name: "epoch",
+		Usage: "The epoch whose start of epoch metablock distributed the simulated rewards. The rewards are " +
+			"computed for the blocks produced in the previous epoch.",
+		Destination: &argsConfig.epoch,
+	}
+	// generalConfigFile defines a flag for the path to the main toml configuration file
+	generalConfigFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file of the node, used for the storage setups.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.generalConfigFile,
+	}
+	// economicsConfigFile defines a flag for the path to the baseline economics toml configuration file
+	economicsConfigFile = cli.StringFlag{
+		Name:        "config-economics",
+		Usage:       "The `filepath` for the baseline economics configuration file, the one used by the network.",
+		Value:       "./config/economics.toml",
+		Destination: &argsConfig.economicsConfigFile,
+	}
+	// alternateEconomicsConfigFile defines a flag for the path to the alternate economics toml configuration file
+	alternateEconomicsConfigFile = cli.StringFlag{
+		Name:        "config-economics-alternate",
+		Usage:       "The `filepath` for the alternate economics configuration file whose outcome is simulated.",
+		Destination: &argsConfig.alternateEconomicsConfig,
+	}
+	// epochConfigFile defines a flag for the path to the enable epochs toml configuration file
+	epochConfigFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The `filepath` for the epoch configuration file containing the activation epochs.",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// nodesSetupFile defines a flag for the path to the nodes setup json file
+	nodesSetupFile = cli.StringFlag{
+		Name:        "nodes-setup-file",
+		Usage:       "The `filepath` for the nodes setup, used for the round duration and the consensus group sizes.",
+		Value:       "./config/nodesSetup.json",
+		Destination: &argsConfig.nodesSetupFile,
+	}
+	// outputFormat defines a flag for the format of the written results
+	outputFormat = cli.StringFlag{
+		Name: "output-format",
+		Usage: "The output `format`: json writes the whole simulation result, csv writes the per-validator and " +
+			"the per-shard deltas as two tables.",
+		Value:       simulator.JSONOutputFormat,
+		Destination: &argsConfig.outputFormat,
+	}
+	// outputDir defines a flag for the directory where the results are written
+	outputDir = cli.StringFlag{
+		Name:        "output-dir",
+		Usage:       "The `directory` where the results are written.",
+		Value:       ".",
+		Destination: &argsConfig.outputDir,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogWarning.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &config{}
+
+	log = logger.GetOrCreate("economicssim")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Economics Simulator"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "Offline tool that re-runs the end of epoch economics and rewards of a recorded epoch with " +
+		"alternate economics parameters and outputs the per-validator and per-shard reward deltas"
+	app.Flags = []cli.Flag{
+		dbPath,
+		epoch,
+		generalConfigFile,
+		economicsConfigFile,
+		alternateEconomicsConfigFile,
+		epochConfigFile,
+		nodesSetupFile,
+		outputFormat,
+		outputDir,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return simulate()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func simulate() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.alternateEconomicsConfig) == 0 {
+		return fmt.Errorf("the %s flag is required", alternateEconomicsConfigFile.Name)
+	}
+	err = simulator.CheckOutputFormat(argsConfig.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.generalConfigFile)
+	if err != nil {
+		return err
+	}
+	baselineConfig, err := common.LoadEconomicsConfig(argsConfig.economicsConfigFile)
+	if err != nil {
+		return err
+	}
+	alternateConfig, err := common.LoadEconomicsConfig(argsConfig.alternateEconomicsConfig)
+	if err != nil {
+		return err
+	}
+	epochConfig, err := common.LoadEpochConfig(argsConfig.epochConfigFile)
+	if err != nil {
+		return err
+	}
+
+	addressPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return fmt.Errorf("%w for AddressPubkeyConverter", err)
+	}
+	validatorPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return fmt.Errorf("%w for ValidatorPubkeyConverter", err)
+	}
+	nodesSetup, err := sharding.NewNodesSetup(
+		argsConfig.nodesSetupFile,
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		generalConfig.GeneralSettings.GenesisMaxNumberOfShards,
+	)
+	if err != nil {
+		return err
+	}
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(nodesSetup.NumberOfShards(), core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	dataLoader, err := simulator.NewDBDataLoader(simulator.ArgsDBDataLoader{
+		DBPath:        argsConfig.dbPath,
+		GeneralConfig: generalConfig,
+		Marshalizer:   marshalizer,
+		Hasher:        hasher,
+	})
+	if err != nil {
+		return err
+	}
+	epochData, err := dataLoader.LoadEpochData(uint32(argsConfig.epoch))
+	if err != nil {
+		return err
+	}
+
+	genesisEpoch, genesisNonce := uint32(0), uint64(0)
+	if generalConfig.Hardfork.AfterHardFork {
+		genesisEpoch = generalConfig.Hardfork.StartEpoch
+		genesisNonce = generalConfig.Hardfork.StartNonce
+	}
+	economicsSimulator, err := simulator.NewSimulator(simulator.ArgsSimulator{
+		GeneralConfig:           generalConfig,
+		EnableEpochs:            epochConfig.EnableEpochs,
+		ShardCoordinator:        shardCoordinator,
+		AddressPubkeyConverter:  addressPubkeyConverter,
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+		RoundDuration:           time.Duration(nodesSetup.GetRoundDuration()) * time.Millisecond,
+		ShardConsensusGroupSize: nodesSetup.GetShardConsensusGroupSize(),
+		MetaConsensusGroupSize:  nodesSetup.GetMetaConsensusGroupSize(),
+		GenesisEpoch:            genesisEpoch,
+		GenesisNonce:            genesisNonce,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := economicsSimulator.Simulate(epochData, baselineConfig, alternateConfig)
+	if err != nil {
+		return err
+	}
+
+	return writeResult(result)
+}
+
+func writeResult(result *simulator.SimulationResult) error {
+	err := os.MkdirAll(argsConfig.outputDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	if argsConfig.outputFormat == simulator.CSVOutputFormat {
+		err = writeFile(fmt.Sprintf("validators-rewards-delta-epoch-%d.csv", result.Epoch), result, simulator.WriteNodesCSV)
+		if err != nil {
+			return err
+		}
+
+		return writeFile(fmt.Sprintf("shards-rewards-delta-epoch-%d.csv", result.Epoch), result, simulator.WriteShardsCSV)
+	}
+
+	return writeFile(fmt.Sprintf("rewards-delta-epoch-%d.json", result.Epoch), result, simulator.WriteJSON)
+}
+
+func writeFile(
+	fileName string,
+	result *simulator.SimulationResult,
+	writeHandler func(writer io.Writer, result *simulator.SimulationResult) error,
+) error {
+	path := filepath.Join(argsConfig.outputDir, fileName)
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = writeHandler(file, result)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	log.Info("simulation result written", "file", path)
+
+	return file.Close()
+}
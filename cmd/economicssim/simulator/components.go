@@ -0,0 +1,171 @@
+package simulator
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// roundDurationHandler provides the fixed round duration read from the nodes setup
+type roundDurationHandler struct {
+	roundDuration time.Duration
+}
+
+// TimeDuration returns the round duration
+func (rdh *roundDurationHandler) TimeDuration() time.Duration {
+	return rdh.roundDuration
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdh *roundDurationHandler) IsInterfaceNil() bool {
+	return rdh == nil
+}
+
+// nodesConfigProvider provides the consensus group sizes read from the nodes setup
+type nodesConfigProvider struct {
+	shardConsensusGroupSize int
+	metaConsensusGroupSize  int
+}
+
+// ConsensusGroupSize returns the consensus group size of the provided shard
+func (ncp *nodesConfigProvider) ConsensusGroupSize(shardID uint32) int {
+	if shardID == core.MetachainShardId {
+		return ncp.metaConsensusGroupSize
+	}
+
+	return ncp.shardConsensusGroupSize
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncp *nodesConfigProvider) IsInterfaceNil() bool {
+	return ncp == nil
+}
+
+// disabledBuiltInFunctionsCost is used by the economics data, the simulation does not compute transaction fees
+type disabledBuiltInFunctionsCost struct {
+}
+
+// ComputeBuiltInCost returns 0
+func (dbc *disabledBuiltInFunctionsCost) ComputeBuiltInCost(_ data.TransactionWithFeeHandler) uint64 {
+	return 0
+}
+
+// IsBuiltInFuncCall returns false
+func (dbc *disabledBuiltInFunctionsCost) IsBuiltInFuncCall(_ data.TransactionWithFeeHandler) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbc *disabledBuiltInFunctionsCost) IsInterfaceNil() bool {
+	return dbc == nil
+}
+
+// recordedStakingDataProvider provides the staking data recorded in the rewards breakdown, as the stake does not
+// depend on the economics parameters
+type recordedStakingDataProvider struct {
+	totalStakeEligible *big.Int
+	totalTopUpEligible *big.Int
+	topUpPerNode       map[string]*big.Int
+}
+
+// GetTotalStakeEligibleNodes returns the recorded total stake of the eligible nodes
+func (rsdp *recordedStakingDataProvider) GetTotalStakeEligibleNodes() *big.Int {
+	return big.NewInt(0).Set(rsdp.totalStakeEligible)
+}
+
+// GetTotalTopUpStakeEligibleNodes returns the recorded total top-up stake of the eligible nodes
+func (rsdp *recordedStakingDataProvider) GetTotalTopUpStakeEligibleNodes() *big.Int {
+	return big.NewInt(0).Set(rsdp.totalTopUpEligible)
+}
+
+// GetNodeStakedTopUp returns the recorded top-up stake of the provided node
+func (rsdp *recordedStakingDataProvider) GetNodeStakedTopUp(blsKey []byte) (*big.Int, error) {
+	topUp, found := rsdp.topUpPerNode[string(blsKey)]
+	if !found {
+		return nil, epochStart.ErrOwnerDoesntHaveEligibleNodesInEpoch
+	}
+
+	return big.NewInt(0).Set(topUp), nil
+}
+
+// PrepareStakingDataForRewards does nothing as the staking data is already recorded
+func (rsdp *recordedStakingDataProvider) PrepareStakingDataForRewards(_ map[uint32][][]byte) error {
+	return nil
+}
+
+// FillValidatorInfo does nothing as the staking data is already recorded
+func (rsdp *recordedStakingDataProvider) FillValidatorInfo(_ []byte) error {
+	return nil
+}
+
+// ComputeUnQualifiedNodes returns no unqualified nodes
+func (rsdp *recordedStakingDataProvider) ComputeUnQualifiedNodes(_ map[uint32][]*state.ValidatorInfo) ([][]byte, map[string][][]byte, error) {
+	return make([][]byte, 0), make(map[string][][]byte), nil
+}
+
+// Clean does nothing
+func (rsdp *recordedStakingDataProvider) Clean() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsdp *recordedStakingDataProvider) IsInterfaceNil() bool {
+	return rsdp == nil
+}
+
+// rewardsBreakdownCollector keeps in memory the rewards breakdown computed by the rewards creator
+type rewardsBreakdownCollector struct {
+	mut       sync.RWMutex
+	breakdown *common.RewardsBreakdownAPIResponse
+}
+
+// SaveRewardsBreakdown keeps the provided rewards breakdown
+func (rbc *rewardsBreakdownCollector) SaveRewardsBreakdown(breakdown *common.RewardsBreakdownAPIResponse) error {
+	if breakdown == nil {
+		return epochStart.ErrNilRewardsBreakdown
+	}
+
+	rbc.mut.Lock()
+	rbc.breakdown = breakdown
+	rbc.mut.Unlock()
+
+	return nil
+}
+
+// GetRewardsBreakdown returns the kept rewards breakdown if it was computed for the provided epoch
+func (rbc *rewardsBreakdownCollector) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	rbc.mut.RLock()
+	defer rbc.mut.RUnlock()
+
+	if rbc.breakdown == nil || rbc.breakdown.Epoch != epoch {
+		return nil, epochStart.ErrRewardsBreakdownNotFound
+	}
+
+	return rbc.breakdown, nil
+}
+
+// GetNodeRewardsBreakdown returns the kept rewards breakdown of the provided node
+func (rbc *rewardsBreakdownCollector) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	breakdown, err := rbc.GetRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range breakdown.Nodes {
+		if node.BLSKey == blsKey {
+			return node, nil
+		}
+	}
+
+	return nil, epochStart.ErrRewardsBreakdownNotFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbc *rewardsBreakdownCollector) IsInterfaceNil() bool {
+	return rbc == nil
+}
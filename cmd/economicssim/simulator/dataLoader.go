@@ -0,0 +1,268 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// EpochData holds the data loaded from a metachain node's database that is needed to simulate the start of epoch
+// economics and rewards. The validators info are the ones read from the peer accounts trie, while the recorded rewards
+// breakdown provides the staking data, which is not held by the peer accounts
+type EpochData struct {
+	MetaBlock               *block.MetaBlock
+	PrevEpochStartMetaBlock *block.MetaBlock
+	ValidatorsInfo          map[uint32][]*state.ValidatorInfo
+	RewardsBreakdown        *common.RewardsBreakdownAPIResponse
+}
+
+// ArgsDBDataLoader holds the arguments needed to create a database data loader
+type ArgsDBDataLoader struct {
+	DBPath        string
+	GeneralConfig *config.Config
+	Marshalizer   marshal.Marshalizer
+	Hasher        hashing.Hasher
+}
+
+type dbDataLoader struct {
+	pathManager   *pathmanager.PathManager
+	generalConfig *config.Config
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+}
+
+// NewDBDataLoader creates a component able to read the epoch data from a stopped metachain node's database. The
+// database path is the one that contains the chain ID, for example ./db/1
+func NewDBDataLoader(args ArgsDBDataLoader) (*dbDataLoader, error) {
+	if len(args.DBPath) == 0 {
+		return nil, ErrInvalidDBPath
+	}
+	if args.GeneralConfig == nil {
+		return nil, ErrNilConfig
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbDataLoader{
+		pathManager:   pathManager,
+		generalConfig: args.GeneralConfig,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+	}, nil
+}
+
+// LoadEpochData loads the start of epoch metablock of the provided epoch, the start of epoch metablock of the
+// previous epoch, the validators info used by the metachain when the provided epoch started and the rewards breakdown
+// saved at that time
+func (ddl *dbDataLoader) LoadEpochData(epoch uint32) (*EpochData, error) {
+	if epoch == 0 {
+		return nil, fmt.Errorf("%w, the genesis epoch does not distribute rewards", ErrNilEpochData)
+	}
+
+	metaBlock, err := ddl.loadEpochStartMetaBlock(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	prevEpochStartMetaBlock, err := ddl.loadEpochStartMetaBlock(epoch - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo, err := ddl.loadValidatorsInfo(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsBreakdown, err := ddl.loadRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EpochData{
+		MetaBlock:               metaBlock,
+		PrevEpochStartMetaBlock: prevEpochStartMetaBlock,
+		ValidatorsInfo:          validatorsInfo,
+		RewardsBreakdown:        rewardsBreakdown,
+	}, nil
+}
+
+// loadValidatorsInfo reads the validators info from the peer accounts trie. The start of epoch metablock is processed
+// on top of the peer accounts trie of its previous block and its own validator statistics root hash already holds the
+// counters reset for the new epoch, so the root hash of the previous block is used
+func (ddl *dbDataLoader) loadValidatorsInfo(metaBlock *block.MetaBlock) (map[uint32][]*state.ValidatorInfo, error) {
+	epochs := []uint32{metaBlock.Epoch - 1, metaBlock.Epoch}
+
+	metaBlocksStorer, err := openEpochsStorer(ddl.pathManager, ddl.generalConfig.MetaBlockStorage, epochs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = metaBlocksStorer.Close()
+	}()
+
+	buff, err := metaBlocksStorer.Get(metaBlock.PrevHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the block before the start of epoch %d", err, metaBlock.Epoch)
+	}
+	prevMetaBlock := &block.MetaBlock{}
+	err = ddl.marshalizer.Unmarshal(prevMetaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAccountsStorer, err := openEpochsStorer(ddl.pathManager, ddl.generalConfig.PeerAccountsTrieStorage, epochs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = peerAccountsStorer.Close()
+	}()
+
+	trieStorageManager, err := trie.NewTrieStorageManagerWithoutPruning(peerAccountsStorer)
+	if err != nil {
+		return nil, err
+	}
+	maxTrieLevelInMemory := ddl.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	peerAccountsTrie, err := trie.NewTrie(trieStorageManager, ddl.marshalizer, ddl.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = peerAccountsTrie.GetAllLeavesOnChannel(leavesChannel, context.Background(), prevMetaBlock.ValidatorStatsRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the peer accounts trie", err)
+	}
+
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	for leaf := range leavesChannel {
+		peerAccount := state.NewEmptyPeerAccount()
+		err = ddl.marshalizer.Unmarshal(peerAccount, leaf.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		shardID := peerAccount.GetShardId()
+		validatorsInfo[shardID] = append(validatorsInfo[shardID], peerAccountToValidatorInfo(peerAccount))
+	}
+
+	err = peerAccountsStorer.MissingRecordError()
+	if err != nil {
+		return nil, fmt.Errorf("%w, the peer accounts trie is incomplete", err)
+	}
+
+	return validatorsInfo, nil
+}
+
+func peerAccountToValidatorInfo(peerAccount state.PeerAccountHandler) *state.ValidatorInfo {
+	return &state.ValidatorInfo{
+		PublicKey:                  peerAccount.GetBLSPublicKey(),
+		ShardId:                    peerAccount.GetShardId(),
+		List:                       peerAccount.GetList(),
+		Index:                      peerAccount.GetIndexInList(),
+		TempRating:                 peerAccount.GetTempRating(),
+		Rating:                     peerAccount.GetRating(),
+		RewardAddress:              peerAccount.GetRewardAddress(),
+		LeaderSuccess:              peerAccount.GetLeaderSuccessRate().NumSuccess,
+		LeaderFailure:              peerAccount.GetLeaderSuccessRate().NumFailure,
+		ValidatorSuccess:           peerAccount.GetValidatorSuccessRate().NumSuccess,
+		ValidatorFailure:           peerAccount.GetValidatorSuccessRate().NumFailure,
+		ValidatorIgnoredSignatures: peerAccount.GetValidatorIgnoredSignaturesRate(),
+		NumSelectedInSuccessBlocks: peerAccount.GetNumSelectedInSuccessBlocks(),
+		AccumulatedFees:            big.NewInt(0).Set(peerAccount.GetAccumulatedFees()),
+	}
+}
+
+func (ddl *dbDataLoader) loadEpochStartMetaBlock(epoch uint32) (*block.MetaBlock, error) {
+	storageConfig := ddl.generalConfig.MetaBlockStorage
+	path := ddl.pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), epoch, storageConfig.DB.FilePath)
+	unit, err := openStorageUnit(storageConfig, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = unit.Close()
+	}()
+
+	buff, err := unit.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the start of epoch metablock for epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = ddl.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (ddl *dbDataLoader) loadRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	storageConfig := ddl.generalConfig.RewardsBreakdown.RewardsBreakdownStorage
+	path := ddl.pathManager.PathForStatic(core.GetShardIDString(core.MetachainShardId), storageConfig.DB.FilePath)
+	unit, err := openStorageUnit(storageConfig, path)
+	if err != nil {
+		return nil, fmt.Errorf("%w, the node should run with the rewards breakdown enabled", err)
+	}
+	defer func() {
+		_ = unit.Close()
+	}()
+
+	// the node persists the rewards breakdowns as JSON, see factory.createRewardsBreakdownHandler
+	rewardsBreakdownStorer, err := metachain.NewRewardsBreakdownStorer(metachain.ArgsRewardsBreakdownStorer{
+		Storer:          unit,
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardsBreakdownStorer.GetRewardsBreakdown(epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ddl *dbDataLoader) IsInterfaceNil() bool {
+	return ddl == nil
+}
+
+// openStorageUnit opens an existing database, as the storage unit would otherwise create an empty one
+func openStorageUnit(storageConfig config.StorageConfig, path string) (storage.Storer, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDatabaseNotFound, path)
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path
+
+	return storageUnit.NewStorageUnitFromConf(storageFactory.GetCacherFromConfig(storageConfig.Cache), dbConfig)
+}
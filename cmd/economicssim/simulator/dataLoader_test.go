@@ -0,0 +1,243 @@
+package simulator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/cmd/economicssim/simulator"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsDBDataLoader(dbPath string) simulator.ArgsDBDataLoader {
+	generalConfig := testscommon.GetGeneralConfig()
+	generalConfig.MetaBlockStorage.DB.Type = string(storageUnit.LvlDBSerial)
+	generalConfig.PeerAccountsTrieStorage.DB.Type = string(storageUnit.LvlDBSerial)
+	generalConfig.RewardsBreakdown.RewardsBreakdownStorage.DB.Type = string(storageUnit.LvlDBSerial)
+
+	return simulator.ArgsDBDataLoader{
+		DBPath:        dbPath,
+		GeneralConfig: &generalConfig,
+		Marshalizer:   &marshal.GogoProtoMarshalizer{},
+		Hasher:        blake2b.NewBlake2b(),
+	}
+}
+
+func saveInStorageUnit(t *testing.T, storageConfig config.StorageConfig, path string, key []byte, value []byte) {
+	unit := createStorageUnit(t, storageConfig, path)
+
+	require.Nil(t, unit.Put(key, value))
+	require.Nil(t, unit.Close())
+}
+
+func saveEpochStartMetaBlock(t *testing.T, args simulator.ArgsDBDataLoader, metaBlock *block.MetaBlock) {
+	storageConfig := args.GeneralConfig.MetaBlockStorage
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	require.Nil(t, err)
+	path := pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), metaBlock.Epoch, storageConfig.DB.FilePath)
+	buff, err := args.Marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	saveInStorageUnit(t, storageConfig, path, []byte(core.EpochStartIdentifier(metaBlock.Epoch)), buff)
+}
+
+func createStorageUnit(t *testing.T, storageConfig config.StorageConfig, path string) storage.Storer {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path
+	unit, err := storageUnit.NewStorageUnitFromConf(storageFactory.GetCacherFromConfig(storageConfig.Cache), dbConfig)
+	require.Nil(t, err)
+
+	return unit
+}
+
+// savePeerAccountsTrie saves the validators info as peer accounts in the previous epoch database, as the trie nodes
+// of a long lived validator statistics trie are found in older epochs, and saves the block before the start of epoch
+// pointing to the resulting root hash
+func savePeerAccountsTrie(t *testing.T, args simulator.ArgsDBDataLoader, epochData *simulator.EpochData) {
+	storageConfig := args.GeneralConfig.PeerAccountsTrieStorage
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	require.Nil(t, err)
+	path := pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), epochData.MetaBlock.Epoch-1, storageConfig.DB.FilePath)
+	unit := createStorageUnit(t, storageConfig, path)
+
+	trieStorageManager, err := trie.NewTrieStorageManagerWithoutPruning(unit)
+	require.Nil(t, err)
+	peerAccountsTrie, err := trie.NewTrie(trieStorageManager, args.Marshalizer, args.Hasher, 5)
+	require.Nil(t, err)
+	for _, validatorsInfo := range epochData.ValidatorsInfo {
+		for _, vInfo := range validatorsInfo {
+			buff, errMarshal := args.Marshalizer.Marshal(&state.PeerAccountData{
+				BLSPublicKey:                   vInfo.PublicKey,
+				RewardAddress:                  vInfo.RewardAddress,
+				ShardId:                        vInfo.ShardId,
+				ValidatorSuccessRate:           state.SignRate{NumSuccess: vInfo.ValidatorSuccess, NumFailure: vInfo.ValidatorFailure},
+				LeaderSuccessRate:              state.SignRate{NumSuccess: vInfo.LeaderSuccess, NumFailure: vInfo.LeaderFailure},
+				ValidatorIgnoredSignaturesRate: vInfo.ValidatorIgnoredSignatures,
+				Rating:                         vInfo.Rating,
+				TempRating:                     vInfo.TempRating,
+				AccumulatedFees:                vInfo.AccumulatedFees,
+				NumSelectedInSuccessBlocks:     vInfo.NumSelectedInSuccessBlocks,
+				IndexInList:                    vInfo.Index,
+				List:                           vInfo.List,
+			})
+			require.Nil(t, errMarshal)
+			require.Nil(t, peerAccountsTrie.Update(vInfo.PublicKey, buff))
+		}
+	}
+	require.Nil(t, peerAccountsTrie.Commit())
+	rootHash, err := peerAccountsTrie.RootHash()
+	require.Nil(t, err)
+	require.Nil(t, unit.Close())
+
+	prevMetaBlock := &block.MetaBlock{
+		Epoch:                  epochData.MetaBlock.Epoch,
+		Nonce:                  epochData.MetaBlock.Nonce - 1,
+		ValidatorStatsRootHash: rootHash,
+	}
+	buff, err := args.Marshalizer.Marshal(prevMetaBlock)
+	require.Nil(t, err)
+	storageConfig = args.GeneralConfig.MetaBlockStorage
+	path = pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), prevMetaBlock.Epoch, storageConfig.DB.FilePath)
+	saveInStorageUnit(t, storageConfig, path, epochData.MetaBlock.PrevHash, buff)
+}
+
+func saveRewardsBreakdown(t *testing.T, args simulator.ArgsDBDataLoader, epochData *simulator.EpochData) {
+	storageConfig := args.GeneralConfig.RewardsBreakdown.RewardsBreakdownStorage
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	require.Nil(t, err)
+	path := pathManager.PathForStatic(core.GetShardIDString(core.MetachainShardId), storageConfig.DB.FilePath)
+	buff, err := (&marshal.JsonMarshalizer{}).Marshal(epochData.RewardsBreakdown)
+	require.Nil(t, err)
+
+	key := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(uint64(epochData.MetaBlock.Epoch))
+	saveInStorageUnit(t, storageConfig, path, key, buff)
+}
+
+func TestNewDBDataLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := simulator.NewDBDataLoader(createMockArgsDBDataLoader(""))
+		assert.Equal(t, simulator.ErrInvalidDBPath, err)
+		assert.True(t, check.IfNil(loader))
+	})
+	t.Run("nil config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		args.GeneralConfig = nil
+		loader, err := simulator.NewDBDataLoader(args)
+		assert.Equal(t, simulator.ErrNilConfig, err)
+		assert.True(t, check.IfNil(loader))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		args.Marshalizer = nil
+		loader, err := simulator.NewDBDataLoader(args)
+		assert.Equal(t, simulator.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(loader))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		args.Hasher = nil
+		loader, err := simulator.NewDBDataLoader(args)
+		assert.Equal(t, simulator.ErrNilHasher, err)
+		assert.True(t, check.IfNil(loader))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := simulator.NewDBDataLoader(createMockArgsDBDataLoader(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(loader))
+	})
+}
+
+func TestDbDataLoader_LoadEpochData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("genesis epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := simulator.NewDBDataLoader(createMockArgsDBDataLoader(t.TempDir()))
+		epochData, err := loader.LoadEpochData(0)
+		assert.True(t, errors.Is(err, simulator.ErrNilEpochData))
+		assert.Nil(t, epochData)
+	})
+	t.Run("missing database should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := simulator.NewDBDataLoader(createMockArgsDBDataLoader(t.TempDir()))
+		epochData, err := loader.LoadEpochData(simulatedEpoch)
+		assert.True(t, errors.Is(err, simulator.ErrDatabaseNotFound))
+		assert.Nil(t, epochData)
+	})
+	t.Run("missing peer accounts trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		expectedEpochData := createEpochData(t)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.MetaBlock)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.PrevEpochStartMetaBlock)
+		saveRewardsBreakdown(t, args, expectedEpochData)
+
+		loader, _ := simulator.NewDBDataLoader(args)
+		epochData, err := loader.LoadEpochData(simulatedEpoch)
+		assert.NotNil(t, err)
+		assert.Nil(t, epochData)
+	})
+	t.Run("missing rewards breakdown should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		expectedEpochData := createEpochData(t)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.MetaBlock)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.PrevEpochStartMetaBlock)
+		savePeerAccountsTrie(t, args, expectedEpochData)
+
+		loader, _ := simulator.NewDBDataLoader(args)
+		epochData, err := loader.LoadEpochData(simulatedEpoch)
+		assert.True(t, errors.Is(err, simulator.ErrDatabaseNotFound))
+		assert.Nil(t, epochData)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBDataLoader(t.TempDir())
+		expectedEpochData := createEpochData(t)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.MetaBlock)
+		saveEpochStartMetaBlock(t, args, expectedEpochData.PrevEpochStartMetaBlock)
+		savePeerAccountsTrie(t, args, expectedEpochData)
+		saveRewardsBreakdown(t, args, expectedEpochData)
+
+		loader, _ := simulator.NewDBDataLoader(args)
+		epochData, err := loader.LoadEpochData(simulatedEpoch)
+		require.Nil(t, err)
+		assert.Equal(t, expectedEpochData.MetaBlock, epochData.MetaBlock)
+		assert.Equal(t, expectedEpochData.PrevEpochStartMetaBlock, epochData.PrevEpochStartMetaBlock)
+		require.Equal(t, len(expectedEpochData.ValidatorsInfo), len(epochData.ValidatorsInfo))
+		for shardID, validatorsInfo := range expectedEpochData.ValidatorsInfo {
+			assert.ElementsMatch(t, validatorsInfo, epochData.ValidatorsInfo[shardID])
+		}
+		assert.Equal(t, expectedEpochData.RewardsBreakdown, epochData.RewardsBreakdown)
+	})
+}
@@ -0,0 +1,110 @@
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+)
+
+var errReadOnlyStorer = errors.New("the epochs storer is read only")
+
+// epochsStorer reads the records of a metachain storage unit from the databases of several epochs, as the node saves
+// each record in the database of the epoch it was in. The first record that could not be found in any of the
+// databases is kept, so the trie traversals, which only log their errors, can be checked afterwards
+type epochsStorer struct {
+	storers          []storage.Storer
+	mutMissingRecord sync.Mutex
+	missingRecordErr error
+}
+
+// openEpochsStorer opens the existing databases of the provided epochs. At least one database should exist
+func openEpochsStorer(
+	pathManager *pathmanager.PathManager,
+	storageConfig config.StorageConfig,
+	epochs ...uint32,
+) (*epochsStorer, error) {
+	es := &epochsStorer{
+		storers: make([]storage.Storer, 0, len(epochs)),
+	}
+
+	var lastErr error
+	for _, epoch := range epochs {
+		path := pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), epoch, storageConfig.DB.FilePath)
+		unit, err := openStorageUnit(storageConfig, path)
+		if errors.Is(err, ErrDatabaseNotFound) {
+			lastErr = err
+			continue
+		}
+		if err != nil {
+			_ = es.Close()
+			return nil, err
+		}
+
+		es.storers = append(es.storers, unit)
+	}
+	if len(es.storers) == 0 {
+		return nil, lastErr
+	}
+
+	return es, nil
+}
+
+// Get returns the record from the first database holding it
+func (es *epochsStorer) Get(key []byte) ([]byte, error) {
+	for _, storer := range es.storers {
+		value, err := storer.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	err := fmt.Errorf("%w for key %x", storage.ErrKeyNotFound, key)
+	es.mutMissingRecord.Lock()
+	if es.missingRecordErr == nil {
+		es.missingRecordErr = err
+	}
+	es.mutMissingRecord.Unlock()
+
+	return nil, err
+}
+
+// Put returns an error as the databases are only read
+func (es *epochsStorer) Put(_, _ []byte) error {
+	return errReadOnlyStorer
+}
+
+// Remove returns an error as the databases are only read
+func (es *epochsStorer) Remove(_ []byte) error {
+	return errReadOnlyStorer
+}
+
+// MissingRecordError returns the error of the first record that could not be found, if any
+func (es *epochsStorer) MissingRecordError() error {
+	es.mutMissingRecord.Lock()
+	defer es.mutMissingRecord.Unlock()
+
+	return es.missingRecordErr
+}
+
+// Close closes all the opened databases
+func (es *epochsStorer) Close() error {
+	var lastErr error
+	for _, storer := range es.storers {
+		err := storer.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *epochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
@@ -0,0 +1,42 @@
+package simulator
+
+import "errors"
+
+// ErrNilConfig signals that a nil config was provided
+var ErrNilConfig = errors.New("nil config")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilEpochData signals that nil or incomplete epoch data was provided
+var ErrNilEpochData = errors.New("nil epoch data")
+
+// ErrNilRewardsBreakdown signals that no rewards breakdown was computed for the simulated epoch
+var ErrNilRewardsBreakdown = errors.New("nil rewards breakdown")
+
+// ErrInvalidRoundDuration signals that an invalid round duration was provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidConsensusGroupSize signals that an invalid consensus group size was provided
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrInvalidDBPath signals that an invalid database path was provided
+var ErrInvalidDBPath = errors.New("invalid database path")
+
+// ErrDatabaseNotFound signals that the required database does not exist
+var ErrDatabaseNotFound = errors.New("database not found")
+
+// ErrRewardsV2NotActive signals that the simulated epoch was not rewarded by the staking v2 rewards creator
+var ErrRewardsV2NotActive = errors.New("rewards v2 not active in the provided epoch")
+
+// ErrInvalidOutputFormat signals that an invalid output format was provided
+var ErrInvalidOutputFormat = errors.New("invalid output format")
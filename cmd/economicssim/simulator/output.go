@@ -0,0 +1,106 @@
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	// JSONOutputFormat writes the whole simulation result as a JSON document
+	JSONOutputFormat = "json"
+	// CSVOutputFormat writes the per-validator and the per-shard deltas as CSV tables
+	CSVOutputFormat = "csv"
+)
+
+var nodesCSVHeader = []string{
+	"blsKey",
+	"shardID",
+	"rewardAddress",
+	"baselineProtocolRewards",
+	"baselineLeaderFees",
+	"baselineTotalRewards",
+	"alternateProtocolRewards",
+	"alternateLeaderFees",
+	"alternateTotalRewards",
+	"delta",
+	"deltaPercent",
+}
+
+var shardsCSVHeader = []string{
+	"shardID",
+	"numNodes",
+	"baselineTotalRewards",
+	"alternateTotalRewards",
+	"delta",
+	"deltaPercent",
+}
+
+// CheckOutputFormat returns an error if the provided output format is not supported
+func CheckOutputFormat(format string) error {
+	switch format {
+	case JSONOutputFormat, CSVOutputFormat:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidOutputFormat, format)
+	}
+}
+
+// WriteJSON writes the simulation result as an indented JSON document
+func WriteJSON(writer io.Writer, result *SimulationResult) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
+// WriteNodesCSV writes the per-validator rewards deltas as a CSV table
+func WriteNodesCSV(writer io.Writer, result *SimulationResult) error {
+	records := make([][]string, 0, len(result.Nodes)+1)
+	records = append(records, nodesCSVHeader)
+	for _, node := range result.Nodes {
+		records = append(records, []string{
+			node.BLSKey,
+			shardIDToString(node.ShardID),
+			node.RewardAddress,
+			node.BaselineProtocolRewards,
+			node.BaselineLeaderFees,
+			node.BaselineTotalRewards,
+			node.AlternateProtocolRewards,
+			node.AlternateLeaderFees,
+			node.AlternateTotalRewards,
+			node.Delta,
+			percentToString(node.DeltaPercent),
+		})
+	}
+
+	return csv.NewWriter(writer).WriteAll(records)
+}
+
+// WriteShardsCSV writes the per-shard rewards deltas as a CSV table
+func WriteShardsCSV(writer io.Writer, result *SimulationResult) error {
+	records := make([][]string, 0, len(result.Shards)+1)
+	records = append(records, shardsCSVHeader)
+	for _, shard := range result.Shards {
+		records = append(records, []string{
+			shardIDToString(shard.ShardID),
+			strconv.Itoa(shard.NumNodes),
+			shard.BaselineTotalRewards,
+			shard.AlternateTotalRewards,
+			shard.Delta,
+			percentToString(shard.DeltaPercent),
+		})
+	}
+
+	return csv.NewWriter(writer).WriteAll(records)
+}
+
+func shardIDToString(shardID uint32) string {
+	return strconv.FormatUint(uint64(shardID), 10)
+}
+
+func percentToString(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', 6, 64)
+}
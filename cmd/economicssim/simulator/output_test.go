@@ -0,0 +1,107 @@
+package simulator_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/cmd/economicssim/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSimulationResult() *simulator.SimulationResult {
+	return &simulator.SimulationResult{
+		Epoch:     simulatedEpoch,
+		Baseline:  &simulator.EconomicsSummary{LeaderPercentage: 0.1},
+		Alternate: &simulator.EconomicsSummary{LeaderPercentage: 0.2},
+		Shards: []*simulator.ShardRewardsDelta{
+			{
+				ShardID:               0,
+				NumNodes:              2,
+				BaselineTotalRewards:  "200",
+				AlternateTotalRewards: "210",
+				Delta:                 "10",
+				DeltaPercent:          5,
+			},
+			{
+				ShardID:               core.MetachainShardId,
+				NumNodes:              1,
+				BaselineTotalRewards:  "300",
+				AlternateTotalRewards: "290",
+				Delta:                 "-10",
+				DeltaPercent:          -3.333333333,
+			},
+		},
+		Nodes: []*simulator.NodeRewardsDelta{
+			{
+				BLSKey:                   "aa",
+				ShardID:                  0,
+				RewardAddress:            "erd1",
+				BaselineProtocolRewards:  "90",
+				BaselineLeaderFees:       "10",
+				BaselineTotalRewards:     "100",
+				AlternateProtocolRewards: "85",
+				AlternateLeaderFees:      "20",
+				AlternateTotalRewards:    "105",
+				Delta:                    "5",
+				DeltaPercent:             5,
+			},
+		},
+	}
+}
+
+func TestCheckOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, simulator.CheckOutputFormat(simulator.JSONOutputFormat))
+	assert.Nil(t, simulator.CheckOutputFormat(simulator.CSVOutputFormat))
+	assert.True(t, errors.Is(simulator.CheckOutputFormat("xml"), simulator.ErrInvalidOutputFormat))
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	expectedResult := createSimulationResult()
+	buff := &bytes.Buffer{}
+	err := simulator.WriteJSON(buff, expectedResult)
+	require.Nil(t, err)
+
+	result := &simulator.SimulationResult{}
+	err = json.Unmarshal(buff.Bytes(), result)
+	require.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestWriteNodesCSV(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	err := simulator.WriteNodesCSV(buff, createSimulationResult())
+	require.Nil(t, err)
+
+	records, err := csv.NewReader(buff).ReadAll()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, "blsKey", records[0][0])
+	assert.Equal(t, "deltaPercent", records[0][10])
+	assert.Equal(t, []string{"aa", "0", "erd1", "90", "10", "100", "85", "20", "105", "5", "5.000000"}, records[1])
+}
+
+func TestWriteShardsCSV(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	err := simulator.WriteShardsCSV(buff, createSimulationResult())
+	require.Nil(t, err)
+
+	records, err := csv.NewReader(buff).ReadAll()
+	require.Nil(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, []string{"shardID", "numNodes", "baselineTotalRewards", "alternateTotalRewards", "delta", "deltaPercent"}, records[0])
+	assert.Equal(t, []string{"0", "2", "200", "210", "10", "5.000000"}, records[1])
+	assert.Equal(t, []string{"4294967295", "1", "300", "290", "-10", "-3.333333"}, records[2])
+}
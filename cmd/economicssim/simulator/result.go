@@ -0,0 +1,193 @@
+package simulator
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// EconomicsSummary holds the rewards parameters and the end of epoch economics computed for one scenario
+type EconomicsSummary struct {
+	LeaderPercentage                 float64 `json:"leaderPercentage"`
+	ProtocolSustainabilityPercentage float64 `json:"protocolSustainabilityPercentage"`
+	TopUpFactor                      float64 `json:"topUpFactor"`
+	TopUpGradientPoint               string  `json:"topUpGradientPoint"`
+	TotalSupply                      string  `json:"totalSupply"`
+	TotalToDistribute                string  `json:"totalToDistribute"`
+	TotalNewlyMinted                 string  `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string  `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string  `json:"rewardsForProtocolSustainability"`
+	LeaderFees                       string  `json:"leaderFees"`
+	RewardsForBlocks                 string  `json:"rewardsForBlocks"`
+	BaseRewards                      string  `json:"baseRewards"`
+	TopUpRewards                     string  `json:"topUpRewards"`
+}
+
+// NodeRewardsDelta holds the rewards of one eligible node in both scenarios
+type NodeRewardsDelta struct {
+	BLSKey                   string  `json:"blsKey"`
+	ShardID                  uint32  `json:"shardID"`
+	RewardAddress            string  `json:"rewardAddress"`
+	BaselineProtocolRewards  string  `json:"baselineProtocolRewards"`
+	BaselineLeaderFees       string  `json:"baselineLeaderFees"`
+	BaselineTotalRewards     string  `json:"baselineTotalRewards"`
+	AlternateProtocolRewards string  `json:"alternateProtocolRewards"`
+	AlternateLeaderFees      string  `json:"alternateLeaderFees"`
+	AlternateTotalRewards    string  `json:"alternateTotalRewards"`
+	Delta                    string  `json:"delta"`
+	DeltaPercent             float64 `json:"deltaPercent"`
+}
+
+// ShardRewardsDelta holds the rewards of the eligible nodes of one shard in both scenarios
+type ShardRewardsDelta struct {
+	ShardID               uint32  `json:"shardID"`
+	NumNodes              int     `json:"numNodes"`
+	BaselineTotalRewards  string  `json:"baselineTotalRewards"`
+	AlternateTotalRewards string  `json:"alternateTotalRewards"`
+	Delta                 string  `json:"delta"`
+	DeltaPercent          float64 `json:"deltaPercent"`
+}
+
+// SimulationResult holds the outcome of simulating one epoch with the baseline and the alternate economics
+type SimulationResult struct {
+	Epoch     uint32               `json:"epoch"`
+	Baseline  *EconomicsSummary    `json:"baseline"`
+	Alternate *EconomicsSummary    `json:"alternate"`
+	Shards    []*ShardRewardsDelta `json:"shards"`
+	Nodes     []*NodeRewardsDelta  `json:"nodes"`
+}
+
+type nodeRewards struct {
+	protocolRewards *big.Int
+	leaderFees      *big.Int
+}
+
+type shardRewards struct {
+	numNodes  int
+	baseline  *big.Int
+	alternate *big.Int
+}
+
+func createSimulationResult(epoch uint32, baseline *scenarioResult, alternate *scenarioResult) *SimulationResult {
+	result := &SimulationResult{
+		Epoch:     epoch,
+		Baseline:  createEconomicsSummary(baseline),
+		Alternate: createEconomicsSummary(alternate),
+		Shards:    make([]*ShardRewardsDelta, 0),
+		Nodes:     make([]*NodeRewardsDelta, 0, len(baseline.breakdown.Nodes)),
+	}
+
+	alternateRewards := make(map[string]*nodeRewards, len(alternate.breakdown.Nodes))
+	for _, node := range alternate.breakdown.Nodes {
+		alternateRewards[node.BLSKey] = getNodeRewards(node)
+	}
+
+	rewardsPerShard := make(map[uint32]*shardRewards)
+	for _, node := range baseline.breakdown.Nodes {
+		baselineNodeRewards := getNodeRewards(node)
+		alternateNodeRewards, found := alternateRewards[node.BLSKey]
+		if !found {
+			alternateNodeRewards = &nodeRewards{protocolRewards: big.NewInt(0), leaderFees: big.NewInt(0)}
+		}
+
+		baselineTotal := big.NewInt(0).Add(baselineNodeRewards.protocolRewards, baselineNodeRewards.leaderFees)
+		alternateTotal := big.NewInt(0).Add(alternateNodeRewards.protocolRewards, alternateNodeRewards.leaderFees)
+		delta := big.NewInt(0).Sub(alternateTotal, baselineTotal)
+
+		result.Nodes = append(result.Nodes, &NodeRewardsDelta{
+			BLSKey:                   node.BLSKey,
+			ShardID:                  node.ShardID,
+			RewardAddress:            node.RewardAddress,
+			BaselineProtocolRewards:  baselineNodeRewards.protocolRewards.String(),
+			BaselineLeaderFees:       baselineNodeRewards.leaderFees.String(),
+			BaselineTotalRewards:     baselineTotal.String(),
+			AlternateProtocolRewards: alternateNodeRewards.protocolRewards.String(),
+			AlternateLeaderFees:      alternateNodeRewards.leaderFees.String(),
+			AlternateTotalRewards:    alternateTotal.String(),
+			Delta:                    delta.String(),
+			DeltaPercent:             computeDeltaPercent(delta, baselineTotal),
+		})
+
+		shard, found := rewardsPerShard[node.ShardID]
+		if !found {
+			shard = &shardRewards{baseline: big.NewInt(0), alternate: big.NewInt(0)}
+			rewardsPerShard[node.ShardID] = shard
+		}
+		shard.numNodes++
+		shard.baseline.Add(shard.baseline, baselineTotal)
+		shard.alternate.Add(shard.alternate, alternateTotal)
+	}
+
+	for shardID, shard := range rewardsPerShard {
+		delta := big.NewInt(0).Sub(shard.alternate, shard.baseline)
+		result.Shards = append(result.Shards, &ShardRewardsDelta{
+			ShardID:               shardID,
+			NumNodes:              shard.numNodes,
+			BaselineTotalRewards:  shard.baseline.String(),
+			AlternateTotalRewards: shard.alternate.String(),
+			Delta:                 delta.String(),
+			DeltaPercent:          computeDeltaPercent(delta, shard.baseline),
+		})
+	}
+	sort.Slice(result.Shards, func(i, j int) bool {
+		return result.Shards[i].ShardID < result.Shards[j].ShardID
+	})
+
+	return result
+}
+
+func createEconomicsSummary(scenario *scenarioResult) *EconomicsSummary {
+	return &EconomicsSummary{
+		LeaderPercentage:                 scenario.economicsData.LeaderPercentage(),
+		ProtocolSustainabilityPercentage: scenario.economicsData.ProtocolSustainabilityPercentage(),
+		TopUpFactor:                      scenario.economicsData.RewardsTopUpFactor(),
+		TopUpGradientPoint:               bigIntToString(scenario.economicsData.RewardsTopUpGradientPoint()),
+		TotalSupply:                      bigIntToString(scenario.economics.TotalSupply),
+		TotalToDistribute:                bigIntToString(scenario.economics.TotalToDistribute),
+		TotalNewlyMinted:                 bigIntToString(scenario.economics.TotalNewlyMinted),
+		RewardsPerBlock:                  bigIntToString(scenario.economics.RewardsPerBlock),
+		RewardsForProtocolSustainability: bigIntToString(scenario.economics.RewardsForProtocolSustainability),
+		LeaderFees:                       bigIntToString(scenario.leaderFees),
+		RewardsForBlocks:                 scenario.breakdown.RewardsForBlocks,
+		BaseRewards:                      scenario.breakdown.BaseRewards,
+		TopUpRewards:                     scenario.breakdown.TopUpRewards,
+	}
+}
+
+// getNodeRewards returns the rewards sent to the node's reward address, the nodes that neither proposed nor
+// validated blocks are not rewarded
+func getNodeRewards(node *common.NodeRewardsBreakdownAPIResponse) *nodeRewards {
+	rewards := &nodeRewards{
+		protocolRewards: big.NewInt(0),
+		leaderFees:      big.NewInt(0),
+	}
+	if !node.Rewarded {
+		return rewards
+	}
+
+	// the values were computed by the rewards creator so they are valid
+	_ = setBigIntFromString(rewards.protocolRewards, node.FullRewards)
+	_ = setBigIntFromString(rewards.leaderFees, node.AccumulatedFees)
+
+	return rewards
+}
+
+func computeDeltaPercent(delta *big.Int, baseline *big.Int) float64 {
+	if baseline.Sign() == 0 {
+		return 0
+	}
+
+	percent := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(delta), big.NewFloat(0).SetInt(baseline))
+	value, _ := percent.Mul(percent, big.NewFloat(100)).Float64()
+
+	return value
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
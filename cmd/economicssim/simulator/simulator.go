@@ -0,0 +1,437 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+var log = logger.GetOrCreate("economicssim/simulator")
+
+// ArgsSimulator holds the arguments needed to create an economics simulator
+type ArgsSimulator struct {
+	GeneralConfig           *config.Config
+	EnableEpochs            config.EnableEpochs
+	ShardCoordinator        sharding.Coordinator
+	AddressPubkeyConverter  core.PubkeyConverter
+	Marshalizer             marshal.Marshalizer
+	Hasher                  hashing.Hasher
+	RoundDuration           time.Duration
+	ShardConsensusGroupSize uint32
+	MetaConsensusGroupSize  uint32
+	GenesisEpoch            uint32
+	GenesisNonce            uint64
+}
+
+type scenarioResult struct {
+	economicsData process.EconomicsDataHandler
+	economics     *block.Economics
+	leaderFees    *big.Int
+	breakdown     *common.RewardsBreakdownAPIResponse
+}
+
+type simulator struct {
+	generalConfig          *config.Config
+	enableEpochs           config.EnableEpochs
+	shardCoordinator       sharding.Coordinator
+	addressPubkeyConverter core.PubkeyConverter
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	roundDuration          time.Duration
+	nodesConfigProvider    *nodesConfigProvider
+	genesisEpoch           uint32
+	genesisNonce           uint64
+}
+
+// NewSimulator creates a component that re-runs the end of epoch economics and the rewards computation of the
+// metachain with different economics parameters
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	if args.GeneralConfig == nil {
+		return nil, ErrNilConfig
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.RoundDuration < time.Second {
+		return nil, fmt.Errorf("%w, minimum %v", ErrInvalidRoundDuration, time.Second)
+	}
+	if args.ShardConsensusGroupSize == 0 {
+		return nil, fmt.Errorf("%w for the shards", ErrInvalidConsensusGroupSize)
+	}
+	if args.MetaConsensusGroupSize == 0 {
+		return nil, fmt.Errorf("%w for the metachain", ErrInvalidConsensusGroupSize)
+	}
+
+	return &simulator{
+		generalConfig:          args.GeneralConfig,
+		enableEpochs:           args.EnableEpochs,
+		shardCoordinator:       args.ShardCoordinator,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		roundDuration:          args.RoundDuration,
+		nodesConfigProvider: &nodesConfigProvider{
+			shardConsensusGroupSize: int(args.ShardConsensusGroupSize),
+			metaConsensusGroupSize:  int(args.MetaConsensusGroupSize),
+		},
+		genesisEpoch: args.GenesisEpoch,
+		genesisNonce: args.GenesisNonce,
+	}, nil
+}
+
+// Simulate computes the start of epoch economics and rewards for both the baseline and the alternate economics
+// configurations and returns the differences between them
+func (s *simulator) Simulate(
+	epochData *EpochData,
+	baselineConfig *config.EconomicsConfig,
+	alternateConfig *config.EconomicsConfig,
+) (*SimulationResult, error) {
+	err := checkEpochData(epochData)
+	if err != nil {
+		return nil, err
+	}
+	if baselineConfig == nil || alternateConfig == nil {
+		return nil, fmt.Errorf("%w for the economics", ErrNilConfig)
+	}
+	epoch := epochData.MetaBlock.GetEpoch()
+	if epoch <= s.enableEpochs.StakingV2EnableEpoch {
+		return nil, fmt.Errorf("%w, epoch %d, staking v2 enable epoch %d",
+			ErrRewardsV2NotActive, epoch, s.enableEpochs.StakingV2EnableEpoch)
+	}
+
+	baselineEconomicsData, err := s.createEconomicsData(baselineConfig, epochData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the baseline economics config", err)
+	}
+	alternateEconomicsData, err := s.createEconomicsData(alternateConfig, epochData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the alternate economics config", err)
+	}
+
+	baseline, err := s.runScenario(epochData, baselineEconomicsData, big.NewRat(1, 1))
+	if err != nil {
+		return nil, fmt.Errorf("%w while simulating the baseline economics config", err)
+	}
+
+	alternate, err := s.runScenario(epochData, alternateEconomicsData, computeLeaderFeesScale(baselineEconomicsData, alternateEconomicsData))
+	if err != nil {
+		return nil, fmt.Errorf("%w while simulating the alternate economics config", err)
+	}
+
+	return createSimulationResult(epoch, baseline, alternate), nil
+}
+
+func checkEpochData(epochData *EpochData) error {
+	if epochData == nil {
+		return ErrNilEpochData
+	}
+	if epochData.MetaBlock == nil || epochData.PrevEpochStartMetaBlock == nil {
+		return fmt.Errorf("%w, missing start of epoch metablocks", ErrNilEpochData)
+	}
+	if len(epochData.ValidatorsInfo) == 0 {
+		return fmt.Errorf("%w, missing validators info", ErrNilEpochData)
+	}
+	if epochData.RewardsBreakdown == nil {
+		return fmt.Errorf("%w, missing recorded rewards breakdown", ErrNilEpochData)
+	}
+
+	return nil
+}
+
+func (s *simulator) createEconomicsData(economicsConfig *config.EconomicsConfig, epochData *EpochData) (process.EconomicsDataHandler, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		BuiltInFunctionsCostHandler:    &disabledBuiltInFunctionsCost{},
+		Economics:                      economicsConfig,
+		EpochNotifier:                  epochNotifier,
+		PenalizedTooMuchGasEnableEpoch: s.enableEpochs.PenalizedTooMuchGasEnableEpoch,
+		GasPriceModifierEnableEpoch:    s.enableEpochs.GasPriceModifierEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards settings are chosen by the epoch of the start of epoch metablock
+	epochNotifier.CheckEpoch(epochData.MetaBlock)
+
+	return economicsData, nil
+}
+
+// computeLeaderFeesScale returns the ratio used to scale the recorded leader fees, as these were accumulated during the
+// epoch with the baseline leader percentage
+func computeLeaderFeesScale(baseline process.RewardsHandler, alternate process.RewardsHandler) *big.Rat {
+	baselinePercentage := big.NewRat(0, 1).SetFloat64(baseline.LeaderPercentage())
+	alternatePercentage := big.NewRat(0, 1).SetFloat64(alternate.LeaderPercentage())
+	if baselinePercentage == nil || alternatePercentage == nil || baselinePercentage.Sign() == 0 {
+		log.Warn("can not scale the recorded leader fees, the alternate scenario will use the recorded values",
+			"baseline leader percentage", baseline.LeaderPercentage(),
+			"alternate leader percentage", alternate.LeaderPercentage())
+		return big.NewRat(1, 1)
+	}
+
+	return big.NewRat(0, 1).Quo(alternatePercentage, baselinePercentage)
+}
+
+func (s *simulator) runScenario(
+	epochData *EpochData,
+	economicsData process.EconomicsDataHandler,
+	leaderFeesScale *big.Rat,
+) (*scenarioResult, error) {
+	store, err := s.createStorageService(epochData)
+	if err != nil {
+		return nil, err
+	}
+
+	economicsDataProvider := metachain.NewEpochEconomicsStatistics()
+	epochEconomics, err := metachain.NewEndOfEpochEconomicsDataCreator(metachain.ArgsNewEpochEconomics{
+		Marshalizer:           s.marshalizer,
+		Hasher:                s.hasher,
+		Store:                 store,
+		ShardCoordinator:      s.shardCoordinator,
+		RewardsHandler:        economicsData,
+		RoundTime:             &roundDurationHandler{roundDuration: s.roundDuration},
+		GenesisEpoch:          s.genesisEpoch,
+		GenesisNonce:          s.genesisNonce,
+		GenesisTotalSupply:    economicsData.GenesisTotalSupply(),
+		EconomicsDataNotified: economicsDataProvider,
+		StakingV2EnableEpoch:  s.enableEpochs.StakingV2EnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	computedEconomics, err := epochEconomics.ComputeEndOfEpochEconomics(epochData.MetaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo, stakingDataProvider, err := s.createRecordedValidatorsInfo(epochData, leaderFeesScale)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPool, err := s.createDataPool(economicsData)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdownCollector := &rewardsBreakdownCollector{}
+	rewardsCreator, err := metachain.NewRewardsCreatorV2(metachain.RewardsCreatorArgsV2{
+		BaseRewardsCreatorArgs: metachain.BaseRewardsCreatorArgs{
+			ShardCoordinator:              s.shardCoordinator,
+			PubkeyConverter:               s.addressPubkeyConverter,
+			RewardsStorage:                disabled.CreateMemUnit(),
+			MiniBlockStorage:              disabled.CreateMemUnit(),
+			Hasher:                        s.hasher,
+			Marshalizer:                   s.marshalizer,
+			DataPool:                      dataPool,
+			ProtocolSustainabilityAddress: economicsData.ProtocolSustainabilityAddress(),
+			NodesConfigProvider:           s.nodesConfigProvider,
+			DelegationSystemSCEnableEpoch: s.enableEpochs.StakingV2EnableEpoch,
+			UserAccountsDB:                disabled.NewAccountsAdapter(),
+			RewardsFix1EpochEnable:        s.enableEpochs.SwitchJailWaitingEnableEpoch,
+		},
+		StakingDataProvider:     stakingDataProvider,
+		EconomicsDataProvider:   economicsDataProvider,
+		RewardsHandler:          economicsData,
+		RewardsBreakdownHandler: breakdownCollector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks, err := rewardsCreator.CreateRewardsMiniBlocks(epochData.MetaBlock, validatorsInfo, computedEconomics)
+	if err != nil {
+		return nil, err
+	}
+	rewardsCreator.SaveTxBlockToStorage(epochData.MetaBlock, &block.Body{MiniBlocks: miniBlocks})
+
+	breakdown, err := breakdownCollector.GetRewardsBreakdown(epochData.MetaBlock.GetEpoch())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNilRewardsBreakdown, err)
+	}
+
+	return &scenarioResult{
+		economicsData: economicsData,
+		economics:     computedEconomics,
+		leaderFees:    economicsDataProvider.LeaderFees(),
+		breakdown:     breakdown,
+	}, nil
+}
+
+// createStorageService creates an in-memory storage service holding the start of epoch metablocks needed by the end
+// of epoch economics
+func (s *simulator) createStorageService(epochData *EpochData) (dataRetriever.StorageService, error) {
+	metaBlockStorer := disabled.CreateMemUnit()
+	for _, metaBlock := range []*block.MetaBlock{epochData.PrevEpochStartMetaBlock, epochData.MetaBlock} {
+		buff, err := s.marshalizer.Marshal(metaBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		err = metaBlockStorer.Put([]byte(core.EpochStartIdentifier(metaBlock.GetEpoch())), buff)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockStorer)
+
+	return store, nil
+}
+
+func (s *simulator) createDataPool(economicsData process.EconomicsDataHandler) (dataRetriever.PoolsHolder, error) {
+	// the pools are required by the rewards creator but never used in the simulation, so the trie nodes are kept only
+	// in memory and the path manager does not create any directory
+	generalConfig := *s.generalConfig
+	generalConfig.TrieSyncStorage.EnableDB = false
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(os.TempDir())
+	if err != nil {
+		return nil, err
+	}
+
+	return dataRetrieverFactory.NewDataPoolFromConfig(dataRetrieverFactory.ArgsDataPool{
+		Config:           &generalConfig,
+		EconomicsData:    economicsData,
+		ShardCoordinator: s.shardCoordinator,
+		Marshalizer:      s.marshalizer,
+		PathManager:      pathManager,
+	})
+}
+
+// createRecordedValidatorsInfo copies the validators info read from the peer accounts trie, scaling the accumulated
+// fees, and rebuilds the staking data from the recorded rewards breakdown. The per node statistics of the breakdown
+// are only cross-checked against the validators info
+func (s *simulator) createRecordedValidatorsInfo(
+	epochData *EpochData,
+	leaderFeesScale *big.Rat,
+) (map[uint32][]*state.ValidatorInfo, *recordedStakingDataProvider, error) {
+	breakdown := epochData.RewardsBreakdown
+	stakingDataProvider := &recordedStakingDataProvider{
+		totalStakeEligible: big.NewInt(0),
+		totalTopUpEligible: big.NewInt(0),
+		topUpPerNode:       make(map[string]*big.Int),
+	}
+	err := setBigIntFromString(stakingDataProvider.totalStakeEligible, breakdown.TotalStakeEligible)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = setBigIntFromString(stakingDataProvider.totalTopUpEligible, breakdown.TotalTopUpEligible)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recordedNodes := make(map[string]*common.NodeRewardsBreakdownAPIResponse, len(breakdown.Nodes))
+	for _, node := range breakdown.Nodes {
+		publicKey, errDecode := hex.DecodeString(node.BLSKey)
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for BLS key %s", errDecode, node.BLSKey)
+		}
+
+		topUp := big.NewInt(0)
+		err = setBigIntFromString(topUp, node.TopUpStake)
+		if err != nil {
+			return nil, nil, err
+		}
+		stakingDataProvider.topUpPerNode[string(publicKey)] = topUp
+		recordedNodes[string(publicKey)] = node
+	}
+
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo, len(epochData.ValidatorsInfo))
+	for shardID, shardValidatorsInfo := range epochData.ValidatorsInfo {
+		for _, vInfo := range shardValidatorsInfo {
+			recordedNode, found := recordedNodes[string(vInfo.PublicKey)]
+			if found {
+				s.crossCheckRecordedNode(vInfo, recordedNode)
+			}
+
+			validatorInfo := *vInfo
+			validatorInfo.AccumulatedFees = scaleValue(vInfo.AccumulatedFees, leaderFeesScale)
+			validatorsInfo[shardID] = append(validatorsInfo[shardID], &validatorInfo)
+		}
+	}
+
+	return validatorsInfo, stakingDataProvider, nil
+}
+
+func (s *simulator) crossCheckRecordedNode(vInfo *state.ValidatorInfo, node *common.NodeRewardsBreakdownAPIResponse) {
+	rewardAddress := s.addressPubkeyConverter.Encode(vInfo.RewardAddress)
+	accumulatedFees := vInfo.AccumulatedFees.String()
+
+	isMatching := node.ShardID == vInfo.ShardId &&
+		node.RewardAddress == rewardAddress &&
+		node.LeaderSuccess == vInfo.LeaderSuccess &&
+		node.LeaderFailure == vInfo.LeaderFailure &&
+		node.ValidatorSuccess == vInfo.ValidatorSuccess &&
+		node.ValidatorFailure == vInfo.ValidatorFailure &&
+		node.ValidatorIgnoredSignatures == vInfo.ValidatorIgnoredSignatures &&
+		node.NumSelectedInSuccessBlocks == vInfo.NumSelectedInSuccessBlocks &&
+		(node.AccumulatedFees == accumulatedFees || len(node.AccumulatedFees) == 0 && vInfo.AccumulatedFees.Sign() == 0)
+	if isMatching {
+		return
+	}
+
+	log.Warn("recorded rewards breakdown does not match the peer accounts trie",
+		"BLS key", node.BLSKey,
+		"recorded reward address", node.RewardAddress, "reward address", rewardAddress,
+		"recorded leader success", node.LeaderSuccess, "leader success", vInfo.LeaderSuccess,
+		"recorded validator success", node.ValidatorSuccess, "validator success", vInfo.ValidatorSuccess,
+		"recorded accumulated fees", node.AccumulatedFees, "accumulated fees", accumulatedFees,
+	)
+}
+
+func setBigIntFromString(value *big.Int, str string) error {
+	if len(str) == 0 {
+		value.SetUint64(0)
+		return nil
+	}
+
+	_, ok := value.SetString(str, 10)
+	if !ok {
+		return fmt.Errorf("%w, invalid big int value %s", ErrNilEpochData, str)
+	}
+
+	return nil
+}
+
+func scaleValue(value *big.Int, scale *big.Rat) *big.Int {
+	scaled := big.NewInt(0).Mul(value, scale.Num())
+
+	return scaled.Quo(scaled, scale.Denom())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulator) IsInterfaceNil() bool {
+	return s == nil
+}
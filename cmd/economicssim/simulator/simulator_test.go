@@ -0,0 +1,378 @@
+package simulator_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/cmd/economicssim/simulator"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	numShards       = 3
+	simulatedEpoch  = 5
+	roundsInEpoch   = 14400
+	consensusSize   = 2
+	nodesPerShard   = 4
+	economicsConfig = "../../node/config/economics.toml"
+)
+
+var oneEGLD = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+func eglds(value int64) *big.Int {
+	return big.NewInt(0).Mul(big.NewInt(value), oneEGLD)
+}
+
+func createAddressPubkeyConverter(t *testing.T) core.PubkeyConverter {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(32, &testscommon.LoggerStub{})
+	require.Nil(t, err)
+
+	return converter
+}
+
+func createMockArgsSimulator(t *testing.T) simulator.ArgsSimulator {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(numShards, core.MetachainShardId)
+	generalConfig := testscommon.GetGeneralConfig()
+
+	return simulator.ArgsSimulator{
+		GeneralConfig: &generalConfig,
+		EnableEpochs: config.EnableEpochs{
+			StakingV2EnableEpoch:         1,
+			SwitchJailWaitingEnableEpoch: 1,
+		},
+		ShardCoordinator:        shardCoordinator,
+		AddressPubkeyConverter:  createAddressPubkeyConverter(t),
+		Marshalizer:             &marshal.GogoProtoMarshalizer{},
+		Hasher:                  blake2b.NewBlake2b(),
+		RoundDuration:           time.Second * 6,
+		ShardConsensusGroupSize: consensusSize,
+		MetaConsensusGroupSize:  consensusSize,
+	}
+}
+
+func createLastFinalizedHeaders(nonce uint64, round uint64) []block.EpochStartShardData {
+	headers := make([]block.EpochStartShardData, 0, numShards)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		headers = append(headers, block.EpochStartShardData{
+			ShardID: shardID,
+			Nonce:   nonce,
+			Round:   round,
+		})
+	}
+
+	return headers
+}
+
+// createEpochData creates the start of epoch metablocks, the validators info and the recorded breakdown of an epoch in which every shard
+// produced a block in each round and the first node of each shard was offline
+func createEpochData(t *testing.T) *simulator.EpochData {
+	prevEpochStartRound := uint64(roundsInEpoch * (simulatedEpoch - 1))
+	prevEpochStartMetaBlock := &block.MetaBlock{
+		Epoch: simulatedEpoch - 1,
+		Nonce: prevEpochStartRound,
+		Round: prevEpochStartRound,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: createLastFinalizedHeaders(prevEpochStartRound, prevEpochStartRound),
+			Economics: block.Economics{
+				TotalSupply:                      eglds(20000000),
+				TotalToDistribute:                big.NewInt(0),
+				TotalNewlyMinted:                 big.NewInt(0),
+				RewardsPerBlock:                  big.NewInt(0),
+				RewardsForProtocolSustainability: big.NewInt(0),
+				NodePrice:                        eglds(2500),
+			},
+		},
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+
+	epochStartRound := prevEpochStartRound + roundsInEpoch
+	metaBlock := &block.MetaBlock{
+		Epoch:    simulatedEpoch,
+		Nonce:    epochStartRound,
+		Round:    epochStartRound,
+		PrevHash: []byte("block before the start of epoch"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: createLastFinalizedHeaders(epochStartRound, epochStartRound),
+		},
+		AccumulatedFeesInEpoch: eglds(1000),
+		DevFeesInEpoch:         eglds(100),
+	}
+
+	addressConverter := createAddressPubkeyConverter(t)
+	breakdown := &common.RewardsBreakdownAPIResponse{
+		Epoch: simulatedEpoch,
+		Nodes: make([]*common.NodeRewardsBreakdownAPIResponse, 0),
+	}
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	totalTopUp := big.NewInt(0)
+	shardIDs := []uint32{0, 1, 2, core.MetachainShardId}
+	for _, shardID := range shardIDs {
+		for i := 0; i < nodesPerShard; i++ {
+			isOnline := i != 0
+			publicKey := []byte(fmt.Sprintf("bls key %d in shard %d", i, shardID))
+			rewardAddress := []byte(fmt.Sprintf("%032d", i))
+			node := &common.NodeRewardsBreakdownAPIResponse{
+				BLSKey:          hex.EncodeToString(publicKey),
+				ShardID:         shardID,
+				RewardAddress:   addressConverter.Encode(rewardAddress),
+				TempRating:      1000000,
+				TopUpStake:      eglds(int64(100 * (i + 1))).String(),
+				AccumulatedFees: "0",
+				Rewarded:        isOnline,
+			}
+			totalTopUp.Add(totalTopUp, eglds(int64(100*(i+1))))
+
+			if isOnline {
+				node.LeaderSuccess = roundsInEpoch / (nodesPerShard - 1)
+				node.ValidatorSuccess = roundsInEpoch * consensusSize / (nodesPerShard - 1)
+				node.NumSelectedInSuccessBlocks = roundsInEpoch * consensusSize / (nodesPerShard - 1)
+				// the 10% leader share of the 900 EGLD of fees without the developer fees split between the online nodes
+				node.AccumulatedFees = big.NewInt(7500000000000000000).String()
+			}
+
+			breakdown.Nodes = append(breakdown.Nodes, node)
+			validatorsInfo[shardID] = append(validatorsInfo[shardID], &state.ValidatorInfo{
+				PublicKey:                  publicKey,
+				ShardId:                    shardID,
+				List:                       string(common.EligibleList),
+				Index:                      uint32(i),
+				TempRating:                 node.TempRating,
+				Rating:                     node.TempRating,
+				RewardAddress:              rewardAddress,
+				LeaderSuccess:              node.LeaderSuccess,
+				ValidatorSuccess:           node.ValidatorSuccess,
+				NumSelectedInSuccessBlocks: node.NumSelectedInSuccessBlocks,
+				AccumulatedFees:            sumOfStrings(t, node.AccumulatedFees),
+			})
+		}
+	}
+	breakdown.TotalTopUpEligible = totalTopUp.String()
+	totalStake := big.NewInt(0).Mul(eglds(2500), big.NewInt(int64(len(breakdown.Nodes))))
+	breakdown.TotalStakeEligible = totalStake.Add(totalStake, totalTopUp).String()
+
+	return &simulator.EpochData{
+		MetaBlock:               metaBlock,
+		PrevEpochStartMetaBlock: prevEpochStartMetaBlock,
+		ValidatorsInfo:          validatorsInfo,
+		RewardsBreakdown:        breakdown,
+	}
+}
+
+func loadEconomicsConfig(t *testing.T) *config.EconomicsConfig {
+	economics, err := common.LoadEconomicsConfig(economicsConfig)
+	require.Nil(t, err)
+
+	return economics
+}
+
+func sumOfStrings(t *testing.T, values ...string) *big.Int {
+	sum := big.NewInt(0)
+	for _, value := range values {
+		bigValue, ok := big.NewInt(0).SetString(value, 10)
+		require.True(t, ok)
+		sum.Add(sum, bigValue)
+	}
+
+	return sum
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil general config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.GeneralConfig = nil
+		s, err := simulator.NewSimulator(args)
+		assert.Equal(t, simulator.ErrNilConfig, err)
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.ShardCoordinator = nil
+		s, err := simulator.NewSimulator(args)
+		assert.Equal(t, simulator.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.AddressPubkeyConverter = nil
+		s, err := simulator.NewSimulator(args)
+		assert.Equal(t, simulator.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.Marshalizer = nil
+		s, err := simulator.NewSimulator(args)
+		assert.Equal(t, simulator.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.Hasher = nil
+		s, err := simulator.NewSimulator(args)
+		assert.Equal(t, simulator.ErrNilHasher, err)
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("invalid round duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.RoundDuration = time.Millisecond
+		s, err := simulator.NewSimulator(args)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidRoundDuration))
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("invalid consensus group size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.MetaConsensusGroupSize = 0
+		s, err := simulator.NewSimulator(args)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidConsensusGroupSize))
+		assert.True(t, check.IfNil(s))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := simulator.NewSimulator(createMockArgsSimulator(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(s))
+	})
+}
+
+func TestSimulator_SimulateInvalidDataShouldError(t *testing.T) {
+	t.Parallel()
+
+	s, _ := simulator.NewSimulator(createMockArgsSimulator(t))
+	economics := loadEconomicsConfig(t)
+
+	result, err := s.Simulate(nil, economics, economics)
+	assert.Equal(t, simulator.ErrNilEpochData, err)
+	assert.Nil(t, result)
+
+	epochData := createEpochData(t)
+	epochData.ValidatorsInfo = nil
+	result, err = s.Simulate(epochData, economics, economics)
+	assert.True(t, errors.Is(err, simulator.ErrNilEpochData))
+	assert.Nil(t, result)
+
+	epochData = createEpochData(t)
+	epochData.RewardsBreakdown = nil
+	result, err = s.Simulate(epochData, economics, economics)
+	assert.True(t, errors.Is(err, simulator.ErrNilEpochData))
+	assert.Nil(t, result)
+
+	result, err = s.Simulate(createEpochData(t), economics, nil)
+	assert.True(t, errors.Is(err, simulator.ErrNilConfig))
+	assert.Nil(t, result)
+
+	args := createMockArgsSimulator(t)
+	args.EnableEpochs.StakingV2EnableEpoch = simulatedEpoch
+	s, _ = simulator.NewSimulator(args)
+	result, err = s.Simulate(createEpochData(t), economics, economics)
+	assert.True(t, errors.Is(err, simulator.ErrRewardsV2NotActive))
+	assert.Nil(t, result)
+}
+
+func TestSimulator_SimulateSameConfigShouldNotChangeTheRewards(t *testing.T) {
+	t.Parallel()
+
+	s, _ := simulator.NewSimulator(createMockArgsSimulator(t))
+	economics := loadEconomicsConfig(t)
+
+	result, err := s.Simulate(createEpochData(t), economics, economics)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(simulatedEpoch), result.Epoch)
+	assert.Equal(t, result.Baseline, result.Alternate)
+	assert.NotEqual(t, "0", result.Baseline.TotalToDistribute)
+	require.Equal(t, numShards+1, len(result.Shards))
+	require.Equal(t, (numShards+1)*nodesPerShard, len(result.Nodes))
+	for _, shard := range result.Shards {
+		assert.Equal(t, nodesPerShard, shard.NumNodes)
+		assert.Equal(t, "0", shard.Delta)
+	}
+	for _, node := range result.Nodes {
+		assert.Equal(t, "0", node.Delta)
+		assert.Equal(t, node.BaselineTotalRewards, node.AlternateTotalRewards)
+	}
+}
+
+func TestSimulator_SimulateShouldComputeTheRewardsDeltas(t *testing.T) {
+	t.Parallel()
+
+	s, _ := simulator.NewSimulator(createMockArgsSimulator(t))
+	baseline := loadEconomicsConfig(t)
+	alternate := loadEconomicsConfig(t)
+	for i := range alternate.RewardsSettings.RewardsConfigByEpoch {
+		alternate.RewardsSettings.RewardsConfigByEpoch[i].LeaderPercentage = 0.2
+		alternate.RewardsSettings.RewardsConfigByEpoch[i].TopUpFactor = 0.1
+	}
+
+	result, err := s.Simulate(createEpochData(t), baseline, alternate)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0.1, result.Baseline.LeaderPercentage)
+	assert.Equal(t, 0.2, result.Alternate.LeaderPercentage)
+	assert.Equal(t, result.Baseline.TotalToDistribute, result.Alternate.TotalToDistribute)
+	assert.Equal(t, "90000000000000000000", result.Baseline.LeaderFees)
+	assert.Equal(t, "180000000000000000000", result.Alternate.LeaderFees)
+
+	baselineTopUp := sumOfStrings(t, result.Baseline.TopUpRewards)
+	alternateTopUp := sumOfStrings(t, result.Alternate.TopUpRewards)
+	assert.True(t, alternateTopUp.Cmp(baselineTopUp) < 0)
+
+	for _, node := range result.Nodes {
+		if node.BaselineTotalRewards == "0" {
+			assert.Equal(t, "0", node.AlternateTotalRewards)
+			continue
+		}
+
+		baselineFees := sumOfStrings(t, node.BaselineLeaderFees)
+		alternateFees := sumOfStrings(t, node.AlternateLeaderFees)
+		assert.Equal(t, big.NewInt(0).Mul(baselineFees, big.NewInt(2)), alternateFees)
+
+		expectedDelta := big.NewInt(0).Sub(
+			sumOfStrings(t, node.AlternateProtocolRewards, node.AlternateLeaderFees),
+			sumOfStrings(t, node.BaselineProtocolRewards, node.BaselineLeaderFees),
+		)
+		assert.Equal(t, expectedDelta.String(), node.Delta)
+		assert.NotEqual(t, "0", node.Delta)
+	}
+
+	for _, shard := range result.Shards {
+		expectedDelta := big.NewInt(0).Sub(
+			sumOfStrings(t, shard.AlternateTotalRewards),
+			sumOfStrings(t, shard.BaselineTotalRewards),
+		)
+		assert.Equal(t, expectedDelta.String(), shard.Delta)
+	}
+}
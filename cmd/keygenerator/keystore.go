@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/urfave/cli"
+)
+
+const keyFileExtension = ".json"
+
+type pemKey struct {
+	key
+	isWalletKey bool
+}
+
+var (
+	walletKeyFileFilenameTemplate    = "walletKey%s" + keyFileExtension
+	validatorKeyFileFilenameTemplate = "validatorKey%s" + keyFileExtension
+
+	// pemFile defines the flag for the PEM file used by the conversion commands
+	pemFile = cli.StringFlag{
+		Name:  "pem-file",
+		Usage: "The `filepath` for the PEM file to be converted, or to be created, by the command",
+	}
+	// keyFiles defines the flag for the key files used by the conversion commands
+	keyFiles = cli.StringSliceFlag{
+		Name:  "keystore-file",
+		Usage: "The `filepath` for a key file to be converted. Can be provided multiple times",
+	}
+
+	pemToKeystoreCommand = cli.Command{
+		Name: "pem-to-keystore",
+		Usage: "converts each key from the PEM file into a password protected key file, created next to the PEM file. " +
+			"The PEM file is left untouched",
+		Flags: []cli.Flag{
+			pemFile,
+			passwordFile,
+		},
+		Action: func(c *cli.Context) error {
+			return convertPemToKeyFiles(c.String(pemFile.Name), c.String(passwordFile.Name))
+		},
+	}
+	keystoreToPemCommand = cli.Command{
+		Name:  "keystore-to-pem",
+		Usage: "decrypts the provided key files and writes all the keys in a new PEM file",
+		Flags: []cli.Flag{
+			keyFiles,
+			pemFile,
+			passwordFile,
+		},
+		Action: func(c *cli.Context) error {
+			return convertKeyFilesToPem(c.StringSlice(keyFiles.Name), c.String(pemFile.Name), c.String(passwordFile.Name))
+		},
+	}
+)
+
+func saveKeysAsKeyFiles(validatorKeys []key, walletKeys []key, saveInSameFile bool) error {
+	if saveInSameFile {
+		return fmt.Errorf("the keys can not be saved in the same file as a key file holds a single key")
+	}
+	if len(validatorKeys)+len(walletKeys) == 0 {
+		return fmt.Errorf("internal error: no keys to save")
+	}
+
+	password, err := readNewPassword(argsConfig.passwordFile)
+	if err != nil {
+		return err
+	}
+
+	err = saveSliceKeyFiles(validatorKeyFileFilenameTemplate, validatorKeys, false, password)
+	if err != nil {
+		return err
+	}
+
+	return saveSliceKeyFiles(walletKeyFileFilenameTemplate, walletKeys, true, password)
+}
+
+func saveSliceKeyFiles(baseFilenameTemplate string, keys []key, isWalletKey bool, password string) error {
+	for i, k := range keys {
+		folder, err := generateFolder(i, len(keys), false)
+		if err != nil {
+			return err
+		}
+
+		filename := filepath.Join(folder, baseFilenameTemplate)
+		backupFileIfExists(filename)
+
+		keyFile, err := encryptKey(k, isWalletKey, password)
+		if err != nil {
+			return err
+		}
+
+		err = keystore.SaveKeyFile(fmt.Sprintf(filename, ""), keyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encryptKey(k key, isWalletKey bool, password string) (*keystore.KeyFile, error) {
+	bech32Address := ""
+	if isWalletKey {
+		bech32Address = walletPubKeyConverter.Encode(k.pkBytes)
+	}
+
+	return keystore.Encrypt(k.skBytes, hex.EncodeToString(k.pkBytes), bech32Address, password)
+}
+
+func convertPemToKeyFiles(pemFilename string, passwordFilename string) error {
+	if len(pemFilename) == 0 {
+		return fmt.Errorf("the --%s flag should be provided", pemFile.Name)
+	}
+
+	keys, err := loadPemKeys(pemFilename)
+	if err != nil {
+		return err
+	}
+
+	outputFilenames := make([]string, 0, len(keys))
+	baseFilename := strings.TrimSuffix(pemFilename, filepath.Ext(pemFilename))
+	for i := range keys {
+		outputFilename := baseFilename + keyFileExtension
+		if len(keys) > 1 {
+			outputFilename = fmt.Sprintf("%s-%d%s", baseFilename, i, keyFileExtension)
+		}
+		if fileExists(outputFilename) {
+			return fmt.Errorf("output file %s already exists", outputFilename)
+		}
+
+		outputFilenames = append(outputFilenames, outputFilename)
+	}
+
+	password, err := readNewPassword(passwordFilename)
+	if err != nil {
+		return err
+	}
+
+	for i, k := range keys {
+		keyFile, errEncrypt := encryptKey(k.key, k.isWalletKey, password)
+		if errEncrypt != nil {
+			return errEncrypt
+		}
+
+		err = keystore.SaveKeyFile(outputFilenames[i], keyFile)
+		if err != nil {
+			return err
+		}
+
+		log.Info("key file created", "file", outputFilenames[i])
+	}
+
+	return nil
+}
+
+func loadPemKeys(pemFilename string) ([]pemKey, error) {
+	keys := make([]pemKey, 0)
+	keyLoader := &core.KeyLoader{}
+	for index := 0; ; index++ {
+		encodedSk, pkString, err := keyLoader.LoadKey(pemFilename, index)
+		if index > 0 && errors.Is(err, core.ErrInvalidIndex) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		isWalletKey := !isValidatorPublicKey(pkString)
+		var converter core.PubkeyConverter = validatorPubKeyConverter
+		if isWalletKey {
+			converter = walletPubKeyConverter
+		}
+
+		skBytes, err := hex.DecodeString(string(encodedSk))
+		if err != nil {
+			return nil, fmt.Errorf("%w for the secret key with index %d", err, index)
+		}
+		pkBytes, err := converter.Decode(pkString)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the public key %s", err, pkString)
+		}
+
+		keys = append(keys, pemKey{
+			key: key{
+				skBytes: skBytes,
+				pkBytes: pkBytes,
+			},
+			isWalletKey: isWalletKey,
+		})
+	}
+
+	return keys, nil
+}
+
+func isValidatorPublicKey(pkString string) bool {
+	_, err := validatorPubKeyConverter.Decode(pkString)
+	return err == nil
+}
+
+func convertKeyFilesToPem(keyFilenames []string, pemFilename string, passwordFilename string) error {
+	if len(keyFilenames) == 0 {
+		return fmt.Errorf("at least one --%s flag should be provided", keyFiles.Name)
+	}
+	if len(pemFilename) == 0 {
+		return fmt.Errorf("the --%s flag should be provided", pemFile.Name)
+	}
+	if fileExists(pemFilename) {
+		return fmt.Errorf("output file %s already exists", pemFilename)
+	}
+
+	passwordProvider := keystore.NewPasswordProvider(keystore.ArgsPasswordProvider{
+		FilePath:      passwordFilename,
+		EnvVariable:   common.KeystorePasswordEnvVariable,
+		PromptMessage: "password: ",
+	})
+	password, err := passwordProvider.Password()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(pemFilename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	for _, keyFilename := range keyFilenames {
+		err = appendKeyFileToPem(file, keyFilename, password)
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(pemFilename)
+			return err
+		}
+	}
+
+	log.Info("PEM file created", "file", pemFilename, "num keys", len(keyFilenames))
+
+	return file.Close()
+}
+
+func appendKeyFileToPem(file *os.File, keyFilename string, password string) error {
+	keyFile, err := keystore.ReadKeyFile(keyFilename)
+	if err != nil {
+		return err
+	}
+
+	skBytes, err := keystore.Decrypt(keyFile, password)
+	if err != nil {
+		return fmt.Errorf("%w for the key file %s", err, keyFilename)
+	}
+
+	pkBytes, err := hex.DecodeString(keyFile.Address)
+	if err != nil {
+		return fmt.Errorf("%w for the address of the key file %s", err, keyFilename)
+	}
+
+	var converter core.PubkeyConverter = validatorPubKeyConverter
+	if len(keyFile.Bech32) > 0 {
+		converter = walletPubKeyConverter
+	}
+
+	return writeKeyToStream(file, key{skBytes: skBytes, pkBytes: pkBytes}, converter)
+}
+
+// readNewPassword reads the password used to encrypt new key files. When prompted, the password has to be typed twice
+func readNewPassword(passwordFilename string) (string, error) {
+	args := keystore.ArgsPasswordProvider{
+		FilePath:      passwordFilename,
+		EnvVariable:   common.KeystorePasswordEnvVariable,
+		PromptMessage: "new password: ",
+	}
+	password, err := keystore.NewPasswordProvider(args).Password()
+	if err != nil {
+		return "", err
+	}
+
+	_, isSetInEnv := os.LookupEnv(args.EnvVariable)
+	if len(passwordFilename) > 0 || isSetInEnv {
+		return password, nil
+	}
+
+	args.PromptMessage = "repeat password: "
+	confirmation, err := keystore.NewPasswordProvider(args).Password()
+	if err != nil {
+		return "", err
+	}
+	if password != confirmation {
+		return "", fmt.Errorf("the passwords do not match")
+	}
+
+	return password, nil
+}
+
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
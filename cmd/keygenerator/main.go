@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/urfave/cli"
 )
 
@@ -29,6 +30,11 @@ type cfg struct {
 	noSplit       bool
 	prefixPattern string
 	shardIDByte   int
+	keystoreOut   bool
+	passwordFile  string
+	mnemonicFile  string
+	startIndex    uint
+	numShards     uint
 }
 
 const validatorType = "validator"
@@ -57,6 +63,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{"\t"}}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -76,11 +85,12 @@ VERSION:
 	keyType = cli.StringFlag{
 		Name: "key-type",
 		Usage: fmt.Sprintf(
-			"What kind of keys should generate. Available options: %s, %s, %s, %s",
+			"What kind of keys should generate. Available options: %s, %s, %s, %s, %s",
 			validatorType,
 			walletType,
 			bothType,
-			minedWalletPrefixKeys),
+			minedWalletPrefixKeys,
+			mnemonicWalletType),
 		Value:       "validator",
 		Destination: &argsConfig.keyType,
 	}
@@ -112,6 +122,20 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// keystoreOut is the flag that, if active, will save each generated key in a password protected key file
+	keystoreOut = cli.BoolFlag{
+		Name: "keystore",
+		Usage: "Boolean option that will save each generated key in a password protected key file instead of a PEM " +
+			"file. Can not be used together with the no-split option",
+		Destination: &argsConfig.keystoreOut,
+	}
+	// passwordFile defines the flag for the file holding the password of the key files
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The `filepath` for the file containing the password of the key files. If not set, the password is read " +
+			"from the " + common.KeystorePasswordEnvVariable + " environment variable or prompted on the terminal",
+		Destination: &argsConfig.passwordFile,
+	}
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
@@ -142,6 +166,15 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		keystoreOut,
+		passwordFile,
+		mnemonicFile,
+		startIndex,
+		numShards,
+	}
+	app.Commands = []cli.Command{
+		pemToKeystoreCommand,
+		keystoreToPemCommand,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -157,6 +190,15 @@ func main() {
 }
 
 func process() error {
+	if argsConfig.keyType == mnemonicWalletType {
+		walletKeys, err := generateMnemonicWalletKeys(signing.NewKeyGenerator(ed25519.NewEd25519()))
+		if err != nil {
+			return err
+		}
+
+		return outputKeys(nil, walletKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	}
+
 	validatorKeys, walletKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
 	if err != nil {
 		return err
@@ -290,6 +332,9 @@ func outputKeys(
 	if consoleOut {
 		return printKeys(validatorKeys, walletKeys)
 	}
+	if argsConfig.keystoreOut {
+		return saveKeysAsKeyFiles(validatorKeys, walletKeys, noSplit)
+	}
 
 	return saveKeys(validatorKeys, walletKeys, noSplit)
 }
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/mnemonic"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+const mnemonicWalletType = "mnemonic-wallet"
+
+var (
+	// mnemonicFile defines the flag for the file holding the mnemonic the wallet keys are derived from
+	mnemonicFile = cli.StringFlag{
+		Name: "mnemonic-file",
+		Usage: "The `filepath` for the file holding the BIP39 mnemonic the " + mnemonicWalletType + " keys are derived " +
+			"from. If the file exists, the keys are restored from its mnemonic. Otherwise, a new mnemonic is generated " +
+			"and saved in the file. If not set, a new mnemonic is generated and printed on the console",
+		Destination: &argsConfig.mnemonicFile,
+	}
+	// startIndex defines the flag for the first address index used when deriving the wallet keys
+	startIndex = cli.UintFlag{
+		Name:        "start-index",
+		Usage:       "The first address index used when deriving the " + mnemonicWalletType + " keys",
+		Value:       0,
+		Destination: &argsConfig.startIndex,
+	}
+	// numShards defines the flag for the number of shards used when filtering the derived keys by shard
+	numShards = cli.UintFlag{
+		Name:        "num-shards",
+		Usage:       "The number of shards of the network, used when filtering the " + mnemonicWalletType + " keys by shard",
+		Value:       3,
+		Destination: &argsConfig.numShards,
+	}
+)
+
+// derivedKey is a wallet key derived from a mnemonic, along with its address index
+type derivedKey struct {
+	key
+	addressIndex uint32
+}
+
+func generateMnemonicWalletKeys(keyGen crypto.KeyGenerator) ([]key, error) {
+	if argsConfig.numKeys < 1 {
+		return nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(argsConfig.numShards), 0)
+	if err != nil {
+		return nil, err
+	}
+	withPreferredShard := argsConfig.shardIDByte != noshard
+	if withPreferredShard && uint32(argsConfig.shardIDByte) >= shardCoordinator.NumberOfShards() {
+		return nil, fmt.Errorf("invalid shard %d for %d shards", argsConfig.shardIDByte, shardCoordinator.NumberOfShards())
+	}
+
+	words, err := loadOrCreateMnemonic(argsConfig.mnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := mnemonic.NewSeed(words, "")
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKeys, err := deriveWalletKeys(keyGen, seed, uint32(argsConfig.startIndex), argsConfig.numKeys,
+		func(pkBytes []byte) bool {
+			return !withPreferredShard || shardCoordinator.ComputeId(pkBytes) == uint32(argsConfig.shardIDByte)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]key, 0, len(derivedKeys))
+	for _, dk := range derivedKeys {
+		log.Info("derived wallet key",
+			"address index", dk.addressIndex,
+			"address", walletPubKeyConverter.Encode(dk.pkBytes),
+			"shard", shardCoordinator.ComputeId(dk.pkBytes))
+		keys = append(keys, dk.key)
+	}
+
+	return keys, nil
+}
+
+// deriveWalletKeys derives wallet keys starting from the provided address index until the requested number of keys
+// is accepted by the filter
+func deriveWalletKeys(
+	keyGen crypto.KeyGenerator,
+	seed []byte,
+	firstIndex uint32,
+	numKeysToDerive int,
+	isAccepted func(pkBytes []byte) bool,
+) ([]derivedKey, error) {
+	keys := make([]derivedKey, 0, numKeysToDerive)
+	for index := uint64(firstIndex); len(keys) < numKeysToDerive; index++ {
+		if index > math.MaxUint32 {
+			return nil, fmt.Errorf("address index space exhausted after deriving %d keys", len(keys))
+		}
+
+		skBytes, err := mnemonic.DeriveWalletKey(seed, uint32(index))
+		if err != nil {
+			return nil, err
+		}
+
+		sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+		if err != nil {
+			return nil, err
+		}
+		pkBytes, err := sk.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		if !isAccepted(pkBytes) {
+			continue
+		}
+
+		keys = append(keys, derivedKey{
+			key: key{
+				skBytes: skBytes,
+				pkBytes: pkBytes,
+			},
+			addressIndex: uint32(index),
+		})
+	}
+
+	return keys, nil
+}
+
+func loadOrCreateMnemonic(filename string) (string, error) {
+	if len(filename) > 0 && fileExists(filename) {
+		buff, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return "", err
+		}
+
+		log.Info("restoring wallet keys from mnemonic", "file", filename)
+		return mnemonic.Normalize(string(buff)), nil
+	}
+
+	words, err := mnemonic.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	if len(filename) == 0 {
+		log.Info("new mnemonic generated, write it down and keep it safe as it restores all the derived keys:\n\n" +
+			words + "\n")
+		return words, nil
+	}
+
+	err = ioutil.WriteFile(filename, []byte(words+"\n"), core.FileModeUserReadWrite)
+	if err != nil {
+		return "", err
+	}
+	log.Info("new mnemonic generated and saved, keep the file safe as it restores all the derived keys",
+		"file", filename)
+
+	return words, nil
+}
@@ -208,6 +208,31 @@ var (
 		Value: "./config/validatorKey.pem",
 	}
 
+	// allValidatorKeysPemFile defines a flag for the path to the file containing all the validator keys managed by
+	// the node, beside the one from the validator key PEM file
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node. " +
+			"All the keys should be assigned to the same shard as the validator key. If the file is missing, the node " +
+			"will only manage the validator key.",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+
+	// validatorKeystoreFile defines a flag for the path to the password protected key file holding the validator key
+	validatorKeystoreFile = cli.StringFlag{
+		Name: "keystore",
+		Usage: "The `filepath` for the password protected key file which contains the secret key for the validator key. " +
+			"If set, it is used instead of the validator key PEM file.",
+	}
+
+	// keystorePasswordFile defines a flag for the path to the file containing the password of the validator key file
+	keystorePasswordFile = cli.StringFlag{
+		Name: "keystore-password-file",
+		Usage: "The `filepath` for the file containing the password of the validator key file. If not set, the password " +
+			"is read from the " + common.KeystorePasswordEnvVariable + " environment variable or, if that is not set " +
+			"either, prompted on the terminal.",
+	}
+
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -327,6 +352,20 @@ var (
 		Name:  "force-start-from-network",
 		Usage: "Flag that will force the start from network bootstrap process",
 	}
+	// reindexESDTSupplies defines a flag that will rebuild the ESDT supplies index from the logs found in storage
+	reindexESDTSupplies = cli.BoolFlag{
+		Name: "reindex-esdt-supplies",
+		Usage: "Boolean option for rebuilding, at startup, the ESDT supplies index from the transaction logs saved in storage. " +
+			"It is relevant only if the DbLookupExtensions feature is enabled.",
+	}
+	// reindexESDTSuppliesPartially defines a flag that will allow rebuilding the ESDT supplies index when the older
+	// blocks are no longer in storage
+	reindexESDTSuppliesPartially = cli.BoolFlag{
+		Name: "reindex-esdt-supplies-partially",
+		Usage: "Boolean option for allowing the ESDT supplies reindexing to start from the oldest block still in storage. " +
+			"Without it, the reindexing fails and keeps the existing index if the older blocks were removed, as the " +
+			"rebuilt supplies would only contain the changes of the available blocks.",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -347,6 +386,9 @@ func getFlags() []cli.Flag {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		allValidatorKeysPemFile,
+		validatorKeystoreFile,
+		keystorePasswordFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -377,6 +419,8 @@ func getFlags() []cli.Flag {
 		memBallast,
 		memoryUsageToCreateProfiles,
 		forceStartFromNetwork,
+		reindexESDTSupplies,
+		reindexESDTSuppliesPartially,
 	}
 }
 
@@ -400,6 +444,8 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.UseLogView = ctx.GlobalBool(useLogView.Name)
 	flagsConfig.ValidatorKeyIndex = ctx.GlobalInt(validatorKeyIndex.Name)
 	flagsConfig.ForceStartFromNetwork = ctx.GlobalBool(forceStartFromNetwork.Name)
+	flagsConfig.ReindexESDTSupplies = ctx.GlobalBool(reindexESDTSupplies.Name)
+	flagsConfig.ReindexESDTSuppliesPartially = ctx.GlobalBool(reindexESDTSuppliesPartially.Name)
 	return flagsConfig
 }
 
@@ -410,6 +456,11 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	cfgs.ConfigurationPathsHolder.GasScheduleDirectoryName = ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	cfgs.ConfigurationPathsHolder.SmartContracts = ctx.GlobalString(smartContractsFile.Name)
 	cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeyPemFile.Name)
+	cfgs.ConfigurationPathsHolder.AllValidatorKeys = ctx.GlobalString(allValidatorKeysPemFile.Name)
+	cfgs.ConfigurationPathsHolder.KeystorePasswordFile = ctx.GlobalString(keystorePasswordFile.Name)
+	if ctx.IsSet(validatorKeystoreFile.Name) {
+		cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeystoreFile.Name)
+	}
 
 	if ctx.IsSet(startInEpoch.Name) {
 		log.Debug("start in epoch is enabled")
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclsig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+	"github.com/urfave/cli"
+)
+
+const filePathPlaceholder = "[path]"
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// keystoreFiles defines a flag for the encrypted keystore files holding the BLS keys
+	keystoreFiles = cli.StringSliceFlag{
+		Name: "keystore",
+		Usage: "The `" + filePathPlaceholder + "` for an encrypted keystore file holding a BLS key. Can be provided " +
+			"multiple times, once for each hosted key",
+	}
+	// passwordFile defines a flag for the file containing the password of the keystore files
+	passwordFile = cli.StringFlag{
+		Name:  "password-file",
+		Usage: "The `" + filePathPlaceholder + "` for the file containing the password used to decrypt the keystore files",
+	}
+	// network defines a flag for the network type the signer listens on
+	network = cli.StringFlag{
+		Name:  "network",
+		Usage: "The `network` type on which the signer listens for connections. Can be `unix` or `tcp`",
+		Value: remoteSigner.UnixNetwork,
+	}
+	// address defines a flag for the address the signer listens on
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The `address` on which the signer listens for connections: a socket path or a host:port pair",
+		Value: "./remote-signer.sock",
+	}
+	// tlsCertificateFile defines a flag for the TLS certificate of the signer
+	tlsCertificateFile = cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "The `" + filePathPlaceholder + "` for the PEM encoded TLS certificate presented by the signer",
+		Value: "./config/signer.crt",
+	}
+	// tlsPrivateKeyFile defines a flag for the TLS private key of the signer
+	tlsPrivateKeyFile = cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "The `" + filePathPlaceholder + "` for the PEM encoded TLS private key of the signer",
+		Value: "./config/signer.key",
+	}
+	// tlsCACertificateFile defines a flag for the CA used to authenticate the nodes
+	tlsCACertificateFile = cli.StringFlag{
+		Name:  "tls-ca",
+		Usage: "The `" + filePathPlaceholder + "` for the PEM encoded CA certificate used to authenticate the nodes",
+		Value: "./config/ca.crt",
+	}
+	// genesisRandSeeds defines a flag for the random seeds of the genesis blocks
+	genesisRandSeeds = cli.StringSliceFlag{
+		Name: "genesis-rand-seed",
+		Usage: "The hex encoded random `seed` of a genesis block. Can be provided multiple times, once for each shard. " +
+			"Only needed to sign the first block proposed after genesis",
+	}
+	// slashingProtectionFile defines a flag for the file where the signed rounds are recorded
+	slashingProtectionFile = cli.StringFlag{
+		Name: "slashing-protection-file",
+		Usage: "The `" + filePathPlaceholder + "` for the file where the last signed rounds are recorded. If empty, " +
+			"the records are kept only in memory and are lost on restart",
+		Value: "./slashing-protection.json",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "Remote Signer CLI App"
+	app.Usage = "This is the entry point for starting a standalone BLS signer that nodes can delegate their consensus signing to"
+	app.Flags = []cli.Flag{
+		keystoreFiles,
+		passwordFile,
+		network,
+		address,
+		tlsCertificateFile,
+		tlsPrivateKeyFile,
+		tlsCACertificateFile,
+		genesisRandSeeds,
+		slashingProtectionFile,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startSigner(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	privateKeys, err := loadPrivateKeys(ctx.GlobalStringSlice(keystoreFiles.Name), ctx.GlobalString(passwordFile.Name))
+	if err != nil {
+		return err
+	}
+
+	randSeeds, err := decodeGenesisRandSeeds(ctx.GlobalStringSlice(genesisRandSeeds.Name))
+	if err != nil {
+		return err
+	}
+
+	suite := mcl.NewSuiteBLS12()
+	service, err := remoteSigner.NewSignerService(remoteSigner.ArgsSignerService{
+		KeyGenerator:               signing.NewKeyGenerator(suite),
+		SingleSigner:               &mclsig.BlsSingleSigner{},
+		PrivateKeys:                privateKeys,
+		GenesisRandSeeds:           randSeeds,
+		SlashingProtectionFilePath: ctx.GlobalString(slashingProtectionFile.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remoteSigner.NewServerTLSConfig(remoteSigner.ArgsTLSConfig{
+		CertificateFile:   ctx.GlobalString(tlsCertificateFile.Name),
+		PrivateKeyFile:    ctx.GlobalString(tlsPrivateKeyFile.Name),
+		CACertificateFile: ctx.GlobalString(tlsCACertificateFile.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		Network:   ctx.GlobalString(network.Name),
+		Address:   ctx.GlobalString(address.Name),
+		TLSConfig: tlsConfig,
+		Service:   service,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = server.Start()
+	if err != nil {
+		return err
+	}
+
+	log.Info("remote signer started",
+		"network", ctx.GlobalString(network.Name),
+		"address", server.Address(),
+		"num keys", len(privateKeys),
+	)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("terminating remote signer...")
+
+	return server.Close()
+}
+
+func loadPrivateKeys(keystorePaths []string, passwordPath string) ([][]byte, error) {
+	if len(keystorePaths) == 0 {
+		return nil, fmt.Errorf("%w, at least one --%s flag should be provided", remoteSigner.ErrNoKeys, keystoreFiles.Name)
+	}
+
+	password, err := readPassword(passwordPath)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(keystorePaths))
+	for _, path := range keystorePaths {
+		secret, pkHex, errLoad := keystore.LoadKeyFile(path, password)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		log.Debug("loaded keystore file", "file", path, "public key", pkHex)
+		privateKeys = append(privateKeys, secret)
+	}
+
+	return privateKeys, nil
+}
+
+func decodeGenesisRandSeeds(encodedRandSeeds []string) ([][]byte, error) {
+	randSeeds := make([][]byte, 0, len(encodedRandSeeds))
+	for _, encoded := range encodedRandSeeds {
+		randSeed, err := hex.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the genesis random seed %s", err, encoded)
+		}
+
+		randSeeds = append(randSeeds, randSeed)
+	}
+
+	return randSeeds, nil
+}
+
+func readPassword(path string) (string, error) {
+	if len(path) == 0 {
+		return "", fmt.Errorf("%w, the --%s flag should be provided", keystore.ErrEmptyPassword, passwordFile.Name)
+	}
+
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(buff), "\r\n"), nil
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/topology"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -13,18 +16,64 @@ import (
 
 var log = logger.GetOrCreate("seednode/api")
 
+// TopologyHandler defines the component able to provide the network topology snapshot
+type TopologyHandler interface {
+	GetTopologySnapshot() (*p2p.TopologySnapshot, error)
+}
+
 // Start will boot up the api and appropriate routes, handlers and validators
-func Start(restApiInterface string, marshalizer marshal.Marshalizer) error {
+func Start(restApiInterface string, marshalizer marshal.Marshalizer, topologyHandler TopologyHandler) error {
 	ws := gin.Default()
 	ws.Use(cors.Default())
 
-	registerRoutes(ws, marshalizer)
+	registerRoutes(ws, marshalizer, topologyHandler)
 
 	return ws.Run(restApiInterface)
 }
 
-func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer, topologyHandler TopologyHandler) {
 	registerLoggerWsRoute(ws, marshalizer)
+	registerTopologyRoutes(ws, topologyHandler)
+}
+
+func registerTopologyRoutes(ws *gin.Engine, topologyHandler TopologyHandler) {
+	ws.GET("/topology", func(c *gin.Context) {
+		snapshot, err := topologyHandler.GetTopologySnapshot()
+		if err != nil {
+			respondWithTopologyError(c, err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"topology": snapshot},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	})
+
+	ws.GET("/topology/dot", func(c *gin.Context) {
+		snapshot, err := topologyHandler.GetTopologySnapshot()
+		if err != nil {
+			respondWithTopologyError(c, err)
+			return
+		}
+
+		c.String(http.StatusOK, topology.SnapshotToDOT(snapshot))
+	})
+}
+
+func respondWithTopologyError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
 }
 
 func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
@@ -14,6 +14,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/display"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	factoryMarshalizer "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/seednode/api"
@@ -22,8 +25,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
+	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/urfave/cli"
 )
 
@@ -31,6 +38,8 @@ const (
 	defaultLogsPath     = "logs"
 	logFilePrefix       = "elrond-seed"
 	filePathPlaceholder = "[path]"
+
+	heartbeatProcessorIdentifier = "seednode"
 )
 
 var (
@@ -169,8 +178,6 @@ func startNode(ctx *cli.Context) error {
 		}
 	}
 
-	startRestServices(ctx, internalMarshalizer)
-
 	log.Info("starting seednode...")
 
 	sigs := make(chan os.Signal, 1)
@@ -200,11 +207,20 @@ func startNode(ctx *cli.Context) error {
 		return err
 	}
 
+	if p2pConfig.TopologyCrawler.Enabled {
+		err = registerPeerShardResolver(messenger, internalMarshalizer, *generalConfig)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = messenger.Bootstrap()
 	if err != nil {
 		return err
 	}
 
+	startRestServices(ctx, internalMarshalizer, messenger)
+
 	log.Info("application is now running...")
 	mainLoop(messenger, sigs)
 
@@ -254,6 +270,47 @@ func createNode(p2pConfig config.P2PConfig, marshalizer marshal.Marshalizer) (p2
 	return libp2p.NewNetworkMessenger(arg)
 }
 
+// registerPeerShardResolver makes the seednode listen to the heartbeat messages, so the topology crawler can report the
+// shard advertised by each peer
+func registerPeerShardResolver(messenger p2p.Messenger, marshalizer marshal.Marshalizer, generalConfig config.Config) error {
+	peerSigCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(generalConfig.PublicKeyPIDSignature))
+	if err != nil {
+		return err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(peerSigCache, &mclSig.BlsSingleSigner{}, keyGen)
+	if err != nil {
+		return err
+	}
+
+	peersCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(generalConfig.PeerIdShardId))
+	if err != nil {
+		return err
+	}
+
+	peerShardResolver, err := heartbeatProcess.NewPeerShardResolver(heartbeatProcess.ArgPeerShardResolver{
+		Marshalizer:          marshalizer,
+		PeerSignatureHandler: peerSigHandler,
+		Cacher:               peersCache,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = messenger.CreateTopic(common.HeartbeatTopic, false)
+	if err != nil {
+		return err
+	}
+
+	err = messenger.RegisterMessageProcessor(common.HeartbeatTopic, heartbeatProcessorIdentifier, peerShardResolver)
+	if err != nil {
+		return err
+	}
+
+	return messenger.SetPeerShardResolver(peerShardResolver)
+}
+
 func displayMessengerInfo(messenger p2p.Messenger) {
 	headerSeedAddresses := []string{"Seednode addresses:"}
 	addresses := make([]*display.LineData, 0)
@@ -316,17 +373,17 @@ func checkExpectedPeerCount(p2pConfig config.P2PConfig) error {
 	return nil
 }
 
-func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer) {
+func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer, topologyHandler api.TopologyHandler) {
 	restApiInterface := ctx.GlobalString(restApiInterfaceFlag.Name)
 	if restApiInterface != facade.DefaultRestPortOff {
-		go startGinServer(restApiInterface, marshalizer)
+		go startGinServer(restApiInterface, marshalizer, topologyHandler)
 	} else {
 		log.Info("rest api is disabled")
 	}
 }
 
-func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer) {
-	err := api.Start(restApiInterface, marshalizer)
+func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer, topologyHandler api.TopologyHandler) {
+	err := api.Start(restApiInterface, marshalizer, topologyHandler)
 	if err != nil {
 		log.LogIfError(err)
 	}
@@ -63,3 +63,18 @@ func (psh *PresenterStatusHandler) GetNetworkSentBytesInEpoch() uint64 {
 func (psh *PresenterStatusHandler) GetNetworkReceivedBytesInEpoch() uint64 {
 	return psh.getFromCacheAsUint64(common.MetricNetworkRecvBytesInCurrentEpochPerHost)
 }
+
+// GetP2PInboundBps will return the p2p inbound bytes per second, computed on the bandwidth accounting window
+func (psh *PresenterStatusHandler) GetP2PInboundBps() uint64 {
+	return psh.getFromCacheAsUint64(common.MetricP2PInboundBytesPerSec)
+}
+
+// GetP2POutboundBps will return the p2p outbound bytes per second, computed on the bandwidth accounting window
+func (psh *PresenterStatusHandler) GetP2POutboundBps() uint64 {
+	return psh.getFromCacheAsUint64(common.MetricP2POutboundBytesPerSec)
+}
+
+// GetP2POutboundDroppedBytes will return the number of p2p outbound bytes dropped by the traffic shaping
+func (psh *PresenterStatusHandler) GetP2POutboundDroppedBytes() uint64 {
+	return psh.getFromCacheAsUint64(common.MetricP2POutboundDroppedBytes)
+}
@@ -138,3 +138,19 @@ func TestPresenterStatusHandler_GetNetworkReceivedBytesInEpoch(t *testing.T) {
 
 	assert.Equal(t, networkBytesSentInEpoch, result)
 }
+
+func TestPresenterStatusHandler_GetP2PBandwidth(t *testing.T) {
+	t.Parallel()
+
+	inboundBps := uint64(2000)
+	outboundBps := uint64(1000)
+	droppedBytes := uint64(300)
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(common.MetricP2PInboundBytesPerSec, inboundBps)
+	presenterStatusHandler.SetUInt64Value(common.MetricP2POutboundBytesPerSec, outboundBps)
+	presenterStatusHandler.SetUInt64Value(common.MetricP2POutboundDroppedBytes, droppedBytes)
+
+	assert.Equal(t, inboundBps, presenterStatusHandler.GetP2PInboundBps())
+	assert.Equal(t, outboundBps, presenterStatusHandler.GetP2POutboundBps())
+	assert.Equal(t, droppedBytes, presenterStatusHandler.GetP2POutboundDroppedBytes())
+}
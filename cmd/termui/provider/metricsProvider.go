@@ -13,6 +13,7 @@ import (
 var log = logger.GetOrCreate("termui/provider")
 
 const statusMetricsUrlSuffix = "/node/status"
+const p2pStatusMetricsUrlSuffix = "/node/p2pstatus"
 
 type statusMetricsResponseData struct {
 	Response map[string]interface{} `json:"metrics"`
@@ -54,11 +55,15 @@ func NewStatusMetricsProvider(presenter PresenterHandler, nodeAddress string, fe
 func (smp *StatusMetricsProvider) StartUpdatingData() {
 	go func() {
 		for {
-			metricsMap, err := smp.loadMetricsFromApi()
-			if err != nil {
-				log.Debug("fetch from API",
-					"error", err.Error())
-			} else {
+			for _, urlSuffix := range []string{statusMetricsUrlSuffix, p2pStatusMetricsUrlSuffix} {
+				metricsMap, err := smp.loadMetricsFromApi(urlSuffix)
+				if err != nil {
+					log.Debug("fetch from API",
+						"path", urlSuffix,
+						"error", err.Error())
+					continue
+				}
+
 				smp.applyMetricsToPresenter(metricsMap)
 			}
 
@@ -67,10 +72,10 @@ func (smp *StatusMetricsProvider) StartUpdatingData() {
 	}()
 }
 
-func (smp *StatusMetricsProvider) loadMetricsFromApi() (map[string]interface{}, error) {
+func (smp *StatusMetricsProvider) loadMetricsFromApi(urlSuffix string) (map[string]interface{}, error) {
 	client := http.Client{}
 
-	statusMetricsUrl := smp.nodeAddress + statusMetricsUrlSuffix
+	statusMetricsUrl := smp.nodeAddress + urlSuffix
 	resp, err := client.Get(statusMetricsUrl)
 	if err != nil {
 		return nil, err
@@ -57,6 +57,10 @@ type Presenter interface {
 	GetNetworkSentBytesInEpoch() uint64
 	GetNetworkReceivedBytesInEpoch() uint64
 
+	GetP2PInboundBps() uint64
+	GetP2POutboundBps() uint64
+	GetP2POutboundDroppedBytes() uint64
+
 	InvalidateCache()
 	IsInterfaceNil() bool
 }
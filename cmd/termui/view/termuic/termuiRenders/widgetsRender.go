@@ -33,6 +33,7 @@ type WidgetsRender struct {
 	networkSent *widgets.Gauge
 
 	networkBytesInEpoch *widgets.Gauge
+	p2pBandwidth        *widgets.Gauge
 
 	presenter view.Presenter
 }
@@ -72,6 +73,7 @@ func (wr *WidgetsRender) initWidgets() {
 	wr.networkRecv = widgets.NewGauge()
 	wr.networkSent = widgets.NewGauge()
 	wr.networkBytesInEpoch = widgets.NewGauge()
+	wr.p2pBandwidth = widgets.NewGauge()
 
 	wr.lLog = widgets.NewList()
 }
@@ -89,12 +91,15 @@ func (wr *WidgetsRender) setGrid() {
 	colCpuLoad := ui.NewCol(1.0/2, wr.cpuLoad)
 	colMemoryLoad := ui.NewCol(1.0/2, wr.memoryLoad)
 
+	colNetworkBytesInEpoch := ui.NewCol(1.0/2, wr.networkBytesInEpoch)
+	colP2PBandwidth := ui.NewCol(1.0/2, wr.p2pBandwidth)
+
 	gridRight := ui.NewGrid()
 	gridRight.Set(
 		ui.NewRow(10.0/22, wr.blockInfo),
 		ui.NewRow(3.0/22, colCpuLoad, colMemoryLoad),
 		ui.NewRow(3.0/22, wr.epochLoad),
-		ui.NewRow(3.0/22, wr.networkBytesInEpoch),
+		ui.NewRow(3.0/22, colNetworkBytesInEpoch, colP2PBandwidth),
 		ui.NewRow(3.0/22, colNetworkSent, colNetworkRecv),
 	)
 
@@ -404,6 +409,16 @@ func (wr *WidgetsRender) prepareLoads() {
 	wr.networkBytesInEpoch.Percent = 0
 	str = fmt.Sprintf("sent: %s / received: %s", core.ConvertBytes(totalBytesSentInEpoch), core.ConvertBytes(totalBytesReceivedInEpoch))
 	wr.networkBytesInEpoch.Label = fitStringToWidth(str, wr.networkBytesInEpoch.Size().X)
+
+	p2pInboundBps := wr.presenter.GetP2PInboundBps()
+	p2pOutboundBps := wr.presenter.GetP2POutboundBps()
+	p2pOutboundDroppedBytes := wr.presenter.GetP2POutboundDroppedBytes()
+
+	wr.p2pBandwidth.Title = "P2P - bandwidth:"
+	wr.p2pBandwidth.Percent = 0
+	str = fmt.Sprintf("in: %s/s / out: %s/s / dropped: %s",
+		core.ConvertBytes(p2pInboundBps), core.ConvertBytes(p2pOutboundBps), core.ConvertBytes(p2pOutboundDroppedBytes))
+	wr.p2pBandwidth.Label = fitStringToWidth(str, wr.p2pBandwidth.Size().X)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,39 @@
+package builder
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key was provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilTxSpec signals that a nil transaction spec was provided
+var ErrNilTxSpec = errors.New("nil transaction spec")
+
+// ErrUnknownTxKind signals that an unknown transaction kind was provided
+var ErrUnknownTxKind = errors.New("unknown transaction kind")
+
+// ErrMissingField signals that a field required by the transaction kind was not provided
+var ErrMissingField = errors.New("missing field")
+
+// ErrInvalidValue signals that an invalid numeric value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrValueNotAllowed signals that a non zero value was provided for a transaction kind that does not transfer EGLD
+var ErrValueNotAllowed = errors.New("value not allowed for this transaction kind")
+
+// ErrInvalidArgument signals that an invalid smart contract call argument was provided
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrNoValidatorKeys signals that no validator keys were provided for a stake transaction
+var ErrNoValidatorKeys = errors.New("no validator keys")
@@ -0,0 +1,184 @@
+package builder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const (
+	// DefaultGasPrice is the gas price used when the spec does not provide one
+	DefaultGasPrice = uint64(1000000000)
+	// DefaultVersion is the transaction version used when the spec does not provide one
+	DefaultVersion = uint32(1)
+
+	minTxVersion = uint32(1)
+)
+
+// ArgsTxBuilder holds the arguments needed to create a transaction builder
+type ArgsTxBuilder struct {
+	AddressPubkeyConverter core.PubkeyConverter
+	SigningMarshalizer     marshal.Marshalizer
+	TxSignHasher           hashing.Hasher
+	TxSingleSigner         crypto.SingleSigner
+	BlsSingleSigner        crypto.SingleSigner
+	MinGasLimit            uint64
+	GasPerDataByte         uint64
+}
+
+type txBuilder struct {
+	addressPubkeyConverter core.PubkeyConverter
+	signingMarshalizer     marshal.Marshalizer
+	txSignHasher           hashing.Hasher
+	txSingleSigner         crypto.SingleSigner
+	blsSingleSigner        crypto.SingleSigner
+	txVersionChecker       process.TxVersionCheckerHandler
+	minGasLimit            uint64
+	gasPerDataByte         uint64
+}
+
+// txContent holds the transaction fields that depend on the transaction kind
+type txContent struct {
+	receiver []byte
+	value    *big.Int
+	data     []byte
+}
+
+// NewTxBuilder creates a component that builds and signs transactions without any connection to the network
+func NewTxBuilder(args ArgsTxBuilder) (*txBuilder, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.SigningMarshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.TxSingleSigner) {
+		return nil, fmt.Errorf("%w for the transactions", ErrNilSingleSigner)
+	}
+	if check.IfNil(args.BlsSingleSigner) {
+		return nil, fmt.Errorf("%w for the validator keys", ErrNilSingleSigner)
+	}
+
+	return &txBuilder{
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		signingMarshalizer:     args.SigningMarshalizer,
+		txSignHasher:           args.TxSignHasher,
+		txSingleSigner:         args.TxSingleSigner,
+		blsSingleSigner:        args.BlsSingleSigner,
+		txVersionChecker:       versioning.NewTxVersionChecker(minTxVersion),
+		minGasLimit:            args.MinGasLimit,
+		gasPerDataByte:         args.GasPerDataByte,
+	}, nil
+}
+
+// Build creates the transaction described by the spec, sent and signed by the provided key, and returns it in the
+// format accepted by the /transaction/send endpoint. The validator keys are only used by the stake transactions
+func (tb *txBuilder) Build(
+	spec *TxSpec,
+	senderKey crypto.PrivateKey,
+	validatorKeys []crypto.PrivateKey,
+) (*transaction.FrontendTransaction, error) {
+	if spec == nil {
+		return nil, ErrNilTxSpec
+	}
+	if check.IfNil(senderKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if len(spec.ChainID) == 0 {
+		return nil, fmt.Errorf("%w chainID", ErrMissingField)
+	}
+
+	sender, err := senderKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := tb.createContent(spec, sender, validatorKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := spec.GasLimit
+	if gasLimit == 0 {
+		if spec.Kind != MoveBalanceKind {
+			return nil, fmt.Errorf("%w gasLimit, required for the %s transactions", ErrMissingField, spec.Kind)
+		}
+		gasLimit = tb.minGasLimit + tb.gasPerDataByte*uint64(len(content.data))
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    spec.Nonce,
+		Value:    content.value,
+		RcvAddr:  content.receiver,
+		SndAddr:  sender,
+		GasPrice: valueOrDefault(spec.GasPrice, DefaultGasPrice),
+		GasLimit: gasLimit,
+		Data:     content.data,
+		ChainID:  []byte(spec.ChainID),
+		Version:  uint32(valueOrDefault(uint64(spec.Version), uint64(DefaultVersion))),
+		Options:  spec.Options,
+	}
+	err = tb.txVersionChecker.CheckTxVersion(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Signature, err = tb.sign(tx, senderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Nonce:     tx.Nonce,
+		Value:     tx.Value.String(),
+		Receiver:  tb.addressPubkeyConverter.Encode(tx.RcvAddr),
+		Sender:    tb.addressPubkeyConverter.Encode(tx.SndAddr),
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Signature: hex.EncodeToString(tx.Signature),
+		ChainID:   string(tx.ChainID),
+		Version:   tx.Version,
+		Options:   tx.Options,
+	}, nil
+}
+
+// sign signs the transaction the same way the node verifies it: the marshalized signing data or, when the
+// transaction options require it, its hash
+func (tb *txBuilder) sign(tx *transaction.Transaction, senderKey crypto.PrivateKey) ([]byte, error) {
+	dataToSign, err := tx.GetDataForSigning(tb.addressPubkeyConverter, tb.signingMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if tb.txVersionChecker.IsSignedWithHash(tx) {
+		dataToSign = tb.txSignHasher.Compute(string(dataToSign))
+	}
+
+	return tb.txSingleSigner.Sign(senderKey, dataToSign)
+}
+
+func valueOrDefault(value uint64, defaultValue uint64) uint64 {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tb *txBuilder) IsInterfaceNil() bool {
+	return tb == nil
+}
@@ -0,0 +1,433 @@
+package builder
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alicePrivateKeyHex = "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9"
+	aliceAddress       = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	aliceHex           = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	bobAddress         = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+	bobHex             = "8049d639e5a6980d1cd2392abcce41029cda74a1563523a202f09641cc2618f8"
+	chainID            = "local-testnet"
+)
+
+func createMockArgsTxBuilder() ArgsTxBuilder {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, &testscommon.LoggerStub{})
+
+	return ArgsTxBuilder{
+		AddressPubkeyConverter: converter,
+		SigningMarshalizer:     &marshal.JsonMarshalizer{},
+		TxSignHasher:           keccak.NewKeccak(),
+		TxSingleSigner:         &singlesig.Ed25519Signer{},
+		BlsSingleSigner:        &mclSig.BlsSingleSigner{},
+		MinGasLimit:            50000,
+		GasPerDataByte:         1500,
+	}
+}
+
+func createAliceKey(t *testing.T) crypto.PrivateKey {
+	skBytes, err := hex.DecodeString(alicePrivateKeyHex)
+	require.Nil(t, err)
+
+	sk, err := signing.NewKeyGenerator(ed25519.NewEd25519()).PrivateKeyFromByteArray(skBytes)
+	require.Nil(t, err)
+
+	return sk
+}
+
+func createBuilder(t *testing.T) *txBuilder {
+	tb, err := NewTxBuilder(createMockArgsTxBuilder())
+	require.Nil(t, err)
+
+	return tb
+}
+
+func dataArgs(tx *transaction.FrontendTransaction) []string {
+	return strings.Split(string(tx.Data), argsSeparator)
+}
+
+func TestNewTxBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxBuilder()
+		args.AddressPubkeyConverter = nil
+		tb, err := NewTxBuilder(args)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(tb))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxBuilder()
+		args.SigningMarshalizer = nil
+		tb, err := NewTxBuilder(args)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(tb))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxBuilder()
+		args.TxSignHasher = nil
+		tb, err := NewTxBuilder(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(tb))
+	})
+	t.Run("nil tx single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxBuilder()
+		args.TxSingleSigner = nil
+		tb, err := NewTxBuilder(args)
+		assert.True(t, errors.Is(err, ErrNilSingleSigner))
+		assert.True(t, check.IfNil(tb))
+	})
+	t.Run("nil bls single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxBuilder()
+		args.BlsSingleSigner = nil
+		tb, err := NewTxBuilder(args)
+		assert.True(t, errors.Is(err, ErrNilSingleSigner))
+		assert.True(t, check.IfNil(tb))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tb, err := NewTxBuilder(createMockArgsTxBuilder())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tb))
+	})
+}
+
+func TestTxBuilder_BuildInvalidInputsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tb := createBuilder(t)
+	sk := createAliceKey(t)
+
+	tx, err := tb.Build(nil, sk, nil)
+	assert.Equal(t, ErrNilTxSpec, err)
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: MoveBalanceKind, Receiver: bobAddress, ChainID: chainID}, nil, nil)
+	assert.Equal(t, ErrNilPrivateKey, err)
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: MoveBalanceKind, Receiver: bobAddress}, sk, nil)
+	assert.True(t, errors.Is(err, ErrMissingField))
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: "transfer", Receiver: bobAddress, ChainID: chainID}, sk, nil)
+	assert.True(t, errors.Is(err, ErrUnknownTxKind))
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: MoveBalanceKind, Receiver: bobAddress, ChainID: chainID, Value: "-1"}, sk, nil)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: MoveBalanceKind, Receiver: "erd1invalid", ChainID: chainID}, sk, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: MoveBalanceKind, Receiver: bobAddress, ChainID: chainID, Options: 1}, sk, nil)
+	assert.Equal(t, core.ErrInvalidTransactionVersion, err)
+	assert.Nil(t, tx)
+
+	tx, err = tb.Build(&TxSpec{Kind: SCCallKind, Receiver: bobAddress, ChainID: chainID, Function: "add"}, sk, nil)
+	assert.True(t, errors.Is(err, ErrMissingField))
+	assert.Nil(t, tx)
+}
+
+func TestTxBuilder_BuildMoveBalance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults should produce the known signature", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &TxSpec{
+			Kind:     MoveBalanceKind,
+			Nonce:    89,
+			Receiver: bobAddress,
+			ChainID:  chainID,
+		}
+		tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+		require.Nil(t, err)
+
+		expectedTx := &transaction.FrontendTransaction{
+			Nonce:     89,
+			Value:     "0",
+			Receiver:  bobAddress,
+			Sender:    aliceAddress,
+			GasPrice:  1000000000,
+			GasLimit:  50000,
+			Signature: "b56769014f2bdc5cf9fc4a05356807d71fcf8775c819b0f1b0964625b679c918ffa64862313bfef86f99b38cb84fcdb16fa33ad6eb565276616723405cd8f109",
+			ChainID:   chainID,
+			Version:   1,
+		}
+		assert.Equal(t, expectedTx, tx)
+	})
+	t.Run("with data and value should produce the known signature", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &TxSpec{
+			Kind:     MoveBalanceKind,
+			Nonce:    91,
+			Receiver: bobAddress,
+			Value:    "10000000000000000000",
+			GasLimit: 100000,
+			Data:     "for the book",
+			ChainID:  chainID,
+		}
+		tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, "10000000000000000000", tx.Value)
+		assert.Equal(t, []byte("for the book"), tx.Data)
+		assert.Equal(t, "9074789e0b4f9b2ac24b1fd351a4dd840afcfeb427b0f93e2a2d429c28c65ee9f4c288ca4dbde79de0e5bcf8c1a5d26e1b1c86203faea923e0edefb0b5099b0c", tx.Signature)
+	})
+	t.Run("default gas limit should include the data cost", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &TxSpec{
+			Kind:     MoveBalanceKind,
+			Receiver: bobAddress,
+			Data:     "hello",
+			ChainID:  chainID,
+		}
+		tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(50000+5*1500), tx.GasLimit)
+	})
+	t.Run("signed with hash should sign the keccak hash", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &TxSpec{
+			Kind:     MoveBalanceKind,
+			Nonce:    7,
+			Receiver: bobAddress,
+			ChainID:  chainID,
+			Version:  2,
+			Options:  versioning.MaskSignedWithHash,
+		}
+		sk := createAliceKey(t)
+		tb := createBuilder(t)
+		tx, err := tb.Build(spec, sk, nil)
+		require.Nil(t, err)
+
+		aliceBytes, _ := hex.DecodeString(aliceHex)
+		bobBytes, _ := hex.DecodeString(bobHex)
+		signedTx := &transaction.Transaction{
+			Nonce:    7,
+			Value:    big.NewInt(0),
+			RcvAddr:  bobBytes,
+			SndAddr:  aliceBytes,
+			GasPrice: DefaultGasPrice,
+			GasLimit: 50000,
+			ChainID:  []byte(chainID),
+			Version:  2,
+			Options:  versioning.MaskSignedWithHash,
+		}
+		dataToSign, _ := signedTx.GetDataForSigning(tb.addressPubkeyConverter, tb.signingMarshalizer)
+		signature, _ := hex.DecodeString(tx.Signature)
+
+		err = tb.txSingleSigner.Verify(sk.GeneratePublic(), keccak.NewKeccak().Compute(string(dataToSign)), signature)
+		assert.Nil(t, err)
+		err = tb.txSingleSigner.Verify(sk.GeneratePublic(), dataToSign, signature)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTxBuilder_BuildESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	spec := &TxSpec{
+		Kind:     ESDTTransferKind,
+		Receiver: bobAddress,
+		GasLimit: 500000,
+		ChainID:  chainID,
+		Token:    "TKN-1a2b3c",
+		Amount:   "1000",
+	}
+	tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, bobAddress, tx.Receiver)
+	assert.Equal(t, "0", tx.Value)
+	assert.Equal(t, "ESDTTransfer@544b4e2d316132623363@03e8", string(tx.Data))
+
+	spec.Value = "1"
+	_, err = createBuilder(t).Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrValueNotAllowed))
+
+	spec.Value = ""
+	spec.Amount = "0"
+	_, err = createBuilder(t).Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestTxBuilder_BuildNFTTransfer(t *testing.T) {
+	t.Parallel()
+
+	spec := &TxSpec{
+		Kind:       NFTTransferKind,
+		Receiver:   bobAddress,
+		GasLimit:   1000000,
+		ChainID:    chainID,
+		Token:      "NFT-1a2b3c",
+		TokenNonce: 10,
+		Amount:     "1",
+	}
+	tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, aliceAddress, tx.Receiver)
+	assert.Equal(t, "ESDTNFTTransfer@4e46542d316132623363@0a@01@"+bobHex, string(tx.Data))
+
+	spec.TokenNonce = 0
+	_, err = createBuilder(t).Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrMissingField))
+}
+
+func TestTxBuilder_BuildSCCall(t *testing.T) {
+	t.Parallel()
+
+	spec := &TxSpec{
+		Kind:      SCCallKind,
+		Receiver:  bobAddress,
+		Value:     "5",
+		GasLimit:  5000000,
+		ChainID:   chainID,
+		Function:  "add",
+		Arguments: []string{"07", "0a0b"},
+	}
+	tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, "5", tx.Value)
+	assert.Equal(t, "add@07@0a0b", string(tx.Data))
+
+	spec.Arguments = []string{"not hex"}
+	_, err = createBuilder(t).Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestTxBuilder_BuildStake(t *testing.T) {
+	t.Parallel()
+
+	blsKeyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk1, pk1 := blsKeyGen.GeneratePair()
+	sk2, pk2 := blsKeyGen.GeneratePair()
+
+	spec := &TxSpec{
+		Kind:          StakeKind,
+		Value:         "5000000000000000000000",
+		GasLimit:      12000000,
+		ChainID:       chainID,
+		RewardAddress: bobAddress,
+	}
+	tb := createBuilder(t)
+	_, err := tb.Build(spec, createAliceKey(t), nil)
+	assert.Equal(t, ErrNoValidatorKeys, err)
+
+	tx, err := tb.Build(spec, createAliceKey(t), []crypto.PrivateKey{sk1, sk2})
+	require.Nil(t, err)
+
+	assert.Equal(t, tb.addressPubkeyConverter.Encode(vm.ValidatorSCAddress), tx.Receiver)
+	assert.Equal(t, "5000000000000000000000", tx.Value)
+
+	args := dataArgs(tx)
+	require.Len(t, args, 7)
+	assert.Equal(t, "stake", args[0])
+	assert.Equal(t, "02", args[1])
+	assert.Equal(t, bobHex, args[6])
+
+	sender, _ := hex.DecodeString(aliceHex)
+	for i, pk := range []crypto.PublicKey{pk1, pk2} {
+		pkBytes, _ := pk.ToByteArray()
+		assert.Equal(t, hex.EncodeToString(pkBytes), args[2+2*i])
+
+		signature, _ := hex.DecodeString(args[3+2*i])
+		assert.Nil(t, tb.blsSingleSigner.Verify(pk, sender, signature))
+	}
+}
+
+func TestTxBuilder_BuildDelegate(t *testing.T) {
+	t.Parallel()
+
+	spec := &TxSpec{
+		Kind:     DelegateKind,
+		Receiver: bobAddress,
+		GasLimit: 12000000,
+		ChainID:  chainID,
+	}
+	_, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrMissingField))
+
+	spec.Value = "1000000000000000000"
+	tx, err := createBuilder(t).Build(spec, createAliceKey(t), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, bobAddress, tx.Receiver)
+	assert.Equal(t, "delegate", string(tx.Data))
+}
+
+func TestTxBuilder_BuildIssue(t *testing.T) {
+	t.Parallel()
+
+	spec := &TxSpec{
+		Kind:          IssueKind,
+		Value:         "5000000000000000000",
+		GasLimit:      60000000,
+		ChainID:       chainID,
+		TokenName:     "Token",
+		TokenTicker:   "TKN",
+		InitialSupply: "1000000",
+		NumDecimals:   6,
+		Properties: map[string]bool{
+			"canMint":   true,
+			"canFreeze": false,
+		},
+	}
+	tb := createBuilder(t)
+	tx, err := tb.Build(spec, createAliceKey(t), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, tb.addressPubkeyConverter.Encode(vm.ESDTSCAddress), tx.Receiver)
+	expectedData := "issue@546f6b656e@544b4e@0f4240@06" +
+		"@63616e467265657a65@66616c7365" +
+		"@63616e4d696e74@74727565"
+	assert.Equal(t, expectedData, string(tx.Data))
+
+	spec.TokenTicker = ""
+	_, err = tb.Build(spec, createAliceKey(t), nil)
+	assert.True(t, errors.Is(err, ErrMissingField))
+}
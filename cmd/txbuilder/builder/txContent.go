@@ -0,0 +1,321 @@
+package builder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const (
+	argsSeparator    = "@"
+	stakeFunction    = "stake"
+	delegateFunction = "delegate"
+	issueFunction    = "issue"
+)
+
+func (tb *txBuilder) createContent(spec *TxSpec, sender []byte, validatorKeys []crypto.PrivateKey) (*txContent, error) {
+	value, err := parseBigInt("value", spec.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch spec.Kind {
+	case MoveBalanceKind:
+		return tb.createMoveBalanceContent(spec, value)
+	case ESDTTransferKind:
+		return tb.createESDTTransferContent(spec, value)
+	case NFTTransferKind:
+		return tb.createNFTTransferContent(spec, sender, value)
+	case SCCallKind:
+		return tb.createSCCallContent(spec, value)
+	case StakeKind:
+		return tb.createStakeContent(spec, sender, validatorKeys, value)
+	case DelegateKind:
+		return tb.createDelegateContent(spec, value)
+	case IssueKind:
+		return tb.createIssueContent(spec, value)
+	default:
+		return nil, fmt.Errorf("%w %s, known kinds: %s", ErrUnknownTxKind, spec.Kind, strings.Join(Kinds, ", "))
+	}
+}
+
+func (tb *txBuilder) createMoveBalanceContent(spec *TxSpec, value *big.Int) (*txContent, error) {
+	receiver, err := tb.decodeAddress("receiver", spec.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &txContent{
+		receiver: receiver,
+		value:    value,
+	}
+	if len(spec.Data) > 0 {
+		content.data = []byte(spec.Data)
+	}
+
+	return content, nil
+}
+
+func (tb *txBuilder) createESDTTransferContent(spec *TxSpec, value *big.Int) (*txContent, error) {
+	receiver, err := tb.decodeAddress("receiver", spec.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNoValue(spec.Kind, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.Token) == 0 {
+		return nil, fmt.Errorf("%w token", ErrMissingField)
+	}
+	amount, err := parsePositiveBigInt("amount", spec.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	data := joinArgs(
+		core.BuiltInFunctionESDTTransfer,
+		hex.EncodeToString([]byte(spec.Token)),
+		encodeBigInt(amount),
+	)
+
+	return &txContent{
+		receiver: receiver,
+		value:    value,
+		data:     []byte(data),
+	}, nil
+}
+
+// createNFTTransferContent creates the content of an NFT transfer. The built-in function is called on the sender's
+// own account, the destination being one of the arguments
+func (tb *txBuilder) createNFTTransferContent(spec *TxSpec, sender []byte, value *big.Int) (*txContent, error) {
+	destination, err := tb.decodeAddress("receiver", spec.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNoValue(spec.Kind, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.Token) == 0 {
+		return nil, fmt.Errorf("%w token", ErrMissingField)
+	}
+	if spec.TokenNonce == 0 {
+		return nil, fmt.Errorf("%w tokenNonce", ErrMissingField)
+	}
+	amount, err := parsePositiveBigInt("amount", spec.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	data := joinArgs(
+		core.BuiltInFunctionESDTNFTTransfer,
+		hex.EncodeToString([]byte(spec.Token)),
+		encodeBigInt(big.NewInt(0).SetUint64(spec.TokenNonce)),
+		encodeBigInt(amount),
+		hex.EncodeToString(destination),
+	)
+
+	return &txContent{
+		receiver: sender,
+		value:    value,
+		data:     []byte(data),
+	}, nil
+}
+
+func (tb *txBuilder) createSCCallContent(spec *TxSpec, value *big.Int) (*txContent, error) {
+	receiver, err := tb.decodeAddress("receiver", spec.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.Function) == 0 {
+		return nil, fmt.Errorf("%w function", ErrMissingField)
+	}
+
+	args := make([]string, 0, len(spec.Arguments)+1)
+	args = append(args, spec.Function)
+	for i, arg := range spec.Arguments {
+		_, err = hex.DecodeString(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%w with index %d, it should be hex encoded: %v", ErrInvalidArgument, i, err)
+		}
+
+		args = append(args, arg)
+	}
+
+	return &txContent{
+		receiver: receiver,
+		value:    value,
+		data:     []byte(joinArgs(args...)),
+	}, nil
+}
+
+// createStakeContent creates the content of a stake transaction. Each staked BLS key is followed by its signature
+// over the sender's address, as required by the validator system smart contract
+func (tb *txBuilder) createStakeContent(
+	spec *TxSpec,
+	sender []byte,
+	validatorKeys []crypto.PrivateKey,
+	value *big.Int,
+) (*txContent, error) {
+	if len(validatorKeys) == 0 {
+		return nil, ErrNoValidatorKeys
+	}
+
+	args := make([]string, 0, 2*len(validatorKeys)+3)
+	args = append(args, stakeFunction, encodeBigInt(big.NewInt(int64(len(validatorKeys)))))
+	for i, validatorKey := range validatorKeys {
+		blsKey, err := validatorKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, fmt.Errorf("%w for the validator key with index %d", err, i)
+		}
+		signature, err := tb.blsSingleSigner.Sign(validatorKey, sender)
+		if err != nil {
+			return nil, fmt.Errorf("%w while signing with the validator key with index %d", err, i)
+		}
+
+		args = append(args, hex.EncodeToString(blsKey), hex.EncodeToString(signature))
+	}
+
+	if len(spec.RewardAddress) > 0 {
+		rewardAddress, err := tb.decodeAddress("rewardAddress", spec.RewardAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, hex.EncodeToString(rewardAddress))
+	}
+
+	return &txContent{
+		receiver: vm.ValidatorSCAddress,
+		value:    value,
+		data:     []byte(joinArgs(args...)),
+	}, nil
+}
+
+func (tb *txBuilder) createDelegateContent(spec *TxSpec, value *big.Int) (*txContent, error) {
+	receiver, err := tb.decodeAddress("receiver", spec.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	if value.Sign() == 0 {
+		return nil, fmt.Errorf("%w value, required for the %s transactions", ErrMissingField, spec.Kind)
+	}
+
+	return &txContent{
+		receiver: receiver,
+		value:    value,
+		data:     []byte(delegateFunction),
+	}, nil
+}
+
+// createIssueContent creates the content of an issue transaction. The value should hold the issuing cost set in the
+// ESDT system smart contract configuration
+func (tb *txBuilder) createIssueContent(spec *TxSpec, value *big.Int) (*txContent, error) {
+	if len(spec.TokenName) == 0 {
+		return nil, fmt.Errorf("%w tokenName", ErrMissingField)
+	}
+	if len(spec.TokenTicker) == 0 {
+		return nil, fmt.Errorf("%w tokenTicker", ErrMissingField)
+	}
+	if len(spec.InitialSupply) == 0 {
+		return nil, fmt.Errorf("%w initialSupply", ErrMissingField)
+	}
+	initialSupply, err := parseBigInt("initialSupply", spec.InitialSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{
+		issueFunction,
+		hex.EncodeToString([]byte(spec.TokenName)),
+		hex.EncodeToString([]byte(spec.TokenTicker)),
+		encodeBigInt(initialSupply),
+		encodeBigInt(big.NewInt(int64(spec.NumDecimals))),
+	}
+
+	properties := make([]string, 0, len(spec.Properties))
+	for property := range spec.Properties {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+	for _, property := range properties {
+		args = append(args,
+			hex.EncodeToString([]byte(property)),
+			hex.EncodeToString([]byte(strconv.FormatBool(spec.Properties[property]))),
+		)
+	}
+
+	return &txContent{
+		receiver: vm.ESDTSCAddress,
+		value:    value,
+		data:     []byte(joinArgs(args...)),
+	}, nil
+}
+
+func (tb *txBuilder) decodeAddress(field string, address string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrMissingField, field)
+	}
+
+	decoded, err := tb.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s %s", err, field, address)
+	}
+
+	return decoded, nil
+}
+
+func checkNoValue(kind string, value *big.Int) error {
+	if value.Sign() != 0 {
+		return fmt.Errorf("%w: %s", ErrValueNotAllowed, kind)
+	}
+
+	return nil
+}
+
+// parseBigInt parses a base 10 non-negative number, an empty string meaning zero
+func parseBigInt(field string, value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || result.Sign() < 0 {
+		return nil, fmt.Errorf("%w for %s: %s", ErrInvalidValue, field, value)
+	}
+
+	return result, nil
+}
+
+func parsePositiveBigInt(field string, value string) (*big.Int, error) {
+	result, err := parseBigInt(field, value)
+	if err != nil {
+		return nil, err
+	}
+	if result.Sign() == 0 {
+		return nil, fmt.Errorf("%w for %s: it should be greater than 0", ErrInvalidValue, field)
+	}
+
+	return result, nil
+}
+
+// encodeBigInt returns the hex encoding of the number's big endian bytes, as expected by the smart contract arguments
+func encodeBigInt(value *big.Int) string {
+	if value.Sign() == 0 {
+		return "00"
+	}
+
+	return hex.EncodeToString(value.Bytes())
+}
+
+func joinArgs(args ...string) string {
+	return strings.Join(args, argsSeparator)
+}
@@ -0,0 +1,67 @@
+package builder
+
+const (
+	// MoveBalanceKind is the kind of the transactions that transfer EGLD, with an optional data field
+	MoveBalanceKind = "move-balance"
+	// ESDTTransferKind is the kind of the transactions that transfer a fungible ESDT token
+	ESDTTransferKind = "esdt-transfer"
+	// NFTTransferKind is the kind of the transactions that transfer an NFT or an SFT
+	NFTTransferKind = "nft-transfer"
+	// SCCallKind is the kind of the transactions that call a smart contract function
+	SCCallKind = "sc-call"
+	// StakeKind is the kind of the transactions that stake validator keys on the validator system smart contract
+	StakeKind = "stake"
+	// DelegateKind is the kind of the transactions that delegate EGLD to a delegation contract
+	DelegateKind = "delegate"
+	// IssueKind is the kind of the transactions that issue a fungible ESDT token on the ESDT system smart contract
+	IssueKind = "issue"
+)
+
+// Kinds holds all the transaction kinds the builder is able to create
+var Kinds = []string{
+	MoveBalanceKind,
+	ESDTTransferKind,
+	NFTTransferKind,
+	SCCallKind,
+	StakeKind,
+	DelegateKind,
+	IssueKind,
+}
+
+// TxSpec describes the transaction to be built. The addresses are bech32 encoded and the amounts are base 10
+// strings. The fields that are not used by the transaction kind are ignored
+type TxSpec struct {
+	Kind     string `json:"kind"`
+	Nonce    uint64 `json:"nonce"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     string `json:"data"`
+	ChainID  string `json:"chainID"`
+	Version  uint32 `json:"version"`
+	Options  uint32 `json:"options"`
+
+	// Token is the token identifier used by the ESDT and NFT transfers
+	Token string `json:"token"`
+	// TokenNonce is the nonce of the transferred NFT or SFT
+	TokenNonce uint64 `json:"tokenNonce"`
+	// Amount is the quantity of tokens transferred by the ESDT and NFT transfers
+	Amount string `json:"amount"`
+
+	// Function is the smart contract function called by the sc-call transactions
+	Function string `json:"function"`
+	// Arguments holds the hex encoded arguments of the called function
+	Arguments []string `json:"arguments"`
+
+	// ValidatorsPemFile is the file holding the BLS keys staked by the stake transactions
+	ValidatorsPemFile string `json:"validatorsPemFile"`
+	// RewardAddress is the optional address receiving the rewards of the staked keys
+	RewardAddress string `json:"rewardAddress"`
+
+	TokenName     string          `json:"tokenName"`
+	TokenTicker   string          `json:"tokenTicker"`
+	InitialSupply string          `json:"initialSupply"`
+	NumDecimals   uint32          `json:"numDecimals"`
+	Properties    map[string]bool `json:"properties"`
+}
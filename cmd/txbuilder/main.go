@@ -0,0 +1,453 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclsig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/txbuilder/builder"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/urfave/cli"
+)
+
+const (
+	addressLength  = 32
+	minGasLimit    = 50000
+	gasPerDataByte = 1500
+)
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// specFile defines a flag for the JSON file describing the transaction
+	specFile = cli.StringFlag{
+		Name: "spec",
+		Usage: "The `filepath` for the JSON file describing the transaction. The fields are named after the flags " +
+			"below in camel case (for example gasLimit or tokenNonce), except chainID, version, arguments and " +
+			"validatorsPemFile. The file can also hold the issued token properties, as a map of booleans. The flags " +
+			"that are set override the values from the file",
+	}
+	// kind defines a flag for the kind of the built transaction
+	kind = cli.StringFlag{
+		Name:  "kind",
+		Usage: "The transaction `kind`, one of: " + strings.Join(builder.Kinds, ", "),
+	}
+	// nonce defines a flag for the nonce of the sender's account
+	nonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The current nonce of the sender's account",
+	}
+	// receiver defines a flag for the receiver of the transaction
+	receiver = cli.StringFlag{
+		Name: "receiver",
+		Usage: "The bech32 `address` of the receiver. For the NFT transfers it is the destination of the token and " +
+			"for the delegate transactions it is the delegation contract. Not used by the stake and issue " +
+			"transactions, sent to the system smart contracts",
+	}
+	// value defines a flag for the EGLD value of the transaction
+	value = cli.StringFlag{
+		Name: "value",
+		Usage: "The EGLD `value` transferred by the transaction, in the smallest denomination. The stake and issue " +
+			"transactions should hold the stake value and the issuing cost",
+	}
+	// gasPrice defines a flag for the gas price of the transaction
+	gasPrice = cli.Uint64Flag{
+		Name:  "gas-price",
+		Usage: "The gas price of the transaction",
+		Value: builder.DefaultGasPrice,
+	}
+	// gasLimit defines a flag for the gas limit of the transaction
+	gasLimit = cli.Uint64Flag{
+		Name: "gas-limit",
+		Usage: fmt.Sprintf("The gas limit of the transaction. Required for all the transaction kinds except %s, "+
+			"which defaults to %d plus %d for each data byte", builder.MoveBalanceKind, minGasLimit, gasPerDataByte),
+	}
+	// data defines a flag for the data field of the move balance transactions
+	data = cli.StringFlag{
+		Name:  "data",
+		Usage: "The data field of the " + builder.MoveBalanceKind + " transactions",
+	}
+	// chainID defines a flag for the chain ID of the network
+	chainID = cli.StringFlag{
+		Name:  "chain-id",
+		Usage: "The chain ID of the network the transaction is sent on, for example 1 for the mainnet",
+	}
+	// version defines a flag for the version of the transaction
+	version = cli.UintFlag{
+		Name:  "tx-version",
+		Usage: "The transaction version",
+		Value: uint(builder.DefaultVersion),
+	}
+	// options defines a flag for the options of the transaction
+	options = cli.UintFlag{
+		Name: "options",
+		Usage: "The transaction options. When set to 1, with a version greater than 1, the hash of the transaction " +
+			"is signed instead of the transaction itself",
+	}
+	// token defines a flag for the transferred token
+	token = cli.StringFlag{
+		Name:  "token",
+		Usage: "The `identifier` of the token transferred by the ESDT and NFT transfers",
+	}
+	// tokenNonce defines a flag for the nonce of the transferred NFT or SFT
+	tokenNonce = cli.Uint64Flag{
+		Name:  "token-nonce",
+		Usage: "The nonce of the NFT or SFT transferred by the NFT transfers",
+	}
+	// amount defines a flag for the transferred quantity of tokens
+	amount = cli.StringFlag{
+		Name:  "amount",
+		Usage: "The quantity of tokens transferred by the ESDT and NFT transfers, in the smallest denomination",
+	}
+	// function defines a flag for the called smart contract function
+	function = cli.StringFlag{
+		Name:  "function",
+		Usage: "The smart contract `function` called by the " + builder.SCCallKind + " transactions",
+	}
+	// arguments defines a flag for the arguments of the called smart contract function
+	arguments = cli.StringSliceFlag{
+		Name:  "argument",
+		Usage: "A hex encoded argument of the called smart contract function. Can be provided multiple times",
+	}
+	// validatorsPemFile defines a flag for the file holding the staked BLS keys
+	validatorsPemFile = cli.StringFlag{
+		Name:  "validators-pem",
+		Usage: "The `filepath` for the PEM or key file holding the BLS keys staked by the stake transactions",
+	}
+	// rewardAddress defines a flag for the address receiving the rewards of the staked keys
+	rewardAddress = cli.StringFlag{
+		Name:  "reward-address",
+		Usage: "The optional bech32 `address` receiving the rewards of the keys staked by the stake transactions",
+	}
+	// tokenName defines a flag for the name of the issued token
+	tokenName = cli.StringFlag{
+		Name:  "token-name",
+		Usage: "The `name` of the token issued by the issue transactions",
+	}
+	// tokenTicker defines a flag for the ticker of the issued token
+	tokenTicker = cli.StringFlag{
+		Name:  "token-ticker",
+		Usage: "The `ticker` of the token issued by the issue transactions",
+	}
+	// initialSupply defines a flag for the initial supply of the issued token
+	initialSupply = cli.StringFlag{
+		Name:  "initial-supply",
+		Usage: "The initial supply of the token issued by the issue transactions, in the smallest denomination",
+	}
+	// numDecimals defines a flag for the number of decimals of the issued token
+	numDecimals = cli.UintFlag{
+		Name:  "num-decimals",
+		Usage: "The number of decimals of the token issued by the issue transactions",
+	}
+	// keyFile defines a flag for the file holding the sender's key
+	keyFile = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "The `filepath` for the PEM or key file holding the wallet key of the sender",
+	}
+	// keyIndex defines a flag for the index of the sender's key in the PEM file
+	keyIndex = cli.IntFlag{
+		Name:  "key-index",
+		Usage: "The index of the sender's key, if the PEM file holds multiple keys",
+	}
+	// passwordFile defines a flag for the file holding the password of the key files
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The `filepath` for the file holding the password of the key files. If not set, the password is " +
+			"read from the " + common.KeystorePasswordEnvVariable + " environment variable or from the terminal",
+	}
+	// outputFile defines a flag for the file the signed transaction is written to
+	outputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `filepath` for the file the signed transaction is written to. If not set, it is printed on the console",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogWarning.String(),
+	}
+
+	log = logger.GetOrCreate("txbuilder")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Transaction Builder"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "Offline tool that builds and signs a transaction and outputs the JSON payload accepted by the " +
+		"/transaction/send endpoint"
+	app.Flags = []cli.Flag{
+		specFile,
+		kind,
+		nonce,
+		receiver,
+		value,
+		gasPrice,
+		gasLimit,
+		data,
+		chainID,
+		version,
+		options,
+		token,
+		tokenNonce,
+		amount,
+		function,
+		arguments,
+		validatorsPemFile,
+		rewardAddress,
+		tokenName,
+		tokenTicker,
+		initialSupply,
+		numDecimals,
+		keyFile,
+		keyIndex,
+		passwordFile,
+		outputFile,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = buildTransaction
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func buildTransaction(c *cli.Context) error {
+	err := logger.SetLogLevel(c.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+	if len(c.GlobalString(keyFile.Name)) == 0 {
+		return fmt.Errorf("the --%s flag should be provided", keyFile.Name)
+	}
+
+	spec, err := createTxSpec(c)
+	if err != nil {
+		return err
+	}
+
+	addressPubkeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+	if err != nil {
+		return err
+	}
+	txBuilder, err := builder.NewTxBuilder(builder.ArgsTxBuilder{
+		AddressPubkeyConverter: addressPubkeyConverter,
+		SigningMarshalizer:     &marshal.JsonMarshalizer{},
+		TxSignHasher:           keccak.NewKeccak(),
+		TxSingleSigner:         &singlesig.Ed25519Signer{},
+		BlsSingleSigner:        &mclsig.BlsSingleSigner{},
+		MinGasLimit:            minGasLimit,
+		GasPerDataByte:         gasPerDataByte,
+	})
+	if err != nil {
+		return err
+	}
+
+	senderKey, err := loadSenderKey(c.GlobalString(keyFile.Name), c.GlobalInt(keyIndex.Name), c.GlobalString(passwordFile.Name))
+	if err != nil {
+		return err
+	}
+
+	var validatorKeys []crypto.PrivateKey
+	if spec.Kind == builder.StakeKind {
+		validatorKeys, err = loadValidatorKeys(spec.ValidatorsPemFile, c.GlobalString(passwordFile.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := txBuilder.Build(spec, senderKey, validatorKeys)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(tx, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("transaction built", "kind", spec.Kind, "sender", tx.Sender, "receiver", tx.Receiver,
+		"nonce", tx.Nonce, "value", tx.Value)
+
+	outputFilename := c.GlobalString(outputFile.Name)
+	if len(outputFilename) == 0 {
+		fmt.Println(string(buff))
+		return nil
+	}
+
+	return ioutil.WriteFile(outputFilename, append(buff, '\n'), core.FileModeUserReadWrite)
+}
+
+// createTxSpec loads the spec file, if provided, and applies the flags that were set on top of it
+func createTxSpec(c *cli.Context) (*builder.TxSpec, error) {
+	spec := &builder.TxSpec{}
+	specFilename := c.GlobalString(specFile.Name)
+	if len(specFilename) > 0 {
+		err := core.LoadJsonFile(spec, specFilename)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the spec file %s", err, specFilename)
+		}
+	}
+
+	applyStringFlag(c, kind, &spec.Kind)
+	applyStringFlag(c, receiver, &spec.Receiver)
+	applyStringFlag(c, value, &spec.Value)
+	applyStringFlag(c, data, &spec.Data)
+	applyStringFlag(c, chainID, &spec.ChainID)
+	applyStringFlag(c, token, &spec.Token)
+	applyStringFlag(c, amount, &spec.Amount)
+	applyStringFlag(c, function, &spec.Function)
+	applyStringFlag(c, validatorsPemFile, &spec.ValidatorsPemFile)
+	applyStringFlag(c, rewardAddress, &spec.RewardAddress)
+	applyStringFlag(c, tokenName, &spec.TokenName)
+	applyStringFlag(c, tokenTicker, &spec.TokenTicker)
+	applyStringFlag(c, initialSupply, &spec.InitialSupply)
+
+	if c.GlobalIsSet(nonce.Name) {
+		spec.Nonce = c.GlobalUint64(nonce.Name)
+	}
+	if c.GlobalIsSet(gasPrice.Name) || spec.GasPrice == 0 {
+		spec.GasPrice = c.GlobalUint64(gasPrice.Name)
+	}
+	if c.GlobalIsSet(gasLimit.Name) {
+		spec.GasLimit = c.GlobalUint64(gasLimit.Name)
+	}
+	if c.GlobalIsSet(version.Name) || spec.Version == 0 {
+		spec.Version = uint32(c.GlobalUint(version.Name))
+	}
+	if c.GlobalIsSet(options.Name) {
+		spec.Options = uint32(c.GlobalUint(options.Name))
+	}
+	if c.GlobalIsSet(tokenNonce.Name) {
+		spec.TokenNonce = c.GlobalUint64(tokenNonce.Name)
+	}
+	if c.GlobalIsSet(numDecimals.Name) {
+		spec.NumDecimals = uint32(c.GlobalUint(numDecimals.Name))
+	}
+	if c.GlobalIsSet(arguments.Name) {
+		spec.Arguments = c.GlobalStringSlice(arguments.Name)
+	}
+
+	if len(spec.Kind) == 0 {
+		return nil, fmt.Errorf("the transaction kind should be provided either in the spec file or with the --%s flag",
+			kind.Name)
+	}
+
+	return spec, nil
+}
+
+func applyStringFlag(c *cli.Context, flag cli.StringFlag, destination *string) {
+	if c.GlobalIsSet(flag.Name) {
+		*destination = c.GlobalString(flag.Name)
+	}
+}
+
+func createKeyLoader(filename string, passwordFilename string) (keystore.PemKeyLoader, error) {
+	return keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+		PemKeyLoader: &core.KeyLoader{},
+		PasswordProvider: keystore.NewPasswordProvider(keystore.ArgsPasswordProvider{
+			FilePath:      passwordFilename,
+			EnvVariable:   common.KeystorePasswordEnvVariable,
+			PromptMessage: fmt.Sprintf("password for %s: ", filename),
+		}),
+	})
+}
+
+func loadSenderKey(filename string, index int, passwordFilename string) (crypto.PrivateKey, error) {
+	keyLoader, err := createKeyLoader(filename, passwordFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedSk, _, err := keyLoader.LoadKey(filename, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePrivateKey(signing.NewKeyGenerator(ed25519.NewEd25519()), encodedSk)
+}
+
+func loadValidatorKeys(filename string, passwordFilename string) ([]crypto.PrivateKey, error) {
+	if len(filename) == 0 {
+		return nil, fmt.Errorf("the validators PEM file should be provided for the %s transactions, either in the "+
+			"spec file or with the --%s flag", builder.StakeKind, validatorsPemFile.Name)
+	}
+
+	keyLoader, err := createKeyLoader(filename, passwordFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	keys := make([]crypto.PrivateKey, 0)
+	for index := 0; ; index++ {
+		encodedSk, _, errLoad := keyLoader.LoadKey(filename, index)
+		if index > 0 && errors.Is(errLoad, core.ErrInvalidIndex) {
+			break
+		}
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		sk, errDecode := decodePrivateKey(keyGen, encodedSk)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for the validator key with index %d", errDecode, index)
+		}
+
+		keys = append(keys, sk)
+	}
+
+	return keys, nil
+}
+
+func decodePrivateKey(keyGen crypto.KeyGenerator, encodedSk []byte) (crypto.PrivateKey, error) {
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, err
+	}
+
+	return keyGen.PrivateKeyFromByteArray(skBytes)
+}
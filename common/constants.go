@@ -640,6 +640,35 @@ const MetricP2PFullHistoryObservers = "erd_p2p_full_history_observers"
 // MetricP2PUnknownPeers is the metric that outputs the unknown-shard connected peers
 const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 
+// MetricP2PConnectionPolicy is the metric that outputs a summary of the static connection policy rules
+const MetricP2PConnectionPolicy = "erd_p2p_connection_policy"
+
+// MetricP2PNumDeniedConnections is the metric that outputs the number of connections denied by the connection policy
+const MetricP2PNumDeniedConnections = "erd_p2p_num_denied_connections"
+
+// MetricP2PCompressionBytesSaved is the metric that outputs the number of bytes saved by the messages compression, on
+// both the sent and the received messages
+const MetricP2PCompressionBytesSaved = "erd_p2p_compression_bytes_saved"
+
+// MetricP2PCompressionBytesSavedPerTopic is the metric that outputs the number of bytes saved by the messages
+// compression on each topic
+const MetricP2PCompressionBytesSavedPerTopic = "erd_p2p_compression_bytes_saved_per_topic"
+
+// MetricP2PInboundBytesPerSec is the metric that outputs the inbound traffic rate, computed on the accounting window
+const MetricP2PInboundBytesPerSec = "erd_p2p_inbound_bytes_per_sec"
+
+// MetricP2POutboundBytesPerSec is the metric that outputs the outbound traffic rate, computed on the accounting window
+const MetricP2POutboundBytesPerSec = "erd_p2p_outbound_bytes_per_sec"
+
+// MetricP2POutboundDroppedBytes is the metric that outputs the number of outbound bytes dropped by the traffic shaping
+const MetricP2POutboundDroppedBytes = "erd_p2p_outbound_dropped_bytes"
+
+// MetricP2PBandwidthPerTopic is the metric that outputs the inbound and the outbound traffic rates of each topic
+const MetricP2PBandwidthPerTopic = "erd_p2p_bandwidth_per_topic"
+
+// MetricP2PBandwidthPerPeer is the metric that outputs the inbound and the outbound traffic rates of the busiest peers
+const MetricP2PBandwidthPerPeer = "erd_p2p_bandwidth_per_peer"
+
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
@@ -823,3 +852,24 @@ const (
 	// ApiOutputFormatProto outport format returns the bytes of the proto object
 	ApiOutputFormatProto ApiOutputFormat = 1
 )
+
+// SigningKind represents the kind of message a hosted BLS key is asked to sign
+type SigningKind string
+
+const (
+	// BlockHeaderSigning is the signature of a proposed block header, issued by the leader
+	BlockHeaderSigning SigningKind = "blockHeader"
+
+	// SignatureShareSigning is the consensus signature share over the proposed block header hash
+	SignatureShareSigning SigningKind = "signatureShare"
+
+	// RandSeedSigning is the signature over the previous random seed, issued by the leader
+	RandSeedSigning SigningKind = "randSeed"
+
+	// PeerSignatureSigning is the signature over the p2p peer ID, attached to consensus and heartbeat messages
+	PeerSignatureSigning SigningKind = "peerSignature"
+)
+
+// KeystorePasswordEnvVariable is the environment variable the node reads the validator key file password from, if
+// no password file is provided
+const KeystorePasswordEnvVariable = "ELROND_KEYSTORE_PASSWORD"
@@ -13,3 +13,378 @@ type TransactionsPoolAPIResponse struct {
 	SmartContractResults []string `json:"smartContractResults"`
 	Rewards              []string `json:"rewards"`
 }
+
+// AccountTransactionsQueryOptions holds the filtering and pagination options used when fetching the transactions of an account
+type AccountTransactionsQueryOptions struct {
+	Page          uint32
+	Size          uint32
+	FromNonce     uint64
+	ToNonce       uint64
+	FromTimestamp uint64
+	ToTimestamp   uint64
+}
+
+// AccountTransactionAPIResponse is a struct that holds the data of an indexed account transaction, as returned by an API call
+type AccountTransactionAPIResponse struct {
+	Hash       string   `json:"hash"`
+	Type       string   `json:"type"`
+	Roles      []string `json:"roles"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Round      uint64   `json:"round"`
+	Epoch      uint32   `json:"epoch"`
+	Timestamp  uint64   `json:"timestamp"`
+}
+
+// AccountTransactionsAPIResponse is a struct that holds the data to be returned when getting the transactions of an account from an API call
+type AccountTransactionsAPIResponse struct {
+	Transactions    []*AccountTransactionAPIResponse `json:"transactions"`
+	NumTransactions uint64                           `json:"numTransactions"`
+}
+
+// ESDTSupplyAPIResponse is a struct that holds the supply details of a token (or of a token's nonce), as returned by an API call
+type ESDTSupplyAPIResponse struct {
+	Supply               string `json:"supply"`
+	Minted               string `json:"minted"`
+	Burned               string `json:"burned"`
+	Wiped                string `json:"wiped"`
+	NumHolders           uint64 `json:"numHolders"`
+	LastUpdateBlockNonce uint64 `json:"lastUpdateBlockNonce"`
+}
+
+// ESDTNonceSupplyAPIResponse is a struct that holds the supply details of a NFT or SFT nonce, as returned by an API call
+type ESDTNonceSupplyAPIResponse struct {
+	Nonce uint64 `json:"nonce"`
+	ESDTSupplyAPIResponse
+}
+
+// ESDTNoncesSuppliesAPIResponse is a struct that holds the data to be returned when getting the supplies of a collection's nonces from an API call
+type ESDTNoncesSuppliesAPIResponse struct {
+	Nonces    []*ESDTNonceSupplyAPIResponse `json:"nonces"`
+	NumNonces uint64                        `json:"numNonces"`
+}
+
+// TokenHolderAPIResponse is a struct that holds the balance of a token holder, as returned by an API call
+type TokenHolderAPIResponse struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// TokenHoldersAPIResponse is a struct that holds the data to be returned when getting the holders of a token from an API call
+type TokenHoldersAPIResponse struct {
+	Token    string                    `json:"token"`
+	Nonce    uint64                    `json:"nonce"`
+	RootHash string                    `json:"rootHash"`
+	Holders  []*TokenHolderAPIResponse `json:"holders"`
+}
+
+// GovernanceVoteAPIResponse is a struct that holds the details of a vote cast on a governance proposal, as returned by
+// an API call
+type GovernanceVoteAPIResponse struct {
+	Value       string `json:"value"`
+	Power       string `json:"power"`
+	Balance     string `json:"balance"`
+	DelegatedTo string `json:"delegatedTo,omitempty"`
+}
+
+// GovernanceVoterAPIResponse is a struct that holds the votes cast by an address on a governance proposal, as returned
+// by an API call
+type GovernanceVoterAPIResponse struct {
+	Address   string                       `json:"address"`
+	UsedPower string                       `json:"usedPower"`
+	TotalYes  string                       `json:"totalYes"`
+	TotalNo   string                       `json:"totalNo"`
+	TotalVeto string                       `json:"totalVeto"`
+	Votes     []*GovernanceVoteAPIResponse `json:"votes"`
+}
+
+// GovernanceProposalAPIResponse is a struct that holds the data to be returned when getting a governance proposal
+// from an API call
+type GovernanceProposalAPIResponse struct {
+	Reference       string                        `json:"reference"`
+	CommitHash      string                        `json:"commitHash"`
+	Issuer          string                        `json:"issuer"`
+	StartVoteNonce  uint64                        `json:"startVoteNonce"`
+	EndVoteNonce    uint64                        `json:"endVoteNonce"`
+	RemainingRounds uint64                        `json:"remainingRounds"`
+	Yes             string                        `json:"yes"`
+	No              string                        `json:"no"`
+	Veto            string                        `json:"veto"`
+	TotalVotes      string                        `json:"totalVotes"`
+	QuorumReached   bool                          `json:"quorumReached"`
+	Passed          bool                          `json:"passed"`
+	Closed          bool                          `json:"closed"`
+	Voters          []*GovernanceVoterAPIResponse `json:"voters"`
+}
+
+// DelegationConfigAPIResponse is a struct that holds the configuration of a delegation contract, as returned by an API call
+type DelegationConfigAPIResponse struct {
+	MaxDelegationCap            string `json:"maxDelegationCap"`
+	InitialOwnerFunds           string `json:"initialOwnerFunds"`
+	AutomaticActivation         bool   `json:"automaticActivation"`
+	ChangeableServiceFee        bool   `json:"changeableServiceFee"`
+	CheckCapOnReDelegateRewards bool   `json:"checkCapOnReDelegateRewards"`
+	CreatedNonce                uint64 `json:"createdNonce"`
+	UnBondPeriodInEpochs        uint32 `json:"unBondPeriodInEpochs"`
+}
+
+// DelegationServiceFeeAPIResponse is a struct that holds the service fee a delegation contract applied starting with
+// an epoch, as returned by an API call
+type DelegationServiceFeeAPIResponse struct {
+	Epoch      uint32 `json:"epoch"`
+	ServiceFee uint64 `json:"serviceFee"`
+}
+
+// DelegationNodeAPIResponse is a struct that holds the state of a node of a delegation contract, as returned by an API call
+type DelegationNodeAPIResponse struct {
+	BLSKey string `json:"blsKey"`
+	Status string `json:"status"`
+}
+
+// DelegationDelegatorAPIResponse is a struct that holds the funds and the rewards of a delegator, as returned by an API call
+type DelegationDelegatorAPIResponse struct {
+	Address               string `json:"address"`
+	ActiveStake           string `json:"activeStake"`
+	UnStaked              string `json:"unStaked"`
+	UnBondable            string `json:"unBondable"`
+	ClaimableRewards      string `json:"claimableRewards"`
+	TotalCumulatedRewards string `json:"totalCumulatedRewards"`
+}
+
+// DelegationRewardAPIResponse is a struct that holds the rewards a delegation contract received in an epoch, as
+// returned by an API call
+type DelegationRewardAPIResponse struct {
+	Epoch               uint32 `json:"epoch"`
+	RewardsToDistribute string `json:"rewardsToDistribute"`
+	TotalActive         string `json:"totalActive"`
+	ServiceFee          uint64 `json:"serviceFee"`
+}
+
+// DelegationSnapshotAPIResponse is a struct that holds the data to be returned when getting the snapshot of a
+// delegation contract from an API call
+type DelegationSnapshotAPIResponse struct {
+	Contract          string                             `json:"contract"`
+	RootHash          string                             `json:"rootHash"`
+	Epoch             uint32                             `json:"epoch"`
+	Owner             string                             `json:"owner"`
+	Name              string                             `json:"name"`
+	Website           string                             `json:"website"`
+	Identifier        string                             `json:"identifier"`
+	Config            *DelegationConfigAPIResponse       `json:"config"`
+	ServiceFee        uint64                             `json:"serviceFee"`
+	ServiceFeeHistory []*DelegationServiceFeeAPIResponse `json:"serviceFeeHistory"`
+	Nodes             []*DelegationNodeAPIResponse       `json:"nodes"`
+	NumUsers          uint64                             `json:"numUsers"`
+	TotalActiveStake  string                             `json:"totalActiveStake"`
+	TotalUnStaked     string                             `json:"totalUnStaked"`
+	TotalUnBondable   string                             `json:"totalUnBondable"`
+	Delegators        []*DelegationDelegatorAPIResponse  `json:"delegators"`
+	RewardsHistory    []*DelegationRewardAPIResponse     `json:"rewardsHistory"`
+}
+
+// NodeRewardsBreakdownAPIResponse is a struct that holds the intermediate values used when computing the end of epoch
+// rewards of an eligible node, as returned by an API call
+type NodeRewardsBreakdownAPIResponse struct {
+	BLSKey                     string `json:"blsKey"`
+	ShardID                    uint32 `json:"shardID"`
+	RewardAddress              string `json:"rewardAddress"`
+	TempRating                 uint32 `json:"tempRating"`
+	LeaderSuccess              uint32 `json:"leaderSuccess"`
+	LeaderFailure              uint32 `json:"leaderFailure"`
+	ValidatorSuccess           uint32 `json:"validatorSuccess"`
+	ValidatorFailure           uint32 `json:"validatorFailure"`
+	ValidatorIgnoredSignatures uint32 `json:"validatorIgnoredSignatures"`
+	NumSelectedInSuccessBlocks uint32 `json:"numSelectedInSuccessBlocks"`
+	TopUpStake                 string `json:"topUpStake"`
+	PowerInShard               string `json:"powerInShard"`
+	BaseReward                 string `json:"baseReward"`
+	TopUpReward                string `json:"topUpReward"`
+	FullRewards                string `json:"fullRewards"`
+	AccumulatedFees            string `json:"accumulatedFees"`
+	Rewarded                   bool   `json:"rewarded"`
+}
+
+// ShardRewardsBreakdownAPIResponse is a struct that holds the per shard values used when computing the end of epoch
+// rewards, as returned by an API call
+type ShardRewardsBreakdownAPIResponse struct {
+	ShardID             uint32 `json:"shardID"`
+	NumBlocks           uint64 `json:"numBlocks"`
+	BaseRewardsPerBlock string `json:"baseRewardsPerBlock"`
+	TopUpStake          string `json:"topUpStake"`
+	TopUpRewards        string `json:"topUpRewards"`
+	TotalPower          string `json:"totalPower"`
+}
+
+// RewardAddressBreakdownAPIResponse is a struct that holds the rewards aggregated for a reward address, as returned
+// by an API call
+type RewardAddressBreakdownAPIResponse struct {
+	RewardAddress       string   `json:"rewardAddress"`
+	BLSKeys             []string `json:"blsKeys"`
+	RewardsFromProtocol string   `json:"rewardsFromProtocol"`
+	AccumulatedFees     string   `json:"accumulatedFees"`
+	TotalRewards        string   `json:"totalRewards"`
+}
+
+// RewardsBreakdownAPIResponse is a struct that holds the data to be returned when getting the breakdown of the
+// rewards distributed by the start of epoch metablock of the provided epoch from an API call
+type RewardsBreakdownAPIResponse struct {
+	Epoch                         uint32                               `json:"epoch"`
+	TotalToDistribute             string                               `json:"totalToDistribute"`
+	RewardsForBlocks              string                               `json:"rewardsForBlocks"`
+	BaseRewards                   string                               `json:"baseRewards"`
+	TopUpRewards                  string                               `json:"topUpRewards"`
+	TotalStakeEligible            string                               `json:"totalStakeEligible"`
+	TotalTopUpEligible            string                               `json:"totalTopUpEligible"`
+	ProtocolSustainabilityRewards string                               `json:"protocolSustainabilityRewards"`
+	Shards                        []*ShardRewardsBreakdownAPIResponse  `json:"shards"`
+	Nodes                         []*NodeRewardsBreakdownAPIResponse   `json:"nodes"`
+	RewardAddresses               []*RewardAddressBreakdownAPIResponse `json:"rewardAddresses"`
+}
+
+// NodesShufflingPreviewRequest holds the hypothetical changes applied on the current nodes lists before previewing
+// the nodes shuffling of the next epoch. The keys are hex encoded BLS keys and the randomness is hex encoded
+type NodesShufflingPreviewRequest struct {
+	Stake      []string `json:"stake"`
+	Unstake    []string `json:"unstake"`
+	Randomness string   `json:"randomness"`
+}
+
+// NodeShufflingPreviewAPIResponse is a struct that holds the current and the previewed allocation of a node, as
+// returned by an API call
+type NodeShufflingPreviewAPIResponse struct {
+	BLSKey          string `json:"blsKey"`
+	PreviousShardID uint32 `json:"previousShardID"`
+	PreviousList    string `json:"previousList"`
+	ShardID         uint32 `json:"shardID"`
+	List            string `json:"list"`
+}
+
+// NodesShufflingPreviewAPIResponse is a struct that holds the previewed nodes allocation of the next epoch, as
+// returned by an API call
+type NodesShufflingPreviewAPIResponse struct {
+	CurrentEpoch   uint32                             `json:"currentEpoch"`
+	Epoch          uint32                             `json:"epoch"`
+	Randomness     string                             `json:"randomness"`
+	WhatIf         bool                               `json:"whatIf"`
+	Eligible       map[uint32][]string                `json:"eligible"`
+	Waiting        map[uint32][]string                `json:"waiting"`
+	Leaving        map[uint32][]string                `json:"leaving"`
+	StillRemaining map[uint32][]string                `json:"stillRemaining"`
+	Nodes          []*NodeShufflingPreviewAPIResponse `json:"nodes"`
+}
+
+// ConsensusGroupsRequest holds the parameters of a historical consensus groups query. A nil field means that the
+// parameter was not provided
+type ConsensusGroupsRequest struct {
+	Epoch   *uint32
+	Round   *uint64
+	ShardID *uint32
+}
+
+// ConsensusGroupAPIResponse is a struct that holds the consensus group computed for a round, as returned by an API call
+type ConsensusGroupAPIResponse struct {
+	Round          uint64   `json:"round"`
+	ShardID        uint32   `json:"shardID"`
+	Epoch          uint32   `json:"epoch"`
+	Randomness     string   `json:"randomness"`
+	BlockProposed  bool     `json:"blockProposed"`
+	BlockHash      string   `json:"blockHash,omitempty"`
+	Leader         string   `json:"leader"`
+	ConsensusGroup []string `json:"consensusGroup"`
+}
+
+// ConsensusGroupsAPIResponse is a struct that holds the nodes lists of an epoch and, optionally, the consensus group
+// of a round, as returned by an API call
+type ConsensusGroupsAPIResponse struct {
+	Epoch          uint32                     `json:"epoch"`
+	Eligible       map[uint32][]string        `json:"eligible"`
+	Waiting        map[uint32][]string        `json:"waiting"`
+	Leaving        map[uint32][]string        `json:"leaving"`
+	ConsensusGroup *ConsensusGroupAPIResponse `json:"consensusGroup,omitempty"`
+}
+
+// ValidatorPerformanceRequest holds the parameters of a validator performance query. A nil epoch means the current epoch
+type ValidatorPerformanceRequest struct {
+	BLSKey string
+	Epoch  *uint32
+}
+
+// MissedProposalAPIResponse is a struct that holds a round in which the validator was the leader but no block was
+// produced, as returned by an API call
+type MissedProposalAPIResponse struct {
+	Round              uint64 `json:"round"`
+	ConsecutiveMisses  uint32 `json:"consecutiveMisses"`
+	PreviousBlockRound uint64 `json:"previousBlockRound"`
+	PreviousBlockHash  string `json:"previousBlockHash"`
+}
+
+// MissedSignatureAPIResponse is a struct that holds a block that was not signed by the validator although it was
+// part of the consensus group, as returned by an API call
+type MissedSignatureAPIResponse struct {
+	Round     uint64 `json:"round"`
+	BlockHash string `json:"blockHash"`
+	Leader    string `json:"leader"`
+}
+
+// RatingTrendPointAPIResponse is a struct that holds the replayed rating of a validator at a given round, as
+// returned by an API call
+type RatingTrendPointAPIResponse struct {
+	Round  uint64  `json:"round"`
+	Rating float32 `json:"rating"`
+}
+
+// ValidatorRatingAPIResponse is a struct that holds the rating trend and the jailing projection of a validator in
+// the current epoch, as returned by an API call. The ratings are expressed as percentages of the maximum rating
+type ValidatorRatingAPIResponse struct {
+	StartRating                float32                        `json:"startRating"`
+	TempRating                 float32                        `json:"tempRating"`
+	RatingChangePerRound       float64                        `json:"ratingChangePerRound"`
+	Trend                      []*RatingTrendPointAPIResponse `json:"trend"`
+	RemainingRounds            uint64                         `json:"remainingRounds"`
+	ProjectedRating            float32                        `json:"projectedRating"`
+	SignedBlocksRatio          float32                        `json:"signedBlocksRatio"`
+	BelowSignedBlocksThreshold bool                           `json:"belowSignedBlocksThreshold"`
+	JailThreshold              float32                        `json:"jailThreshold"`
+	MissedProposalsToJail      uint32                         `json:"missedProposalsToJail"`
+	JailingRisk                string                         `json:"jailingRisk"`
+}
+
+// ValidatorPerformanceAPIResponse is a struct that holds the performance report of a validator in an epoch, as
+// returned by an API call
+type ValidatorPerformanceAPIResponse struct {
+	BLSKey                     string                        `json:"blsKey"`
+	Epoch                      uint32                        `json:"epoch"`
+	ShardID                    uint32                        `json:"shardID"`
+	FirstRound                 uint64                        `json:"firstRound"`
+	LastRound                  uint64                        `json:"lastRound"`
+	NumRoundsInConsensus       uint32                        `json:"numRoundsInConsensus"`
+	NumProposedBlocks          uint32                        `json:"numProposedBlocks"`
+	NumMissedProposals         uint32                        `json:"numMissedProposals"`
+	NumSignedBlocks            uint32                        `json:"numSignedBlocks"`
+	NumMissedSignatures        uint32                        `json:"numMissedSignatures"`
+	NumMissedBlocksAsValidator uint32                        `json:"numMissedBlocksAsValidator"`
+	MissedProposals            []*MissedProposalAPIResponse  `json:"missedProposals"`
+	MissedSignatures           []*MissedSignatureAPIResponse `json:"missedSignatures"`
+	Rating                     *ValidatorRatingAPIResponse   `json:"rating,omitempty"`
+}
+
+// ManagedKeyStatus holds the activity of a BLS key managed by the current node process
+type ManagedKeyStatus struct {
+	PublicKey              string `json:"publicKey"`
+	NumRoundsInConsensus   uint64 `json:"numRoundsInConsensus"`
+	NumRoundsAsLeader      uint64 `json:"numRoundsAsLeader"`
+	NumProposedBlocks      uint64 `json:"numProposedBlocks"`
+	NumSignatures          uint64 `json:"numSignatures"`
+	LastRoundInConsensus   int64  `json:"lastRoundInConsensus"`
+	LastProposedRound      int64  `json:"lastProposedRound"`
+	LastSignedRound        int64  `json:"lastSignedRound"`
+	NumHeartbeatsSent      uint64 `json:"numHeartbeatsSent"`
+	LastHeartbeatTimestamp int64  `json:"lastHeartbeatTimestamp"`
+}
+
+// SigningRequest holds the data needed to sign a message on behalf of a hosted BLS key
+type SigningRequest struct {
+	PublicKey []byte      `json:"publicKey"`
+	Message   []byte      `json:"message"`
+	Kind      SigningKind `json:"kind"`
+	Round     int64       `json:"round"`
+}
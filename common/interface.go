@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 )
 
 // NumNodesDTO represents the DTO structure that will hold the number of nodes split by category and other
@@ -137,3 +138,26 @@ type ProcessStatusHandler interface {
 	IsIdle() bool
 	IsInterfaceNil() bool
 }
+
+// ManagedPeersHolder defines the operations of an entity that holds all the BLS keys managed by the current node
+// process, alongside the per key activity counters
+type ManagedPeersHolder interface {
+	AddManagedPeer(privateKeyBytes []byte) error
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	GetManagedKeys() [][]byte
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	IsMultiKeyMode() bool
+	RecordConsensusRound(pkBytes []byte, round int64, isLeader bool)
+	RecordProposedBlock(pkBytes []byte, round int64)
+	RecordSignature(pkBytes []byte, round int64)
+	RecordHeartbeat(pkBytes []byte)
+	GetManagedKeysStatus() []*ManagedKeyStatus
+	IsInterfaceNil() bool
+}
+
+// SigningHandler defines the operations of an entity able to sign messages on behalf of the BLS keys hosted by the
+// current node, either by using the keys loaded in memory or by delegating the operation to an external signer
+type SigningHandler interface {
+	Sign(request *SigningRequest) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -167,15 +167,17 @@ type Config struct {
 	Consensus           ConsensusConfig
 	StoragePruning      StoragePruningConfig
 	LogsAndEvents       LogsAndEventsConfig
+	RewardsBreakdown    RewardsBreakdownConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
 	VirtualMachine          VirtualMachineServicesConfig
 
-	Hardfork HardforkConfig
-	Debug    DebugConfig
-	Health   HealthServiceConfig
+	Hardfork     HardforkConfig
+	Debug        DebugConfig
+	Health       HealthServiceConfig
+	RemoteSigner RemoteSignerConfig
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
@@ -192,6 +194,10 @@ type Config struct {
 type PeersRatingConfig struct {
 	TopRatedCacheCapacity int
 	BadRatedCacheCapacity int
+	PersistIntervalInSec  uint32
+	DecayIntervalInSec    uint32
+	DecayPercent          uint32
+	Storage               StorageConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -415,6 +421,12 @@ type LogsAndEventsConfig struct {
 	TxLogsStorage        StorageConfig
 }
 
+// RewardsBreakdownConfig holds the configuration for the rewards breakdown saved by the metachain nodes
+type RewardsBreakdownConfig struct {
+	Enabled                 bool
+	RewardsBreakdownStorage StorageConfig
+}
+
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
@@ -425,6 +437,9 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AccountTransactionsIndexEnabled    bool
+	AccountTransactionsMaxQueryPage    uint32
+	AccountTransactionsStorageConfig   StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -445,6 +460,20 @@ type HealthServiceConfig struct {
 	FolderPath                                string
 }
 
+// RemoteSignerConfig will hold the settings of the connection with an external process that signs on behalf of the
+// node's BLS keys
+type RemoteSignerConfig struct {
+	Enabled                      bool
+	Network                      string
+	Address                      string
+	ServerName                   string
+	CertificateFile              string
+	PrivateKeyFile               string
+	CACertificateFile            string
+	RequestTimeoutInMilliseconds int
+	PublicKeys                   []string
+}
+
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
 type InterceptorResolverDebugConfig struct {
 	Enabled                    bool
@@ -479,9 +508,75 @@ type EpochStartDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	TLS         ApiTLSConfig
+	Auth        ApiAuthConfig
+	ApiKeys     ApiKeysConfig
+	GRPC        ApiGRPCConfig
+	RPC         ApiRPCConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiRPCConfig holds the configuration related to the JSON-RPC endpoint
+type ApiRPCConfig struct {
+	MaxBatchSize uint32
+}
+
+// ApiGRPCConfig holds the configuration related to the gRPC API
+type ApiGRPCConfig struct {
+	Enabled                          bool
+	Interface                        string
+	MaxBlockStreams                  uint32
+	BlocksPollIntervalInMilliseconds uint32
+}
+
+// ApiTLSConfig holds the configuration related to serving the Rest API over TLS
+type ApiTLSConfig struct {
+	Enabled             bool
+	CertificateFile     string
+	KeyFile             string
+	ReloadIntervalInSec uint32
+}
+
+// ApiAuthConfig holds the configuration related to the authentication of the Rest API requests
+type ApiAuthConfig struct {
+	Enabled           bool
+	MaxClockSkewInSec uint32
+	Credentials       []ApiCredentialConfig
+}
+
+// ApiKeysConfig holds the configuration related to the API keys used to rate limit and account the requests of each
+// API consumer
+type ApiKeysConfig struct {
+	Enabled                   bool
+	RequireKey                bool
+	UsageFilePath             string
+	UsagePersistIntervalInSec uint32
+	Keys                      []ApiKeyConfig
+}
+
+// ApiKeyConfig holds the configuration for a single API key
+type ApiKeyConfig struct {
+	Name   string
+	Key    string
+	Limits []ApiKeyLimitsConfig
+}
+
+// ApiKeyLimitsConfig holds the budgets of an API key for a route group. The "*" group applies to all the groups
+// without their own limits
+type ApiKeyLimitsConfig struct {
+	Group                 string
+	RequestsPerSecond     uint32
+	MaxConcurrentRequests uint32
+}
+
+// ApiCredentialConfig holds the configuration for a single set of credentials accepted by the Rest API
+type ApiCredentialConfig struct {
+	Name   string
+	Type   string
+	Secret string
+	Role   string
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -497,6 +592,7 @@ type APIPackageConfig struct {
 type RouteConfig struct {
 	Name string
 	Open bool
+	Role string
 }
 
 // VersionByEpochs represents a version entry that will be applied between the provided epochs
@@ -544,6 +640,8 @@ type ConfigurationPathsHolder struct {
 	Genesis                  string
 	SmartContracts           string
 	ValidatorKey             string
+	AllValidatorKeys         string
+	KeystorePasswordFile     string
 	Epoch                    string
 	RoundActivation          string
 }
@@ -21,6 +21,8 @@ type ContextFlagsConfig struct {
 	EnableRestAPIServerDebugMode bool
 	Version                      string
 	ForceStartFromNetwork        bool
+	ReindexESDTSupplies          bool
+	ReindexESDTSuppliesPartially bool
 }
 
 // ImportDbConfig will hold the import-db parameters
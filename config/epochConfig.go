@@ -82,6 +82,7 @@ type EnableEpochs struct {
 	RefactorContextEnableEpoch                        uint32
 	CheckFunctionArgumentEnableEpoch                  uint32
 	CheckExecuteOnReadOnlyEnableEpoch                 uint32
+	P2PCompressionEnableEpoch                         uint32
 }
 
 // GasScheduleByEpochs represents a gas schedule toml entry that will be applied from the provided epoch
@@ -5,6 +5,10 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	ConnectionPolicy    ConnectionPolicyConfig
+	Compression         P2PCompressionConfig
+	Bandwidth           P2PBandwidthConfig
+	TopologyCrawler     TopologyCrawlerConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -45,3 +49,56 @@ type ShardingConfig struct {
 type AdditionalConnectionsConfig struct {
 	MaxFullHistoryObservers uint32
 }
+
+// ConnectionPolicyConfig will hold the settings of the static connection policy
+type ConnectionPolicyConfig struct {
+	Enabled             bool
+	RulesFilePath       string
+	ReloadIntervalInSec uint32
+}
+
+// ConnectionPolicyRulesConfig will hold the static rules deciding which peers can be connected to the node
+type ConnectionPolicyRulesConfig struct {
+	DefaultAction string
+	Allow         ConnectionRulesConfig
+	Deny          ConnectionRulesConfig
+}
+
+// P2PCompressionConfig will hold the settings of the messages payload compression
+type P2PCompressionConfig struct {
+	Enabled               bool
+	Algorithm             string
+	MinPayloadSizeInBytes uint32
+	TopicPrefixes         []string
+}
+
+// P2PBandwidthConfig will hold the bandwidth accounting and the outbound traffic shaping settings
+type P2PBandwidthConfig struct {
+	AccountingWindowInSec  uint32
+	ShapingEnabled         bool
+	MaxOutboundBytesPerSec uint64
+	TopicClasses           []TopicClassConfig
+}
+
+// TopicClassConfig will hold the outbound traffic shaping settings of a group of topics
+type TopicClassConfig struct {
+	Name                   string
+	TopicPrefixes          []string
+	Prioritized            bool
+	MaxOutboundBytesPerSec uint64
+}
+
+// TopologyCrawlerConfig will hold the settings of the kad-dht crawler used to build the network topology snapshots
+type TopologyCrawlerConfig struct {
+	Enabled                bool
+	CrawlIntervalInSec     uint32
+	Parallelism            uint32
+	ConnectionTimeoutInSec uint32
+}
+
+// ConnectionRulesConfig will hold the networks, the peer IDs and the validator public keys matched by a rule
+type ConnectionRulesConfig struct {
+	CIDRs      []string
+	PeerIDs    []string
+	PublicKeys []string
+}
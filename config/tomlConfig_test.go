@@ -383,16 +383,45 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		TLS: ApiTLSConfig{
+			Enabled:             true,
+			CertificateFile:     "cert.pem",
+			KeyFile:             "key.pem",
+			ReloadIntervalInSec: 60,
+		},
+		Auth: ApiAuthConfig{
+			Enabled:           true,
+			MaxClockSkewInSec: 30,
+			Credentials: []ApiCredentialConfig{
+				{Name: "monitoring", Type: "bearer", Secret: "token", Role: "operator"},
+				{Name: "ops", Type: "hmac", Secret: "secret", Role: "admin"},
+			},
+		},
+		ApiKeys: ApiKeysConfig{
+			Enabled:                   true,
+			RequireKey:                true,
+			UsageFilePath:             "usage.json",
+			UsagePersistIntervalInSec: 60,
+			Keys: []ApiKeyConfig{
+				{
+					Name: "partner",
+					Key:  "key",
+					Limits: []ApiKeyLimitsConfig{
+						{Group: "*", RequestsPerSecond: 50, MaxConcurrentRequests: 10},
+					},
+				},
+			},
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
 					{Name: route0, Open: true},
-					{Name: route1, Open: true},
+					{Name: route1, Open: true, Role: "operator"},
 				},
 			},
 			package1: {
 				Routes: []RouteConfig{
-					{Name: route2, Open: false},
+					{Name: route2, Open: false, Role: "admin"},
 				},
 			},
 		},
@@ -403,6 +432,29 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[TLS]
+    Enabled = true
+    CertificateFile = "cert.pem"
+    KeyFile = "key.pem"
+    ReloadIntervalInSec = 60
+
+[Auth]
+    Enabled = true
+    MaxClockSkewInSec = 30
+    Credentials = [
+        { Name = "monitoring", Type = "bearer", Secret = "token", Role = "operator" },
+        { Name = "ops", Type = "hmac", Secret = "secret", Role = "admin" },
+    ]
+
+[ApiKeys]
+    Enabled = true
+    RequireKey = true
+    UsageFilePath = "usage.json"
+    UsagePersistIntervalInSec = 60
+    Keys = [
+        { Name = "partner", Key = "key", Limits = [{ Group = "*", RequestsPerSecond = 50, MaxConcurrentRequests = 10 }] },
+    ]
+
      # API routes configuration
 [APIPackages]
 
@@ -412,13 +464,13 @@ func TestAPIRoutesToml(t *testing.T) {
         { Name = "` + route0 + `", Open = true },
 
         # test comment
-        { Name = "` + route1 + `", Open = true },
+        { Name = "` + route1 + `", Open = true, Role = "operator" },
 	]
 
 [APIPackages.` + package1 + `]
 	Routes = [
          # test comment
-        { Name = "` + route2 + `", Open = false }
+        { Name = "` + route2 + `", Open = false, Role = "admin" }
     ]
  `
 
@@ -42,6 +42,7 @@ type commonMessenger struct {
 	privateKey              crypto.PrivateKey
 	shardCoordinator        sharding.Coordinator
 	peerSignatureHandler    crypto.PeerSignatureHandler
+	signingHandler          common.SigningHandler
 	delayedBlockBroadcaster delayedBroadcaster
 }
 
@@ -53,6 +54,7 @@ type CommonMessengerArgs struct {
 	PrivateKey                 crypto.PrivateKey
 	ShardCoordinator           sharding.Coordinator
 	PeerSignatureHandler       crypto.PeerSignatureHandler
+	SigningHandler             common.SigningHandler
 	HeadersSubscriber          consensus.HeadersPoolSubscriber
 	InterceptorsContainer      process.InterceptorsContainer
 	MaxDelayCacheSize          uint32
@@ -81,6 +83,9 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.PeerSignatureHandler) {
 		return spos.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(args.SigningHandler) {
+		return spos.ErrNilSigningHandler
+	}
 	if check.IfNil(args.InterceptorsContainer) {
 		return spos.ErrNilInterceptorsContainer
 	}
@@ -99,7 +104,13 @@ func checkCommonMessengerNilParameters(
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	request := &common.SigningRequest{
+		PublicKey: message.PubKey,
+		Message:   message.OriginatorPid,
+		Kind:      common.PeerSignatureSigning,
+		Round:     message.RoundIndex,
+	}
+	signature, err := cm.signingHandler.Sign(request)
 	if err != nil {
 		return err
 	}
@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,12 +37,12 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 	messengerMock := &mock.MessengerStub{}
 	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}}
+	signingHandler := &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
 			return nil, err
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
@@ -48,6 +50,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		signingHandler,
 	)
 
 	msg := &consensus.Message{}
@@ -63,12 +66,12 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	}
 	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}}
+	signingHandler := &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
 			return []byte(""), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
@@ -76,6 +79,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		signingHandler,
 	)
 
 	msg := &consensus.Message{}
@@ -83,6 +87,50 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageShouldSignOnBehalfOfTheMessageKey(t *testing.T) {
+	managedPubKey := []byte("managed pk")
+	pid := []byte("pid")
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+		},
+	}
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}}
+	var signingRequest *common.SigningRequest
+	signingHandler := &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			signingRequest = request
+			return []byte("signature"), nil
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		marshalizerMock,
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		shardCoordinatorMock,
+		peerSigHandler,
+		signingHandler,
+	)
+
+	msg := &consensus.Message{
+		PubKey:        managedPubKey,
+		OriginatorPid: pid,
+		RoundIndex:    37,
+	}
+	err := cm.BroadcastConsensusMessage(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("signature"), msg.Signature)
+	expectedRequest := &common.SigningRequest{
+		PublicKey: managedPubKey,
+		Message:   pid,
+		Kind:      common.PeerSignatureSigning,
+		Round:     37,
+	}
+	assert.Equal(t, expectedRequest, signingRequest)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -102,6 +150,7 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.SigningHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -157,6 +206,7 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.SigningHandlerStub{},
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -199,6 +249,7 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.SigningHandlerStub{},
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -178,6 +179,7 @@ func NewCommonMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	peerSigHandler crypto.PeerSignatureHandler,
+	signingHandler common.SigningHandler,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
@@ -186,5 +188,6 @@ func NewCommonMessenger(
 		privateKey:           privateKey,
 		shardCoordinator:     shardCoordinator,
 		peerSignatureHandler: peerSigHandler,
+		signingHandler:       signingHandler,
 	}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			SigningHandler:             &cryptoMocks.SigningHandlerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxValidatorDelayCacheSize: 2,
@@ -89,6 +91,15 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFa
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestMetaChainMessenger_NewMetaChainMessengerNilSigningHandlerShouldFail(t *testing.T) {
+	args := createDefaultMetaChainArgs()
+	args.SigningHandler = nil
+	mcm, err := broadcast.NewMetaChainMessenger(args)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, spos.ErrNilSigningHandler, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	mcm, err := broadcast.NewMetaChainMessenger(args)
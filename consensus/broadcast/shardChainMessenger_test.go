@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -71,6 +72,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			SigningHandler:             &cryptoMocks.SigningHandlerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxDelayCacheSize:          1,
@@ -125,6 +127,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShould
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilSigningHandlerShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.SigningHandler = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilSigningHandler, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.InterceptorsContainer = nil
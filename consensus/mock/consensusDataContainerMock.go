@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -37,6 +38,8 @@ type ConsensusCoreMock struct {
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
 	scheduledProcessor      consensus.ScheduledProcessor
+	managedPeersHolder      common.ManagedPeersHolder
+	signingHandler          common.SigningHandler
 }
 
 // GetAntiFloodHandler -
@@ -229,6 +232,26 @@ func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler con
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
 }
 
+// ManagedPeersHolder -
+func (ccm *ConsensusCoreMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccm.managedPeersHolder
+}
+
+// SetManagedPeersHolder -
+func (ccm *ConsensusCoreMock) SetManagedPeersHolder(managedPeersHolder common.ManagedPeersHolder) {
+	ccm.managedPeersHolder = managedPeersHolder
+}
+
+// SigningHandler -
+func (ccm *ConsensusCoreMock) SigningHandler() common.SigningHandler {
+	return ccm.signingHandler
+}
+
+// SetSigningHandler -
+func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler common.SigningHandler) {
+	ccm.signingHandler = signingHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
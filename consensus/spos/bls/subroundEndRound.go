@@ -86,7 +86,7 @@ func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(_ context.Context, cnsD
 		return false
 	}
 
-	if sr.IsSelfLeaderInCurrentRound() {
+	if sr.IsSelfLeader() {
 		return false
 	}
 
@@ -156,7 +156,7 @@ func (sr *subroundEndRound) isBlockHeaderFinalInfoValid(cnsDta *consensus.Messag
 }
 
 func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
-	if sr.ConsensusGroup() == nil || sr.IsSelfLeaderInCurrentRound() {
+	if sr.ConsensusGroup() == nil || sr.IsSelfLeader() {
 		return
 	}
 
@@ -167,8 +167,8 @@ func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
 
 // doEndRoundJob method does the job of the subround EndRound
 func (sr *subroundEndRound) doEndRoundJob(_ context.Context) bool {
-	if !sr.IsSelfLeaderInCurrentRound() {
-		if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if !sr.IsSelfLeader() {
+		if sr.IsSelfInConsensusGroup() {
 			err := sr.prepareBroadcastBlockDataForValidator()
 			if err != nil {
 				log.Warn("validator in consensus group preparing for delayed broadcast",
@@ -264,6 +264,8 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
+	sr.recordProposedBlock()
+
 	sr.displayStatistics()
 
 	log.Debug("step 3: Body and Header have been committed and header has been broadcast")
@@ -281,13 +283,29 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	return true
 }
 
+func (sr *subroundEndRound) recordProposedBlock() {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("recordProposedBlock.GetLeader", "error", err.Error())
+		return
+	}
+
+	sr.ManagedPeersHolder().RecordProposedBlock([]byte(leader), sr.RoundHandler().Index())
+}
+
 func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("createAndBroadcastHeaderFinalInfo.GetLeader", "error", err.Error())
+		return
+	}
+
 	cnsMsg := consensus.NewConsensusMessage(
 		sr.GetData(),
 		nil,
 		nil,
 		nil,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockHeaderFinalInfo),
 		sr.RoundHandler().Index(),
@@ -298,7 +316,7 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		sr.CurrentPid(),
 	)
 
-	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
 		log.Debug("doEndRoundJob.BroadcastConsensusMessage", "error", err.Error())
 		return
@@ -375,7 +393,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
-	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if sr.IsSelfInConsensusGroup() {
 		err = sr.setHeaderForValidator(header)
 		if err != nil {
 			log.Warn("doEndRoundJobByParticipant", "error", err.Error())
@@ -484,7 +502,12 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	leader, err := sr.GetLeader()
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SignWithKey(leader, common.BlockHeaderSigning, marshalizedHdr)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
@@ -503,7 +526,7 @@ func (sr *subroundEndRound) broadcastBlockDataLeader() error {
 }
 
 func (sr *subroundEndRound) setHeaderForValidator(header data.HeaderHandler) error {
-	idx, err := sr.SelfConsensusGroupIndex()
+	idx, err := sr.getFirstOwnKeyIndex()
 	if err != nil {
 		return err
 	}
@@ -520,7 +543,7 @@ func (sr *subroundEndRound) setHeaderForValidator(header data.HeaderHandler) err
 }
 
 func (sr *subroundEndRound) prepareBroadcastBlockDataForValidator() error {
-	idx, err := sr.SelfConsensusGroupIndex()
+	idx, err := sr.getFirstOwnKeyIndex()
 	if err != nil {
 		return err
 	}
@@ -535,6 +558,17 @@ func (sr *subroundEndRound) prepareBroadcastBlockDataForValidator() error {
 	return nil
 }
 
+// getFirstOwnKeyIndex returns the consensus group index of the first key owned by the current node, which is used
+// to compute the delayed broadcast of the validator
+func (sr *subroundEndRound) getFirstOwnKeyIndex() (int, error) {
+	ownKeys := sr.OwnKeysInConsensusGroup()
+	if len(ownKeys) == 0 {
+		return 0, spos.ErrNotFoundInConsensus
+	}
+
+	return sr.ConsensusGroupIndex(ownKeys[0])
+}
+
 // doEndRoundConsensusCheck method checks if the consensus is achieved
 func (sr *subroundEndRound) doEndRoundConsensusCheck() bool {
 	if sr.RoundCanceled {
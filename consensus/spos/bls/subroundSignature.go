@@ -62,54 +62,62 @@ func checkNewSubroundSignatureParams(
 
 // doSignatureJob method does the job of the subround Signature
 func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
-	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if !sr.IsSelfInConsensusGroup() {
 		return true
 	}
-	if !sr.CanDoSubroundJob(sr.Current()) {
+	if !sr.canDoSignatureJob() {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
-	if err != nil {
-		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
-		return false
-	}
+	isSelfLeader := sr.IsSelfLeader()
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-
-	if !isSelfLeader {
-		// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.RoundHandler().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
+	for _, pk := range sr.OwnKeysInConsensusGroup() {
+		if sr.IsJobDone(pk, sr.Current()) {
+			continue
+		}
 
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		signatureShare, err := sr.createSignatureShare(pk)
 		if err != nil {
-			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+			log.Debug("doSignatureJob.createSignatureShare", "error", err.Error())
 			return false
 		}
 
-		log.Debug("step 2: signature has been sent")
-	}
+		if !isSelfLeader {
+			// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+			cnsMsg := consensus.NewConsensusMessage(
+				sr.GetData(),
+				signatureShare,
+				nil,
+				nil,
+				[]byte(pk),
+				nil,
+				int(MtSignature),
+				sr.RoundHandler().Index(),
+				sr.ChainID(),
+				nil,
+				nil,
+				nil,
+				sr.CurrentPid(),
+			)
+
+			err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+			if err != nil {
+				log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+				return false
+			}
+
+			log.Debug("step 2: signature has been sent", "pk", core.GetTrimmedPk(hex.EncodeToString([]byte(pk))))
+		}
 
-	err = sr.SetSelfJobDone(sr.Current(), true)
-	if err != nil {
-		log.Debug("doSignatureJob.SetSelfJobDone",
-			"subround", sr.Name(),
-			"error", err.Error())
-		return false
+		err = sr.SetJobDone(pk, sr.Current(), true)
+		if err != nil {
+			log.Debug("doSignatureJob.SetJobDone",
+				"subround", sr.Name(),
+				"error", err.Error())
+			return false
+		}
+
+		sr.ManagedPeersHolder().RecordSignature([]byte(pk), sr.RoundHandler().Index())
 	}
 
 	if isSelfLeader {
@@ -119,6 +127,41 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 	return true
 }
 
+func (sr *subroundSignature) canDoSignatureJob() bool {
+	if !sr.IsConsensusDataSet() {
+		return false
+	}
+	if sr.AreSelfJobsDone(sr.Current()) {
+		return false
+	}
+	if sr.IsSubroundFinished(sr.Current()) {
+		return false
+	}
+
+	return true
+}
+
+// createSignatureShare creates the signature share of the provided key and stores it in the multi signer, at the
+// key's index in the consensus group
+func (sr *subroundSignature) createSignatureShare(pk string) ([]byte, error) {
+	index, err := sr.ConsensusGroupIndex(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.SignWithKey(pk, common.SignatureShareSigning, sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
 // receivedSignature method is called when a signature is received through the signature channel.
 // If the signature is valid, than the jobDone map corresponding to the node which sent it,
 // is set on true for the subround Signature
@@ -140,7 +183,7 @@ func (sr *subroundSignature) receivedSignature(_ context.Context, cnsDta *consen
 		return false
 	}
 
-	if !sr.IsSelfLeaderInCurrentRound() {
+	if !sr.IsSelfLeader() {
 		return false
 	}
 
@@ -210,8 +253,8 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 		return true
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	isSelfLeader := sr.IsSelfLeader()
+	isSelfInConsensusGroup := sr.IsSelfInConsensusGroup()
 
 	threshold := sr.Threshold(sr.Current())
 	if sr.FallbackHeaderValidator().ShouldApplyFallbackValidation(sr.Header) {
@@ -226,7 +269,7 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	areAllSignaturesCollected := numSigs == sr.ConsensusGroupSize()
 
 	isJobDoneByLeader := isSelfLeader && (areAllSignaturesCollected || (areSignaturesCollected && sr.WaitingAllSignaturesTimeOut))
-	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.IsSelfJobDone(sr.Current())
+	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.AreSelfJobsDone(sr.Current())
 
 	isSubroundFinished := !isSelfInConsensusGroup || isJobDoneByConsensusNode || isJobDoneByLeader
 
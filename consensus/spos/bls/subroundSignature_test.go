@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -274,24 +275,21 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	sr.Data = []byte("X")
 
-	multiSignerMock := mock.InitMultiSignerMock()
-
 	err := errors.New("create signature share error")
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return nil, err
-	}
-
-	container.SetMultiSigner(multiSignerMock)
+	container.SetSigningHandler(&cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			return nil, err
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.False(t, r)
 
-	multiSignerMock = mock.InitMultiSignerMock()
-
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return []byte("SIG"), nil
-	}
-	container.SetMultiSigner(multiSignerMock)
+	container.SetSigningHandler(&cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			return []byte("SIG"), nil
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
@@ -304,6 +302,60 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobWithManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusGroup := initConsensusState().ConsensusGroup()
+	managedKey := consensusGroup[3]
+	signedKeys := make(map[string]struct{})
+	storedIndexes := make([]uint16, 0)
+	recordedKeys := make(map[string]struct{})
+	container.SetManagedPeersHolder(&cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == managedKey
+		},
+		RecordSignatureCalled: func(pkBytes []byte, round int64) {
+			recordedKeys[string(pkBytes)] = struct{}{}
+		},
+	})
+	container.SetSigningHandler(&cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			assert.Equal(t, common.SignatureShareSigning, request.Kind)
+			signedKeys[string(request.PublicKey)] = struct{}{}
+			return []byte("SIG"), nil
+		},
+	})
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.StoreSignatureShareCalled = func(index uint16, sig []byte) error {
+		storedIndexes = append(storedIndexes, index)
+		return nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	broadcastKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastKeys = append(broadcastKeys, string(message.PubKey))
+			return nil
+		},
+	})
+
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{sr.SelfPubKey(), managedKey}, broadcastKeys)
+	assert.Equal(t, map[string]struct{}{sr.SelfPubKey(): {}, managedKey: {}}, signedKeys)
+	assert.Equal(t, []uint16{1, 3}, storedIndexes)
+	assert.Equal(t, 2, len(recordedKeys))
+	assert.True(t, sr.IsJobDone(sr.SelfPubKey(), bls.SrSignature))
+	assert.True(t, sr.IsJobDone(managedKey, bls.SrSignature))
+
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
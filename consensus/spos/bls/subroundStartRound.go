@@ -155,7 +155,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	}
 
 	msg := ""
-	if leader == sr.SelfPubKey() {
+	if sr.IsSelfLeader() {
 		sr.AppStatusHandler().Increment(common.MetricCountLeader)
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusRoundState, "proposed")
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "proposer")
@@ -170,17 +170,19 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 
 	sr.indexRoundIfNeeded(pubKeys)
 
-	selfIndex, err := sr.SelfConsensusGroupIndex()
-	if err != nil {
+	if !sr.IsSelfInConsensusGroup() {
 		log.Debug("not in consensus group")
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
 	} else {
-		if leader != sr.SelfPubKey() {
+		if !sr.IsSelfLeader() {
 			sr.AppStatusHandler().Increment(common.MetricCountConsensus)
 		}
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
+		sr.recordConsensusRound(leader)
 	}
 
+	selfIndex, _ := sr.SelfConsensusGroupIndex()
+
 	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
 	if err != nil {
 		log.Debug("initCurrentRound.Reset", "error", err.Error())
@@ -210,6 +212,13 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+func (sr *subroundStartRound) recordConsensusRound(leader string) {
+	round := sr.RoundHandler().Index()
+	for _, pk := range sr.OwnKeysInConsensusGroup() {
+		sr.ManagedPeersHolder().RecordConsensusRound([]byte(pk), round, pk == leader)
+	}
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	sr.outportMutex.RLock()
 	defer sr.outportMutex.RUnlock()
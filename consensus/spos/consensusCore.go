@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -37,6 +38,8 @@ type ConsensusCore struct {
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 	scheduledProcessor            consensus.ScheduledProcessor
+	managedPeersHolder            common.ManagedPeersHolder
+	signingHandler                common.SigningHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -62,6 +65,8 @@ type ConsensusCoreArgs struct {
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
 	ScheduledProcessor            consensus.ScheduledProcessor
+	ManagedPeersHolder            common.ManagedPeersHolder
+	SigningHandler                common.SigningHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -90,6 +95,8 @@ func NewConsensusCore(
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
 		scheduledProcessor:            args.ScheduledProcessor,
+		managedPeersHolder:            args.ManagedPeersHolder,
+		signingHandler:                args.SigningHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -205,6 +212,16 @@ func (cc *ConsensusCore) ScheduledProcessor() consensus.ScheduledProcessor {
 	return cc.scheduledProcessor
 }
 
+// ManagedPeersHolder returns the holder of all the BLS keys managed by the node
+func (cc *ConsensusCore) ManagedPeersHolder() common.ManagedPeersHolder {
+	return cc.managedPeersHolder
+}
+
+// SigningHandler returns the component used to sign on behalf of the hosted BLS keys
+func (cc *ConsensusCore) SigningHandler() common.SigningHandler {
+	return cc.signingHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -64,6 +64,12 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.NodeRedundancyHandler()) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(container.ManagedPeersHolder()) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(container.SigningHandler()) {
+		return ErrNilSigningHandler
+	}
 
 	return nil
 }
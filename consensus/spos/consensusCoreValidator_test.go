@@ -31,6 +31,8 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{}
+	signingHandler := &cryptoMocks.SigningHandlerStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -52,6 +54,8 @@ func initConsensusDataContainer() *ConsensusCore {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		managedPeersHolder:      managedPeersHolder,
+		signingHandler:          signingHandler,
 	}
 }
 
@@ -231,6 +235,28 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.managedPeersHolder = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilManagedPeersHolder, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilSigningHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.signingHandler = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSigningHandler, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
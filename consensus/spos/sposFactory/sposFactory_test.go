@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -146,6 +147,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&cryptoMocks.SigningHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -178,6 +180,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&cryptoMocks.SigningHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -201,6 +204,7 @@ func TestGetBroadcastMessenger_NilShardCoordinatorShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -228,6 +232,7 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		shardCoord,
 		nil,
 		nil,
+		nil,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
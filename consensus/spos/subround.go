@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
@@ -204,6 +205,77 @@ func (sr *Subround) ConsensusChannel() chan bool {
 	return sr.consensusStateChangedChannel
 }
 
+// IsKeyOwnedByCurrentNode returns true if the provided public key is the node's own key or one of the managed keys
+func (sr *Subround) IsKeyOwnedByCurrentNode(pkBytes string) bool {
+	if sr.IsNodeSelf(pkBytes) {
+		return true
+	}
+
+	managedPeersHolder := sr.ManagedPeersHolder()
+	if check.IfNil(managedPeersHolder) {
+		return false
+	}
+
+	return managedPeersHolder.IsKeyManagedByCurrentNode([]byte(pkBytes))
+}
+
+// IsSelfLeader returns true if the leader of the current round is one of the keys owned by the current node
+func (sr *Subround) IsSelfLeader() bool {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		return false
+	}
+
+	return sr.IsKeyOwnedByCurrentNode(leader)
+}
+
+// IsSelfInConsensusGroup returns true if at least one of the keys owned by the current node is in the consensus group
+func (sr *Subround) IsSelfInConsensusGroup() bool {
+	return len(sr.OwnKeysInConsensusGroup()) > 0
+}
+
+// OwnKeysInConsensusGroup returns the keys owned by the current node that are part of the consensus group, in the
+// consensus group order
+func (sr *Subround) OwnKeysInConsensusGroup() []string {
+	ownKeys := make([]string, 0)
+	for _, pk := range sr.ConsensusGroup() {
+		if sr.IsKeyOwnedByCurrentNode(pk) {
+			ownKeys = append(ownKeys, pk)
+		}
+	}
+
+	return ownKeys
+}
+
+// AreSelfJobsDone returns true if all the keys owned by the current node that are part of the consensus group
+// finished the job for the provided subround
+func (sr *Subround) AreSelfJobsDone(subroundId int) bool {
+	ownKeys := sr.OwnKeysInConsensusGroup()
+	if len(ownKeys) == 0 {
+		return false
+	}
+
+	for _, pk := range ownKeys {
+		if !sr.IsJobDone(pk, subroundId) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SignWithKey signs the provided message on behalf of the provided public key, in the context of the current round
+func (sr *Subround) SignWithKey(pkBytes string, kind common.SigningKind, message []byte) ([]byte, error) {
+	request := &common.SigningRequest{
+		PublicKey: []byte(pkBytes),
+		Message:   message,
+		Kind:      kind,
+		Round:     sr.RoundHandler().Index(),
+	}
+
+	return sr.SigningHandler().Sign(request)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sr *Subround) IsInterfaceNil() bool {
 	return sr == nil
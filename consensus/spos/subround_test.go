@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -930,3 +933,113 @@ func TestSubround_Name(t *testing.T) {
 
 	assert.Equal(t, "(BLOCK)", sr.Name())
 }
+
+func createSubroundWithManagedKeys(managedKeys []string) *spos.Subround {
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+	container.SetManagedPeersHolder(&cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			for _, key := range managedKeys {
+				if key == string(pkBytes) {
+					return true
+				}
+			}
+
+			return false
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return &mock.PrivateKeyMock{}, nil
+		},
+	})
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+		&statusHandler.AppStatusHandlerStub{},
+	)
+
+	return sr
+}
+
+func TestSubround_IsSelfLeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("self key is not leader and no managed keys should return false", func(t *testing.T) {
+		t.Parallel()
+
+		sr := createSubroundWithManagedKeys(nil)
+		assert.False(t, sr.IsSelfLeader())
+	})
+	t.Run("managed key is leader should return true", func(t *testing.T) {
+		t.Parallel()
+
+		sr := createSubroundWithManagedKeys(nil)
+		leader := sr.ConsensusGroup()[0]
+		sr = createSubroundWithManagedKeys([]string{leader})
+		assert.True(t, sr.IsSelfLeader())
+	})
+}
+
+func TestSubround_OwnKeysInConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	sr := createSubroundWithManagedKeys(nil)
+	consensusGroup := sr.ConsensusGroup()
+	assert.Equal(t, []string{sr.SelfPubKey()}, sr.OwnKeysInConsensusGroup())
+
+	sr = createSubroundWithManagedKeys([]string{consensusGroup[3], consensusGroup[0], "not in consensus"})
+	expectedKeys := []string{consensusGroup[0], sr.SelfPubKey(), consensusGroup[3]}
+	assert.Equal(t, expectedKeys, sr.OwnKeysInConsensusGroup())
+	assert.True(t, sr.IsSelfInConsensusGroup())
+}
+
+func TestSubround_AreSelfJobsDone(t *testing.T) {
+	t.Parallel()
+
+	sr := createSubroundWithManagedKeys(nil)
+	managedKey := sr.ConsensusGroup()[3]
+	sr = createSubroundWithManagedKeys([]string{managedKey})
+	assert.False(t, sr.AreSelfJobsDone(bls.SrSignature))
+
+	_ = sr.SetJobDone(sr.SelfPubKey(), bls.SrSignature, true)
+	assert.False(t, sr.AreSelfJobsDone(bls.SrSignature))
+
+	_ = sr.SetJobDone(managedKey, bls.SrSignature, true)
+	assert.True(t, sr.AreSelfJobsDone(bls.SrSignature))
+}
+
+func TestSubround_SignWithKey(t *testing.T) {
+	t.Parallel()
+
+	sr := createSubroundWithManagedKeys(nil)
+	pk := sr.ConsensusGroup()[3]
+	message := []byte("message")
+	expectedSig := []byte("signature")
+	container := mock.InitConsensusCore()
+	container.SetSigningHandler(&cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			assert.Equal(t, []byte(pk), request.PublicKey)
+			assert.Equal(t, message, request.Message)
+			assert.Equal(t, common.SignatureShareSigning, request.Kind)
+			assert.Equal(t, container.RoundHandler().Index(), request.Round)
+
+			return expectedSig, nil
+		},
+	})
+	sr.ConsensusCoreHandler = container
+
+	sig, err := sr.SignWithKey(pk, common.SignatureShareSigning, message)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSig, sig)
+}
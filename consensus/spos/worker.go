@@ -74,6 +74,7 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	managedPeersHolder        common.ManagedPeersHolder
 	closer                    core.SafeCloser
 }
 
@@ -103,6 +104,7 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	ManagedPeersHolder       common.ManagedPeersHolder
 }
 
 // NewWorker creates a new Worker object
@@ -149,6 +151,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		managedPeersHolder:       args.ManagedPeersHolder,
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -247,6 +250,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
 
 	return nil
 }
@@ -522,6 +528,9 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
 		return ErrMessageFromItself
 	}
+	if wrk.managedPeersHolder.IsKeyManagedByCurrentNode(cnsDta.PubKey) {
+		return ErrMessageFromItself
+	}
 
 	if wrk.consensusState.RoundCanceled && wrk.consensusState.RoundIndex == cnsDta.RoundIndex {
 		return ErrRoundCanceled
@@ -45,6 +45,10 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AccountTransactionsUnit:
+		return "AccountTransactionsUnit"
+	case RewardsBreakdownUnit:
+		return "RewardsBreakdownUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +109,10 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AccountTransactionsUnit is the transactions by account storage unit identifier
+	AccountTransactionsUnit UnitType = 25
+	// RewardsBreakdownUnit is the end of epoch rewards breakdown storage unit identifier
+	RewardsBreakdownUnit UnitType = 26
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -0,0 +1,1328 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountTransactions.proto
+
+package accountTransactions
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountTransaction is used to store the coordinates of a transaction (or smart contract result) an account was involved in
+type AccountTransaction struct {
+	Hash          []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	MiniblockType int32  `protobuf:"varint,2,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	Roles         uint32 `protobuf:"varint,3,opt,name=Roles,proto3" json:"Roles,omitempty"`
+	BlockNonce    uint64 `protobuf:"varint,4,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Round         uint64 `protobuf:"varint,5,opt,name=Round,proto3" json:"Round,omitempty"`
+	Timestamp     uint64 `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Epoch         uint32 `protobuf:"varint,7,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *AccountTransaction) Reset()      { *m = AccountTransaction{} }
+func (*AccountTransaction) ProtoMessage() {}
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{0}
+}
+func (m *AccountTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransaction.Merge(m, src)
+}
+func (m *AccountTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransaction proto.InternalMessageInfo
+
+func (m *AccountTransaction) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *AccountTransaction) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetRoles() uint32 {
+	if m != nil {
+		return m.Roles
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// AccountTransactionsBucket is used to store a bounded chunk of an account's transactions, in ascending block nonce order
+type AccountTransactionsBucket struct {
+	Transactions []*AccountTransaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *AccountTransactionsBucket) Reset()      { *m = AccountTransactionsBucket{} }
+func (*AccountTransactionsBucket) ProtoMessage() {}
+func (*AccountTransactionsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{1}
+}
+func (m *AccountTransactionsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionsBucket.Merge(m, src)
+}
+func (m *AccountTransactionsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionsBucket proto.InternalMessageInfo
+
+func (m *AccountTransactionsBucket) GetTransactions() []*AccountTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// AccountTransactionsHead is used to store the bookkeeping data of an account's transactions index
+type AccountTransactionsHead struct {
+	NumBuckets      uint64 `protobuf:"varint,1,opt,name=NumBuckets,proto3" json:"NumBuckets,omitempty"`
+	NumTransactions uint64 `protobuf:"varint,2,opt,name=NumTransactions,proto3" json:"NumTransactions,omitempty"`
+}
+
+func (m *AccountTransactionsHead) Reset()      { *m = AccountTransactionsHead{} }
+func (*AccountTransactionsHead) ProtoMessage() {}
+func (*AccountTransactionsHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{2}
+}
+func (m *AccountTransactionsHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTransactionsHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTransactionsHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransactionsHead.Merge(m, src)
+}
+func (m *AccountTransactionsHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTransactionsHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransactionsHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransactionsHead proto.InternalMessageInfo
+
+func (m *AccountTransactionsHead) GetNumBuckets() uint64 {
+	if m != nil {
+		return m.NumBuckets
+	}
+	return 0
+}
+
+func (m *AccountTransactionsHead) GetNumTransactions() uint64 {
+	if m != nil {
+		return m.NumTransactions
+	}
+	return 0
+}
+
+// BlockAccounts is used to store the addresses whose transactions index was modified by a block
+type BlockAccounts struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *BlockAccounts) Reset()      { *m = BlockAccounts{} }
+func (*BlockAccounts) ProtoMessage() {}
+func (*BlockAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a5f698702587fa3, []int{3}
+}
+func (m *BlockAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAccounts.Merge(m, src)
+}
+func (m *BlockAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAccounts proto.InternalMessageInfo
+
+func (m *BlockAccounts) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountTransaction)(nil), "proto.AccountTransaction")
+	proto.RegisterType((*AccountTransactionsBucket)(nil), "proto.AccountTransactionsBucket")
+	proto.RegisterType((*AccountTransactionsHead)(nil), "proto.AccountTransactionsHead")
+	proto.RegisterType((*BlockAccounts)(nil), "proto.BlockAccounts")
+}
+
+func init() { proto.RegisterFile("accountTransactions.proto", fileDescriptor_0a5f698702587fa3) }
+
+var fileDescriptor_0a5f698702587fa3 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x4e, 0xc2, 0x30,
+	0x1c, 0xc6, 0x57, 0xd8, 0x30, 0x56, 0x88, 0x49, 0x35, 0xb1, 0x18, 0xd3, 0x2c, 0x8b, 0x87, 0x5d,
+	0x80, 0x44, 0xcf, 0x1e, 0x20, 0x31, 0xe1, 0x02, 0x87, 0x86, 0x13, 0xb7, 0xad, 0xab, 0xb0, 0xc0,
+	0xd6, 0x85, 0x6e, 0x07, 0x6f, 0x3e, 0x82, 0x8f, 0xe1, 0xa3, 0x78, 0x32, 0x1c, 0x39, 0x4a, 0xb9,
+	0x78, 0xe4, 0x11, 0xcc, 0x5a, 0x13, 0x40, 0x38, 0xad, 0xdf, 0xaf, 0xff, 0xaf, 0xdf, 0xfa, 0xa5,
+	0xb0, 0x19, 0x30, 0x26, 0x8a, 0x34, 0x1f, 0x2d, 0x82, 0x54, 0x06, 0x2c, 0x8f, 0x45, 0x2a, 0xdb,
+	0xd9, 0x42, 0xe4, 0x02, 0x39, 0xfa, 0x73, 0xdb, 0x9a, 0xc4, 0xf9, 0xb4, 0x08, 0xdb, 0x4c, 0x24,
+	0x9d, 0x89, 0x98, 0x88, 0x8e, 0xc6, 0x61, 0xf1, 0xa2, 0x95, 0x16, 0x7a, 0x65, 0x5c, 0xde, 0x17,
+	0x80, 0xa8, 0x7b, 0x74, 0x26, 0x42, 0xd0, 0xee, 0x07, 0x72, 0x8a, 0x81, 0x0b, 0xfc, 0x3a, 0xd5,
+	0x6b, 0x74, 0x0f, 0x1b, 0x83, 0x38, 0x8d, 0xc3, 0xb9, 0x60, 0xb3, 0xd1, 0x6b, 0xc6, 0x71, 0xc5,
+	0x05, 0xbe, 0x43, 0x0f, 0x21, 0xba, 0x86, 0x0e, 0x15, 0x73, 0x2e, 0x71, 0xd5, 0x05, 0x7e, 0x83,
+	0x1a, 0x81, 0x08, 0x84, 0xbd, 0x72, 0x64, 0x28, 0x52, 0xc6, 0xb1, 0xed, 0x02, 0xdf, 0xa6, 0x7b,
+	0xc4, 0xb8, 0x8a, 0x34, 0xc2, 0x8e, 0xde, 0x32, 0x02, 0xdd, 0xc1, 0xf3, 0x51, 0x9c, 0x70, 0x99,
+	0x07, 0x49, 0x86, 0x6b, 0x7a, 0x67, 0x07, 0x4a, 0xcf, 0x73, 0x26, 0xd8, 0x14, 0x9f, 0x99, 0x24,
+	0x2d, 0xbc, 0x31, 0x6c, 0x1e, 0xdf, 0x47, 0xf6, 0x0a, 0x36, 0xe3, 0x39, 0x7a, 0x82, 0xf5, 0x7d,
+	0x8a, 0x81, 0x5b, 0xf5, 0x2f, 0x1e, 0x9a, 0xa6, 0x8b, 0xf6, 0xb1, 0x8f, 0x1e, 0x8c, 0x7b, 0x0c,
+	0xde, 0x9c, 0x38, 0xbb, 0xcf, 0x83, 0xa8, 0xbc, 0xe0, 0xb0, 0x48, 0x4c, 0x8c, 0xd4, 0xb5, 0xd9,
+	0x74, 0x8f, 0x20, 0x1f, 0x5e, 0x0e, 0x8b, 0xe4, 0x20, 0xbc, 0xa2, 0x87, 0xfe, 0x63, 0xaf, 0x05,
+	0x1b, 0xba, 0x98, 0xbf, 0x24, 0x59, 0xb6, 0xd0, 0x8d, 0xa2, 0x05, 0x97, 0x92, 0x9b, 0x3f, 0xae,
+	0xd3, 0x1d, 0xe8, 0x0d, 0x96, 0x6b, 0x62, 0xad, 0xd6, 0xc4, 0xda, 0xae, 0x09, 0x78, 0x53, 0x04,
+	0x7c, 0x28, 0x02, 0x3e, 0x15, 0x01, 0x4b, 0x45, 0xc0, 0x4a, 0x11, 0xf0, 0xad, 0x08, 0xf8, 0x51,
+	0xc4, 0xda, 0x2a, 0x02, 0xde, 0x37, 0xc4, 0x5a, 0x6e, 0x88, 0xb5, 0xda, 0x10, 0x6b, 0x7c, 0x75,
+	0xe2, 0x2d, 0x85, 0x35, 0x5d, 0xc5, 0xe3, 0xef, 0x00, 0x22, 0x99, 0x75, 0xb7, 0x69, 0x02, 0x00,
+	0x00,
+}
+
+func (this *AccountTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransaction)
+	if !ok {
+		that2, ok := that.(AccountTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if this.Roles != that1.Roles {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *AccountTransactionsBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionsBucket)
+	if !ok {
+		that2, ok := that.(AccountTransactionsBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTransactionsHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTransactionsHead)
+	if !ok {
+		that2, ok := that.(AccountTransactionsHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumBuckets != that1.NumBuckets {
+		return false
+	}
+	if this.NumTransactions != that1.NumTransactions {
+		return false
+	}
+	return true
+}
+func (this *BlockAccounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockAccounts)
+	if !ok {
+		that2, ok := that.(BlockAccounts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&accountTransactions.AccountTransaction{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTransactionsBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&accountTransactions.AccountTransactionsBucket{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTransactionsHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&accountTransactions.AccountTransactionsHead{")
+	s = append(s, "NumBuckets: "+fmt.Sprintf("%#v", this.NumBuckets)+",\n")
+	s = append(s, "NumTransactions: "+fmt.Sprintf("%#v", this.NumTransactions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockAccounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&accountTransactions.BlockAccounts{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountTransactions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Round != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Roles != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.Roles))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTransactionsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountTransactions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTransactionsHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTransactionsHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTransactionsHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTransactions != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.NumTransactions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumBuckets != 0 {
+		i = encodeVarintAccountTransactions(dAtA, i, uint64(m.NumBuckets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAccountTransactions(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountTransactions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountTransactions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAccountTransactions(uint64(l))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.MiniblockType))
+	}
+	if m.Roles != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Roles))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.BlockNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Round))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Timestamp))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *AccountTransactionsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovAccountTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountTransactionsHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBuckets != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.NumBuckets))
+	}
+	if m.NumTransactions != 0 {
+		n += 1 + sovAccountTransactions(uint64(m.NumTransactions))
+	}
+	return n
+}
+
+func (m *BlockAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAccountTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountTransactions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountTransactions(x uint64) (n int) {
+	return sovAccountTransactions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTransaction{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTransactionsBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*AccountTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "AccountTransaction", "AccountTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&AccountTransactionsBucket{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTransactionsHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTransactionsHead{`,
+		`NumBuckets:` + fmt.Sprintf("%v", this.NumBuckets) + `,`,
+		`NumTransactions:` + fmt.Sprintf("%v", this.NumTransactions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockAccounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockAccounts{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountTransactions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			m.Roles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Roles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTransactionsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &AccountTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTransactionsHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTransactionsHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTransactionsHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBuckets", wireType)
+			}
+			m.NumBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBuckets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTransactions", wireType)
+			}
+			m.NumTransactions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTransactions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountTransactions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountTransactions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountTransactions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountTransactions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountTransactions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountTransactions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountTransactions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountTransactions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,324 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. accountTransactions.proto
+
+package accountTransactions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dblookupext/accountTransactions")
+
+const (
+	// RoleSender signals that the account is the sender of the transaction
+	RoleSender uint32 = 1 << iota
+	// RoleReceiver signals that the account is the receiver of the transaction or smart contract result
+	RoleReceiver
+	// RoleESDTSender signals that the account sent ESDT tokens as a result of the transaction
+	RoleESDTSender
+	// RoleESDTReceiver signals that the account received ESDT tokens as a result of the transaction
+	RoleESDTReceiver
+)
+
+var roleNames = []struct {
+	role uint32
+	name string
+}{
+	{role: RoleSender, name: "sender"},
+	{role: RoleReceiver, name: "receiver"},
+	{role: RoleESDTSender, name: "esdtSender"},
+	{role: RoleESDTReceiver, name: "esdtReceiver"},
+}
+
+// ArgsAccountTransactionsIndexer holds all dependencies required by the account transactions indexer
+type ArgsAccountTransactionsIndexer struct {
+	Marshalizer                marshal.Marshalizer
+	AccountTransactionsStorer  storage.Storer
+	TransactionsStorer         storage.Storer
+	UnsignedTransactionsStorer storage.Storer
+	RewardTransactionsStorer   storage.Storer
+	MaxQueryPage               uint32
+}
+
+type accountTransactionsIndexer struct {
+	marshalizer                marshal.Marshalizer
+	store                      *accountTransactionsStore
+	transactionsStorer         storage.Storer
+	unsignedTransactionsStorer storage.Storer
+	rewardTransactionsStorer   storage.Storer
+	esdtTransferEvents         map[string]struct{}
+	mutex                      sync.RWMutex
+}
+
+// NewAccountTransactionsIndexer will create a new instance of the account transactions indexer
+func NewAccountTransactionsIndexer(args ArgsAccountTransactionsIndexer) (*accountTransactionsIndexer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AccountTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.TransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.UnsignedTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.RewardTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if args.MaxQueryPage == 0 {
+		return nil, errInvalidMaxQueryPage
+	}
+
+	return &accountTransactionsIndexer{
+		marshalizer:                args.Marshalizer,
+		store:                      newAccountTransactionsStore(args.Marshalizer, args.AccountTransactionsStorer, args.MaxQueryPage),
+		transactionsStorer:         args.TransactionsStorer,
+		unsignedTransactionsStorer: args.UnsignedTransactionsStorer,
+		rewardTransactionsStorer:   args.RewardTransactionsStorer,
+		esdtTransferEvents: map[string]struct{}{
+			core.BuiltInFunctionESDTTransfer:         {},
+			core.BuiltInFunctionESDTNFTTransfer:      {},
+			core.BuiltInFunctionMultiESDTNFTTransfer: {},
+		},
+	}, nil
+}
+
+// RecordBlock will index, for every involved account, the transactions and smart contract results of the provided block
+func (ati *accountTransactionsIndexer) RecordBlock(
+	blockHeader data.HeaderHandler,
+	blockBody data.BodyHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) error {
+	if check.IfNil(blockHeader) {
+		return errNilHeaderHandler
+	}
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return errCannotCastToBlockBody
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	collector := newBlockTransactionsCollector(blockHeader)
+	for _, miniblock := range body.MiniBlocks {
+		ati.collectMiniblockTransactions(collector, miniblock)
+	}
+	ati.collectSmartContractResults(collector, scrResultsFromPool)
+	ati.collectESDTTransfers(collector, logs)
+
+	return ati.saveCollectedTransactions(blockHeader.GetNonce(), collector)
+}
+
+func (ati *accountTransactionsIndexer) collectMiniblockTransactions(collector *blockTransactionsCollector, miniblock *block.MiniBlock) {
+	for _, txHash := range miniblock.TxHashes {
+		tx, err := ati.getTransaction(txHash, miniblock.Type)
+		if err != nil {
+			log.Trace("accountTransactionsIndexer.collectMiniblockTransactions", "txHash", txHash, "error", err)
+			continue
+		}
+
+		collector.addTransaction(txHash, miniblock.Type, tx)
+	}
+}
+
+func (ati *accountTransactionsIndexer) getTransaction(txHash []byte, miniblockType block.Type) (data.TransactionHandler, error) {
+	var storer storage.Storer
+	var tx data.TransactionHandler
+
+	switch miniblockType {
+	case block.TxBlock, block.InvalidBlock:
+		storer = ati.transactionsStorer
+		tx = &transaction.Transaction{}
+	case block.SmartContractResultBlock:
+		storer = ati.unsignedTransactionsStorer
+		tx = &smartContractResult.SmartContractResult{}
+	case block.RewardsBlock:
+		storer = ati.rewardTransactionsStorer
+		tx = &rewardTx.RewardTx{}
+	default:
+		return nil, errUnknownMiniblockType
+	}
+
+	txBytes, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ati.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (ati *accountTransactionsIndexer) collectSmartContractResults(collector *blockTransactionsCollector, scrResults map[string]data.TransactionHandler) {
+	scrHashes := make([]string, 0, len(scrResults))
+	for scrHash := range scrResults {
+		scrHashes = append(scrHashes, scrHash)
+	}
+	sort.Strings(scrHashes)
+
+	for _, scrHash := range scrHashes {
+		scr := scrResults[scrHash]
+		if check.IfNil(scr) {
+			continue
+		}
+
+		collector.addTransaction([]byte(scrHash), block.SmartContractResultBlock, scr)
+	}
+}
+
+func (ati *accountTransactionsIndexer) collectESDTTransfers(collector *blockTransactionsCollector, logs []*data.LogData) {
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(eventHandler) {
+				continue
+			}
+
+			_, isESDTTransfer := ati.esdtTransferEvents[string(eventHandler.GetIdentifier())]
+			if !isESDTTransfer {
+				continue
+			}
+
+			ati.collectESDTTransferParties(collector, []byte(logData.TxHash), eventHandler)
+		}
+	}
+}
+
+// collectESDTTransferParties uses the transfer events layout, in which the event address is the sender
+// and the last topic is the receiver of the tokens
+func (ati *accountTransactionsIndexer) collectESDTTransferParties(collector *blockTransactionsCollector, txHash []byte, event data.EventHandler) {
+	sender := event.GetAddress()
+	collector.addEntry(sender, txHash, block.TxBlock, RoleESDTSender)
+
+	topics := event.GetTopics()
+	if len(topics) == 0 {
+		return
+	}
+
+	receiver := topics[len(topics)-1]
+	if len(receiver) != len(sender) {
+		return
+	}
+
+	collector.addEntry(receiver, txHash, block.TxBlock, RoleESDTReceiver)
+}
+
+func (ati *accountTransactionsIndexer) saveCollectedTransactions(blockNonce uint64, collector *blockTransactionsCollector) error {
+	previousBlockAccounts, err := ati.store.getBlockAccounts(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	// accounts touched by a previously recorded block with the same nonce, not involved in this one, have to be cleaned up
+	for _, address := range previousBlockAccounts.Addresses {
+		if collector.hasAddress(address) {
+			continue
+		}
+
+		err = ati.store.removeTransactions(address, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	blockAccounts := &BlockAccounts{
+		Addresses: make([][]byte, 0, len(collector.addresses)),
+	}
+	for _, address := range collector.addresses {
+		err = ati.store.appendTransactions([]byte(address), blockNonce, collector.transactionsByAddress[address])
+		if err != nil {
+			return err
+		}
+
+		blockAccounts.Addresses = append(blockAccounts.Addresses, []byte(address))
+	}
+
+	if len(blockAccounts.Addresses) == 0 {
+		if len(previousBlockAccounts.Addresses) == 0 {
+			return nil
+		}
+
+		return ati.store.removeBlockAccounts(blockNonce)
+	}
+
+	return ati.store.saveBlockAccounts(blockNonce, blockAccounts)
+}
+
+// RevertBlock will remove the index entries added when the provided block was recorded
+func (ati *accountTransactionsIndexer) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	blockNonce := blockHeader.GetNonce()
+	blockAccounts, err := ati.store.getBlockAccounts(blockNonce)
+	if err != nil {
+		return err
+	}
+	if len(blockAccounts.Addresses) == 0 {
+		return nil
+	}
+
+	for _, address := range blockAccounts.Addresses {
+		err = ati.store.removeTransactions(address, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.store.removeBlockAccounts(blockNonce)
+}
+
+// GetAccountTransactions returns the indexed transactions of the provided address, most recent first, along with the
+// total number of indexed transactions of that address
+func (ati *accountTransactionsIndexer) GetAccountTransactions(
+	address []byte,
+	options common.AccountTransactionsQueryOptions,
+) ([]*AccountTransaction, uint64, error) {
+	ati.mutex.RLock()
+	defer ati.mutex.RUnlock()
+
+	return ati.store.getTransactions(address, options)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ati *accountTransactionsIndexer) IsInterfaceNil() bool {
+	return ati == nil
+}
+
+// RoleNames returns the human readable names of the roles encoded in the provided bitmask
+func RoleNames(roles uint32) []string {
+	names := make([]string, 0, len(roleNames))
+	for _, rn := range roleNames {
+		if roles&rn.role != 0 {
+			names = append(names, rn.name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,273 @@
+package accountTransactions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+	carol = []byte("carol")
+)
+
+func createMockArgsAccountTransactionsIndexer() ArgsAccountTransactionsIndexer {
+	return ArgsAccountTransactionsIndexer{
+		Marshalizer:                &testscommon.MarshalizerMock{},
+		AccountTransactionsStorer:  testscommon.CreateMemUnit(),
+		TransactionsStorer:         testscommon.CreateMemUnit(),
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+		MaxQueryPage:               100,
+	}
+}
+
+func putTransaction(t *testing.T, args ArgsAccountTransactionsIndexer, hash []byte, tx data.TransactionHandler) {
+	txBytes, err := args.Marshalizer.Marshal(tx)
+	require.Nil(t, err)
+
+	switch tx.(type) {
+	case *smartContractResult.SmartContractResult:
+		err = args.UnsignedTransactionsStorer.Put(hash, txBytes)
+	case *rewardTx.RewardTx:
+		err = args.RewardTransactionsStorer.Put(hash, txBytes)
+	default:
+		err = args.TransactionsStorer.Put(hash, txBytes)
+	}
+	require.Nil(t, err)
+}
+
+func getHashes(txs []*AccountTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.Hash))
+	}
+
+	return hashes
+}
+
+func TestNewAccountTransactionsIndexer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountTransactionsIndexer()
+	args.Marshalizer = nil
+	ati, err := NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	args.AccountTransactionsStorer = nil
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	args.TransactionsStorer = nil
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	args.UnsignedTransactionsStorer = nil
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	args.RewardTransactionsStorer = nil
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	args.MaxQueryPage = 0
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, ati)
+	require.Equal(t, errInvalidMaxQueryPage, err)
+
+	args = createMockArgsAccountTransactionsIndexer()
+	ati, err = NewAccountTransactionsIndexer(args)
+	require.Nil(t, err)
+	require.False(t, ati.IsInterfaceNil())
+}
+
+func TestAccountTransactionsIndexer_RecordBlockInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ati, _ := NewAccountTransactionsIndexer(createMockArgsAccountTransactionsIndexer())
+
+	err := ati.RecordBlock(nil, &block.Body{}, nil, nil)
+	require.Equal(t, errNilHeaderHandler, err)
+
+	err = ati.RecordBlock(&block.Header{}, nil, nil, nil)
+	require.Equal(t, errCannotCastToBlockBody, err)
+}
+
+func TestAccountTransactionsIndexer_RecordBlockShouldIndexAllParties(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountTransactionsIndexer()
+	putTransaction(t, args, []byte("tx"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob, Value: big.NewInt(1)})
+	putTransaction(t, args, []byte("reward"), &rewardTx.RewardTx{RcvAddr: carol, Value: big.NewInt(1)})
+	ati, _ := NewAccountTransactionsIndexer(args)
+
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 1, TimeStamp: 1000}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx"), []byte("missing")}},
+			{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}},
+			{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peer")}},
+		},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: bob, RcvAddr: alice, Value: big.NewInt(1)},
+	}
+	logs := []*data.LogData{
+		{
+			TxHash: "tx",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    bob,
+						Identifier: []byte(core.BuiltInFunctionESDTNFTTransfer),
+						Topics:     [][]byte{[]byte("NFT-abcdef"), {1}, {1}, []byte("dan")},
+					},
+					{
+						Address:    bob,
+						Identifier: []byte(core.BuiltInFunctionESDTLocalMint),
+						Topics:     [][]byte{[]byte("TKN-abcdef"), nil, {1}},
+					},
+				},
+			},
+		},
+	}
+
+	err := ati.RecordBlock(header, body, scrs, logs)
+	require.Nil(t, err)
+
+	txs, numTxs, err := ati.GetAccountTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), numTxs)
+	require.Equal(t, []string{"scr", "tx"}, getHashes(txs))
+	require.Equal(t, RoleReceiver, txs[0].Roles)
+	require.Equal(t, int32(block.SmartContractResultBlock), txs[0].MiniblockType)
+	require.Equal(t, RoleSender, txs[1].Roles)
+	require.Equal(t, uint64(7), txs[1].BlockNonce)
+	require.Equal(t, uint64(8), txs[1].Round)
+	require.Equal(t, uint32(1), txs[1].Epoch)
+	require.Equal(t, uint64(1000), txs[1].Timestamp)
+
+	txs, _, err = ati.GetAccountTransactions(bob, common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx"}, getHashes(txs))
+	require.Equal(t, RoleReceiver|RoleESDTSender, txs[0].Roles)
+
+	txs, _, err = ati.GetAccountTransactions(carol, common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"reward"}, getHashes(txs))
+	require.Equal(t, int32(block.RewardsBlock), txs[0].MiniblockType)
+
+	txs, _, err = ati.GetAccountTransactions([]byte("dan"), common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx"}, getHashes(txs))
+	require.Equal(t, RoleESDTReceiver, txs[0].Roles)
+}
+
+func TestAccountTransactionsIndexer_RevertBlockShouldRemoveEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountTransactionsIndexer()
+	putTransaction(t, args, []byte("tx1"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob})
+	putTransaction(t, args, []byte("tx2"), &transaction.Transaction{SndAddr: alice, RcvAddr: carol})
+	ati, _ := NewAccountTransactionsIndexer(args)
+
+	header1 := &block.Header{Nonce: 1}
+	body1 := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx1")}}}}
+	header2 := &block.Header{Nonce: 2}
+	body2 := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx2")}}}}
+
+	require.Nil(t, ati.RecordBlock(header1, body1, nil, nil))
+	require.Nil(t, ati.RecordBlock(header2, body2, nil, nil))
+
+	txs, numTxs, _ := ati.GetAccountTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Equal(t, uint64(2), numTxs)
+	require.Equal(t, []string{"tx2", "tx1"}, getHashes(txs))
+
+	err := ati.RevertBlock(header2)
+	require.Nil(t, err)
+
+	txs, numTxs, _ = ati.GetAccountTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Equal(t, uint64(1), numTxs)
+	require.Equal(t, []string{"tx1"}, getHashes(txs))
+
+	txs, numTxs, _ = ati.GetAccountTransactions(carol, common.AccountTransactionsQueryOptions{})
+	require.Zero(t, numTxs)
+	require.Empty(t, txs)
+
+	err = ati.RevertBlock(header2)
+	require.Nil(t, err)
+	err = ati.RevertBlock(nil)
+	require.Nil(t, err)
+}
+
+func TestAccountTransactionsIndexer_RecordSameNonceTwiceShouldReplaceEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountTransactionsIndexer()
+	putTransaction(t, args, []byte("txFork"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob})
+	putTransaction(t, args, []byte("txCanonical"), &transaction.Transaction{SndAddr: alice, RcvAddr: carol})
+	ati, _ := NewAccountTransactionsIndexer(args)
+
+	header := &block.Header{Nonce: 5}
+	bodyFork := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txFork")}}}}
+	bodyCanonical := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txCanonical")}}}}
+
+	require.Nil(t, ati.RecordBlock(header, bodyFork, nil, nil))
+	require.Nil(t, ati.RecordBlock(header, bodyCanonical, nil, nil))
+
+	txs, numTxs, _ := ati.GetAccountTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Equal(t, uint64(1), numTxs)
+	require.Equal(t, []string{"txCanonical"}, getHashes(txs))
+
+	txs, numTxs, _ = ati.GetAccountTransactions(bob, common.AccountTransactionsQueryOptions{})
+	require.Zero(t, numTxs)
+	require.Empty(t, txs)
+}
+
+func TestAccountTransactionsIndexer_RecordBlockStorerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAccountTransactionsIndexer()
+	putTransaction(t, args, []byte("tx"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob})
+	args.AccountTransactionsStorer = &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	ati, _ := NewAccountTransactionsIndexer(args)
+
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx")}}}}
+	err := ati.RecordBlock(&block.Header{Nonce: 1}, body, nil, nil)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestRoleNames(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, RoleNames(0))
+	require.Equal(t, []string{"sender"}, RoleNames(RoleSender))
+	require.Equal(t, []string{"receiver", "esdtSender"}, RoleNames(RoleReceiver|RoleESDTSender))
+}
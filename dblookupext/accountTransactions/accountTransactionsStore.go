@@ -0,0 +1,279 @@
+package accountTransactions
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	maxTransactionsInBucket = 100
+	defaultQuerySize        = 25
+	maxQuerySize            = 100
+
+	headKeyPrefix   = "head-"
+	bucketKeyPrefix = "bucket-"
+	blockKeyPrefix  = "block-"
+)
+
+// accountTransactionsStore keeps, for each account, an append-only list of transactions split in fixed size buckets.
+// The head record holds the number of buckets so that the most recent ones can be fetched without scanning.
+type accountTransactionsStore struct {
+	marshalizer  marshal.Marshalizer
+	storer       storage.Storer
+	maxQueryPage uint32
+}
+
+func newAccountTransactionsStore(marshalizer marshal.Marshalizer, storer storage.Storer, maxQueryPage uint32) *accountTransactionsStore {
+	return &accountTransactionsStore{
+		marshalizer:  marshalizer,
+		storer:       storer,
+		maxQueryPage: maxQueryPage,
+	}
+}
+
+// appendTransactions will add the provided transactions at the end of the account's list. Any previously recorded
+// transactions belonging to the same or higher block nonces are removed first, so that re-recording a block
+// (e.g. after a fork switch) does not leave stale entries behind
+func (ats *accountTransactionsStore) appendTransactions(address []byte, blockNonce uint64, txs []*AccountTransaction) error {
+	head, bucket, err := ats.truncate(address, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	if bucket == nil {
+		bucket = &AccountTransactionsBucket{}
+		head.NumBuckets++
+	}
+
+	for _, tx := range txs {
+		if len(bucket.Transactions) >= maxTransactionsInBucket {
+			err = ats.saveBucket(address, head.NumBuckets-1, bucket)
+			if err != nil {
+				return err
+			}
+
+			bucket = &AccountTransactionsBucket{}
+			head.NumBuckets++
+		}
+
+		bucket.Transactions = append(bucket.Transactions, tx)
+		head.NumTransactions++
+	}
+
+	err = ats.saveBucket(address, head.NumBuckets-1, bucket)
+	if err != nil {
+		return err
+	}
+
+	return ats.saveHead(address, head)
+}
+
+// removeTransactions will remove the account's transactions recorded at or after the provided block nonce
+func (ats *accountTransactionsStore) removeTransactions(address []byte, blockNonce uint64) error {
+	head, bucket, err := ats.truncate(address, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	if bucket != nil {
+		err = ats.saveBucket(address, head.NumBuckets-1, bucket)
+		if err != nil {
+			return err
+		}
+	}
+
+	if head.NumBuckets == 0 {
+		return ats.storer.Remove(headKey(address))
+	}
+
+	return ats.saveHead(address, head)
+}
+
+// truncate drops the transactions recorded at or after the provided block nonce. Emptied buckets are removed from
+// the storer, while the returned head and last bucket (nil if none is left) are only altered in memory
+func (ats *accountTransactionsStore) truncate(address []byte, blockNonce uint64) (*AccountTransactionsHead, *AccountTransactionsBucket, error) {
+	head, err := ats.getHead(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for head.NumBuckets > 0 {
+		bucket, errGet := ats.getBucket(address, head.NumBuckets-1)
+		if errGet != nil {
+			return nil, nil, errGet
+		}
+
+		numKept := len(bucket.Transactions)
+		for numKept > 0 && bucket.Transactions[numKept-1].BlockNonce >= blockNonce {
+			numKept--
+		}
+
+		head.NumTransactions -= uint64(len(bucket.Transactions) - numKept)
+		bucket.Transactions = bucket.Transactions[:numKept]
+		if numKept > 0 {
+			return head, bucket, nil
+		}
+
+		errRemove := ats.storer.Remove(bucketKey(address, head.NumBuckets-1))
+		if errRemove != nil {
+			return nil, nil, errRemove
+		}
+		head.NumBuckets--
+	}
+
+	return head, nil, nil
+}
+
+// getTransactions returns the account's transactions matching the provided options, most recent first, along with
+// the total number of indexed transactions of the account. The pages above the maximum query page are rejected, as
+// serving a page reads all the preceding transactions
+func (ats *accountTransactionsStore) getTransactions(
+	address []byte,
+	options common.AccountTransactionsQueryOptions,
+) ([]*AccountTransaction, uint64, error) {
+	if options.Page > ats.maxQueryPage {
+		return nil, 0, fmt.Errorf("%w: requested page %d, maximum page %d", errQueryPageTooHigh, options.Page, ats.maxQueryPage)
+	}
+
+	head, err := ats.getHead(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := options.Size
+	if size == 0 {
+		size = defaultQuerySize
+	}
+	if size > maxQuerySize {
+		size = maxQuerySize
+	}
+	numToSkip := uint64(options.Page) * uint64(size)
+
+	result := make([]*AccountTransaction, 0, size)
+	for bucketIndex := head.NumBuckets; bucketIndex > 0; bucketIndex-- {
+		bucket, errGet := ats.getBucket(address, bucketIndex-1)
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		for i := len(bucket.Transactions) - 1; i >= 0; i-- {
+			tx := bucket.Transactions[i]
+			if isBeforeRange(tx, options) {
+				return result, head.NumTransactions, nil
+			}
+			if isAfterRange(tx, options) {
+				continue
+			}
+			if numToSkip > 0 {
+				numToSkip--
+				continue
+			}
+
+			result = append(result, tx)
+			if uint32(len(result)) == size {
+				return result, head.NumTransactions, nil
+			}
+		}
+	}
+
+	return result, head.NumTransactions, nil
+}
+
+func isBeforeRange(tx *AccountTransaction, options common.AccountTransactionsQueryOptions) bool {
+	return tx.BlockNonce < options.FromNonce || tx.Timestamp < options.FromTimestamp
+}
+
+func isAfterRange(tx *AccountTransaction, options common.AccountTransactionsQueryOptions) bool {
+	isAfterNonce := options.ToNonce > 0 && tx.BlockNonce > options.ToNonce
+	isAfterTimestamp := options.ToTimestamp > 0 && tx.Timestamp > options.ToTimestamp
+
+	return isAfterNonce || isAfterTimestamp
+}
+
+func (ats *accountTransactionsStore) getHead(address []byte) (*AccountTransactionsHead, error) {
+	head := &AccountTransactionsHead{}
+	err := ats.getRecord(headKey(address), head)
+	if err == storage.ErrKeyNotFound {
+		return head, nil
+	}
+
+	return head, err
+}
+
+func (ats *accountTransactionsStore) saveHead(address []byte, head *AccountTransactionsHead) error {
+	return ats.putRecord(headKey(address), head)
+}
+
+func (ats *accountTransactionsStore) getBucket(address []byte, index uint64) (*AccountTransactionsBucket, error) {
+	bucket := &AccountTransactionsBucket{}
+	err := ats.getRecord(bucketKey(address, index), bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+func (ats *accountTransactionsStore) saveBucket(address []byte, index uint64, bucket *AccountTransactionsBucket) error {
+	return ats.putRecord(bucketKey(address, index), bucket)
+}
+
+func (ats *accountTransactionsStore) getBlockAccounts(blockNonce uint64) (*BlockAccounts, error) {
+	blockAccounts := &BlockAccounts{}
+	err := ats.getRecord(blockKey(blockNonce), blockAccounts)
+	if err == storage.ErrKeyNotFound {
+		return blockAccounts, nil
+	}
+
+	return blockAccounts, err
+}
+
+func (ats *accountTransactionsStore) saveBlockAccounts(blockNonce uint64, blockAccounts *BlockAccounts) error {
+	return ats.putRecord(blockKey(blockNonce), blockAccounts)
+}
+
+func (ats *accountTransactionsStore) removeBlockAccounts(blockNonce uint64) error {
+	return ats.storer.Remove(blockKey(blockNonce))
+}
+
+func (ats *accountTransactionsStore) getRecord(key []byte, record interface{}) error {
+	recordBytes, err := ats.storer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return ats.marshalizer.Unmarshal(record, recordBytes)
+}
+
+func (ats *accountTransactionsStore) putRecord(key []byte, record interface{}) error {
+	recordBytes, err := ats.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return ats.storer.Put(key, recordBytes)
+}
+
+func headKey(address []byte) []byte {
+	return append([]byte(headKeyPrefix), address...)
+}
+
+func bucketKey(address []byte, index uint64) []byte {
+	key := append([]byte(bucketKeyPrefix), address...)
+	return appendUint64(key, index)
+}
+
+func blockKey(blockNonce uint64) []byte {
+	return appendUint64([]byte(blockKeyPrefix), blockNonce)
+}
+
+func appendUint64(buff []byte, value uint64) []byte {
+	valueBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(valueBytes, value)
+
+	return append(buff, valueBytes...)
+}
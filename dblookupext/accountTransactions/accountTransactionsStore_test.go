@@ -0,0 +1,123 @@
+package accountTransactions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createStoreWithTransactions(t *testing.T, address []byte, numBlocks int, txsPerBlock int) *accountTransactionsStore {
+	store := newAccountTransactionsStore(&testscommon.MarshalizerMock{}, testscommon.CreateMemUnit(), 10)
+	for nonce := 1; nonce <= numBlocks; nonce++ {
+		txs := make([]*AccountTransaction, 0, txsPerBlock)
+		for i := 0; i < txsPerBlock; i++ {
+			txs = append(txs, &AccountTransaction{
+				Hash:       []byte{byte(nonce), byte(i)},
+				BlockNonce: uint64(nonce),
+				Timestamp:  uint64(nonce * 10),
+			})
+		}
+
+		err := store.appendTransactions(address, uint64(nonce), txs)
+		require.Nil(t, err)
+	}
+
+	return store
+}
+
+func TestAccountTransactionsStore_AppendShouldSplitInBuckets(t *testing.T) {
+	t.Parallel()
+
+	store := createStoreWithTransactions(t, alice, 30, 7)
+
+	head, err := store.getHead(alice)
+	require.Nil(t, err)
+	require.Equal(t, uint64(210), head.NumTransactions)
+	require.Equal(t, uint64(3), head.NumBuckets)
+
+	lastBucket, err := store.getBucket(alice, 2)
+	require.Nil(t, err)
+	require.Len(t, lastBucket.Transactions, 10)
+}
+
+func TestAccountTransactionsStore_GetTransactionsShouldPaginateAcrossBuckets(t *testing.T) {
+	t.Parallel()
+
+	store := createStoreWithTransactions(t, alice, 30, 7)
+
+	txs, numTxs, err := store.getTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(210), numTxs)
+	require.Len(t, txs, defaultQuerySize)
+	require.Equal(t, []byte{30, 6}, txs[0].Hash)
+
+	txs, _, err = store.getTransactions(alice, common.AccountTransactionsQueryOptions{Page: 2, Size: 50})
+	require.Nil(t, err)
+	require.Len(t, txs, 50)
+	// 100 entries skipped: 14 full blocks and 2 entries of the 15th one
+	require.Equal(t, []byte{16, 4}, txs[0].Hash)
+
+	txs, _, err = store.getTransactions(alice, common.AccountTransactionsQueryOptions{Size: 1000})
+	require.Nil(t, err)
+	require.Len(t, txs, maxQuerySize)
+
+	txs, _, err = store.getTransactions(alice, common.AccountTransactionsQueryOptions{Page: 10, Size: 100})
+	require.Nil(t, err)
+	require.Empty(t, txs)
+}
+
+func TestAccountTransactionsStore_GetTransactionsPageTooHighShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createStoreWithTransactions(t, alice, 30, 7)
+
+	txs, numTxs, err := store.getTransactions(alice, common.AccountTransactionsQueryOptions{Page: 11})
+	require.True(t, errors.Is(err, errQueryPageTooHigh))
+	require.Nil(t, txs)
+	require.Equal(t, uint64(0), numTxs)
+}
+
+func TestAccountTransactionsStore_GetTransactionsShouldFilterByNonceAndTimestamp(t *testing.T) {
+	t.Parallel()
+
+	store := createStoreWithTransactions(t, alice, 30, 2)
+
+	txs, _, err := store.getTransactions(alice, common.AccountTransactionsQueryOptions{FromNonce: 10, ToNonce: 12})
+	require.Nil(t, err)
+	require.Equal(t, []string{string([]byte{12, 1}), string([]byte{12, 0}), string([]byte{11, 1}),
+		string([]byte{11, 0}), string([]byte{10, 1}), string([]byte{10, 0})}, getHashes(txs))
+
+	txs, _, err = store.getTransactions(alice, common.AccountTransactionsQueryOptions{FromTimestamp: 285})
+	require.Nil(t, err)
+	require.Equal(t, []string{string([]byte{30, 1}), string([]byte{30, 0}), string([]byte{29, 1}),
+		string([]byte{29, 0})}, getHashes(txs))
+
+	txs, _, err = store.getTransactions(alice, common.AccountTransactionsQueryOptions{ToTimestamp: 10})
+	require.Nil(t, err)
+	require.Equal(t, []string{string([]byte{1, 1}), string([]byte{1, 0})}, getHashes(txs))
+}
+
+func TestAccountTransactionsStore_RemoveTransactionsShouldDropEmptiedBuckets(t *testing.T) {
+	t.Parallel()
+
+	store := createStoreWithTransactions(t, alice, 1, 250)
+
+	err := store.removeTransactions(alice, 1)
+	require.Nil(t, err)
+
+	head, err := store.getHead(alice)
+	require.Nil(t, err)
+	require.Zero(t, head.NumBuckets)
+	require.Zero(t, head.NumTransactions)
+
+	_, err = store.getBucket(alice, 0)
+	require.NotNil(t, err)
+
+	txs, numTxs, err := store.getTransactions(alice, common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Zero(t, numTxs)
+	require.Empty(t, txs)
+}
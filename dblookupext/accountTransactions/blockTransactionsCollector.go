@@ -0,0 +1,68 @@
+package accountTransactions
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+)
+
+// blockTransactionsCollector groups the transactions of a block by the involved accounts, merging the roles
+// an account has in the same transaction
+type blockTransactionsCollector struct {
+	header                data.HeaderHandler
+	addresses             []string
+	transactionsByAddress map[string][]*AccountTransaction
+	entries               map[string]*AccountTransaction
+}
+
+func newBlockTransactionsCollector(header data.HeaderHandler) *blockTransactionsCollector {
+	return &blockTransactionsCollector{
+		header:                header,
+		addresses:             make([]string, 0),
+		transactionsByAddress: make(map[string][]*AccountTransaction),
+		entries:               make(map[string]*AccountTransaction),
+	}
+}
+
+func (btc *blockTransactionsCollector) addTransaction(txHash []byte, miniblockType block.Type, tx data.TransactionHandler) {
+	if miniblockType != block.SmartContractResultBlock && miniblockType != block.RewardsBlock {
+		btc.addEntry(tx.GetSndAddr(), txHash, miniblockType, RoleSender)
+	}
+
+	btc.addEntry(tx.GetRcvAddr(), txHash, miniblockType, RoleReceiver)
+}
+
+func (btc *blockTransactionsCollector) addEntry(address []byte, txHash []byte, miniblockType block.Type, role uint32) {
+	if len(address) == 0 {
+		return
+	}
+
+	entryKey := string(address) + string(txHash)
+	existingEntry, found := btc.entries[entryKey]
+	if found {
+		existingEntry.Roles |= role
+		return
+	}
+
+	entry := &AccountTransaction{
+		Hash:          txHash,
+		MiniblockType: int32(miniblockType),
+		Roles:         role,
+		BlockNonce:    btc.header.GetNonce(),
+		Round:         btc.header.GetRound(),
+		Timestamp:     btc.header.GetTimeStamp(),
+		Epoch:         btc.header.GetEpoch(),
+	}
+	btc.entries[entryKey] = entry
+
+	addressStr := string(address)
+	_, isKnownAddress := btc.transactionsByAddress[addressStr]
+	if !isKnownAddress {
+		btc.addresses = append(btc.addresses, addressStr)
+	}
+	btc.transactionsByAddress[addressStr] = append(btc.transactionsByAddress[addressStr], entry)
+}
+
+func (btc *blockTransactionsCollector) hasAddress(address []byte) bool {
+	_, found := btc.transactionsByAddress[string(address)]
+	return found
+}
@@ -0,0 +1,13 @@
+package accountTransactions
+
+import "errors"
+
+var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+var errNilHeaderHandler = errors.New("nil header handler")
+
+var errUnknownMiniblockType = errors.New("unknown miniblock type")
+
+var errInvalidMaxQueryPage = errors.New("invalid maximum query page")
+
+var errQueryPageTooHigh = errors.New("query page too high")
@@ -0,0 +1,39 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
+)
+
+var errAccountTransactionsIndexDisabled = errors.New("account transactions index is disabled")
+
+type accountTransactionsIndexer struct {
+}
+
+// NewAccountTransactionsIndexer returns a disabled account transactions indexer
+func NewAccountTransactionsIndexer() *accountTransactionsIndexer {
+	return &accountTransactionsIndexer{}
+}
+
+// RecordBlock does nothing
+func (ati *accountTransactionsIndexer) RecordBlock(_ data.HeaderHandler, _ data.BodyHandler, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ati *accountTransactionsIndexer) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetAccountTransactions returns a disabled index error
+func (ati *accountTransactionsIndexer) GetAccountTransactions(_ []byte, _ common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error) {
+	return nil, 0, errAccountTransactionsIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ati *accountTransactionsIndexer) IsInterfaceNil() bool {
+	return ati == nil
+}
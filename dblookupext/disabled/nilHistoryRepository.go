@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
@@ -52,6 +54,16 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetESDTNoncesSupplies -
+func (nhr *nilHistoryRepository) GetESDTNoncesSupplies(_ string, _ uint32, _ uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
+// GetAccountTransactions -
+func (nhr *nilHistoryRepository) GetAccountTransactions(_ []byte, _ common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
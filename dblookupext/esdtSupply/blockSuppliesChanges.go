@@ -0,0 +1,273 @@
+package esdtSupply
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	balanceKeyPrefix      = "balance-"
+	balanceKeySeparator   = "@"
+	blockChangesKeyPrefix = "block-changes-"
+)
+
+// blockSuppliesChanges accumulates the supplies and balances altered by the logs of a block, so that they are
+// loaded and saved only once per block
+type blockSuppliesChanges struct {
+	marshalizer    marshal.Marshalizer
+	suppliesStorer storage.Storer
+	blockNonce     uint64
+	isRevert       bool
+	supplies       map[string]*SupplyESDT
+	balances       map[string]*big.Int
+	undo           *BlockSupplyChanges
+}
+
+func newBlockSuppliesChanges(
+	marshalizer marshal.Marshalizer,
+	suppliesStorer storage.Storer,
+	blockNonce uint64,
+	isRevert bool,
+) (*blockSuppliesChanges, error) {
+	bsc := &blockSuppliesChanges{
+		marshalizer:    marshalizer,
+		suppliesStorer: suppliesStorer,
+		blockNonce:     blockNonce,
+		isRevert:       isRevert,
+		supplies:       make(map[string]*SupplyESDT),
+		balances:       make(map[string]*big.Int),
+		undo:           &BlockSupplyChanges{},
+	}
+	if !isRevert {
+		return bsc, nil
+	}
+
+	err := getRecord(marshalizer, suppliesStorer, blockChangesKey(blockNonce), bsc.undo)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+
+	return bsc, nil
+}
+
+// updateSupply alters the supply records of the provided token. The first key is the one of the token (or of the
+// nonce, for NFTs and SFTs) while the optional second key is the one of the collection
+func (bsc *blockSuppliesChanges) updateSupply(keys *tokenKeys, update func(supply *SupplyESDT)) error {
+	for _, key := range keys.all() {
+		supply, err := bsc.getSupply(key, keys)
+		if err != nil {
+			return err
+		}
+
+		update(supply)
+		supply.LastUpdateBlockNonce = bsc.blockNonce
+	}
+
+	return nil
+}
+
+// updateBalance alters the balance the provided address holds from the token and keeps the number of holders of the
+// token in sync with it
+func (bsc *blockSuppliesChanges) updateBalance(keys *tokenKeys, address []byte, delta *big.Int) error {
+	for _, key := range keys.all() {
+		balance, err := bsc.getBalance(key, address)
+		if err != nil {
+			return err
+		}
+
+		wasHolder := balance.Sign() > 0
+		balance.Add(balance, delta)
+		isHolder := balance.Sign() > 0
+		if wasHolder == isHolder {
+			continue
+		}
+
+		supply, err := bsc.getSupply(key, keys)
+		if err != nil {
+			return err
+		}
+
+		supply.LastUpdateBlockNonce = bsc.blockNonce
+		if isHolder {
+			supply.NumHolders++
+			continue
+		}
+		if supply.NumHolders > 0 {
+			supply.NumHolders--
+		}
+	}
+
+	return nil
+}
+
+func (bsc *blockSuppliesChanges) getSupply(key []byte, keys *tokenKeys) (*SupplyESDT, error) {
+	supply, found := bsc.supplies[string(key)]
+	if found {
+		return supply, nil
+	}
+
+	supply, err := getSupplyFromStorage(bsc.marshalizer, bsc.suppliesStorer, key)
+	if err == storage.ErrKeyNotFound {
+		supply = newSupplyESDTZero()
+		bsc.onNewSupply(key, keys)
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bsc.supplies[string(key)] = supply
+
+	return supply, nil
+}
+
+func (bsc *blockSuppliesChanges) onNewSupply(key []byte, keys *tokenKeys) {
+	isNonceKey := keys.isNFT() && bytes.Equal(key, keys.tokenKey)
+	if !isNonceKey || bsc.isRevert {
+		return
+	}
+
+	bsc.undo.IndexedNonces = append(bsc.undo.IndexedNonces, &IndexedNonce{
+		Collection: keys.collectionKey,
+		Nonce:      keys.nonce,
+	})
+}
+
+func (bsc *blockSuppliesChanges) getBalance(key []byte, address []byte) (*big.Int, error) {
+	holderKey := balanceKey(key, address)
+	balance, found := bsc.balances[string(holderKey)]
+	if found {
+		return balance, nil
+	}
+
+	holderBalance := &HolderBalance{}
+	err := getRecord(bsc.marshalizer, bsc.suppliesStorer, holderKey, holderBalance)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+
+	balance = big.NewInt(0)
+	if holderBalance.Balance != nil {
+		balance.Set(holderBalance.Balance)
+	}
+	bsc.balances[string(holderKey)] = balance
+
+	return balance, nil
+}
+
+// getWipedAmount returns the amount removed by a wipe operation. As the wipe events do not hold the amount, it is
+// the balance of the wiped account when processing the block and it is read back from the block changes on revert
+func (bsc *blockSuppliesChanges) getWipedAmount(keys *tokenKeys, address []byte) (*big.Int, error) {
+	if bsc.isRevert {
+		for _, wiped := range bsc.undo.WipedBalances {
+			if bytes.Equal(wiped.TokenKey, keys.tokenKey) && bytes.Equal(wiped.Address, address) {
+				return big.NewInt(0).Set(wiped.Amount), nil
+			}
+		}
+
+		return big.NewInt(0), nil
+	}
+
+	balance, err := bsc.getBalance(keys.tokenKey, address)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := big.NewInt(0).Set(balance)
+	bsc.undo.WipedBalances = append(bsc.undo.WipedBalances, &WipedBalance{
+		TokenKey: keys.tokenKey,
+		Address:  address,
+		Amount:   amount,
+	})
+
+	return amount, nil
+}
+
+func (bsc *blockSuppliesChanges) save(index *noncesIndex) error {
+	err := bsc.saveIndexedNonces(index)
+	if err != nil {
+		return err
+	}
+
+	for key, supply := range bsc.supplies {
+		if bsc.isRevert && bsc.wasIndexedInBlock(key) {
+			err = bsc.suppliesStorer.Remove([]byte(key))
+		} else {
+			err = putRecord(bsc.marshalizer, bsc.suppliesStorer, []byte(key), supply)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, balance := range bsc.balances {
+		if balance.Sign() == 0 {
+			err = bsc.suppliesStorer.Remove([]byte(key))
+		} else {
+			err = putRecord(bsc.marshalizer, bsc.suppliesStorer, []byte(key), &HolderBalance{Balance: balance})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return bsc.saveUndo()
+}
+
+func (bsc *blockSuppliesChanges) saveIndexedNonces(index *noncesIndex) error {
+	if !bsc.isRevert {
+		for _, indexedNonce := range bsc.undo.IndexedNonces {
+			err := index.appendNonce(indexedNonce.Collection, indexedNonce.Nonce)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for i := len(bsc.undo.IndexedNonces) - 1; i >= 0; i-- {
+		err := index.removeLastNonce(bsc.undo.IndexedNonces[i].Collection)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bsc *blockSuppliesChanges) wasIndexedInBlock(key string) bool {
+	for _, indexedNonce := range bsc.undo.IndexedNonces {
+		if key == string(nonceTokenKey(indexedNonce.Collection, indexedNonce.Nonce)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bsc *blockSuppliesChanges) saveUndo() error {
+	isEmpty := len(bsc.undo.IndexedNonces) == 0 && len(bsc.undo.WipedBalances) == 0
+	if isEmpty {
+		return nil
+	}
+	if bsc.isRevert {
+		return bsc.suppliesStorer.Remove(blockChangesKey(bsc.blockNonce))
+	}
+
+	return putRecord(bsc.marshalizer, bsc.suppliesStorer, blockChangesKey(bsc.blockNonce), bsc.undo)
+}
+
+func balanceKey(key []byte, address []byte) []byte {
+	holderKey := append([]byte(balanceKeyPrefix), key...)
+	holderKey = append(holderKey, balanceKeySeparator...)
+
+	return append(holderKey, address...)
+}
+
+func blockChangesKey(blockNonce uint64) []byte {
+	return appendUint64([]byte(blockChangesKeyPrefix), blockNonce)
+}
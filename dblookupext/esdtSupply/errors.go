@@ -3,3 +3,11 @@ package esdtSupply
 import "errors"
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+var errNilShardCoordinator = errors.New("nil shard coordinator")
+
+var errIncompleteChain = errors.New("the blocks in storage do not form a complete chain")
+
+var errMissingLastCommittedBlock = errors.New("the last committed block is unknown")
+
+var errMissingOlderBlocks = errors.New("the older blocks are no longer in storage, the supplies can only be partially rebuilt")
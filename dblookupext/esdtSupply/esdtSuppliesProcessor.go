@@ -10,35 +10,49 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var log = logger.GetOrCreate("dblookupext/esdtSupply")
 
+// NonceSupplyESDT holds the supply of one nonce of a NFT or SFT collection
+type NonceSupplyESDT struct {
+	Nonce  uint64
+	Supply *SupplyESDT
+}
+
+// ArgsSuppliesProcessor holds all dependencies required by the supplies processor
+type ArgsSuppliesProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	SuppliesStorer   storage.Storer
+	LogsStorer       storage.Storer
+	ShardCoordinator sharding.Coordinator
+}
+
 type suppliesProcessor struct {
 	logsProc *logsProcessor
 	logsGet  *logsGetter
-	mutex    sync.Mutex
+	mutex    sync.RWMutex
 }
 
 // NewSuppliesProcessor will create a new instance of the supplies processor
-func NewSuppliesProcessor(
-	marshalizer marshal.Marshalizer,
-	suppliesStorer storage.Storer,
-	logsStorer storage.Storer,
-) (*suppliesProcessor, error) {
-	if check.IfNil(marshalizer) {
+func NewSuppliesProcessor(args ArgsSuppliesProcessor) (*suppliesProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
 		return nil, core.ErrNilMarshalizer
 	}
-	if check.IfNil(suppliesStorer) {
+	if check.IfNil(args.SuppliesStorer) {
 		return nil, core.ErrNilStore
 	}
-	if check.IfNil(logsStorer) {
+	if check.IfNil(args.LogsStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, errNilShardCoordinator
+	}
 
-	logsGet := newLogsGetter(marshalizer, logsStorer)
-	logsProc := newLogsProcessor(marshalizer, suppliesStorer)
+	logsGet := newLogsGetter(args.Marshalizer, args.LogsStorer)
+	logsProc := newLogsProcessor(args.Marshalizer, args.SuppliesStorer, args.ShardCoordinator)
 
 	return &suppliesProcessor{
 		logsProc: logsProc,
@@ -78,11 +92,24 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 	return sp.logsProc.processLogs(header.GetNonce(), logsFromDB, true)
 }
 
-// GetESDTSupply will return the supply from the storage for the given token
+// GetESDTSupply will return the supply from the storage for the given token. For NFTs and SFTs, the token can either
+// be the collection, in which case the aggregated supply of all its nonces is returned, or a collection nonce
 func (sp *suppliesProcessor) GetESDTSupply(token string) (*SupplyESDT, error) {
+	sp.mutex.RLock()
+	defer sp.mutex.RUnlock()
+
 	return sp.logsProc.getESDTSupply([]byte(token))
 }
 
+// GetESDTNoncesSupplies will return the supplies of the nonces of the given collection, in the order they were seen
+// in the current shard, along with the total number of nonces
+func (sp *suppliesProcessor) GetESDTNoncesSupplies(collection string, page uint32, size uint32) ([]*NonceSupplyESDT, uint64, error) {
+	sp.mutex.RLock()
+	defer sp.mutex.RUnlock()
+
+	return sp.logsProc.getNoncesSupplies([]byte(collection), page, size)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sp *suppliesProcessor) IsInterfaceNil() bool {
 	return sp == nil
@@ -1,6 +1,7 @@
 package esdtSupply
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -27,19 +28,37 @@ const (
 	testFungibleTokenBurn  = 25
 )
 
+func createArgsSuppliesProcessor(marshalizer marshal.Marshalizer, suppliesStorer storage.Storer, logsStorer storage.Storer) ArgsSuppliesProcessor {
+	return ArgsSuppliesProcessor{
+		Marshalizer:      marshalizer,
+		SuppliesStorer:   suppliesStorer,
+		LogsStorer:       logsStorer,
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	}
+}
+
 func TestNewSuppliesProcessor(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	args := createArgsSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	_, err := NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, nil, &storageStubs.StorerStub{})
+	args = createArgsSuppliesProcessor(&testscommon.MarshalizerMock{}, nil, &storageStubs.StorerStub{})
+	_, err = NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilStore, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, nil)
+	args = createArgsSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, nil)
+	_, err = NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilStore, err)
 
-	proc, err := NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	args = createArgsSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	args.ShardCoordinator = nil
+	_, err = NewSuppliesProcessor(args)
+	require.Equal(t, errNilShardCoordinator, err)
+
+	args = createArgsSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	proc, err := NewSuppliesProcessor(args)
 	require.Nil(t, err)
 	require.NotNil(t, proc)
 	require.False(t, proc.IsInterfaceNil())
@@ -96,13 +115,11 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 			return nil, storage.ErrKeyNotFound
 		},
 		PutCalled: func(key, data []byte) error {
-			if string(key) == "processed-block" {
+			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
+			if string(key) != supplyKey {
 				return nil
 			}
 
-			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
-			require.Equal(t, supplyKey, string(key))
-
 			var supplyESDT SupplyESDT
 			_ = marshalizer.Unmarshal(&supplyESDT, data)
 			require.Equal(t, big.NewInt(30), supplyESDT.Supply)
@@ -113,7 +130,7 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(createArgsSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}))
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logs)
@@ -242,7 +259,7 @@ func TestProcessLogsSaveSupplyShouldUpdateSupplyMintedAndBurned(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(createArgsSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}))
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsCreate)
@@ -318,7 +335,7 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingMinting(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound("", 0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(createArgsSuppliesProcessor(marshalizer, suppliesStorer, logsStorer))
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
@@ -409,7 +426,7 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingBurning(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound("", 0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(createArgsSuppliesProcessor(marshalizer, suppliesStorer, logsStorer))
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
@@ -473,7 +490,7 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &testscommon.MarshalizerMock{}
-	proc, _ := NewSuppliesProcessor(marshalizer, &storageStubs.StorerStub{
+	suppliesStorer := &storageStubs.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
 			if string(key) == "my-token" {
 				supply := &SupplyESDT{Supply: big.NewInt(123456)}
@@ -481,7 +498,8 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 			}
 			return nil, errors.New("local err")
 		},
-	}, &storageStubs.StorerStub{})
+	}
+	proc, _ := NewSuppliesProcessor(createArgsSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}))
 
 	res, err := proc.GetESDTSupply("my-token")
 	require.Nil(t, err)
@@ -489,7 +507,123 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 		Supply: big.NewInt(123456),
 		Burned: big.NewInt(0),
 		Minted: big.NewInt(0),
+		Wiped:  big.NewInt(0),
 	}
 
 	require.Equal(t, expectedESDTSupply, res)
 }
+
+func createNFTEvent(identifier string, caller []byte, collection []byte, nonce uint64, value int64, receiver []byte) *transaction.Event {
+	topics := [][]byte{collection, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(value).Bytes()}
+	if len(receiver) > 0 {
+		topics = append(topics, receiver)
+	}
+
+	return &transaction.Event{
+		Address:    caller,
+		Identifier: []byte(identifier),
+		Topics:     topics,
+	}
+}
+
+func TestSuppliesProcessor_NFTSuppliesHoldersWipeAndRevert(t *testing.T) {
+	t.Parallel()
+
+	collection := []byte("NFT-abcdef")
+	alice := []byte("alice")
+	bob := []byte("bob")
+	carolOtherShard := []byte("carol")
+	esdtSC := []byte("esdt-sc")
+
+	marshalizer := &testscommon.MarshalizerMock{}
+	suppliesStorer := testscommon.CreateMemUnit()
+	logsStorer := testscommon.CreateMemUnit()
+	args := createArgsSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+		NoShards: 2,
+		ComputeIdCalled: func(address []byte) uint32 {
+			if bytes.Equal(address, carolOtherShard) {
+				return 1
+			}
+			return 0
+		},
+	}
+	suppliesProc, err := NewSuppliesProcessor(args)
+	require.Nil(t, err)
+
+	firstBlockLog := &transaction.Log{
+		Events: []*transaction.Event{
+			createNFTEvent(core.BuiltInFunctionESDTNFTCreate, alice, collection, 1, 10, nil),
+			createNFTEvent(core.BuiltInFunctionESDTNFTCreate, alice, collection, 2, 5, nil),
+			createNFTEvent(core.BuiltInFunctionESDTNFTTransfer, alice, collection, 1, 4, bob),
+			createNFTEvent(core.BuiltInFunctionESDTNFTTransfer, alice, collection, 2, 5, carolOtherShard),
+		},
+	}
+	err = suppliesProc.ProcessLogs(1, []*data.LogData{{TxHash: "txHash1", LogHandler: firstBlockLog}})
+	require.Nil(t, err)
+
+	checkSupply := func(token string, supply, minted, burned, wiped int64, numHolders uint64) {
+		res, errGet := suppliesProc.GetESDTSupply(token)
+		require.Nil(t, errGet)
+		require.Equal(t, big.NewInt(supply), res.Supply, token)
+		require.Equal(t, big.NewInt(minted), res.Minted, token)
+		require.Equal(t, big.NewInt(burned), res.Burned, token)
+		require.Equal(t, big.NewInt(wiped), res.Wiped, token)
+		require.Equal(t, numHolders, res.NumHolders, token)
+	}
+	checkFirstBlockState := func() {
+		checkSupply("NFT-abcdef", 15, 15, 0, 0, 2)
+		checkSupply("NFT-abcdef-01", 10, 10, 0, 0, 2)
+		checkSupply("NFT-abcdef-02", 5, 5, 0, 0, 0)
+
+		noncesSupplies, numNonces, errGet := suppliesProc.GetESDTNoncesSupplies(string(collection), 0, 0)
+		require.Nil(t, errGet)
+		require.Equal(t, uint64(2), numNonces)
+		require.Len(t, noncesSupplies, 2)
+		require.Equal(t, uint64(1), noncesSupplies[0].Nonce)
+		require.Equal(t, big.NewInt(10), noncesSupplies[0].Supply.Supply)
+		require.Equal(t, uint64(2), noncesSupplies[1].Nonce)
+		require.Equal(t, big.NewInt(5), noncesSupplies[1].Supply.Supply)
+	}
+	checkFirstBlockState()
+
+	secondBlockLog := &transaction.Log{
+		Events: []*transaction.Event{
+			createNFTEvent(core.BuiltInFunctionESDTNFTBurn, alice, collection, 1, 6, nil),
+			createNFTEvent(core.BuiltInFunctionESDTWipe, esdtSC, collection, 1, 0, bob),
+			createNFTEvent(core.BuiltInFunctionESDTNFTCreate, bob, collection, 3, 1, nil),
+		},
+	}
+	secondBlockLogBytes, err := marshalizer.Marshal(secondBlockLog)
+	require.Nil(t, err)
+	err = logsStorer.Put([]byte("txHash2"), secondBlockLogBytes)
+	require.Nil(t, err)
+
+	err = suppliesProc.ProcessLogs(2, []*data.LogData{{TxHash: "txHash2", LogHandler: secondBlockLog}})
+	require.Nil(t, err)
+
+	checkSupply("NFT-abcdef", 6, 16, 6, 4, 1)
+	checkSupply("NFT-abcdef-01", 0, 10, 6, 4, 0)
+	checkSupply("NFT-abcdef-03", 1, 1, 0, 0, 1)
+	res, err := suppliesProc.GetESDTSupply("NFT-abcdef")
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), res.LastUpdateBlockNonce)
+
+	_, numNonces, err := suppliesProc.GetESDTNoncesSupplies(string(collection), 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), numNonces)
+
+	revertedHeader := &block.Header{Nonce: 2}
+	revertedBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{[]byte("txHash2")},
+			},
+		},
+	}
+	err = suppliesProc.RevertChanges(revertedHeader, revertedBody)
+	require.Nil(t, err)
+
+	checkFirstBlockState()
+	checkSupply("NFT-abcdef-03", 0, 0, 0, 0, 0)
+}
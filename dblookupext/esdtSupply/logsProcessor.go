@@ -6,39 +6,61 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"sort"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+const minTopicsForReceiver = 4
+
+type operationType int
+
+const (
+	mintOperation operationType = iota
+	burnOperation
+	wipeOperation
+	transferOperation
+)
+
 type logsProcessor struct {
-	marshalizer        marshal.Marshalizer
-	suppliesStorer     storage.Storer
-	nonceProc          *nonceProcessor
-	fungibleOperations map[string]struct{}
+	marshalizer      marshal.Marshalizer
+	suppliesStorer   storage.Storer
+	shardCoordinator sharding.Coordinator
+	nonceProc        *nonceProcessor
+	noncesIdx        *noncesIndex
+	operations       map[string]operationType
 }
 
 func newLogsProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
+	shardCoordinator sharding.Coordinator,
 ) *logsProcessor {
 	nonceProc := newNonceProcessor(marshalizer, suppliesStorer)
 
 	return &logsProcessor{
-		nonceProc:      nonceProc,
-		marshalizer:    marshalizer,
-		suppliesStorer: suppliesStorer,
-		fungibleOperations: map[string]struct{}{
-			core.BuiltInFunctionESDTLocalBurn:      {},
-			core.BuiltInFunctionESDTLocalMint:      {},
-			core.BuiltInFunctionESDTWipe:           {},
-			core.BuiltInFunctionESDTNFTCreate:      {},
-			core.BuiltInFunctionESDTNFTAddQuantity: {},
-			core.BuiltInFunctionESDTNFTBurn:        {},
+		nonceProc:        nonceProc,
+		noncesIdx:        newNoncesIndex(marshalizer, suppliesStorer),
+		marshalizer:      marshalizer,
+		suppliesStorer:   suppliesStorer,
+		shardCoordinator: shardCoordinator,
+		operations: map[string]operationType{
+			core.BuiltInFunctionESDTLocalMint:        mintOperation,
+			core.BuiltInFunctionESDTNFTCreate:        mintOperation,
+			core.BuiltInFunctionESDTNFTAddQuantity:   mintOperation,
+			core.BuiltInFunctionESDTLocalBurn:        burnOperation,
+			core.BuiltInFunctionESDTNFTBurn:          burnOperation,
+			core.BuiltInFunctionESDTBurn:             burnOperation,
+			core.BuiltInFunctionESDTWipe:             wipeOperation,
+			core.BuiltInFunctionESDTTransfer:         transferOperation,
+			core.BuiltInFunctionESDTNFTTransfer:      transferOperation,
+			core.BuiltInFunctionMultiESDTNFTTransfer: transferOperation,
 		},
 	}
 }
@@ -52,19 +74,31 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 		return nil
 	}
 
-	supplies := make(map[string]*SupplyESDT)
-	for _, logHandler := range logs {
+	changes, err := newBlockSuppliesChanges(lp.marshalizer, lp.suppliesStorer, blockNonce, isRevert)
+	if err != nil {
+		return err
+	}
+
+	// the logs are processed in a deterministic order as the amounts removed by wipe operations depend on it
+	txHashes := make([]string, 0, len(logs))
+	for txHash := range logs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	for _, txHash := range txHashes {
+		logHandler := logs[txHash]
 		if logHandler == nil || check.IfNil(logHandler.LogHandler) {
 			continue
 		}
 
-		errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert)
+		errProc := lp.processLog(logHandler.LogHandler, changes)
 		if errProc != nil {
 			return errProc
 		}
 	}
 
-	err = lp.saveSupplies(supplies)
+	err = changes.save(lp.noncesIdx)
 	if err != nil {
 		return err
 	}
@@ -72,7 +106,7 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processLog(txLog data.LogHandler, changes *blockSuppliesChanges) error {
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -83,11 +117,12 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 			continue
 		}
 
-		if lp.shouldIgnoreEvent(event) {
+		operation, found := lp.operations[string(event.Identifier)]
+		if !found {
 			continue
 		}
 
-		err := lp.processEvent(event, supplies, isRevert)
+		err := lp.processEvent(event, operation, changes)
 		if err != nil {
 			return err
 		}
@@ -96,95 +131,139 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 	return nil
 }
 
-func (lp *logsProcessor) saveSupplies(supplies map[string]*SupplyESDT) error {
-	for identifier, supplyESDT := range supplies {
-		supplyESDTBytes, err := lp.marshalizer.Marshal(supplyESDT)
-		if err != nil {
-			return err
-		}
+func (lp *logsProcessor) processEvent(txLog *transaction.Event, operation operationType, changes *blockSuppliesChanges) error {
+	if len(txLog.Topics) < 3 {
+		return nil
+	}
 
-		err = lp.suppliesStorer.Put([]byte(identifier), supplyESDTBytes)
-		if err != nil {
-			return err
-		}
+	keys := newTokenKeys(txLog.Topics[0], txLog.Topics[1])
+	value := big.NewInt(0).SetBytes(txLog.Topics[2])
+
+	switch operation {
+	case mintOperation:
+		return lp.processMint(keys, value, txLog.Address, changes)
+	case burnOperation:
+		return lp.processBurn(keys, value, txLog.Address, changes)
+	case wipeOperation:
+		return lp.processWipe(keys, value, txLog, changes)
+	default:
+		return lp.processTransfer(keys, value, txLog, changes)
 	}
+}
 
-	return nil
+func (lp *logsProcessor) processMint(keys *tokenKeys, value *big.Int, minter []byte, changes *blockSuppliesChanges) error {
+	delta := signedValue(value, changes.isRevert)
+	err := changes.updateSupply(keys, func(supply *SupplyESDT) {
+		supply.Minted.Add(supply.Minted, delta)
+		supply.Supply.Add(supply.Supply, delta)
+	})
+	if err != nil {
+		return err
+	}
+
+	return lp.updateBalance(keys, minter, delta, changes)
 }
 
-func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[string]*SupplyESDT, isRevert bool) error {
-	if len(txLog.Topics) < 3 {
+func (lp *logsProcessor) processBurn(keys *tokenKeys, value *big.Int, burner []byte, changes *blockSuppliesChanges) error {
+	delta := signedValue(value, changes.isRevert)
+	err := changes.updateSupply(keys, func(supply *SupplyESDT) {
+		supply.Burned.Add(supply.Burned, delta)
+		supply.Supply.Sub(supply.Supply, delta)
+	})
+	if err != nil {
+		return err
+	}
+
+	return lp.updateBalance(keys, burner, big.NewInt(0).Neg(delta), changes)
+}
+
+func (lp *logsProcessor) processWipe(keys *tokenKeys, value *big.Int, txLog *transaction.Event, changes *blockSuppliesChanges) error {
+	if len(txLog.Topics) < minTopicsForReceiver {
 		return nil
 	}
 
-	tokenIdentifier := txLog.Topics[0]
-	if len(txLog.Topics[1]) != 0 {
-		nonceBytes := txLog.Topics[1]
-		nonceHexStr := hex.EncodeToString(nonceBytes)
+	wipedAddress := txLog.Topics[minTopicsForReceiver-1]
+	if value.Sign() == 0 {
+		var err error
+		value, err = changes.getWipedAmount(keys, wipedAddress)
+		if err != nil {
+			return err
+		}
+	}
 
-		tokenIdentifier = bytes.Join([][]byte{tokenIdentifier, []byte(nonceHexStr)}, []byte("-"))
+	delta := signedValue(value, changes.isRevert)
+	err := changes.updateSupply(keys, func(supply *SupplyESDT) {
+		supply.Wiped.Add(supply.Wiped, delta)
+		supply.Supply.Sub(supply.Supply, delta)
+	})
+	if err != nil {
+		return err
 	}
 
-	valueFromEvent := big.NewInt(0).SetBytes(txLog.Topics[2])
+	return lp.updateBalance(keys, wipedAddress, big.NewInt(0).Neg(delta), changes)
+}
 
-	tokenIDStr := string(tokenIdentifier)
-	tokenSupply, found := supplies[tokenIDStr]
-	if found {
-		lp.updateTokenSupply(tokenSupply, valueFromEvent, string(txLog.Identifier), isRevert)
+func (lp *logsProcessor) processTransfer(keys *tokenKeys, value *big.Int, txLog *transaction.Event, changes *blockSuppliesChanges) error {
+	if len(txLog.Topics) < minTopicsForReceiver {
 		return nil
 	}
 
-	supply, err := lp.getESDTSupply(tokenIdentifier)
+	delta := signedValue(value, changes.isRevert)
+	err := lp.updateBalance(keys, txLog.Address, big.NewInt(0).Neg(delta), changes)
 	if err != nil {
 		return err
 	}
 
-	supplies[tokenIDStr] = supply
-	lp.updateTokenSupply(supplies[tokenIDStr], valueFromEvent, string(txLog.Identifier), isRevert)
-
-	return nil
+	return lp.updateBalance(keys, txLog.Topics[minTopicsForReceiver-1], delta, changes)
 }
 
-func (lp *logsProcessor) updateTokenSupply(tokenSupply *SupplyESDT, valueFromEvent *big.Int, eventIdentifier string, isRevert bool) {
-	isBurnOp := eventIdentifier == core.BuiltInFunctionESDTLocalBurn || eventIdentifier == core.BuiltInFunctionESDTNFTBurn ||
-		eventIdentifier == core.BuiltInFunctionESDTWipe
-	isMintOp := eventIdentifier == core.BuiltInFunctionESDTNFTAddQuantity || eventIdentifier == core.BuiltInFunctionESDTLocalMint ||
-		eventIdentifier == core.BuiltInFunctionESDTNFTCreate
+// updateBalance will only alter the balances of the accounts from the current shard, as the same transfer events
+// are generated in both the sender and the receiver shards
+func (lp *logsProcessor) updateBalance(keys *tokenKeys, address []byte, delta *big.Int, changes *blockSuppliesChanges) error {
+	if len(address) == 0 || delta.Sign() == 0 {
+		return nil
+	}
+	if lp.shardCoordinator.ComputeId(address) != lp.shardCoordinator.SelfId() {
+		return nil
+	}
 
-	negativeValueFromEvent := big.NewInt(0).Neg(valueFromEvent)
+	return changes.updateBalance(keys, address, delta)
+}
 
-	switch {
-	case isMintOp && !isRevert:
-		// normal processing mint - add to supply and add to minted
-		tokenSupply.Minted.Add(tokenSupply.Minted, valueFromEvent)
-		tokenSupply.Supply.Add(tokenSupply.Supply, valueFromEvent)
-	case isMintOp && isRevert:
-		// reverted mint - subtract from supply and subtract from minted
-		tokenSupply.Minted.Add(tokenSupply.Minted, negativeValueFromEvent)
-		tokenSupply.Supply.Add(tokenSupply.Supply, negativeValueFromEvent)
-	case isBurnOp && !isRevert:
-		// normal processing burn - subtract from supply and add to burn
-		tokenSupply.Burned.Add(tokenSupply.Burned, valueFromEvent)
-		tokenSupply.Supply.Add(tokenSupply.Supply, negativeValueFromEvent)
-	case isBurnOp && isRevert:
-		// reverted burn - subtract from burned and add to supply
-		tokenSupply.Burned.Add(tokenSupply.Burned, negativeValueFromEvent)
-		tokenSupply.Supply.Add(tokenSupply.Supply, valueFromEvent)
+func (lp *logsProcessor) getESDTSupply(tokenIdentifier []byte) (*SupplyESDT, error) {
+	supplyFromStorage, err := getSupplyFromStorage(lp.marshalizer, lp.suppliesStorer, tokenIdentifier)
+	if err == storage.ErrKeyNotFound {
+		return newSupplyESDTZero(), nil
 	}
+
+	return supplyFromStorage, err
 }
 
-func (lp *logsProcessor) getESDTSupply(tokenIdentifier []byte) (*SupplyESDT, error) {
-	supplyFromStorageBytes, err := lp.suppliesStorer.Get(tokenIdentifier)
+func (lp *logsProcessor) getNoncesSupplies(collection []byte, page uint32, size uint32) ([]*NonceSupplyESDT, uint64, error) {
+	nonces, numNonces, err := lp.noncesIdx.getNonces(collection, page, size)
 	if err != nil {
-		if err == storage.ErrKeyNotFound {
-			return newSupplyESDTZero(), nil
+		return nil, 0, err
+	}
+
+	noncesSupplies := make([]*NonceSupplyESDT, 0, len(nonces))
+	for _, nonce := range nonces {
+		supply, errGet := lp.getESDTSupply(nonceTokenKey(collection, nonce))
+		if errGet != nil {
+			return nil, 0, errGet
 		}
 
-		return nil, err
+		noncesSupplies = append(noncesSupplies, &NonceSupplyESDT{
+			Nonce:  nonce,
+			Supply: supply,
+		})
 	}
 
+	return noncesSupplies, numNonces, nil
+}
+
+func getSupplyFromStorage(marshalizer marshal.Marshalizer, storer storage.Storer, key []byte) (*SupplyESDT, error) {
 	supplyFromStorage := &SupplyESDT{}
-	err = lp.marshalizer.Unmarshal(supplyFromStorage, supplyFromStorageBytes)
+	err := getRecord(marshalizer, storer, key, supplyFromStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -193,10 +272,56 @@ func (lp *logsProcessor) getESDTSupply(tokenIdentifier []byte) (*SupplyESDT, err
 	return supplyFromStorage, nil
 }
 
-func (lp *logsProcessor) shouldIgnoreEvent(event *transaction.Event) bool {
-	_, found := lp.fungibleOperations[string(event.Identifier)]
+func signedValue(value *big.Int, isRevert bool) *big.Int {
+	if isRevert {
+		return big.NewInt(0).Neg(value)
+	}
+
+	return big.NewInt(0).Set(value)
+}
 
-	return !found
+// tokenKeys holds the storage keys of a token: the one of the token itself (or of the nonce, for NFTs and SFTs) and,
+// for NFTs and SFTs, the one of the collection
+type tokenKeys struct {
+	tokenKey      []byte
+	collectionKey []byte
+	nonce         uint64
+}
+
+func newTokenKeys(tokenIdentifier []byte, nonceBytes []byte) *tokenKeys {
+	if len(nonceBytes) == 0 {
+		return &tokenKeys{
+			tokenKey: tokenIdentifier,
+		}
+	}
+
+	return &tokenKeys{
+		tokenKey:      joinNonce(tokenIdentifier, nonceBytes),
+		collectionKey: tokenIdentifier,
+		nonce:         big.NewInt(0).SetBytes(nonceBytes).Uint64(),
+	}
+}
+
+func (tk *tokenKeys) isNFT() bool {
+	return len(tk.collectionKey) > 0
+}
+
+func (tk *tokenKeys) all() [][]byte {
+	if tk.isNFT() {
+		return [][]byte{tk.tokenKey, tk.collectionKey}
+	}
+
+	return [][]byte{tk.tokenKey}
+}
+
+func nonceTokenKey(collection []byte, nonce uint64) []byte {
+	return joinNonce(collection, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+func joinNonce(tokenIdentifier []byte, nonceBytes []byte) []byte {
+	nonceHexStr := hex.EncodeToString(nonceBytes)
+
+	return bytes.Join([][]byte{tokenIdentifier, []byte(nonceHexStr)}, []byte("-"))
 }
 
 func newSupplyESDTZero() *SupplyESDT {
@@ -204,6 +329,7 @@ func newSupplyESDTZero() *SupplyESDT {
 		Burned: big.NewInt(0),
 		Minted: big.NewInt(0),
 		Supply: big.NewInt(0),
+		Wiped:  big.NewInt(0),
 	}
 }
 
@@ -217,4 +343,7 @@ func makePropertiesNotNil(supplyESDT *SupplyESDT) {
 	if supplyESDT.Burned == nil {
 		supplyESDT.Burned = big.NewInt(0)
 	}
+	if supplyESDT.Wiped == nil {
+		supplyESDT.Wiped = big.NewInt(0)
+	}
 }
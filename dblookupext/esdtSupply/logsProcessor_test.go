@@ -56,13 +56,11 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 			return nil, storage.ErrKeyNotFound
 		},
 		PutCalled: func(key, data []byte) error {
-			if string(key) == processedBlockKey {
+			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
+			if string(key) != supplyKey {
 				return nil
 			}
 
-			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
-			require.Equal(t, supplyKey, string(key))
-
 			var supplyESDT SupplyESDT
 			_ = marshalizer.Unmarshal(&supplyESDT, data)
 			require.Equal(t, big.NewInt(30), supplyESDT.Supply)
@@ -71,7 +69,7 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, testscommon.NewMultiShardsCoordinatorMock(1))
 
 	err := logsProc.processLogs(1, logs, false)
 	require.Nil(t, err)
@@ -124,7 +122,7 @@ func TestTestProcessLogsSaveSupplyExistsInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, testscommon.NewMultiShardsCoordinatorMock(1))
 
 	err := logsProc.processLogs(0, logs, false)
 	require.Nil(t, err)
@@ -140,11 +138,13 @@ func TestMakePropertiesNotNil(t *testing.T) {
 			Supply: nil,
 			Burned: big.NewInt(1),
 			Minted: big.NewInt(2),
+			Wiped:  big.NewInt(3),
 		}
 		expected := SupplyESDT{
 			Supply: big.NewInt(0),
 			Burned: big.NewInt(1),
 			Minted: big.NewInt(2),
+			Wiped:  big.NewInt(3),
 		}
 		makePropertiesNotNil(&provided)
 		assert.Equal(t, expected, provided)
@@ -156,11 +156,13 @@ func TestMakePropertiesNotNil(t *testing.T) {
 			Supply: big.NewInt(1),
 			Burned: nil,
 			Minted: big.NewInt(2),
+			Wiped:  big.NewInt(3),
 		}
 		expected := SupplyESDT{
 			Supply: big.NewInt(1),
 			Burned: big.NewInt(0),
 			Minted: big.NewInt(2),
+			Wiped:  big.NewInt(3),
 		}
 		makePropertiesNotNil(&provided)
 		assert.Equal(t, expected, provided)
@@ -172,11 +174,31 @@ func TestMakePropertiesNotNil(t *testing.T) {
 			Supply: big.NewInt(1),
 			Burned: big.NewInt(2),
 			Minted: nil,
+			Wiped:  big.NewInt(3),
 		}
 		expected := SupplyESDT{
 			Supply: big.NewInt(1),
 			Burned: big.NewInt(2),
 			Minted: big.NewInt(0),
+			Wiped:  big.NewInt(3),
+		}
+		makePropertiesNotNil(&provided)
+		assert.Equal(t, expected, provided)
+	})
+	t.Run("wiped is nil", func(t *testing.T) {
+		t.Parallel()
+
+		provided := SupplyESDT{
+			Supply: big.NewInt(1),
+			Burned: big.NewInt(2),
+			Minted: big.NewInt(3),
+			Wiped:  nil,
+		}
+		expected := SupplyESDT{
+			Supply: big.NewInt(1),
+			Burned: big.NewInt(2),
+			Minted: big.NewInt(3),
+			Wiped:  big.NewInt(0),
 		}
 		makePropertiesNotNil(&provided)
 		assert.Equal(t, expected, provided)
@@ -189,6 +211,7 @@ func TestMakePropertiesNotNil(t *testing.T) {
 			Supply: big.NewInt(0),
 			Burned: big.NewInt(0),
 			Minted: big.NewInt(0),
+			Wiped:  big.NewInt(0),
 		}
 		makePropertiesNotNil(&provided)
 		assert.Equal(t, expected, provided)
@@ -200,14 +223,15 @@ func TestMakePropertiesNotNil(t *testing.T) {
 			Supply: big.NewInt(1),
 			Burned: big.NewInt(2),
 			Minted: big.NewInt(3),
+			Wiped:  big.NewInt(4),
 		}
 		expected := SupplyESDT{
 			Supply: big.NewInt(1),
 			Burned: big.NewInt(2),
 			Minted: big.NewInt(3),
+			Wiped:  big.NewInt(4),
 		}
 		makePropertiesNotNil(&provided)
 		assert.Equal(t, expected, provided)
 	})
-
 }
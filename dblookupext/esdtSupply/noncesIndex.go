@@ -0,0 +1,193 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. supplyIndex.proto
+
+package esdtSupply
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	maxNoncesInBucket = 100
+	defaultPageSize   = 25
+	maxPageSize       = 100
+
+	noncesHeadKeyPrefix   = "nonces-head-"
+	noncesBucketKeyPrefix = "nonces-bucket-"
+)
+
+// noncesIndex keeps, for each collection, the list of nonces seen in the current shard split in fixed size buckets,
+// so that the supplies of a collection's nonces can be listed page by page
+type noncesIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newNoncesIndex(marshalizer marshal.Marshalizer, storer storage.Storer) *noncesIndex {
+	return &noncesIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+func (ni *noncesIndex) appendNonce(collection []byte, nonce uint64) error {
+	head, err := ni.getHead(collection)
+	if err != nil {
+		return err
+	}
+
+	bucketIndex := head.NumNonces / maxNoncesInBucket
+	bucket := &NoncesBucket{}
+	if head.NumNonces%maxNoncesInBucket != 0 {
+		bucket, err = ni.getBucket(collection, bucketIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	bucket.Nonces = append(bucket.Nonces, nonce)
+	err = putRecord(ni.marshalizer, ni.storer, noncesBucketKey(collection, bucketIndex), bucket)
+	if err != nil {
+		return err
+	}
+
+	head.NumNonces++
+
+	return putRecord(ni.marshalizer, ni.storer, noncesHeadKey(collection), head)
+}
+
+func (ni *noncesIndex) removeLastNonce(collection []byte) error {
+	head, err := ni.getHead(collection)
+	if err != nil {
+		return err
+	}
+	if head.NumNonces == 0 {
+		return nil
+	}
+
+	head.NumNonces--
+	bucketIndex := head.NumNonces / maxNoncesInBucket
+	if head.NumNonces%maxNoncesInBucket == 0 {
+		err = ni.storer.Remove(noncesBucketKey(collection, bucketIndex))
+	} else {
+		err = ni.truncateBucket(collection, bucketIndex)
+	}
+	if err != nil {
+		return err
+	}
+
+	if head.NumNonces == 0 {
+		return ni.storer.Remove(noncesHeadKey(collection))
+	}
+
+	return putRecord(ni.marshalizer, ni.storer, noncesHeadKey(collection), head)
+}
+
+func (ni *noncesIndex) truncateBucket(collection []byte, bucketIndex uint64) error {
+	bucket, err := ni.getBucket(collection, bucketIndex)
+	if err != nil {
+		return err
+	}
+	if len(bucket.Nonces) > 0 {
+		bucket.Nonces = bucket.Nonces[:len(bucket.Nonces)-1]
+	}
+
+	return putRecord(ni.marshalizer, ni.storer, noncesBucketKey(collection, bucketIndex), bucket)
+}
+
+// getNonces returns the nonces of the provided page, in the order they were seen, along with the total number of
+// indexed nonces of the collection
+func (ni *noncesIndex) getNonces(collection []byte, page uint32, size uint32) ([]uint64, uint64, error) {
+	head, err := ni.getHead(collection)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	start := uint64(page) * uint64(size)
+	end := start + uint64(size)
+	if end > head.NumNonces {
+		end = head.NumNonces
+	}
+
+	nonces := make([]uint64, 0, size)
+	var bucket *NoncesBucket
+	for position := start; position < end; position++ {
+		positionInBucket := position % maxNoncesInBucket
+		if bucket == nil || positionInBucket == 0 {
+			bucket, err = ni.getBucket(collection, position/maxNoncesInBucket)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if positionInBucket >= uint64(len(bucket.Nonces)) {
+			break
+		}
+
+		nonces = append(nonces, bucket.Nonces[positionInBucket])
+	}
+
+	return nonces, head.NumNonces, nil
+}
+
+func (ni *noncesIndex) getHead(collection []byte) (*NoncesIndexHead, error) {
+	head := &NoncesIndexHead{}
+	err := getRecord(ni.marshalizer, ni.storer, noncesHeadKey(collection), head)
+	if err == storage.ErrKeyNotFound {
+		return head, nil
+	}
+
+	return head, err
+}
+
+func (ni *noncesIndex) getBucket(collection []byte, bucketIndex uint64) (*NoncesBucket, error) {
+	bucket := &NoncesBucket{}
+	err := getRecord(ni.marshalizer, ni.storer, noncesBucketKey(collection, bucketIndex), bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+func getRecord(marshalizer marshal.Marshalizer, storer storage.Storer, key []byte, record interface{}) error {
+	recordBytes, err := storer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return marshalizer.Unmarshal(record, recordBytes)
+}
+
+func putRecord(marshalizer marshal.Marshalizer, storer storage.Storer, key []byte, record interface{}) error {
+	recordBytes, err := marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return storer.Put(key, recordBytes)
+}
+
+func noncesHeadKey(collection []byte) []byte {
+	return append([]byte(noncesHeadKeyPrefix), collection...)
+}
+
+func noncesBucketKey(collection []byte, bucketIndex uint64) []byte {
+	key := append([]byte(noncesBucketKeyPrefix), collection...)
+	return appendUint64(key, bucketIndex)
+}
+
+func appendUint64(buff []byte, value uint64) []byte {
+	valueBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(valueBytes, value)
+
+	return append(buff, valueBytes...)
+}
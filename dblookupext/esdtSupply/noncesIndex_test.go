@@ -0,0 +1,87 @@
+package esdtSupply
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoncesIndex_AppendAndGetNonces(t *testing.T) {
+	t.Parallel()
+
+	collection := []byte("NFT-abcdef")
+	index := newNoncesIndex(&testscommon.MarshalizerMock{}, testscommon.CreateMemUnit())
+
+	numNonces := uint64(2*maxNoncesInBucket + 50)
+	for nonce := uint64(1); nonce <= numNonces; nonce++ {
+		err := index.appendNonce(collection, nonce)
+		require.Nil(t, err)
+	}
+
+	nonces, total, err := index.getNonces(collection, 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, numNonces, total)
+	require.Len(t, nonces, defaultPageSize)
+	require.Equal(t, uint64(1), nonces[0])
+
+	nonces, _, err = index.getNonces(collection, 1, 80)
+	require.Nil(t, err)
+	require.Len(t, nonces, 80)
+	require.Equal(t, uint64(81), nonces[0])
+	require.Equal(t, uint64(160), nonces[79])
+
+	nonces, _, err = index.getNonces(collection, 2, maxPageSize+1)
+	require.Nil(t, err)
+	require.Len(t, nonces, 50)
+	require.Equal(t, uint64(201), nonces[0])
+	require.Equal(t, numNonces, nonces[49])
+
+	nonces, _, err = index.getNonces(collection, 10, 0)
+	require.Nil(t, err)
+	require.Empty(t, nonces)
+}
+
+func TestNoncesIndex_RemoveLastNonce(t *testing.T) {
+	t.Parallel()
+
+	collection := []byte("NFT-abcdef")
+	index := newNoncesIndex(&testscommon.MarshalizerMock{}, testscommon.CreateMemUnit())
+
+	for nonce := uint64(1); nonce <= maxNoncesInBucket+1; nonce++ {
+		err := index.appendNonce(collection, nonce)
+		require.Nil(t, err)
+	}
+
+	err := index.removeLastNonce(collection)
+	require.Nil(t, err)
+	err = index.removeLastNonce(collection)
+	require.Nil(t, err)
+
+	nonces, total, err := index.getNonces(collection, 0, maxPageSize)
+	require.Nil(t, err)
+	require.Equal(t, uint64(maxNoncesInBucket-1), total)
+	require.Len(t, nonces, maxNoncesInBucket-1)
+	require.Equal(t, uint64(maxNoncesInBucket-1), nonces[len(nonces)-1])
+
+	err = index.appendNonce(collection, 1000)
+	require.Nil(t, err)
+	nonces, total, err = index.getNonces(collection, 0, maxPageSize)
+	require.Nil(t, err)
+	require.Equal(t, uint64(maxNoncesInBucket), total)
+	require.Equal(t, uint64(1000), nonces[len(nonces)-1])
+}
+
+func TestNoncesIndex_RemoveLastNonceEmptyIndexShouldNotErr(t *testing.T) {
+	t.Parallel()
+
+	index := newNoncesIndex(&testscommon.MarshalizerMock{}, testscommon.CreateMemUnit())
+
+	err := index.removeLastNonce([]byte("NFT-abcdef"))
+	require.Nil(t, err)
+
+	nonces, total, err := index.getNonces([]byte("NFT-abcdef"), 0, 0)
+	require.Nil(t, err)
+	require.Zero(t, total)
+	require.Empty(t, nonces)
+}
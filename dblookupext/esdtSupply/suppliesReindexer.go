@@ -0,0 +1,222 @@
+package esdtSupply
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const reindexProgressStep = 10000
+
+// ArgsSuppliesReindexer holds all dependencies required by the supplies reindexer
+type ArgsSuppliesReindexer struct {
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	Store                    dataRetriever.StorageService
+	ShardCoordinator         sharding.Coordinator
+	AllowPartialHistory      bool
+}
+
+type suppliesReindexer struct {
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	store                    dataRetriever.StorageService
+	shardCoordinator         sharding.Coordinator
+	allowPartialHistory      bool
+}
+
+// NewSuppliesReindexer will create a new instance of the supplies reindexer
+func NewSuppliesReindexer(args ArgsSuppliesReindexer) (*suppliesReindexer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.Store) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, errNilShardCoordinator
+	}
+
+	return &suppliesReindexer{
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		store:                    args.Store,
+		shardCoordinator:         args.ShardCoordinator,
+		allowPartialHistory:      args.AllowPartialHistory,
+	}, nil
+}
+
+// Reindex will rebuild the supplies index by replaying the logs of all the blocks found in storage, from the oldest
+// available block up to the last committed one. The previous index is removed beforehand. It errors, leaving the
+// previous index untouched, if the older blocks are no longer in storage and the partial history was not allowed.
+// It also errors if any block of the replayed range is missing, and it returns the number of replayed blocks
+func (sr *suppliesReindexer) Reindex() (uint64, error) {
+	lastNonce, err := sr.getLastCommittedNonce()
+	if err != nil {
+		return 0, err
+	}
+
+	firstNonce, err := sr.getOldestAvailableNonce(lastNonce)
+	if err != nil {
+		return 0, err
+	}
+	if firstNonce > 1 {
+		if !sr.allowPartialHistory {
+			return 0, fmt.Errorf("%w, the oldest available nonce is %d", errMissingOlderBlocks, firstNonce)
+		}
+
+		log.Warn("suppliesReindexer.Reindex: the older blocks are no longer in storage, the supplies will only "+
+			"contain the changes starting with the oldest available block", "oldest nonce", firstNonce)
+	}
+
+	suppliesStorer := sr.store.GetStorer(dataRetriever.ESDTSuppliesUnit)
+	err = clearStorer(suppliesStorer)
+	if err != nil {
+		return 0, err
+	}
+
+	logsProc := newLogsProcessor(sr.marshalizer, suppliesStorer, sr.shardCoordinator)
+	logsGet := newLogsGetter(sr.marshalizer, sr.store.GetStorer(dataRetriever.TxLogsUnit))
+
+	numBlocks := uint64(0)
+	var previousHash []byte
+	for nonce := firstNonce; nonce <= lastNonce; nonce++ {
+		header, hash, errGet := sr.getHeader(nonce)
+		if errGet != nil {
+			return numBlocks, fmt.Errorf("%w for nonce %d: %s", errIncompleteChain, nonce, errGet.Error())
+		}
+		if previousHash != nil && !bytes.Equal(header.GetPrevHash(), previousHash) {
+			return numBlocks, fmt.Errorf("%w: the block with nonce %d does not follow the previous block", errIncompleteChain, nonce)
+		}
+		previousHash = hash
+
+		body, errGet := sr.getBody(header)
+		if errGet != nil {
+			return numBlocks, errGet
+		}
+
+		logs, errGet := logsGet.getLogsBasedOnBody(body)
+		if errGet != nil {
+			return numBlocks, errGet
+		}
+
+		err = logsProc.processLogs(nonce, logs, false)
+		if err != nil {
+			return numBlocks, err
+		}
+
+		numBlocks++
+		if numBlocks%reindexProgressStep == 0 {
+			log.Info("reindexing ESDT supplies", "processed blocks", numBlocks, "last nonce", nonce)
+		}
+	}
+
+	return numBlocks, nil
+}
+
+// getLastCommittedNonce returns the nonce of the last block committed by the node, as saved for the bootstrap
+func (sr *suppliesReindexer) getLastCommittedNonce() (uint64, error) {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(sr.marshalizer, sr.store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return 0, err
+	}
+
+	bootstrapData, err := bootStorer.Get(bootStorer.GetHighestRound())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errMissingLastCommittedBlock, err.Error())
+	}
+
+	return bootstrapData.LastHeader.Nonce, nil
+}
+
+// getOldestAvailableNonce searches the oldest block still in storage, as the blocks of the older epochs might have
+// been removed. The stored blocks are expected to form a range ending with the last committed block, which is
+// checked while replaying them
+func (sr *suppliesReindexer) getOldestAvailableNonce(lastNonce uint64) (uint64, error) {
+	if !sr.isHeaderAvailable(lastNonce) {
+		return 0, fmt.Errorf("%w for the last committed nonce %d", errIncompleteChain, lastNonce)
+	}
+
+	firstNonce := uint64(1)
+	if lastNonce < firstNonce {
+		return firstNonce, nil
+	}
+
+	offset := sort.Search(int(lastNonce-firstNonce), func(i int) bool {
+		return sr.isHeaderAvailable(firstNonce + uint64(i))
+	})
+
+	return firstNonce + uint64(offset), nil
+}
+
+func (sr *suppliesReindexer) isHeaderAvailable(nonce uint64) bool {
+	_, _, err := sr.getHeader(nonce)
+
+	return err == nil
+}
+
+func (sr *suppliesReindexer) getHeader(nonce uint64) (data.HeaderHandler, []byte, error) {
+	return process.GetHeaderFromStorageWithNonce(
+		nonce,
+		sr.shardCoordinator.SelfId(),
+		sr.store,
+		sr.uint64ByteSliceConverter,
+		sr.marshalizer,
+	)
+}
+
+func clearStorer(storer storage.Storer) error {
+	keys := make([][]byte, 0)
+	storer.RangeKeys(func(key []byte, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	for _, key := range keys {
+		err := storer.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("suppliesReindexer: removed the previous index", "num records", len(keys))
+
+	return nil
+}
+
+func (sr *suppliesReindexer) getBody(header data.HeaderHandler) (*block.Body, error) {
+	miniblocksStorer := sr.store.GetStorer(dataRetriever.MiniBlockUnit)
+
+	body := &block.Body{}
+	for _, miniblockHash := range header.GetMiniBlockHeadersHashes() {
+		miniblock := &block.MiniBlock{}
+		err := getRecord(sr.marshalizer, miniblocksStorer, miniblockHash, miniblock)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniblock)
+	}
+
+	return body, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *suppliesReindexer) IsInterfaceNil() bool {
+	return sr == nil
+}
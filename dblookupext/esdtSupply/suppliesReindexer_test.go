@@ -0,0 +1,258 @@
+package esdtSupply
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsSuppliesReindexer(store dataRetriever.StorageService) ArgsSuppliesReindexer {
+	return ArgsSuppliesReindexer{
+		Marshalizer:              &marshal.GogoProtoMarshalizer{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		Store:                    store,
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(1),
+	}
+}
+
+func createReindexerStore() *dataRetriever.ChainStorer {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, testscommon.CreateMemUnit())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, testscommon.CreateMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockUnit, testscommon.CreateMemUnit())
+	store.AddStorer(dataRetriever.TxLogsUnit, testscommon.CreateMemUnit())
+	store.AddStorer(dataRetriever.ESDTSuppliesUnit, testscommon.CreateMemUnit())
+	store.AddStorer(dataRetriever.BootstrapUnit, testscommon.CreateMemUnit())
+
+	return store
+}
+
+func TestNewSuppliesReindexer(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSuppliesReindexer(createReindexerStore())
+	args.Marshalizer = nil
+	_, err := NewSuppliesReindexer(args)
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createArgsSuppliesReindexer(createReindexerStore())
+	args.Uint64ByteSliceConverter = nil
+	_, err = NewSuppliesReindexer(args)
+	require.Equal(t, process.ErrNilUint64Converter, err)
+
+	args = createArgsSuppliesReindexer(nil)
+	_, err = NewSuppliesReindexer(args)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createArgsSuppliesReindexer(createReindexerStore())
+	args.ShardCoordinator = nil
+	_, err = NewSuppliesReindexer(args)
+	require.Equal(t, errNilShardCoordinator, err)
+
+	args = createArgsSuppliesReindexer(createReindexerStore())
+	reindexer, err := NewSuppliesReindexer(args)
+	require.Nil(t, err)
+	require.False(t, reindexer.IsInterfaceNil())
+}
+
+func putReindexerBlocks(
+	t *testing.T,
+	store dataRetriever.StorageService,
+	args ArgsSuppliesReindexer,
+	token []byte,
+	mintedPerBlock int64,
+	firstNonce uint64,
+	lastNonce uint64,
+) {
+	marshalizer := args.Marshalizer
+	var previousHash []byte
+	for nonce := firstNonce; nonce <= lastNonce; nonce++ {
+		txHash := append([]byte("txHash"), byte(nonce))
+		miniblockHash := append([]byte("miniblockHash"), byte(nonce))
+		headerHash := append([]byte("headerHash"), byte(nonce))
+
+		txLog := &transaction.Log{
+			Events: []*transaction.Event{
+				{
+					Identifier: []byte(core.BuiltInFunctionESDTLocalMint),
+					Topics:     [][]byte{token, nil, big.NewInt(mintedPerBlock).Bytes()},
+				},
+			},
+		}
+		err := putRecord(marshalizer, store.GetStorer(dataRetriever.TxLogsUnit), txHash, txLog)
+		require.Nil(t, err)
+
+		miniblock := &block.MiniBlock{TxHashes: [][]byte{txHash}}
+		err = putRecord(marshalizer, store.GetStorer(dataRetriever.MiniBlockUnit), miniblockHash, miniblock)
+		require.Nil(t, err)
+
+		header := &block.Header{
+			Nonce:            nonce,
+			PrevHash:         previousHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniblockHash}},
+		}
+		err = putRecord(marshalizer, store.GetStorer(dataRetriever.BlockHeaderUnit), headerHash, header)
+		require.Nil(t, err)
+
+		nonceBytes := args.Uint64ByteSliceConverter.ToByteSlice(nonce)
+		err = store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit).Put(nonceBytes, headerHash)
+		require.Nil(t, err)
+
+		previousHash = headerHash
+	}
+}
+
+func putLastCommittedNonce(t *testing.T, store dataRetriever.StorageService, args ArgsSuppliesReindexer, nonce uint64) {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(args.Marshalizer, store.GetStorer(dataRetriever.BootstrapUnit))
+	require.Nil(t, err)
+
+	round := int64(nonce + 5)
+	err = bootStorer.Put(round, bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			Nonce: nonce,
+			Hash:  append([]byte("headerHash"), byte(nonce)),
+		},
+		LastRound: round,
+	})
+	require.Nil(t, err)
+}
+
+func TestSuppliesReindexer_ReindexShouldRebuildSupplies(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	staleToken := []byte("OLD-abcdef")
+	store := createReindexerStore()
+	args := createArgsSuppliesReindexer(store)
+	marshalizer := args.Marshalizer
+
+	// stale data from a previous indexing
+	suppliesStorer := store.GetStorer(dataRetriever.ESDTSuppliesUnit)
+	err := putRecord(marshalizer, suppliesStorer, token, &SupplyESDT{Supply: big.NewInt(1000), Minted: big.NewInt(1000)})
+	require.Nil(t, err)
+	err = putRecord(marshalizer, suppliesStorer, staleToken, &SupplyESDT{Supply: big.NewInt(1000), Minted: big.NewInt(1000)})
+	require.Nil(t, err)
+
+	mintedPerBlock := int64(10)
+	numBlocks := uint64(3)
+	putReindexerBlocks(t, store, args, token, mintedPerBlock, 1, numBlocks)
+	putLastCommittedNonce(t, store, args, numBlocks)
+
+	reindexer, _ := NewSuppliesReindexer(args)
+	numReindexed, err := reindexer.Reindex()
+	require.Nil(t, err)
+	require.Equal(t, numBlocks, numReindexed)
+
+	supply, err := getSupplyFromStorage(marshalizer, suppliesStorer, token)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(mintedPerBlock*int64(numBlocks)), supply.Supply)
+	require.Equal(t, big.NewInt(mintedPerBlock*int64(numBlocks)), supply.Minted)
+	require.Equal(t, numBlocks, supply.LastUpdateBlockNonce)
+
+	_, err = suppliesStorer.Get(staleToken)
+	require.NotNil(t, err)
+}
+
+func TestSuppliesReindexer_ReindexMissingOlderBlocksShouldErrAndKeepTheIndex(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	store := createReindexerStore()
+	args := createArgsSuppliesReindexer(store)
+	suppliesStorer := store.GetStorer(dataRetriever.ESDTSuppliesUnit)
+	existingSupply := &SupplyESDT{Supply: big.NewInt(1000), Minted: big.NewInt(1000), LastUpdateBlockNonce: 4}
+	err := putRecord(args.Marshalizer, suppliesStorer, token, existingSupply)
+	require.Nil(t, err)
+
+	putReindexerBlocks(t, store, args, token, 10, 5, 8)
+	putLastCommittedNonce(t, store, args, 8)
+
+	reindexer, _ := NewSuppliesReindexer(args)
+	numReindexed, err := reindexer.Reindex()
+	require.True(t, errors.Is(err, errMissingOlderBlocks))
+	require.Equal(t, uint64(0), numReindexed)
+
+	supply, err := getSupplyFromStorage(args.Marshalizer, suppliesStorer, token)
+	require.Nil(t, err)
+	require.Equal(t, existingSupply.Supply, supply.Supply)
+	require.Equal(t, existingSupply.LastUpdateBlockNonce, supply.LastUpdateBlockNonce)
+}
+
+func TestSuppliesReindexer_ReindexPartialHistoryShouldStartFromTheOldestAvailableBlock(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	store := createReindexerStore()
+	args := createArgsSuppliesReindexer(store)
+	args.AllowPartialHistory = true
+
+	mintedPerBlock := int64(10)
+	putReindexerBlocks(t, store, args, token, mintedPerBlock, 5, 8)
+	putLastCommittedNonce(t, store, args, 8)
+
+	reindexer, _ := NewSuppliesReindexer(args)
+	numReindexed, err := reindexer.Reindex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), numReindexed)
+
+	supply, err := getSupplyFromStorage(args.Marshalizer, store.GetStorer(dataRetriever.ESDTSuppliesUnit), token)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(mintedPerBlock*4), supply.Supply)
+	require.Equal(t, uint64(8), supply.LastUpdateBlockNonce)
+}
+
+func TestSuppliesReindexer_ReindexIncompleteChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+
+	t.Run("missing last committed block", func(t *testing.T) {
+		t.Parallel()
+
+		store := createReindexerStore()
+		args := createArgsSuppliesReindexer(store)
+		putReindexerBlocks(t, store, args, token, 10, 1, 3)
+
+		reindexer, _ := NewSuppliesReindexer(args)
+		_, err := reindexer.Reindex()
+		require.True(t, errors.Is(err, errMissingLastCommittedBlock))
+	})
+	t.Run("chain does not reach the last committed block", func(t *testing.T) {
+		t.Parallel()
+
+		store := createReindexerStore()
+		args := createArgsSuppliesReindexer(store)
+		putReindexerBlocks(t, store, args, token, 10, 1, 3)
+		putLastCommittedNonce(t, store, args, 5)
+
+		reindexer, _ := NewSuppliesReindexer(args)
+		_, err := reindexer.Reindex()
+		require.True(t, errors.Is(err, errIncompleteChain))
+	})
+	t.Run("missing block in the middle", func(t *testing.T) {
+		t.Parallel()
+
+		store := createReindexerStore()
+		args := createArgsSuppliesReindexer(store)
+		putReindexerBlocks(t, store, args, token, 10, 1, 6)
+		putLastCommittedNonce(t, store, args, 6)
+		nonceBytes := args.Uint64ByteSliceConverter.ToByteSlice(4)
+		_ = store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit).Remove(nonceBytes)
+
+		reindexer, _ := NewSuppliesReindexer(args)
+		numReindexed, err := reindexer.Reindex()
+		require.True(t, errors.Is(err, errIncompleteChain))
+		require.Equal(t, uint64(3), numReindexed)
+	})
+}
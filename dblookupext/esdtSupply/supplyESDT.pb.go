@@ -29,9 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SupplyESDT is used to store information a shard esdt token supply
 type SupplyESDT struct {
-	Supply *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"value"`
-	Burned *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"burned"`
-	Minted *math_big.Int `protobuf:"bytes,3,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"minted"`
+	Supply               *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"value"`
+	Burned               *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"burned"`
+	Minted               *math_big.Int `protobuf:"bytes,3,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"minted"`
+	Wiped                *math_big.Int `protobuf:"bytes,4,opt,name=Wiped,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"wiped"`
+	NumHolders           uint64        `protobuf:"varint,5,opt,name=NumHolders,proto3" json:"numHolders"`
+	LastUpdateBlockNonce uint64        `protobuf:"varint,6,opt,name=LastUpdateBlockNonce,proto3" json:"lastUpdateBlockNonce"`
 }
 
 func (m *SupplyESDT) Reset()      { *m = SupplyESDT{} }
@@ -83,6 +86,27 @@ func (m *SupplyESDT) GetMinted() *math_big.Int {
 	return nil
 }
 
+func (m *SupplyESDT) GetWiped() *math_big.Int {
+	if m != nil {
+		return m.Wiped
+	}
+	return nil
+}
+
+func (m *SupplyESDT) GetNumHolders() uint64 {
+	if m != nil {
+		return m.NumHolders
+	}
+	return 0
+}
+
+func (m *SupplyESDT) GetLastUpdateBlockNonce() uint64 {
+	if m != nil {
+		return m.LastUpdateBlockNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SupplyESDT)(nil), "proto.SupplyESDT")
 }
@@ -90,26 +114,31 @@ func init() {
 func init() { proto.RegisterFile("supplyESDT.proto", fileDescriptor_173c6d56cc05b222) }
 
 var fileDescriptor_173c6d56cc05b222 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd1, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x06, 0x60, 0xbb, 0xa8, 0x19, 0x2c, 0x06, 0x94, 0x29, 0x62, 0x78, 0x41, 0x4c, 0x2c, 0x49,
-	0x06, 0x46, 0xb6, 0xd0, 0x22, 0x75, 0x80, 0x81, 0x74, 0x62, 0x4b, 0x1a, 0xe3, 0x46, 0x4d, 0xe2,
-	0xc8, 0x71, 0x40, 0x6c, 0x1c, 0x81, 0x63, 0x20, 0x4e, 0xc2, 0x98, 0x05, 0x29, 0x53, 0x21, 0xce,
-	0x82, 0x3a, 0xf5, 0x08, 0x08, 0x1b, 0x01, 0x07, 0xe8, 0x64, 0xff, 0xbf, 0x64, 0x7f, 0xd2, 0x7b,
-	0xe4, 0xa0, 0x6e, 0xaa, 0x2a, 0x7f, 0x98, 0x46, 0x93, 0xb9, 0x5f, 0x09, 0x2e, 0xb9, 0x3d, 0xd6,
-	0xc7, 0xa1, 0xc7, 0x32, 0xb9, 0x6c, 0x12, 0x7f, 0xc1, 0x8b, 0x80, 0x71, 0xc6, 0x03, 0x5d, 0x27,
-	0xcd, 0xad, 0x4e, 0x3a, 0xe8, 0x9b, 0x79, 0x75, 0xfc, 0x36, 0x22, 0x24, 0xfa, 0xfd, 0xca, 0x5e,
-	0x11, 0xcb, 0x24, 0x07, 0x1f, 0xe1, 0x93, 0xfd, 0x30, 0xda, 0xac, 0xdd, 0xf1, 0x5d, 0x9c, 0x37,
-	0xf4, 0xe5, 0xdd, 0xbd, 0x28, 0x62, 0xb9, 0x0c, 0x92, 0x8c, 0xf9, 0xb3, 0x52, 0x9e, 0xfd, 0x73,
-	0xa6, 0xb9, 0xe0, 0x65, 0x7a, 0x45, 0xe5, 0x3d, 0x17, 0xab, 0x80, 0xea, 0xe4, 0x31, 0xee, 0x2d,
-	0xb8, 0xa0, 0x41, 0x1a, 0xcb, 0xd8, 0x0f, 0x33, 0x36, 0x2b, 0xe5, 0x79, 0x5c, 0x4b, 0x2a, 0xae,
-	0x7f, 0x08, 0x3b, 0x27, 0x56, 0xd8, 0x88, 0x92, 0xa6, 0xce, 0x48, 0x63, 0xf3, 0xcd, 0xda, 0xb5,
-	0x12, 0xdd, 0xec, 0x52, 0x33, 0xc6, 0xb7, 0x76, 0x99, 0x95, 0x92, 0xa6, 0xce, 0xde, 0x9f, 0x56,
-	0xe8, 0x66, 0x97, 0x9a, 0x31, 0xc2, 0x49, 0xdb, 0x03, 0xea, 0x7a, 0x40, 0xdb, 0x1e, 0xf0, 0xa3,
-	0x02, 0xfc, 0xac, 0x00, 0xbf, 0x2a, 0xc0, 0xad, 0x02, 0xdc, 0x29, 0xc0, 0x1f, 0x0a, 0xf0, 0xa7,
-	0x02, 0xb4, 0x55, 0x80, 0x9f, 0x06, 0x40, 0xed, 0x00, 0xa8, 0x1b, 0x00, 0xdd, 0x10, 0x5a, 0xa7,
-	0xd2, 0x4c, 0x28, 0xb1, 0xf4, 0x92, 0x4e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x16, 0xcf, 0x36,
-	0xdc, 0xee, 0x01, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd2, 0x31, 0x6f, 0xb2, 0x40,
+	0x18, 0x07, 0x70, 0xee, 0x7d, 0x85, 0xe1, 0xf2, 0xe6, 0xcd, 0x1b, 0xe2, 0x40, 0xde, 0xe1, 0xc1,
+	0x74, 0x72, 0x11, 0x86, 0x8e, 0xdd, 0xa8, 0x36, 0x35, 0xb1, 0x0e, 0x6a, 0xd3, 0xa4, 0x1b, 0x70,
+	0x57, 0x24, 0x02, 0x47, 0xe0, 0xa8, 0xe9, 0xd6, 0x8f, 0xd0, 0x8f, 0xd1, 0xf4, 0x43, 0x74, 0xee,
+	0xe8, 0xe8, 0x44, 0xeb, 0xb9, 0x34, 0x4c, 0x7e, 0x84, 0xa6, 0x47, 0xa3, 0x0e, 0x8e, 0x4e, 0xf0,
+	0xff, 0xc3, 0x73, 0xbf, 0xe4, 0xf2, 0xe0, 0x7f, 0x79, 0x91, 0xa6, 0xd1, 0x43, 0x6f, 0xdc, 0x9d,
+	0x58, 0x69, 0xc6, 0x38, 0xd3, 0x55, 0xf9, 0xf8, 0xdf, 0x09, 0x42, 0x3e, 0x2d, 0x3c, 0xcb, 0x67,
+	0xb1, 0x1d, 0xb0, 0x80, 0xd9, 0xb2, 0xf6, 0x8a, 0x3b, 0x99, 0x64, 0x90, 0x6f, 0xf5, 0xd4, 0xc9,
+	0x6b, 0x03, 0xe3, 0xf1, 0xf6, 0x28, 0x7d, 0x86, 0xb5, 0x3a, 0x19, 0xa8, 0x85, 0xda, 0x7f, 0x9c,
+	0x71, 0x55, 0x9a, 0xea, 0xbd, 0x1b, 0x15, 0xf4, 0xe5, 0xdd, 0xbc, 0x88, 0x5d, 0x3e, 0xb5, 0xbd,
+	0x30, 0xb0, 0xfa, 0x09, 0x3f, 0xdb, 0x73, 0x7a, 0x51, 0xc6, 0x12, 0x32, 0xa4, 0x7c, 0xce, 0xb2,
+	0x99, 0x4d, 0x65, 0xea, 0x04, 0xac, 0xe3, 0xb3, 0x8c, 0xda, 0xc4, 0xe5, 0xae, 0xe5, 0x84, 0x41,
+	0x3f, 0xe1, 0xe7, 0x6e, 0xce, 0x69, 0x36, 0xfa, 0x21, 0xf4, 0x08, 0x6b, 0x4e, 0x91, 0x25, 0x94,
+	0x18, 0xbf, 0x24, 0x36, 0xa9, 0x4a, 0x53, 0xf3, 0x64, 0x73, 0x4c, 0xad, 0x36, 0xbe, 0xb5, 0xab,
+	0x30, 0xe1, 0x94, 0x18, 0xbf, 0x77, 0x5a, 0x2c, 0x9b, 0x63, 0x6a, 0xb5, 0xa1, 0x87, 0x58, 0xbd,
+	0x09, 0x53, 0x4a, 0x8c, 0xc6, 0xee, 0x1e, 0xe7, 0x61, 0x7a, 0x54, 0xab, 0x16, 0x74, 0x0b, 0xe3,
+	0x61, 0x11, 0x5f, 0xb2, 0x88, 0xd0, 0x2c, 0x37, 0xd4, 0x16, 0x6a, 0x37, 0x9c, 0xbf, 0x55, 0x69,
+	0xe2, 0x64, 0xdb, 0x8e, 0xf6, 0xfe, 0xd0, 0x07, 0xb8, 0x39, 0x70, 0x73, 0x7e, 0x9d, 0x12, 0x97,
+	0x53, 0x27, 0x62, 0xfe, 0x6c, 0xc8, 0x12, 0x9f, 0x1a, 0x9a, 0x9c, 0x34, 0xaa, 0xd2, 0x6c, 0x46,
+	0x07, 0xbe, 0x8f, 0x0e, 0x4e, 0x39, 0xdd, 0xc5, 0x0a, 0x94, 0xe5, 0x0a, 0x94, 0xcd, 0x0a, 0xd0,
+	0xa3, 0x00, 0xf4, 0x2c, 0x00, 0xbd, 0x09, 0x40, 0x0b, 0x01, 0x68, 0x29, 0x00, 0x7d, 0x08, 0x40,
+	0x9f, 0x02, 0x94, 0x8d, 0x00, 0xf4, 0xb4, 0x06, 0x65, 0xb1, 0x06, 0x65, 0xb9, 0x06, 0xe5, 0x16,
+	0xd3, 0x9c, 0xf0, 0x7a, 0x15, 0x3c, 0x4d, 0x6e, 0xe3, 0xe9, 0xd7, 0x00, 0x70, 0xa7, 0x63, 0x31,
+	0xd7, 0x02, 0x00, 0x00,
 }
 
 func (this *SupplyESDT) Equal(that interface{}) bool {
@@ -149,17 +178,32 @@ func (this *SupplyESDT) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Wiped, that1.Wiped) {
+			return false
+		}
+	}
+	if this.NumHolders != that1.NumHolders {
+		return false
+	}
+	if this.LastUpdateBlockNonce != that1.LastUpdateBlockNonce {
+		return false
+	}
 	return true
 }
 func (this *SupplyESDT) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 10)
 	s = append(s, "&esdtSupply.SupplyESDT{")
 	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
 	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
 	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Wiped: "+fmt.Sprintf("%#v", this.Wiped)+",\n")
+	s = append(s, "NumHolders: "+fmt.Sprintf("%#v", this.NumHolders)+",\n")
+	s = append(s, "LastUpdateBlockNonce: "+fmt.Sprintf("%#v", this.LastUpdateBlockNonce)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -191,6 +235,27 @@ func (m *SupplyESDT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUpdateBlockNonce != 0 {
+		i = encodeVarintSupplyESDT(dAtA, i, uint64(m.LastUpdateBlockNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumHolders != 0 {
+		i = encodeVarintSupplyESDT(dAtA, i, uint64(m.NumHolders))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Wiped)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Wiped, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyESDT(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
 		size := __caster.Size(m.Minted)
@@ -259,6 +324,17 @@ func (m *SupplyESDT) Size() (n int) {
 		l = __caster.Size(m.Minted)
 		n += 1 + l + sovSupplyESDT(uint64(l))
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Wiped)
+		n += 1 + l + sovSupplyESDT(uint64(l))
+	}
+	if m.NumHolders != 0 {
+		n += 1 + sovSupplyESDT(uint64(m.NumHolders))
+	}
+	if m.LastUpdateBlockNonce != 0 {
+		n += 1 + sovSupplyESDT(uint64(m.LastUpdateBlockNonce))
+	}
 	return n
 }
 
@@ -276,6 +352,9 @@ func (this *SupplyESDT) String() string {
 		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
 		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
 		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Wiped:` + fmt.Sprintf("%v", this.Wiped) + `,`,
+		`NumHolders:` + fmt.Sprintf("%v", this.NumHolders) + `,`,
+		`LastUpdateBlockNonce:` + fmt.Sprintf("%v", this.LastUpdateBlockNonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -431,6 +510,82 @@ func (m *SupplyESDT) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wiped", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Wiped = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumHolders", wireType)
+			}
+			m.NumHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumHolders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlockNonce", wireType)
+			}
+			m.LastUpdateBlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateBlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSupplyESDT(dAtA[iNdEx:])
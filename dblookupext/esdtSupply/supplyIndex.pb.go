@@ -0,0 +1,1797 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: supplyIndex.proto
+
+package esdtSupply
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HolderBalance is used to store the balance an account holds from a token, as seen from the processed logs
+type HolderBalance struct {
+	Balance *math_big.Int `protobuf:"bytes,1,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Balance,omitempty"`
+}
+
+func (m *HolderBalance) Reset()      { *m = HolderBalance{} }
+func (*HolderBalance) ProtoMessage() {}
+func (*HolderBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{0}
+}
+func (m *HolderBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HolderBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderBalance.Merge(m, src)
+}
+func (m *HolderBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderBalance proto.InternalMessageInfo
+
+func (m *HolderBalance) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// NoncesIndexHead is used to store the number of nonces of a collection that were seen in the current shard
+type NoncesIndexHead struct {
+	NumNonces uint64 `protobuf:"varint,1,opt,name=NumNonces,proto3" json:"NumNonces,omitempty"`
+}
+
+func (m *NoncesIndexHead) Reset()      { *m = NoncesIndexHead{} }
+func (*NoncesIndexHead) ProtoMessage() {}
+func (*NoncesIndexHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{1}
+}
+func (m *NoncesIndexHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoncesIndexHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NoncesIndexHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoncesIndexHead.Merge(m, src)
+}
+func (m *NoncesIndexHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoncesIndexHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoncesIndexHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoncesIndexHead proto.InternalMessageInfo
+
+func (m *NoncesIndexHead) GetNumNonces() uint64 {
+	if m != nil {
+		return m.NumNonces
+	}
+	return 0
+}
+
+// NoncesBucket is used to store a fixed size chunk of the nonces of a collection, in the order they were seen
+type NoncesBucket struct {
+	Nonces []uint64 `protobuf:"varint,1,rep,packed,name=Nonces,proto3" json:"Nonces,omitempty"`
+}
+
+func (m *NoncesBucket) Reset()      { *m = NoncesBucket{} }
+func (*NoncesBucket) ProtoMessage() {}
+func (*NoncesBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{2}
+}
+func (m *NoncesBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoncesBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NoncesBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoncesBucket.Merge(m, src)
+}
+func (m *NoncesBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoncesBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoncesBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoncesBucket proto.InternalMessageInfo
+
+func (m *NoncesBucket) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+// IndexedNonce is used to identify a nonce added to the index of a collection
+type IndexedNonce struct {
+	Collection []byte `protobuf:"bytes,1,opt,name=Collection,proto3" json:"Collection,omitempty"`
+	Nonce      uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *IndexedNonce) Reset()      { *m = IndexedNonce{} }
+func (*IndexedNonce) ProtoMessage() {}
+func (*IndexedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{3}
+}
+func (m *IndexedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedNonce.Merge(m, src)
+}
+func (m *IndexedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedNonce proto.InternalMessageInfo
+
+func (m *IndexedNonce) GetCollection() []byte {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+func (m *IndexedNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// WipedBalance is used to store the balance removed from an account by a wipe operation
+type WipedBalance struct {
+	TokenKey []byte        `protobuf:"bytes,1,opt,name=TokenKey,proto3" json:"TokenKey,omitempty"`
+	Address  []byte        `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Amount   *math_big.Int `protobuf:"bytes,3,opt,name=Amount,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Amount,omitempty"`
+}
+
+func (m *WipedBalance) Reset()      { *m = WipedBalance{} }
+func (*WipedBalance) ProtoMessage() {}
+func (*WipedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{4}
+}
+func (m *WipedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WipedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WipedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WipedBalance.Merge(m, src)
+}
+func (m *WipedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *WipedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_WipedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WipedBalance proto.InternalMessageInfo
+
+func (m *WipedBalance) GetTokenKey() []byte {
+	if m != nil {
+		return m.TokenKey
+	}
+	return nil
+}
+
+func (m *WipedBalance) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *WipedBalance) GetAmount() *math_big.Int {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BlockSupplyChanges is used to store the information needed to revert the changes a block brought to the supplies index
+type BlockSupplyChanges struct {
+	IndexedNonces []*IndexedNonce `protobuf:"bytes,1,rep,name=IndexedNonces,proto3" json:"IndexedNonces,omitempty"`
+	WipedBalances []*WipedBalance `protobuf:"bytes,2,rep,name=WipedBalances,proto3" json:"WipedBalances,omitempty"`
+}
+
+func (m *BlockSupplyChanges) Reset()      { *m = BlockSupplyChanges{} }
+func (*BlockSupplyChanges) ProtoMessage() {}
+func (*BlockSupplyChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9b1d4c725eb28df, []int{5}
+}
+func (m *BlockSupplyChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSupplyChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockSupplyChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSupplyChanges.Merge(m, src)
+}
+func (m *BlockSupplyChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSupplyChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSupplyChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSupplyChanges proto.InternalMessageInfo
+
+func (m *BlockSupplyChanges) GetIndexedNonces() []*IndexedNonce {
+	if m != nil {
+		return m.IndexedNonces
+	}
+	return nil
+}
+
+func (m *BlockSupplyChanges) GetWipedBalances() []*WipedBalance {
+	if m != nil {
+		return m.WipedBalances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*HolderBalance)(nil), "proto.HolderBalance")
+	proto.RegisterType((*NoncesIndexHead)(nil), "proto.NoncesIndexHead")
+	proto.RegisterType((*NoncesBucket)(nil), "proto.NoncesBucket")
+	proto.RegisterType((*IndexedNonce)(nil), "proto.IndexedNonce")
+	proto.RegisterType((*WipedBalance)(nil), "proto.WipedBalance")
+	proto.RegisterType((*BlockSupplyChanges)(nil), "proto.BlockSupplyChanges")
+}
+
+func init() { proto.RegisterFile("supplyIndex.proto", fileDescriptor_f9b1d4c725eb28df) }
+
+var fileDescriptor_f9b1d4c725eb28df = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xd1, 0x36, 0x85, 0x87, 0x2b, 0xc4, 0x81, 0x50, 0x54, 0xa1, 0x03, 0x79, 0x40, 0x5d,
+	0x62, 0x4b, 0x30, 0x21, 0xa6, 0x3a, 0x05, 0x35, 0x20, 0x65, 0x30, 0x48, 0x48, 0x6c, 0xb6, 0xef,
+	0xe1, 0x58, 0x71, 0xee, 0x45, 0xf6, 0x59, 0xd0, 0x8d, 0x99, 0x89, 0x9f, 0x51, 0xf1, 0x4b, 0x18,
+	0x33, 0x66, 0x83, 0x5c, 0x16, 0xc6, 0xfe, 0x04, 0xd4, 0xbb, 0xa4, 0xb8, 0x7b, 0x27, 0xbf, 0xef,
+	0x7b, 0xef, 0x7d, 0x4f, 0xdf, 0xe7, 0x83, 0xfb, 0x4d, 0x3b, 0x9f, 0x57, 0x67, 0x23, 0x25, 0xf1,
+	0x6b, 0x38, 0xaf, 0x49, 0x13, 0xdf, 0xb3, 0x9f, 0xc3, 0x41, 0x51, 0xea, 0x49, 0x9b, 0x85, 0x39,
+	0xcd, 0xa2, 0x82, 0x0a, 0x8a, 0x2c, 0x9d, 0xb5, 0x9f, 0x2d, 0xb2, 0xc0, 0x56, 0x6e, 0x2b, 0x68,
+	0xe1, 0xe0, 0x94, 0x2a, 0x89, 0x75, 0x9c, 0x56, 0xa9, 0xca, 0x91, 0x4b, 0xd8, 0xdf, 0x94, 0x7d,
+	0xf6, 0x94, 0x1d, 0xf9, 0xf1, 0xdb, 0x9f, 0xbf, 0x9f, 0xbc, 0x99, 0xa5, 0x7a, 0x12, 0x65, 0x65,
+	0x11, 0x8e, 0x94, 0x7e, 0xd5, 0xb9, 0xf0, 0xba, 0xaa, 0x49, 0xc9, 0x31, 0xea, 0x2f, 0x54, 0x4f,
+	0x23, 0xb4, 0x68, 0x50, 0xd0, 0x20, 0xa7, 0x1a, 0x23, 0x99, 0xea, 0x34, 0x8c, 0xcb, 0x62, 0xa4,
+	0xf4, 0x30, 0x6d, 0x34, 0xd6, 0xc9, 0x56, 0x3a, 0x88, 0xe0, 0xde, 0x98, 0x54, 0x8e, 0x8d, 0x75,
+	0x70, 0x8a, 0xa9, 0xe4, 0x8f, 0xe1, 0xce, 0xb8, 0x9d, 0x39, 0xd6, 0x9e, 0xde, 0x4d, 0xfe, 0x13,
+	0xc1, 0x33, 0xf0, 0x5d, 0x15, 0xb7, 0xf9, 0x14, 0x35, 0x7f, 0x04, 0xbd, 0xab, 0xd1, 0x9d, 0xa3,
+	0xdd, 0x64, 0x83, 0x82, 0x13, 0xf0, 0xad, 0x24, 0x4a, 0x4b, 0x70, 0x01, 0x30, 0xa4, 0xaa, 0xc2,
+	0x5c, 0x97, 0xa4, 0x9c, 0xa3, 0xa4, 0xc3, 0xf0, 0x87, 0xb0, 0x67, 0x07, 0xfb, 0xb7, 0xec, 0x45,
+	0x07, 0x82, 0x73, 0x06, 0xfe, 0xc7, 0x72, 0x8e, 0x72, 0x9b, 0xca, 0x21, 0xdc, 0xfe, 0x40, 0x53,
+	0x54, 0xef, 0xf0, 0x6c, 0x23, 0x72, 0x85, 0x79, 0x1f, 0xf6, 0x8f, 0xa5, 0xac, 0xb1, 0x69, 0xac,
+	0x88, 0x9f, 0x6c, 0x21, 0xcf, 0xa0, 0x77, 0x3c, 0xa3, 0x56, 0xe9, 0xfe, 0xce, 0x8d, 0x47, 0xb9,
+	0x51, 0x0e, 0xbe, 0x33, 0xe0, 0x71, 0x45, 0xf9, 0xf4, 0xbd, 0x7d, 0x11, 0xc3, 0x49, 0xaa, 0x0a,
+	0x6c, 0xf8, 0x4b, 0x38, 0xe8, 0xe6, 0xe0, 0x62, 0xba, 0xfb, 0xfc, 0x81, 0xfb, 0xed, 0x61, 0xb7,
+	0x97, 0x5c, 0x9f, 0xbc, 0x5c, 0xed, 0x7a, 0xbf, 0x74, 0xd5, 0x5d, 0xed, 0xf6, 0x92, 0xeb, 0x93,
+	0xf1, 0xc9, 0x62, 0x25, 0xbc, 0xe5, 0x4a, 0x78, 0x17, 0x2b, 0xc1, 0xbe, 0x19, 0xc1, 0xce, 0x8d,
+	0x60, 0xbf, 0x8c, 0x60, 0x0b, 0x23, 0xd8, 0xd2, 0x08, 0xf6, 0xc7, 0x08, 0xf6, 0xd7, 0x08, 0xef,
+	0xc2, 0x08, 0xf6, 0x63, 0x2d, 0xbc, 0xc5, 0x5a, 0x78, 0xcb, 0xb5, 0xf0, 0x3e, 0x01, 0x36, 0x52,
+	0x3b, 0x0b, 0x59, 0xcf, 0x1e, 0x7a, 0xf1, 0x6f, 0x00, 0x44, 0xf5, 0x62, 0xbb, 0xe5, 0x02, 0x00,
+	0x00,
+}
+
+func (this *HolderBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HolderBalance)
+	if !ok {
+		that2, ok := that.(HolderBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NoncesIndexHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoncesIndexHead)
+	if !ok {
+		that2, ok := that.(NoncesIndexHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumNonces != that1.NumNonces {
+		return false
+	}
+	return true
+}
+func (this *NoncesBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoncesBucket)
+	if !ok {
+		that2, ok := that.(NoncesBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nonces) != len(that1.Nonces) {
+		return false
+	}
+	for i := range this.Nonces {
+		if this.Nonces[i] != that1.Nonces[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *IndexedNonce) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedNonce)
+	if !ok {
+		that2, ok := that.(IndexedNonce)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Collection, that1.Collection) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *WipedBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WipedBalance)
+	if !ok {
+		that2, ok := that.(WipedBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TokenKey, that1.TokenKey) {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Amount, that1.Amount) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockSupplyChanges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockSupplyChanges)
+	if !ok {
+		that2, ok := that.(BlockSupplyChanges)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.IndexedNonces) != len(that1.IndexedNonces) {
+		return false
+	}
+	for i := range this.IndexedNonces {
+		if !this.IndexedNonces[i].Equal(that1.IndexedNonces[i]) {
+			return false
+		}
+	}
+	if len(this.WipedBalances) != len(that1.WipedBalances) {
+		return false
+	}
+	for i := range this.WipedBalances {
+		if !this.WipedBalances[i].Equal(that1.WipedBalances[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HolderBalance) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.HolderBalance{")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoncesIndexHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.NoncesIndexHead{")
+	s = append(s, "NumNonces: "+fmt.Sprintf("%#v", this.NumNonces)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoncesBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.NoncesBucket{")
+	s = append(s, "Nonces: "+fmt.Sprintf("%#v", this.Nonces)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IndexedNonce) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&esdtSupply.IndexedNonce{")
+	s = append(s, "Collection: "+fmt.Sprintf("%#v", this.Collection)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WipedBalance) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&esdtSupply.WipedBalance{")
+	s = append(s, "TokenKey: "+fmt.Sprintf("%#v", this.TokenKey)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockSupplyChanges) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&esdtSupply.BlockSupplyChanges{")
+	if this.IndexedNonces != nil {
+		s = append(s, "IndexedNonces: "+fmt.Sprintf("%#v", this.IndexedNonces)+",\n")
+	}
+	if this.WipedBalances != nil {
+		s = append(s, "WipedBalances: "+fmt.Sprintf("%#v", this.WipedBalances)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSupplyIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *HolderBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NoncesIndexHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoncesIndexHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoncesIndexHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumNonces != 0 {
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(m.NumNonces))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NoncesBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoncesBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoncesBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		dAtA2 := make([]byte, len(m.Nonces)*10)
+		var j1 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WipedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WipedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WipedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Amount)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Amount, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenKey) > 0 {
+		i -= len(m.TokenKey)
+		copy(dAtA[i:], m.TokenKey)
+		i = encodeVarintSupplyIndex(dAtA, i, uint64(len(m.TokenKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockSupplyChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSupplyChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSupplyChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WipedBalances) > 0 {
+		for iNdEx := len(m.WipedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WipedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSupplyIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IndexedNonces) > 0 {
+		for iNdEx := len(m.IndexedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSupplyIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSupplyIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSupplyIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HolderBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovSupplyIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *NoncesIndexHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumNonces != 0 {
+		n += 1 + sovSupplyIndex(uint64(m.NumNonces))
+	}
+	return n
+}
+
+func (m *NoncesBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovSupplyIndex(uint64(e))
+		}
+		n += 1 + sovSupplyIndex(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IndexedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovSupplyIndex(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovSupplyIndex(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *WipedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenKey)
+	if l > 0 {
+		n += 1 + l + sovSupplyIndex(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSupplyIndex(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Amount)
+		n += 1 + l + sovSupplyIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockSupplyChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IndexedNonces) > 0 {
+		for _, e := range m.IndexedNonces {
+			l = e.Size()
+			n += 1 + l + sovSupplyIndex(uint64(l))
+		}
+	}
+	if len(m.WipedBalances) > 0 {
+		for _, e := range m.WipedBalances {
+			l = e.Size()
+			n += 1 + l + sovSupplyIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSupplyIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSupplyIndex(x uint64) (n int) {
+	return sovSupplyIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HolderBalance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HolderBalance{`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoncesIndexHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoncesIndexHead{`,
+		`NumNonces:` + fmt.Sprintf("%v", this.NumNonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoncesBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoncesBucket{`,
+		`Nonces:` + fmt.Sprintf("%v", this.Nonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexedNonce) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexedNonce{`,
+		`Collection:` + fmt.Sprintf("%v", this.Collection) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WipedBalance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WipedBalance{`,
+		`TokenKey:` + fmt.Sprintf("%v", this.TokenKey) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockSupplyChanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIndexedNonces := "[]*IndexedNonce{"
+	for _, f := range this.IndexedNonces {
+		repeatedStringForIndexedNonces += strings.Replace(f.String(), "IndexedNonce", "IndexedNonce", 1) + ","
+	}
+	repeatedStringForIndexedNonces += "}"
+	repeatedStringForWipedBalances := "[]*WipedBalance{"
+	for _, f := range this.WipedBalances {
+		repeatedStringForWipedBalances += strings.Replace(f.String(), "WipedBalance", "WipedBalance", 1) + ","
+	}
+	repeatedStringForWipedBalances += "}"
+	s := strings.Join([]string{`&BlockSupplyChanges{`,
+		`IndexedNonces:` + repeatedStringForIndexedNonces + `,`,
+		`WipedBalances:` + repeatedStringForWipedBalances + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSupplyIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HolderBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoncesIndexHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoncesIndexHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoncesIndexHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNonces", wireType)
+			}
+			m.NumNonces = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNonces |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoncesBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoncesBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoncesBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSupplyIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSupplyIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSupplyIndex
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSupplyIndex
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSupplyIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = append(m.Collection[:0], dAtA[iNdEx:postIndex]...)
+			if m.Collection == nil {
+				m.Collection = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WipedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WipedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WipedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenKey = append(m.TokenKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenKey == nil {
+				m.TokenKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Amount = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockSupplyChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSupplyChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSupplyChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedNonces = append(m.IndexedNonces, &IndexedNonce{})
+			if err := m.IndexedNonces[len(m.IndexedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WipedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WipedBalances = append(m.WipedBalances, &WipedBalance{})
+			if err := m.WipedBalances[len(m.WipedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSupplyIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSupplyIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSupplyIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSupplyIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSupplyIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSupplyIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSupplyIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSupplyIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSupplyIndex = fmt.Errorf("proto: unexpected end of group")
+)
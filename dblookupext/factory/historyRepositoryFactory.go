@@ -9,9 +9,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/disabled"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +25,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +35,7 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -48,6 +52,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -56,6 +63,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -65,11 +73,17 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return disabled.NewNilHistoryRepository()
 	}
 
-	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(
-		hpf.marshalizer,
-		hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit),
-		hpf.store.GetStorer(dataRetriever.TxLogsUnit),
-	)
+	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(esdtSupply.ArgsSuppliesProcessor{
+		Marshalizer:      hpf.marshalizer,
+		SuppliesStorer:   hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit),
+		LogsStorer:       hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		ShardCoordinator: hpf.shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountTransactionsHandler, err := hpf.createAccountTransactionsHandler()
 	if err != nil {
 		return nil, err
 	}
@@ -85,10 +99,28 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AccountTransactionsHandler:  accountTransactionsHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createAccountTransactionsHandler() (dblookupext.AccountTransactionsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AccountTransactionsIndexEnabled {
+		return disabled.NewAccountTransactionsIndexer(), nil
+	}
+
+	args := accountTransactions.ArgsAccountTransactionsIndexer{
+		Marshalizer:                hpf.marshalizer,
+		AccountTransactionsStorer:  hpf.store.GetStorer(dataRetriever.AccountTransactionsUnit),
+		TransactionsStorer:         hpf.store.GetStorer(dataRetriever.TransactionUnit),
+		UnsignedTransactionsStorer: hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit),
+		RewardTransactionsStorer:   hpf.store.GetStorer(dataRetriever.RewardTransactionUnit),
+		MaxQueryPage:               hpf.dbLookupExtensionsConfig.AccountTransactionsMaxQueryPage,
+	}
+
+	return accountTransactions.NewAccountTransactionsIndexer(args)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
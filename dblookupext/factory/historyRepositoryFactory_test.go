@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
@@ -44,6 +45,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, process.ErrNilUint64Converter, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, process.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -75,6 +82,27 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithAccountTransactionsIndexShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.AccountTransactionsIndexEnabled = true
+	args.Config.AccountTransactionsMaxQueryPage = 100
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.AccountTransactionsUnit)
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              0,
@@ -83,5 +111,6 @@ func getArgs() *factory.ArgsHistoryRepositoryFactory {
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &hashingMocks.HasherMock{},
 		Uint64ByteSliceConverter: &processMock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(1),
 	}
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -37,6 +39,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AccountTransactionsHandler  AccountTransactionsHandler
 }
 
 type historyRepository struct {
@@ -50,6 +53,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	accountTransactionsHandler AccountTransactionsHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -93,6 +97,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.AccountTransactionsHandler) {
+		return nil, errNilAccountTransactionsHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -116,6 +123,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		accountTransactionsHandler:                   arguments.AccountTransactionsHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -166,6 +174,11 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.accountTransactionsHandler.RecordBlock(blockHeader, blockBody, scrResultsFromPool, logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -466,7 +479,12 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.accountTransactionsHandler.RevertBlock(blockHeader)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -474,6 +492,19 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetESDTNoncesSupplies will return the supplies of the given collection's nonces, along with their total number
+func (hr *historyRepository) GetESDTNoncesSupplies(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error) {
+	return hr.esdtSuppliesHandler.GetESDTNoncesSupplies(collection, page, size)
+}
+
+// GetAccountTransactions will return the indexed transactions of the given address, along with their total number
+func (hr *historyRepository) GetAccountTransactions(
+	address []byte,
+	options common.AccountTransactionsQueryOptions,
+) ([]*accountTransactions.AccountTransaction, uint64, error) {
+	return hr.accountTransactionsHandler.GetAccountTransactions(address, options)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
@@ -8,11 +8,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
@@ -21,11 +25,24 @@ import (
 )
 
 func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
-	sp, _ := esdtSupply.NewSuppliesProcessor(&mock.MarshalizerMock{}, &storageStubs.StorerStub{
-		GetCalled: func(key []byte) ([]byte, error) {
-			return nil, storage.ErrKeyNotFound
+	sp, _ := esdtSupply.NewSuppliesProcessor(esdtSupply.ArgsSuppliesProcessor{
+		Marshalizer: &mock.MarshalizerMock{},
+		SuppliesStorer: &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
 		},
-	}, &storageStubs.StorerStub{})
+		LogsStorer:       &storageStubs.StorerStub{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	})
+	ati, _ := accountTransactions.NewAccountTransactionsIndexer(accountTransactions.ArgsAccountTransactionsIndexer{
+		Marshalizer:                &mock.MarshalizerMock{},
+		AccountTransactionsStorer:  testscommon.CreateMemUnit(),
+		TransactionsStorer:         testscommon.CreateMemUnit(),
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+		MaxQueryPage:               100,
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -37,6 +54,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		AccountTransactionsHandler:  ati,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +106,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AccountTransactionsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAccountTransactionsHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -152,6 +176,46 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockAndRevertShouldUpdateAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	logs := []*data.LogData{
+		{
+			TxHash: "txA",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("alice"),
+						Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+						Topics:     [][]byte{[]byte("TKN-abcdef"), nil, []byte{10}, []byte("carol")},
+					},
+				},
+			},
+		},
+	}
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	err = repo.RecordBlock([]byte("headerHash"), blockHeader, &block.Body{}, nil, nil, logs)
+	require.Nil(t, err)
+
+	txs, numTxs, err := repo.GetAccountTransactions([]byte("carol"), common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), numTxs)
+	require.Equal(t, []byte("txA"), txs[0].Hash)
+	require.Equal(t, accountTransactions.RoleESDTReceiver, txs[0].Roles)
+
+	err = repo.RevertBlock(blockHeader, &block.Body{})
+	require.Nil(t, err)
+
+	txs, numTxs, err = repo.GetAccountTransactions([]byte("carol"), common.AccountTransactionsQueryOptions{})
+	require.Nil(t, err)
+	require.Zero(t, numTxs)
+	require.Empty(t, txs)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
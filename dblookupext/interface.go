@@ -2,6 +2,8 @@ package dblookupext
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
@@ -25,6 +27,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTNoncesSupplies(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error)
+	GetAccountTransactions(address []byte, options common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -43,5 +47,14 @@ type SuppliesHandler interface {
 	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
 	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTNoncesSupplies(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error)
+	IsInterfaceNil() bool
+}
+
+// AccountTransactionsHandler defines the interface of an index holding the transactions each account was involved in
+type AccountTransactionsHandler interface {
+	RecordBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrResultsFromPool map[string]data.TransactionHandler, logs []*data.LogData) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetAccountTransactions(address []byte, options common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error)
 	IsInterfaceNil() bool
 }
@@ -27,6 +27,16 @@ func (dprs *disabledPeersRatingHandler) GetTopRatedPeersFromList(peers []core.Pe
 	return peers
 }
 
+// GetPeersRatings returns an empty map as it is disabled
+func (dprs *disabledPeersRatingHandler) GetPeersRatings() map[core.PeerID]int32 {
+	return make(map[core.PeerID]int32)
+}
+
+// Close returns nil as it is disabled
+func (dprs *disabledPeersRatingHandler) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dprs *disabledPeersRatingHandler) IsInterfaceNil() bool {
 	return dprs == nil
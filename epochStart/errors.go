@@ -331,3 +331,15 @@ var ErrNilScheduledTxsHandler = errors.New("nil scheduled transactions handler")
 
 // ErrNilScheduledDataSyncerFactory signals that a nil scheduled data syncer factory was provided
 var ErrNilScheduledDataSyncerFactory = errors.New("nil scheduled data syncer factory")
+
+// ErrNilRewardsBreakdownHandler signals that a nil rewards breakdown handler has been provided
+var ErrNilRewardsBreakdownHandler = errors.New("nil rewards breakdown handler")
+
+// ErrNilRewardsBreakdown signals that a nil rewards breakdown has been provided
+var ErrNilRewardsBreakdown = errors.New("nil rewards breakdown")
+
+// ErrRewardsBreakdownNotEnabled signals that the rewards breakdown is not saved by the current node
+var ErrRewardsBreakdownNotEnabled = errors.New("rewards breakdown is not enabled, it is available only on metachain nodes")
+
+// ErrRewardsBreakdownNotFound signals that the requested rewards breakdown was not found
+var ErrRewardsBreakdownNotFound = errors.New("rewards breakdown not found")
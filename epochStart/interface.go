@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -198,6 +199,14 @@ type RewardsCreator interface {
 	IsInterfaceNil() bool
 }
 
+// RewardsBreakdownHandler defines the component able to save and load the end of epoch rewards breakdowns
+type RewardsBreakdownHandler interface {
+	SaveRewardsBreakdown(breakdown *common.RewardsBreakdownAPIResponse) error
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
 // EpochNotifier can notify upon an epoch change and provide the current epoch
 type EpochNotifier interface {
 	RegisterNotifyHandler(handler vmcommon.EpochSubscriberHandler)
@@ -0,0 +1,35 @@
+package metachain
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+type disabledRewardsBreakdownStorer struct {
+}
+
+// NewDisabledRewardsBreakdownStorer returns a rewards breakdown handler used on the nodes that do not save the
+// rewards breakdowns
+func NewDisabledRewardsBreakdownStorer() *disabledRewardsBreakdownStorer {
+	return &disabledRewardsBreakdownStorer{}
+}
+
+// SaveRewardsBreakdown does nothing and returns nil
+func (d *disabledRewardsBreakdownStorer) SaveRewardsBreakdown(_ *common.RewardsBreakdownAPIResponse) error {
+	return nil
+}
+
+// GetRewardsBreakdown returns the ErrRewardsBreakdownNotEnabled error
+func (d *disabledRewardsBreakdownStorer) GetRewardsBreakdown(_ uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nil, epochStart.ErrRewardsBreakdownNotEnabled
+}
+
+// GetNodeRewardsBreakdown returns the ErrRewardsBreakdownNotEnabled error
+func (d *disabledRewardsBreakdownStorer) GetNodeRewardsBreakdown(_ uint32, _ string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	return nil, epochStart.ErrRewardsBreakdownNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledRewardsBreakdownStorer) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,184 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// epochRewardsTotals holds the network wide values computed when distributing the rewards for blocks
+type epochRewardsTotals struct {
+	rewardsForBlocks   *big.Int
+	baseRewards        *big.Int
+	topUpRewards       *big.Int
+	totalStakeEligible *big.Int
+	totalTopUpEligible *big.Int
+}
+
+// createRewardsBreakdown gathers the intermediate values computed for the provided metablock. Should be called
+// under the rewards data mutex, after the rewards per node were computed
+func (rc *rewardsCreatorV2) createRewardsBreakdown(
+	metaBlock data.HeaderHandler,
+	computedEconomics *block.Economics,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+	protocolSustainabilityRewards *big.Int,
+) *common.RewardsBreakdownAPIResponse {
+	totals := rc.rewardsTotals
+	if totals == nil {
+		totals = &epochRewardsTotals{}
+	}
+
+	breakdown := &common.RewardsBreakdownAPIResponse{
+		Epoch:                         metaBlock.GetEpoch(),
+		TotalToDistribute:             bigIntToString(computedEconomics.TotalToDistribute),
+		RewardsForBlocks:              bigIntToString(totals.rewardsForBlocks),
+		BaseRewards:                   bigIntToString(totals.baseRewards),
+		TopUpRewards:                  bigIntToString(totals.topUpRewards),
+		TotalStakeEligible:            bigIntToString(totals.totalStakeEligible),
+		TotalTopUpEligible:            bigIntToString(totals.totalTopUpEligible),
+		ProtocolSustainabilityRewards: bigIntToString(protocolSustainabilityRewards),
+		Shards:                        rc.createShardsRewardsBreakdown(nodesRewardInfo, totals.topUpRewards),
+	}
+	breakdown.Nodes, breakdown.RewardAddresses = rc.createNodesRewardsBreakdown(nodesRewardInfo)
+
+	return breakdown
+}
+
+func (rc *rewardsCreatorV2) createShardsRewardsBreakdown(
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+	topUpRewards *big.Int,
+) []*common.ShardRewardsBreakdownAPIResponse {
+	if topUpRewards == nil {
+		topUpRewards = big.NewInt(0)
+	}
+
+	blocksPerShard := rc.economicsDataProvider.NumberOfBlocksPerShard()
+	topUpPerShard := computeTopUpPerShard(nodesRewardInfo)
+	topUpRewardsPerShard := rc.computeTopUpRewardsPerShard(topUpRewards, nodesRewardInfo)
+
+	shards := make([]*common.ShardRewardsBreakdownAPIResponse, 0, len(nodesRewardInfo))
+	for _, shardID := range sortedShardIDs(nodesRewardInfo) {
+		totalPower := big.NewInt(0)
+		for _, nodeInfo := range nodesRewardInfo[shardID] {
+			totalPower.Add(totalPower, nodeInfo.powerInShard)
+		}
+
+		shards = append(shards, &common.ShardRewardsBreakdownAPIResponse{
+			ShardID:             shardID,
+			NumBlocks:           blocksPerShard[shardID],
+			BaseRewardsPerBlock: bigIntToString(rc.mapBaseRewardsPerBlockPerValidator[shardID]),
+			TopUpStake:          bigIntToString(topUpPerShard[shardID]),
+			TopUpRewards:        bigIntToString(topUpRewardsPerShard[shardID]),
+			TotalPower:          totalPower.String(),
+		})
+	}
+
+	return shards
+}
+
+// createNodesRewardsBreakdown returns the breakdown for each eligible node and the rewards aggregated by reward
+// address. As in computeValidatorInfoPerRewardAddress, the nodes that neither proposed nor validated blocks do not
+// add to their reward address
+func (rc *rewardsCreatorV2) createNodesRewardsBreakdown(
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+) ([]*common.NodeRewardsBreakdownAPIResponse, []*common.RewardAddressBreakdownAPIResponse) {
+	nodes := make([]*common.NodeRewardsBreakdownAPIResponse, 0)
+	rewardAddresses := make(map[string]*common.RewardAddressBreakdownAPIResponse)
+	rewardsFromProtocol := make(map[string]*big.Int)
+	accumulatedFees := make(map[string]*big.Int)
+
+	for _, shardID := range sortedShardIDs(nodesRewardInfo) {
+		for _, nodeInfo := range nodesRewardInfo[shardID] {
+			valInfo := nodeInfo.valInfo
+			rewardAddress := rc.pubkeyConverter.Encode(valInfo.RewardAddress)
+			blsKey := hex.EncodeToString(valInfo.PublicKey)
+			isRewarded := valInfo.LeaderSuccess != 0 || valInfo.ValidatorSuccess != 0
+
+			nodes = append(nodes, &common.NodeRewardsBreakdownAPIResponse{
+				BLSKey:                     blsKey,
+				ShardID:                    shardID,
+				RewardAddress:              rewardAddress,
+				TempRating:                 valInfo.TempRating,
+				LeaderSuccess:              valInfo.LeaderSuccess,
+				LeaderFailure:              valInfo.LeaderFailure,
+				ValidatorSuccess:           valInfo.ValidatorSuccess,
+				ValidatorFailure:           valInfo.ValidatorFailure,
+				ValidatorIgnoredSignatures: valInfo.ValidatorIgnoredSignatures,
+				NumSelectedInSuccessBlocks: valInfo.NumSelectedInSuccessBlocks,
+				TopUpStake:                 bigIntToString(nodeInfo.topUpStake),
+				PowerInShard:               bigIntToString(nodeInfo.powerInShard),
+				BaseReward:                 bigIntToString(nodeInfo.baseReward),
+				TopUpReward:                bigIntToString(nodeInfo.topUpReward),
+				FullRewards:                bigIntToString(nodeInfo.fullRewards),
+				AccumulatedFees:            bigIntToString(valInfo.AccumulatedFees),
+				Rewarded:                   isRewarded,
+			})
+
+			if !isRewarded {
+				continue
+			}
+
+			rewardAddressBreakdown, found := rewardAddresses[rewardAddress]
+			if !found {
+				rewardAddressBreakdown = &common.RewardAddressBreakdownAPIResponse{
+					RewardAddress: rewardAddress,
+					BLSKeys:       make([]string, 0),
+				}
+				rewardAddresses[rewardAddress] = rewardAddressBreakdown
+				rewardsFromProtocol[rewardAddress] = big.NewInt(0)
+				accumulatedFees[rewardAddress] = big.NewInt(0)
+			}
+
+			rewardAddressBreakdown.BLSKeys = append(rewardAddressBreakdown.BLSKeys, blsKey)
+			addIfNotNil(rewardsFromProtocol[rewardAddress], nodeInfo.fullRewards)
+			addIfNotNil(accumulatedFees[rewardAddress], valInfo.AccumulatedFees)
+		}
+	}
+
+	sortedRewardAddresses := make([]*common.RewardAddressBreakdownAPIResponse, 0, len(rewardAddresses))
+	for rewardAddress, rewardAddressBreakdown := range rewardAddresses {
+		total := big.NewInt(0).Add(rewardsFromProtocol[rewardAddress], accumulatedFees[rewardAddress])
+		rewardAddressBreakdown.RewardsFromProtocol = rewardsFromProtocol[rewardAddress].String()
+		rewardAddressBreakdown.AccumulatedFees = accumulatedFees[rewardAddress].String()
+		rewardAddressBreakdown.TotalRewards = total.String()
+
+		sortedRewardAddresses = append(sortedRewardAddresses, rewardAddressBreakdown)
+	}
+	sort.Slice(sortedRewardAddresses, func(i, j int) bool {
+		return sortedRewardAddresses[i].RewardAddress < sortedRewardAddresses[j].RewardAddress
+	})
+
+	return nodes, sortedRewardAddresses
+}
+
+func sortedShardIDs(nodesRewardInfo map[uint32][]*nodeRewardsData) []uint32 {
+	shardIDs := make([]uint32, 0, len(nodesRewardInfo))
+	for shardID := range nodesRewardInfo {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+func addIfNotNil(sum *big.Int, value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	sum.Add(sum, value)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,96 @@
+package metachain
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsRewardsBreakdownStorer holds the arguments needed to create a rewards breakdown storer
+type ArgsRewardsBreakdownStorer struct {
+	Storer          storage.Storer
+	Marshalizer     marshal.Marshalizer
+	Uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+type rewardsBreakdownStorer struct {
+	storer          storage.Storer
+	marshalizer     marshal.Marshalizer
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+// NewRewardsBreakdownStorer creates a component able to save and load the rewards breakdowns, keyed by the epoch of
+// the start of epoch metablock that distributed the rewards
+func NewRewardsBreakdownStorer(args ArgsRewardsBreakdownStorer) (*rewardsBreakdownStorer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, epochStart.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, epochStart.ErrNilUint64Converter
+	}
+
+	return &rewardsBreakdownStorer{
+		storer:          args.Storer,
+		marshalizer:     args.Marshalizer,
+		uint64Converter: args.Uint64Converter,
+	}, nil
+}
+
+// SaveRewardsBreakdown saves the provided breakdown, overwriting the one previously saved for the same epoch
+func (rbs *rewardsBreakdownStorer) SaveRewardsBreakdown(breakdown *common.RewardsBreakdownAPIResponse) error {
+	if breakdown == nil {
+		return epochStart.ErrNilRewardsBreakdown
+	}
+
+	buff, err := rbs.marshalizer.Marshal(breakdown)
+	if err != nil {
+		return err
+	}
+
+	return rbs.storer.Put(rbs.uint64Converter.ToByteSlice(uint64(breakdown.Epoch)), buff)
+}
+
+// GetRewardsBreakdown returns the rewards breakdown saved for the provided epoch
+func (rbs *rewardsBreakdownStorer) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	buff, err := rbs.storer.Get(rbs.uint64Converter.ToByteSlice(uint64(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d", epochStart.ErrRewardsBreakdownNotFound, epoch)
+	}
+
+	breakdown := &common.RewardsBreakdownAPIResponse{}
+	err = rbs.marshalizer.Unmarshal(breakdown, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return breakdown, nil
+}
+
+// GetNodeRewardsBreakdown returns the rewards breakdown of the provided node, saved for the provided epoch
+func (rbs *rewardsBreakdownStorer) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	breakdown, err := rbs.GetRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range breakdown.Nodes {
+		if node.BLSKey == blsKey {
+			return node, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for epoch %d and BLS key %s", epochStart.ErrRewardsBreakdownNotFound, epoch, blsKey)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbs *rewardsBreakdownStorer) IsInterfaceNil() bool {
+	return rbs == nil
+}
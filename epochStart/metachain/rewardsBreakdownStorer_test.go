@@ -0,0 +1,114 @@
+package metachain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRewardsBreakdownStorer() ArgsRewardsBreakdownStorer {
+	return ArgsRewardsBreakdownStorer{
+		Storer:          mock.NewStorerMock(),
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	}
+}
+
+func TestNewRewardsBreakdownStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRewardsBreakdownStorer()
+		args.Storer = nil
+		rbs, err := NewRewardsBreakdownStorer(args)
+		assert.Equal(t, epochStart.ErrNilStorage, err)
+		assert.True(t, check.IfNil(rbs))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRewardsBreakdownStorer()
+		args.Marshalizer = nil
+		rbs, err := NewRewardsBreakdownStorer(args)
+		assert.Equal(t, epochStart.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(rbs))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRewardsBreakdownStorer()
+		args.Uint64Converter = nil
+		rbs, err := NewRewardsBreakdownStorer(args)
+		assert.Equal(t, epochStart.ErrNilUint64Converter, err)
+		assert.True(t, check.IfNil(rbs))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rbs, err := NewRewardsBreakdownStorer(createMockArgsRewardsBreakdownStorer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rbs))
+	})
+}
+
+func TestRewardsBreakdownStorer_SaveAndGetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	rbs, _ := NewRewardsBreakdownStorer(createMockArgsRewardsBreakdownStorer())
+	err := rbs.SaveRewardsBreakdown(nil)
+	assert.Equal(t, epochStart.ErrNilRewardsBreakdown, err)
+
+	breakdown := &common.RewardsBreakdownAPIResponse{
+		Epoch:       7,
+		BaseRewards: "100",
+		Nodes: []*common.NodeRewardsBreakdownAPIResponse{
+			{BLSKey: "key1", BaseReward: "60", Rewarded: true},
+			{BLSKey: "key2", BaseReward: "40", Rewarded: true},
+		},
+		RewardAddresses: []*common.RewardAddressBreakdownAPIResponse{
+			{RewardAddress: "address", BLSKeys: []string{"key1", "key2"}, TotalRewards: "100"},
+		},
+	}
+	err = rbs.SaveRewardsBreakdown(breakdown)
+	require.Nil(t, err)
+
+	loaded, err := rbs.GetRewardsBreakdown(7)
+	require.Nil(t, err)
+	assert.Equal(t, breakdown, loaded)
+
+	_, err = rbs.GetRewardsBreakdown(8)
+	assert.True(t, errors.Is(err, epochStart.ErrRewardsBreakdownNotFound))
+
+	node, err := rbs.GetNodeRewardsBreakdown(7, "key2")
+	require.Nil(t, err)
+	assert.Equal(t, breakdown.Nodes[1], node)
+
+	_, err = rbs.GetNodeRewardsBreakdown(7, "key3")
+	assert.True(t, errors.Is(err, epochStart.ErrRewardsBreakdownNotFound))
+}
+
+func TestDisabledRewardsBreakdownStorer(t *testing.T) {
+	t.Parallel()
+
+	d := NewDisabledRewardsBreakdownStorer()
+	assert.False(t, check.IfNil(d))
+	assert.Nil(t, d.SaveRewardsBreakdown(&common.RewardsBreakdownAPIResponse{}))
+
+	breakdown, err := d.GetRewardsBreakdown(0)
+	assert.Nil(t, breakdown)
+	assert.Equal(t, epochStart.ErrRewardsBreakdownNotEnabled, err)
+
+	node, err := d.GetNodeRewardsBreakdown(0, "key")
+	assert.Nil(t, node)
+	assert.Equal(t, epochStart.ErrRewardsBreakdownNotEnabled, err)
+}
@@ -23,10 +23,11 @@ const (
 // RewardsCreatorProxyArgs holds the proxy arguments
 type RewardsCreatorProxyArgs struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
-	EpochEnableV2         uint32
+	StakingDataProvider     epochStart.StakingDataProvider
+	EconomicsDataProvider   epochStart.EpochEconomicsDataProvider
+	RewardsHandler          process.RewardsHandler
+	RewardsBreakdownHandler epochStart.RewardsBreakdownHandler
+	EpochEnableV2           uint32
 }
 
 type rewardsCreatorProxy struct {
@@ -182,10 +183,11 @@ func (rcp *rewardsCreatorProxy) switchToRewardsCreatorV2() error {
 
 func (rcp *rewardsCreatorProxy) createRewardsCreatorV2() (*rewardsCreatorV2, error) {
 	argsV2 := RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: rcp.args.BaseRewardsCreatorArgs,
-		StakingDataProvider:    rcp.args.StakingDataProvider,
-		EconomicsDataProvider:  rcp.args.EconomicsDataProvider,
-		RewardsHandler:         rcp.args.RewardsHandler,
+		BaseRewardsCreatorArgs:  rcp.args.BaseRewardsCreatorArgs,
+		StakingDataProvider:     rcp.args.StakingDataProvider,
+		EconomicsDataProvider:   rcp.args.EconomicsDataProvider,
+		RewardsHandler:          rcp.args.RewardsHandler,
+		RewardsBreakdownHandler: rcp.args.RewardsBreakdownHandler,
 	}
 
 	return NewRewardsCreatorV2(argsV2)
@@ -365,10 +365,11 @@ func createDefaultRewardsCreatorProxyArgs() RewardsCreatorProxyArgs {
 	}
 
 	return RewardsCreatorProxyArgs{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &mock.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &mock.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		RewardsBreakdownHandler: NewDisabledRewardsBreakdownStorer(),
 	}
 }
 
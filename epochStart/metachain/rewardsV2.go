@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/validatorInfo"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -30,16 +31,20 @@ type nodeRewardsData struct {
 // RewardsCreatorArgsV2 holds the data required to create end of epoch rewards
 type RewardsCreatorArgsV2 struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
+	StakingDataProvider     epochStart.StakingDataProvider
+	EconomicsDataProvider   epochStart.EpochEconomicsDataProvider
+	RewardsHandler          process.RewardsHandler
+	RewardsBreakdownHandler epochStart.RewardsBreakdownHandler
 }
 
 type rewardsCreatorV2 struct {
 	*baseRewardsCreator
-	stakingDataProvider   epochStart.StakingDataProvider
-	economicsDataProvider epochStart.EpochEconomicsDataProvider
-	rewardsHandler        process.RewardsHandler
+	stakingDataProvider     epochStart.StakingDataProvider
+	economicsDataProvider   epochStart.EpochEconomicsDataProvider
+	rewardsHandler          process.RewardsHandler
+	rewardsBreakdownHandler epochStart.RewardsBreakdownHandler
+	rewardsTotals           *epochRewardsTotals
+	rewardsBreakdown        *common.RewardsBreakdownAPIResponse
 }
 
 // NewRewardsCreatorV2 creates a new rewards creator object
@@ -58,12 +63,16 @@ func NewRewardsCreatorV2(args RewardsCreatorArgsV2) (*rewardsCreatorV2, error) {
 	if check.IfNil(args.RewardsHandler) {
 		return nil, epochStart.ErrNilRewardsHandler
 	}
+	if check.IfNil(args.RewardsBreakdownHandler) {
+		return nil, epochStart.ErrNilRewardsBreakdownHandler
+	}
 
 	rc := &rewardsCreatorV2{
-		baseRewardsCreator:    brc,
-		economicsDataProvider: args.EconomicsDataProvider,
-		stakingDataProvider:   args.StakingDataProvider,
-		rewardsHandler:        args.RewardsHandler,
+		baseRewardsCreator:      brc,
+		economicsDataProvider:   args.EconomicsDataProvider,
+		stakingDataProvider:     args.StakingDataProvider,
+		rewardsHandler:          args.RewardsHandler,
+		rewardsBreakdownHandler: args.RewardsBreakdownHandler,
 	}
 
 	return rc, nil
@@ -99,6 +108,7 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	miniBlocks := rc.initializeRewardsMiniBlocks()
 	rc.clean()
+	rc.rewardsBreakdown = nil
 	rc.flagDelegationSystemSCEnabled.SetValue(metaBlock.GetEpoch() >= rc.delegationSystemSCEnableEpoch)
 
 	protRwdTx, protRwdShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
@@ -123,6 +133,8 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.rewardsBreakdown = rc.createRewardsBreakdown(metaBlock, computedEconomics, nodesRewardInfo, protRwdTx.Value)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
@@ -256,6 +268,30 @@ func (rc *rewardsCreatorV2) computeValidatorInfoPerRewardAddress(
 	return rwdAddrValidatorInfo, accumulatedUnassigned
 }
 
+// SaveTxBlockToStorage saves created data to storage, together with the rewards breakdown computed for the
+// provided metablock
+func (rc *rewardsCreatorV2) SaveTxBlockToStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
+	rc.baseRewardsCreator.SaveTxBlockToStorage(metaBlock, body)
+	if check.IfNil(metaBlock) {
+		return
+	}
+
+	rc.mutRewardsData.RLock()
+	breakdown := rc.rewardsBreakdown
+	rc.mutRewardsData.RUnlock()
+
+	if breakdown == nil || breakdown.Epoch != metaBlock.GetEpoch() {
+		return
+	}
+
+	err := rc.rewardsBreakdownHandler.SaveRewardsBreakdown(breakdown)
+	if err != nil {
+		log.Warn("rewardsCreatorV2.SaveTxBlockToStorage: could not save the rewards breakdown",
+			"epoch", breakdown.Epoch,
+			"error", err)
+	}
+}
+
 // IsInterfaceNil return true if underlying object is nil
 func (rc *rewardsCreatorV2) IsInterfaceNil() bool {
 	return rc == nil
@@ -288,6 +324,14 @@ func (rc *rewardsCreatorV2) computeRewardsPerNode(
 		"baseRewards", baseRewards.String(),
 		"topUpRewards", topUpRewards.String())
 
+	rc.rewardsTotals = &epochRewardsTotals{
+		rewardsForBlocks:   remainingToBeDistributed,
+		baseRewards:        baseRewards,
+		topUpRewards:       topUpRewards,
+		totalStakeEligible: totalStakeEligible,
+		totalTopUpEligible: totalTopUpEligible,
+	}
+
 	rc.fillBaseRewardsPerBlockPerNode(baseRewardsPerBlock)
 
 	accumulatedDust := big.NewInt(0)
@@ -3,6 +3,7 @@ package metachain
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -13,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
@@ -1670,6 +1673,113 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNewRewardsCreatorV2_SaveTxBlockToStorageShouldSaveTheRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(40)
+	dummyRwd, _ := NewRewardsCreatorV2(args)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+	nodesRewardInfo := dummyRwd.initNodesRewardsInfo(vInfo)
+	_, _ = setDummyValuesInNodesRewardInfo(nodesRewardInfo, nbEligiblePerShard, tuStake, 0)
+	// one offline node, which should not be rewarded
+	vInfo[0][0].LeaderSuccess = 0
+	vInfo[0][0].ValidatorSuccess = 0
+
+	args.StakingDataProvider = &mock.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			totalTopUpStake, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
+			return totalTopUpStake
+		},
+		GetNodeStakedTopUpCalled: func(blsKey []byte) (*big.Int, error) {
+			for shardID, vList := range vInfo {
+				for i, v := range vList {
+					if bytes.Equal(v.PublicKey, blsKey) {
+						return nodesRewardInfo[shardID][i].topUpStake, nil
+					}
+				}
+			}
+			return nil, fmt.Errorf("not found")
+		},
+	}
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = uint64(defaultBlocksPerShard)
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	rewardsForBlocks, _ := big.NewInt(0).SetString("5000000000000000000000", 10)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(rewardsForBlocks)
+	args.RewardsBreakdownHandler, _ = NewRewardsBreakdownStorer(ArgsRewardsBreakdownStorer{
+		Storer:          mock.NewStorerMock(),
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	})
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+
+	metaBlock := &block.MetaBlock{
+		Epoch:          3,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	miniBlocks, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	_, err = args.RewardsBreakdownHandler.GetRewardsBreakdown(3)
+	require.True(t, errors.Is(err, epochStart.ErrRewardsBreakdownNotFound))
+
+	rwd.SaveTxBlockToStorage(metaBlock, &block.Body{MiniBlocks: miniBlocks})
+
+	breakdown, err := args.RewardsBreakdownHandler.GetRewardsBreakdown(3)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), breakdown.Epoch)
+	require.Equal(t, rewardsForBlocks.String(), breakdown.RewardsForBlocks)
+	require.Equal(t, rwd.GetProtocolSustainabilityRewards().String(), breakdown.ProtocolSustainabilityRewards)
+	require.Equal(t, len(blocksPerShard), len(breakdown.Shards))
+	require.Equal(t, int(nbEligiblePerShard)*len(blocksPerShard), len(breakdown.Nodes))
+	require.Equal(t, int(nbEligiblePerShard)*len(blocksPerShard)-1, len(breakdown.RewardAddresses))
+
+	// the rewards aggregated per reward address should match the created reward transactions
+	txValuePerAddress := make(map[string]string)
+	for _, mb := range miniBlocks {
+		for _, txHash := range mb.TxHashes {
+			tx, errGet := rwd.currTxs.GetTx(txHash)
+			require.Nil(t, errGet)
+			txValuePerAddress[args.PubkeyConverter.Encode(tx.GetRcvAddr())] = tx.GetValue().String()
+		}
+	}
+	for _, rewardAddress := range breakdown.RewardAddresses {
+		require.Equal(t, txValuePerAddress[rewardAddress.RewardAddress], rewardAddress.TotalRewards)
+	}
+
+	offlineNode, err := args.RewardsBreakdownHandler.GetNodeRewardsBreakdown(3, hex.EncodeToString(vInfo[0][0].PublicKey))
+	require.Nil(t, err)
+	require.False(t, offlineNode.Rewarded)
+	require.Equal(t, "0", offlineNode.PowerInShard)
+
+	onlineNode, err := args.RewardsBreakdownHandler.GetNodeRewardsBreakdown(3, hex.EncodeToString(vInfo[0][1].PublicKey))
+	require.Nil(t, err)
+	require.True(t, onlineNode.Rewarded)
+	require.Equal(t, onlineNode.FullRewards, sumStrings(onlineNode.BaseReward, onlineNode.TopUpReward))
+	require.Equal(t, rwd.mapBaseRewardsPerBlockPerValidator[0].String(), breakdown.Shards[0].BaseRewardsPerBlock)
+
+	// a breakdown computed for another epoch is not saved
+	rwd.SaveTxBlockToStorage(&block.MetaBlock{Epoch: 4}, &block.Body{})
+	_, err = args.RewardsBreakdownHandler.GetRewardsBreakdown(4)
+	require.True(t, errors.Is(err, epochStart.ErrRewardsBreakdownNotFound))
+}
+
+func sumStrings(values ...string) string {
+	sum := big.NewInt(0)
+	for _, value := range values {
+		bigValue, _ := big.NewInt(0).SetString(value, 10)
+		sum.Add(sum, bigValue)
+	}
+
+	return sum.String()
+}
+
 func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	t.Parallel()
 
@@ -1780,10 +1890,11 @@ func getRewardsCreatorV2Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &mock.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &mock.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		RewardsBreakdownHandler: NewDisabledRewardsBreakdownStorer(),
 	}
 }
 
@@ -1800,10 +1911,11 @@ func getRewardsCreatorV35Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &mock.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &mock.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		RewardsBreakdownHandler: NewDisabledRewardsBreakdownStorer(),
 	}
 }
 
@@ -466,3 +466,15 @@ var ErrNilTxsSender = errors.New("nil transactions sender has been provided")
 
 // ErrNilProcessStatusHandler signals that a nil process status handler was provided
 var ErrNilProcessStatusHandler = errors.New("nil process status handler")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
+// ErrKeyNotHostedByRemoteSigner signals that a key managed by the node is not hosted by the remote signer
+var ErrKeyNotHostedByRemoteSigner = errors.New("key not hosted by the remote signer")
+
+// ErrNoKeysHostedByRemoteSigner signals that the remote signer does not host any key
+var ErrNoKeysHostedByRemoteSigner = errors.New("no keys hosted by the remote signer")
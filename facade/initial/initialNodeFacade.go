@@ -231,6 +231,16 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetPeersRatings returns nil and error
+func (inf *initialNodeFacade) GetPeersRatings() (map[string]int32, error) {
+	return nil, errNodeStarting
+}
+
+// GetManagedKeysStatus returns an empty slice
+func (inf *initialNodeFacade) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	return make([]*common.ManagedKeyStatus, 0)
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -311,6 +321,51 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, errNodeStarting
 }
 
+// GetTokenHolders returns nil and error
+func (inf *initialNodeFacade) GetTokenHolders(_ string, _ uint64) (*common.TokenHoldersAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ string) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationSnapshot returns nil and error
+func (inf *initialNodeFacade) GetDelegationSnapshot(_ string) (*common.DelegationSnapshotAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetRewardsBreakdown returns nil and error
+func (inf *initialNodeFacade) GetRewardsBreakdown(_ uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetNodeRewardsBreakdown returns nil and error
+func (inf *initialNodeFacade) GetNodeRewardsBreakdown(_ uint32, _ string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// PreviewNodesShuffling returns nil and error
+func (inf *initialNodeFacade) PreviewNodesShuffling(_ *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetConsensusGroups returns nil and error
+func (inf *initialNodeFacade) GetConsensusGroups(_ *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorPerformance returns nil and error
+func (inf *initialNodeFacade) GetValidatorPerformance(_ *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetESDTData returns nil and error
 func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
@@ -327,7 +382,17 @@ func (inf *initialNodeFacade) GetAllIssuedESDTs(_ string) ([]string, error) {
 }
 
 // GetTokenSupply returns nil and error
-func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
+func (inf *initialNodeFacade) GetTokenSupply(_ string) (*common.ESDTSupplyAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTokenNoncesSupplies returns nil and error
+func (inf *initialNodeFacade) GetTokenNoncesSupplies(_ string, _ uint32, _ uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetAccountTransactions returns nil and error
+func (inf *initialNodeFacade) GetAccountTransactions(_ string, _ common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -51,7 +51,13 @@ type NodeHandler interface {
 	GetAllESDTTokens(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
 
 	// GetTokenSupply returns the provided token supply from current shard
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error)
+
+	// GetTokenNoncesSupplies returns the supplies of the provided collection's nonces from current shard
+	GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
+
+	// GetAccountTransactions returns the indexed transactions of the given address
+	GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
 
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -87,6 +93,8 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatings() (map[string]int32, error)
+	GetManagedKeysStatus() []*common.ManagedKeyStatus
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
@@ -107,6 +115,15 @@ type ApiResolver interface {
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
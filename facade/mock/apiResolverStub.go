@@ -19,6 +19,15 @@ type ApiResolverStub struct {
 	GetTotalStakedValueHandler             func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler             func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler               func(ctx context.Context) ([]*api.Delegator, error)
+	GetTokenHoldersCalled                  func(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposalsCalled           func(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled            func(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshotCalled            func(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error)
+	GetRewardsBreakdownCalled              func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdownCalled          func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShufflingCalled            func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroupsCalled               func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformanceCalled          func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	GetBlockByHashCalled                   func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                  func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                  func(round uint64, withTxs bool) (*api.Block, error)
@@ -125,6 +134,87 @@ func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nil, nil
 }
 
+// GetTokenHolders -
+func (ars *ApiResolverStub) GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error) {
+	if ars.GetTokenHoldersCalled != nil {
+		return ars.GetTokenHoldersCalled(tokenIdentifier, nonce, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalsCalled != nil {
+		return ars.GetGovernanceProposalsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(reference, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationSnapshot -
+func (ars *ApiResolverStub) GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+	if ars.GetDelegationSnapshotCalled != nil {
+		return ars.GetDelegationSnapshotCalled(contractAddress, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (ars *ApiResolverStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if ars.GetRewardsBreakdownCalled != nil {
+		return ars.GetRewardsBreakdownCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetNodeRewardsBreakdown -
+func (ars *ApiResolverStub) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	if ars.GetNodeRewardsBreakdownCalled != nil {
+		return ars.GetNodeRewardsBreakdownCalled(epoch, blsKey)
+	}
+
+	return nil, nil
+}
+
+// PreviewNodesShuffling -
+func (ars *ApiResolverStub) PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	if ars.PreviewNodesShufflingCalled != nil {
+		return ars.PreviewNodesShufflingCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusGroups -
+func (ars *ApiResolverStub) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	if ars.GetConsensusGroupsCalled != nil {
+		return ars.GetConsensusGroupsCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorPerformance -
+func (ars *ApiResolverStub) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	if ars.GetValidatorPerformanceCalled != nil {
+		return ars.GetValidatorPerformanceCalled(request)
+	}
+
+	return nil, nil
+}
+
 // GetInternalShardBlockByNonce -
 func (ars *ApiResolverStub) GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if ars.GetInternalShardBlockByNonceCalled != nil {
@@ -38,6 +38,8 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatingsCalled                          func() (map[string]int32, error)
+	GetManagedKeysStatusCalled                     func() []*common.ManagedKeyStatus
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                         func(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
@@ -49,6 +51,9 @@ type NodeStub struct {
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetAccountTransactionsCalled                   func(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
+	GetTokenSupplyCalled                           func(token string) (*common.ESDTSupplyAPIResponse, error)
+	GetTokenNoncesSuppliesCalled                   func(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
 }
 
 // GetProof -
@@ -194,6 +199,24 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetPeersRatings -
+func (ns *NodeStub) GetPeersRatings() (map[string]int32, error) {
+	if ns.GetPeersRatingsCalled != nil {
+		return ns.GetPeersRatingsCalled()
+	}
+
+	return make(map[string]int32), nil
+}
+
+// GetManagedKeysStatus -
+func (ns *NodeStub) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	if ns.GetManagedKeysStatusCalled != nil {
+		return ns.GetManagedKeysStatusCalled()
+	}
+
+	return make([]*common.ManagedKeyStatus, 0)
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
@@ -231,7 +254,29 @@ func (ns *NodeStub) GetAllESDTTokens(address string, ctx context.Context) (map[s
 }
 
 // GetTokenSupply -
-func (ns *NodeStub) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
+func (ns *NodeStub) GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error) {
+	if ns.GetTokenSupplyCalled != nil {
+		return ns.GetTokenSupplyCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetTokenNoncesSupplies -
+func (ns *NodeStub) GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+	if ns.GetTokenNoncesSuppliesCalled != nil {
+		return ns.GetTokenNoncesSuppliesCalled(collection, page, size)
+	}
+
+	return nil, nil
+}
+
+// GetAccountTransactions -
+func (ns *NodeStub) GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+	if ns.GetAccountTransactionsCalled != nil {
+		return ns.GetAccountTransactionsCalled(address, options)
+	}
+
 	return nil, nil
 }
 
@@ -226,10 +226,20 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigit
 }
 
 // GetTokenSupply returns the provided token supply
-func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error) {
+func (nf *nodeFacade) GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error) {
 	return nf.node.GetTokenSupply(token)
 }
 
+// GetTokenNoncesSupplies returns the supplies of the provided collection's nonces
+func (nf *nodeFacade) GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+	return nf.node.GetTokenNoncesSupplies(collection, page, size)
+}
+
+// GetAccountTransactions returns the indexed transactions of the provided address
+func (nf *nodeFacade) GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+	return nf.node.GetAccountTransactions(address, options)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -356,6 +366,65 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList(ctx)
 }
 
+// GetTokenHolders will output the holders of the provided token and their balances
+func (nf *nodeFacade) GetTokenHolders(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetTokenHolders(tokenIdentifier, nonce, ctx)
+}
+
+// GetGovernanceProposals will output the governance proposals along with their tallies and voters
+func (nf *nodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposals(ctx)
+}
+
+// GetGovernanceProposal will output the governance proposal identified by the provided reference
+func (nf *nodeFacade) GetGovernanceProposal(reference string) (*common.GovernanceProposalAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposal(reference, ctx)
+}
+
+// GetDelegationSnapshot will output the decoded snapshot of the provided delegation contract
+func (nf *nodeFacade) GetDelegationSnapshot(contractAddress string) (*common.DelegationSnapshotAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetDelegationSnapshot(contractAddress, ctx)
+}
+
+// GetRewardsBreakdown will output the breakdown of the rewards distributed at the start of the provided epoch
+func (nf *nodeFacade) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nf.apiResolver.GetRewardsBreakdown(epoch)
+}
+
+// GetNodeRewardsBreakdown will output the breakdown of the rewards distributed at the start of the provided epoch
+// for the provided node
+func (nf *nodeFacade) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	return nf.apiResolver.GetNodeRewardsBreakdown(epoch, blsKey)
+}
+
+// PreviewNodesShuffling will output the previewed nodes lists of the next epoch, optionally after applying the
+// hypothetical stake and unstake sets of the request
+func (nf *nodeFacade) PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nf.apiResolver.PreviewNodesShuffling(request)
+}
+
+// GetConsensusGroups will output the nodes lists of a past epoch and, optionally, the consensus group of a past round
+func (nf *nodeFacade) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	return nf.apiResolver.GetConsensusGroups(request)
+}
+
+// GetValidatorPerformance will output the performance report of a validator in an epoch
+func (nf *nodeFacade) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	return nf.apiResolver.GetValidatorPerformance(request)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -401,6 +470,16 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetPeersRatings returns the ratings of the known peers, as used when choosing the peers to send requests to
+func (nf *nodeFacade) GetPeersRatings() (map[string]int32, error) {
+	return nf.node.GetPeersRatings()
+}
+
+// GetManagedKeysStatus returns the activity status of every BLS key managed by the current node
+func (nf *nodeFacade) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	return nf.node.GetManagedKeysStatus()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -862,6 +862,176 @@ func TestNodeFacade_GetDelegatorsList(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTokenHoldersCalled: func(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error) {
+			called = true
+			assert.Equal(t, "TKN-abcdef", tokenIdentifier)
+			assert.Equal(t, uint64(3), nonce)
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetTokenHolders("TKN-abcdef", 3)
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalsCalled: func(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+			called = true
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceProposals()
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalCalled: func(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error) {
+			called = true
+			assert.Equal(t, "reference", reference)
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceProposal("reference")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetDelegationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationSnapshotCalled: func(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+			called = true
+			assert.Equal(t, "contract", contractAddress)
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetDelegationSnapshot("contract")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	expectedBreakdown := &common.RewardsBreakdownAPIResponse{Epoch: 4}
+	expectedNodeBreakdown := &common.NodeRewardsBreakdownAPIResponse{BLSKey: "blsKey"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+			assert.Equal(t, uint32(4), epoch)
+			return expectedBreakdown, nil
+		},
+		GetNodeRewardsBreakdownCalled: func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+			assert.Equal(t, uint32(4), epoch)
+			assert.Equal(t, "blsKey", blsKey)
+			return expectedNodeBreakdown, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	breakdown, err := nf.GetRewardsBreakdown(4)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBreakdown, breakdown)
+
+	nodeBreakdown, err := nf.GetNodeRewardsBreakdown(4, "blsKey")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedNodeBreakdown, nodeBreakdown)
+}
+
+func TestNodeFacade_PreviewNodesShuffling(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := &common.NodesShufflingPreviewRequest{Stake: []string{"blsKey"}}
+	expectedPreview := &common.NodesShufflingPreviewAPIResponse{Epoch: 4, WhatIf: true}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		PreviewNodesShufflingCalled: func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedPreview, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	preview, err := nf.PreviewNodesShuffling(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPreview, preview)
+}
+
+func TestNodeFacade_GetConsensusGroups(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(4)
+	expectedRequest := &common.ConsensusGroupsRequest{Epoch: &epoch}
+	expectedResponse := &common.ConsensusGroupsAPIResponse{Epoch: 4}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetConsensusGroupsCalled: func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetConsensusGroups(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetValidatorPerformance(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := &common.ValidatorPerformanceRequest{BLSKey: "blsKey"}
+	expectedResponse := &common.ValidatorPerformanceAPIResponse{BLSKey: "blsKey", Epoch: 4}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetValidatorPerformanceCalled: func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetValidatorPerformance(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
 func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
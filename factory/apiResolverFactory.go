@@ -10,10 +10,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/consensusGroupsAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/validatorPerformanceAPI"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	trieIteratorsFactory "github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -26,6 +29,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/state"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -180,6 +184,64 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsTokenHolders := trieIterators.ArgTokenHoldersProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
+	}
+	tokenHoldersHandler, err := trieIteratorsFactory.CreateTokenHoldersHandler(argsTokenHolders)
+	if err != nil {
+		return nil, err
+	}
+
+	argsGovernance := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
+		BlockChain:               args.DataComponents.Blockchain(),
+	}
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsGovernance)
+	if err != nil {
+		return nil, err
+	}
+
+	argsDelegationSnapshot := trieIterators.ArgDelegationSnapshotProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
+		BlockChain:               args.DataComponents.Blockchain(),
+		MaxServiceFee:            args.Configs.SystemSCConfig.DelegationSystemSCConfig.MaxServiceFee,
+	}
+	delegationSnapshotHandler, err := trieIteratorsFactory.CreateDelegationSnapshotHandler(argsDelegationSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsBreakdownHandler, err := createRewardsBreakdownHandler(
+		args.DataComponents.StorageService(),
+		args.CoreComponents.Uint64ByteSliceConverter(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesShufflingPreviewer, err := createNodesShufflingPreviewer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalNodesCoordinator, err := createHistoricalNodesCoordinator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroupsHandler, err := createConsensusGroupsHandler(args, historicalNodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorPerformanceHandler, err := createValidatorPerformanceHandler(args, historicalNodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
 	argsAPITransactionProc := &transactionAPI.ArgAPITransactionProcessor{
 		RoundDuration:            args.CoreComponents.GenesisNodesSetup().GetRoundDuration(),
 		GenesisTime:              args.CoreComponents.GenesisTime(),
@@ -207,22 +269,104 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 	}
 
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           scQueryService,
-		StatusMetricsHandler:     args.CoreComponents.StatusHandlerUtils().Metrics(),
-		TxCostHandler:            txCostHandler,
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionProcessor,
-		APIBlockHandler:          apiBlockProcessor,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
-		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+		SCQueryService:              scQueryService,
+		StatusMetricsHandler:        args.CoreComponents.StatusHandlerUtils().Metrics(),
+		TxCostHandler:               txCostHandler,
+		TotalStakedValueHandler:     totalStakedValueHandler,
+		DirectStakedListHandler:     directStakedListHandler,
+		DelegatedListHandler:        delegatedListHandler,
+		TokenHoldersHandler:         tokenHoldersHandler,
+		GovernanceHandler:           governanceHandler,
+		DelegationSnapshotHandler:   delegationSnapshotHandler,
+		RewardsBreakdownHandler:     rewardsBreakdownHandler,
+		NodesShufflingPreviewer:     nodesShufflingPreviewer,
+		ConsensusGroupsHandler:      consensusGroupsHandler,
+		ValidatorPerformanceHandler: validatorPerformanceHandler,
+		APITransactionHandler:       apiTransactionProcessor,
+		APIBlockHandler:             apiBlockProcessor,
+		APIInternalBlockHandler:     apiInternalBlockProcessor,
+		GenesisNodesSetupHandler:    args.CoreComponents.GenesisNodesSetup(),
+		ValidatorPubKeyConverter:    args.CoreComponents.ValidatorPubKeyConverter(),
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
 }
 
+func createHistoricalNodesCoordinator(args *ApiResolverArgs) (consensusGroupsAPI.HistoricalNodesCoordinator, error) {
+	storageService := args.DataComponents.StorageService()
+	argsHistoricalNodesCoordinator := nodesCoordinator.ArgsHistoricalNodesCoordinator{
+		NodesCoordinator: args.ProcessComponents.NodesCoordinator(),
+		BootStorer:       storageService.GetStorer(dataRetriever.BootstrapUnit),
+		MetaBlockStorer:  storageService.GetStorer(dataRetriever.MetaBlockUnit),
+		Marshalizer:      args.CoreComponents.InternalMarshalizer(),
+		Hasher:           args.CoreComponents.Hasher(),
+	}
+
+	return nodesCoordinator.NewHistoricalNodesCoordinator(argsHistoricalNodesCoordinator)
+}
+
+func createConsensusGroupsHandler(
+	args *ApiResolverArgs,
+	historicalNodesCoordinator consensusGroupsAPI.HistoricalNodesCoordinator,
+) (external.ConsensusGroupsHandler, error) {
+	argsConsensusGroups := consensusGroupsAPI.ArgsConsensusGroupsProcessor{
+		SelfShardID:                args.BootstrapComponents.ShardCoordinator().SelfId(),
+		Store:                      args.DataComponents.StorageService(),
+		Marshalizer:                args.CoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter:   args.CoreComponents.Uint64ByteSliceConverter(),
+		HistoricalNodesCoordinator: historicalNodesCoordinator,
+		ValidatorPubKeyConverter:   args.CoreComponents.ValidatorPubKeyConverter(),
+	}
+
+	return consensusGroupsAPI.NewConsensusGroupsProcessor(argsConsensusGroups)
+}
+
+func createValidatorPerformanceHandler(
+	args *ApiResolverArgs,
+	historicalNodesCoordinator validatorPerformanceAPI.HistoricalNodesCoordinator,
+) (external.ValidatorPerformanceHandler, error) {
+	argsValidatorPerformance := validatorPerformanceAPI.ArgsValidatorPerformanceProcessor{
+		SelfShardID:                args.BootstrapComponents.ShardCoordinator().SelfId(),
+		RoundsPerEpoch:             uint64(args.Configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch),
+		Store:                      args.DataComponents.StorageService(),
+		Marshalizer:                args.CoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter:   args.CoreComponents.Uint64ByteSliceConverter(),
+		BlockChain:                 args.DataComponents.Blockchain(),
+		HistoricalNodesCoordinator: historicalNodesCoordinator,
+		ValidatorsProvider:         args.ProcessComponents.ValidatorsProvider(),
+		Rater:                      args.CoreComponents.Rater(),
+		RatingsData:                args.CoreComponents.RatingsData(),
+		ValidatorPubKeyConverter:   args.CoreComponents.ValidatorPubKeyConverter(),
+	}
+
+	return validatorPerformanceAPI.NewValidatorPerformanceProcessor(argsValidatorPerformance)
+}
+
+func createNodesShufflingPreviewer(args *ApiResolverArgs) (external.NodesShufflingPreviewer, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return nodesCoordinator.NewDisabledNodesShufflingPreviewer(), nil
+	}
+
+	// the previewer uses its own shuffler, configured as the one of the nodes coordinator, as updating the nodes lists
+	// changes the shuffler's configuration
+	enableEpochs := args.Configs.EpochConfig.EnableEpochs
+	shuffler, err := nodesCoordinator.NewHashValidatorsShuffler(createNodesShufflerArgs(args.CoreComponents.GenesisNodesSetup(), enableEpochs))
+	if err != nil {
+		return nil, err
+	}
+
+	argsPreviewer := nodesCoordinator.ArgsNodesShufflingPreviewer{
+		Shuffler:                  shuffler,
+		NodesCoordinator:          args.ProcessComponents.NodesCoordinator(),
+		ValidatorInfoProvider:     args.ProcessComponents.ValidatorsStatistics(),
+		CurrentBlockProvider:      args.DataComponents.Blockchain(),
+		PubKeyConverter:           args.CoreComponents.ValidatorPubKeyConverter(),
+		WaitingListFixEnableEpoch: enableEpochs.WaitingListFixEnableEpoch,
+	}
+
+	return nodesCoordinator.NewNodesShufflingPreviewer(argsPreviewer)
+}
+
 func createScQueryService(
 	args *scQueryServiceArgs,
 ) (process.SCQueryService, error) {
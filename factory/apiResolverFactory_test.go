@@ -39,8 +39,9 @@ func TestCreateApiResolver(t *testing.T) {
 			FlagsConfig: &config.ContextFlagsConfig{
 				WorkingDir: "",
 			},
-			GeneralConfig: &cfg,
-			EpochConfig:   &config.EpochConfig{},
+			GeneralConfig:  &cfg,
+			EpochConfig:    &config.EpochConfig{},
+			SystemSCConfig: &config.SystemSmartContractsConfig{},
 		},
 		CoreComponents:      coreComponents,
 		DataComponents:      dataComponents,
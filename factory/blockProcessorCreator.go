@@ -5,7 +5,10 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -725,6 +728,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	rewardsBreakdownHandler, err := createRewardsBreakdownHandler(pcf.data.StorageService(), pcf.coreData.Uint64ByteSliceConverter())
+	if err != nil {
+		return nil, err
+	}
+
 	rewardsStorage := pcf.data.StorageService().GetStorer(dataRetriever.RewardTransactionUnit)
 	miniBlockStorage := pcf.data.StorageService().GetStorer(dataRetriever.MiniBlockUnit)
 	argsEpochRewards := metachainEpochStart.RewardsCreatorProxyArgs{
@@ -742,10 +750,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 			RewardsFix1EpochEnable:        enableEpochs.SwitchJailWaitingEnableEpoch,
 			DelegationSystemSCEnableEpoch: pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
 		},
-		StakingDataProvider:   stakingDataProvider,
-		RewardsHandler:        pcf.coreData.EconomicsData(),
-		EconomicsDataProvider: economicsDataProvider,
-		EpochEnableV2:         pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
+		StakingDataProvider:     stakingDataProvider,
+		RewardsHandler:          pcf.coreData.EconomicsData(),
+		EconomicsDataProvider:   economicsDataProvider,
+		RewardsBreakdownHandler: rewardsBreakdownHandler,
+		EpochEnableV2:           pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
 	}
 	epochRewards, err := metachainEpochStart.NewRewardsCreatorProxy(argsEpochRewards)
 	if err != nil {
@@ -1160,3 +1169,23 @@ func (pcf *processComponentsFactory) createBuiltInFunctionContainer(
 
 	return builtInFunctions.CreateBuiltInFuncContainerAndNFTStorageHandler(argsBuiltIn)
 }
+
+// createRewardsBreakdownHandler returns the component saving and loading the rewards breakdowns. The breakdowns are
+// not protobuf structures, so they are stored as JSON
+func createRewardsBreakdownHandler(
+	storageService dataRetriever.StorageService,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+) (epochStart.RewardsBreakdownHandler, error) {
+	rewardsBreakdownStorer := storageService.GetStorer(dataRetriever.RewardsBreakdownUnit)
+	if check.IfNil(rewardsBreakdownStorer) {
+		return metachainEpochStart.NewDisabledRewardsBreakdownStorer(), nil
+	}
+
+	args := metachainEpochStart.ArgsRewardsBreakdownStorer{
+		Storer:          rewardsBreakdownStorer,
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Uint64Converter: uint64Converter,
+	}
+
+	return metachainEpochStart.NewRewardsBreakdownStorer(args)
+}
@@ -148,6 +148,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 
 	cc.bootstrapper.StartSyncingBlocks()
 
+	ccf.checkManagedKeysShard()
+
 	epoch := ccf.getEpoch()
 	consensusState, err := ccf.createConsensusState(epoch, cc.consensusGroupSize)
 	if err != nil {
@@ -166,6 +168,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.processComponents.ShardCoordinator(),
 		ccf.cryptoComponents.PrivateKey(),
 		ccf.cryptoComponents.PeerSignatureHandler(),
+		ccf.cryptoComponents.SigningHandler(),
 		ccf.dataComponents.Datapool().Headers(),
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
@@ -205,6 +208,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		ManagedPeersHolder:       ccf.cryptoComponents.ManagedPeersHolder(),
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -247,6 +251,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
 		ScheduledProcessor:            ccf.scheduledProcessor,
+		ManagedPeersHolder:            ccf.cryptoComponents.ManagedPeersHolder(),
+		SigningHandler:                ccf.cryptoComponents.SigningHandler(),
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -332,6 +338,30 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 	return chronologyHandler, nil
 }
 
+func (ccf *consensusComponentsFactory) checkManagedKeysShard() {
+	managedPeersHolder := ccf.cryptoComponents.ManagedPeersHolder()
+	if !managedPeersHolder.IsMultiKeyMode() {
+		return
+	}
+
+	selfShardID := ccf.processComponents.ShardCoordinator().SelfId()
+	nodesCoordinator := ccf.processComponents.NodesCoordinator()
+	pubKeyConverter := ccf.coreComponents.ValidatorPubKeyConverter()
+	for _, pkBytes := range managedPeersHolder.GetManagedKeys() {
+		_, shardID, err := nodesCoordinator.GetValidatorWithPublicKey(pkBytes)
+		if err != nil {
+			continue
+		}
+		if shardID != selfShardID {
+			log.Warn("managed key is assigned to another shard and will not take part in consensus",
+				"pk", pubKeyConverter.Encode(pkBytes),
+				"key shard", shardID,
+				"node shard", selfShardID,
+			)
+		}
+	}
+}
+
 func (ccf *consensusComponentsFactory) getEpoch() uint32 {
 	blockchain := ccf.dataComponents.Blockchain()
 	epoch := blockchain.GetGenesisHeader().GetEpoch()
@@ -554,5 +554,7 @@ func getDefaultCryptoComponents() *mock.CryptoComponentsMock {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		ManagedPeers:    &cryptoMocks.ManagedPeersHolderStub{},
+		Signing:         &cryptoMocks.SigningHandlerStub{},
 	}
 }
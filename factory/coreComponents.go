@@ -311,17 +311,7 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 		log.Debug("cannot set status handler to economicsData", "error", err)
 	}
 
-	argsNodesShuffler := &nodesCoordinator.NodesShufflerArgs{
-		NodesShard:                     genesisNodesConfig.MinNumberOfShardNodes(),
-		NodesMeta:                      genesisNodesConfig.MinNumberOfMetaNodes(),
-		Hysteresis:                     genesisNodesConfig.GetHysteresis(),
-		Adaptivity:                     genesisNodesConfig.GetAdaptivity(),
-		ShuffleBetweenShards:           true,
-		MaxNodesEnableConfig:           ccf.epochConfig.EnableEpochs.MaxNodesChangeEnableEpoch,
-		BalanceWaitingListsEnableEpoch: ccf.epochConfig.EnableEpochs.BalanceWaitingListsEnableEpoch,
-		WaitingListFixEnableEpoch:      ccf.epochConfig.EnableEpochs.WaitingListFixEnableEpoch,
-	}
-
+	argsNodesShuffler := createNodesShufflerArgs(genesisNodesConfig, ccf.epochConfig.EnableEpochs)
 	nodesShuffler, err := nodesCoordinator.NewHashValidatorsShuffler(argsNodesShuffler)
 	if err != nil {
 		return nil, err
@@ -368,6 +358,23 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 	}, nil
 }
 
+// createNodesShufflerArgs returns the arguments of the nodes shuffler used by the nodes coordinator
+func createNodesShufflerArgs(
+	genesisNodesConfig sharding.GenesisNodesSetupHandler,
+	enableEpochs config.EnableEpochs,
+) *nodesCoordinator.NodesShufflerArgs {
+	return &nodesCoordinator.NodesShufflerArgs{
+		NodesShard:                     genesisNodesConfig.MinNumberOfShardNodes(),
+		NodesMeta:                      genesisNodesConfig.MinNumberOfMetaNodes(),
+		Hysteresis:                     genesisNodesConfig.GetHysteresis(),
+		Adaptivity:                     genesisNodesConfig.GetAdaptivity(),
+		ShuffleBetweenShards:           true,
+		MaxNodesEnableConfig:           enableEpochs.MaxNodesChangeEnableEpoch,
+		BalanceWaitingListsEnableEpoch: enableEpochs.BalanceWaitingListsEnableEpoch,
+		WaitingListFixEnableEpoch:      enableEpochs.WaitingListFixEnableEpoch,
+	}
+}
+
 // Close closes all underlying components
 func (cc *coreComponents) Close() error {
 	if !check.IfNil(cc.statusHandlersUtils) {
@@ -365,6 +365,15 @@ func getCoreArgs() factory.CoreComponentsFactoryArgs {
 			PeersRatingConfig: config.PeersRatingConfig{
 				TopRatedCacheCapacity: 1000,
 				BadRatedCacheCapacity: 1000,
+				Storage: config.StorageConfig{
+					Cache: config.CacheConfig{
+						Type:     "LRU",
+						Capacity: 1000,
+					},
+					DB: config.DBConfig{
+						Type: "MemoryDB",
+					},
+				},
 			},
 		},
 		ConfigPathsHolder: config.ConfigurationPathsHolder{
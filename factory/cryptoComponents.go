@@ -3,8 +3,13 @@ package factory
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
@@ -20,11 +25,14 @@ import (
 	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
 	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
-	"github.com/ElrondNetwork/elrond-go/errors"
+	errErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -33,9 +41,16 @@ import (
 
 const disabledSigChecking = "disabled"
 
+type remoteSignerHandler interface {
+	common.SigningHandler
+	GetPublicKeys() ([][]byte, error)
+	Close() error
+}
+
 // CryptoComponentsFactoryArgs holds the arguments needed for creating crypto components
 type CryptoComponentsFactoryArgs struct {
 	ValidatorKeyPemFileName              string
+	AllValidatorKeysPemFileName          string
 	SkIndex                              int
 	Config                               config.Config
 	CoreComponentsHolder                 CoreComponentsHolder
@@ -48,6 +63,7 @@ type CryptoComponentsFactoryArgs struct {
 type cryptoComponentsFactory struct {
 	consensusType                        string
 	validatorKeyPemFileName              string
+	allValidatorKeysPemFileName          string
 	skIndex                              int
 	config                               config.Config
 	coreComponentsHolder                 CoreComponentsHolder
@@ -75,24 +91,27 @@ type cryptoComponents struct {
 	blockSignKeyGen     crypto.KeyGenerator
 	txSignKeyGen        crypto.KeyGenerator
 	messageSignVerifier vm.MessageSignVerifier
+	managedPeersHolder  common.ManagedPeersHolder
+	signingHandler      common.SigningHandler
 	cryptoParams
 }
 
 // NewCryptoComponentsFactory returns a new crypto components factory
 func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoComponentsFactory, error) {
 	if check.IfNil(args.CoreComponentsHolder) {
-		return nil, errors.ErrNilCoreComponents
+		return nil, errErd.ErrNilCoreComponents
 	}
 	if len(args.ValidatorKeyPemFileName) == 0 {
-		return nil, errors.ErrNilPath
+		return nil, errErd.ErrNilPath
 	}
 	if args.KeyLoader == nil {
-		return nil, errors.ErrNilKeyLoader
+		return nil, errErd.ErrNilKeyLoader
 	}
 
 	ccf := &cryptoComponentsFactory{
 		consensusType:                        args.Config.Consensus.Type,
 		validatorKeyPemFileName:              args.ValidatorKeyPemFileName,
+		allValidatorKeysPemFileName:          args.AllValidatorKeysPemFileName,
 		skIndex:                              args.SkIndex,
 		config:                               args.Config,
 		coreComponentsHolder:                 args.CoreComponentsHolder,
@@ -107,13 +126,51 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 
 // Create will create and return crypto components
 func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
+	if !ccf.isRemoteSignerEnabled() {
+		return ccf.createCryptoComponents(nil)
+	}
+
+	remoteSigningHandler, err := ccf.createRemoteSigningHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := ccf.createCryptoComponents(remoteSigningHandler)
+	if err != nil {
+		_ = remoteSigningHandler.Close()
+		return nil, err
+	}
+
+	return cc, nil
+}
+
+func (ccf *cryptoComponentsFactory) isRemoteSignerEnabled() bool {
+	if !ccf.config.RemoteSigner.Enabled {
+		return false
+	}
+	if ccf.isInImportMode {
+		log.Warn("the remote signer is not used because the node is running in import mode")
+		return false
+	}
+
+	return true
+}
+
+// createCryptoComponents creates the crypto components. When a remote signing handler is provided, the BLS keys are
+// the ones hosted by the remote signer and no secret key is loaded in the node's memory
+func (ccf *cryptoComponentsFactory) createCryptoComponents(remoteSigningHandler remoteSignerHandler) (*cryptoComponents, error) {
 	suite, err := ccf.getSuite()
 	if err != nil {
 		return nil, err
 	}
 
 	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	hostedKeys, err := ccf.getHostedKeys(blockSignKeyGen, remoteSigningHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, hostedKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +221,16 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	managedPeersHolder, err := ccf.createManagedPeersHolder(blockSignKeyGen, cp, hostedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	signingHandler, err := ccf.createSigningHandler(remoteSigningHandler, managedPeersHolder, interceptSingleSigner, peerSigHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("block sign pubkey", "value", cp.publicKeyString)
 
 	return &cryptoComponents{
@@ -174,6 +241,8 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		blockSignKeyGen:     blockSignKeyGen,
 		txSignKeyGen:        txSignKeyGen,
 		messageSignVerifier: messageSignVerifier,
+		managedPeersHolder:  managedPeersHolder,
+		signingHandler:      signingHandler,
 		cryptoParams:        *cp,
 	}, nil
 }
@@ -191,13 +260,13 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool
 		log.Warn("using disabled single signer")
 		return &disabledSig.DisabledSingleSig{}, nil
 	default:
-		return nil, errors.ErrInvalidConsensusConfig
+		return nil, errErd.ErrInvalidConsensusConfig
 	}
 }
 
 func (ccf *cryptoComponentsFactory) getMultiSigHasherFromConfig() (hashing.Hasher, error) {
 	if ccf.consensusType == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
-		return nil, errors.ErrMultiSigHasherMissmatch
+		return nil, errErd.ErrMultiSigHasherMissmatch
 	}
 
 	switch ccf.config.MultisigHasher.Type {
@@ -210,7 +279,7 @@ func (ccf *cryptoComponentsFactory) getMultiSigHasherFromConfig() (hashing.Hashe
 		return blake2b.NewBlake2b(), nil
 	}
 
-	return nil, errors.ErrMissingMultiHasherConfig
+	return nil, errErd.ErrMissingMultiHasherConfig
 }
 
 func (ccf *cryptoComponentsFactory) createMultiSigner(
@@ -232,7 +301,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 		log.Warn("using disabled multi signer")
 		return &disabledMultiSig.DisabledMultiSig{}, nil
 	default:
-		return nil, errors.ErrInvalidConsensusConfig
+		return nil, errErd.ErrInvalidConsensusConfig
 	}
 }
 
@@ -244,17 +313,21 @@ func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 		log.Warn("using disabled multi signer")
 		return disabledCrypto.NewDisabledSuite(), nil
 	default:
-		return nil, errors.ErrInvalidConsensusConfig
+		return nil, errErd.ErrInvalidConsensusConfig
 	}
 }
 
 func (ccf *cryptoComponentsFactory) createCryptoParams(
 	keygen crypto.KeyGenerator,
+	hostedKeys []crypto.PrivateKey,
 ) (*cryptoParams, error) {
 
 	if ccf.isInImportMode {
 		return ccf.generateCryptoParams(keygen)
 	}
+	if len(hostedKeys) > 0 {
+		return ccf.createHostedCryptoParams(hostedKeys[0])
+	}
 
 	return ccf.readCryptoParams(keygen)
 }
@@ -279,7 +352,7 @@ func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator)
 		}
 
 		if !bytes.Equal(cp.publicKeyBytes, readPk) {
-			return nil, errors.ErrPublicKeyMismatch
+			return nil, errErd.ErrPublicKeyMismatch
 		}
 	}
 
@@ -306,8 +379,32 @@ func (ccf *cryptoComponentsFactory) generateCryptoParams(keygen crypto.KeyGenera
 	return cp, nil
 }
 
+// createHostedCryptoParams creates the node's crypto params out of a key hosted by the remote signer. The private key
+// bytes are not available
+func (ccf *cryptoComponentsFactory) createHostedCryptoParams(hostedKey crypto.PrivateKey) (*cryptoParams, error) {
+	cp := &cryptoParams{
+		privateKey: hostedKey,
+		publicKey:  hostedKey.GeneratePublic(),
+	}
+
+	var err error
+	cp.publicKeyBytes, err = cp.publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	cp.publicKeyString = validatorKeyConverter.Encode(cp.publicKeyBytes)
+
+	return cp, nil
+}
+
 func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
-	encodedSk, pkString, err := ccf.keyLoader.LoadKey(ccf.validatorKeyPemFileName, ccf.skIndex)
+	return ccf.loadSkPk(ccf.validatorKeyPemFileName, ccf.skIndex)
+}
+
+func (ccf *cryptoComponentsFactory) loadSkPk(pemFileName string, skIndex int) ([]byte, []byte, error) {
+	encodedSk, pkString, err := ccf.keyLoader.LoadKey(pemFileName, skIndex)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -326,7 +423,216 @@ func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 	return skBytes, pkBytes, nil
 }
 
+// createManagedPeersHolder creates the holder of all the BLS keys managed by the node. Beside the validator key, the
+// holder contains all the keys found in the all validator keys PEM file, if the file exists, or all the keys hosted by
+// the remote signer, if one is used
+func (ccf *cryptoComponentsFactory) createManagedPeersHolder(
+	keygen crypto.KeyGenerator,
+	cp *cryptoParams,
+	hostedKeys []crypto.PrivateKey,
+) (common.ManagedPeersHolder, error) {
+	args := keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator:             keygen,
+		PrivateKey:               cp.privateKey,
+		ValidatorPubKeyConverter: ccf.coreComponentsHolder.ValidatorPubKeyConverter(),
+	}
+	managedPeersHolder, err := keysManagement.NewManagedPeersHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hostedKeys) > 0 {
+		for _, hostedKey := range hostedKeys[1:] {
+			err = managedPeersHolder.AddManagedKey(hostedKey)
+			if err != nil {
+				return nil, fmt.Errorf("%w while adding the keys hosted by the remote signer", err)
+			}
+		}
+
+		log.Info("managed validator keys hosted by the remote signer", "num keys", len(managedPeersHolder.GetManagedKeys()))
+
+		return managedPeersHolder, nil
+	}
+
+	if ccf.isInImportMode || len(ccf.allValidatorKeysPemFileName) == 0 {
+		return managedPeersHolder, nil
+	}
+
+	_, err = os.Stat(ccf.allValidatorKeysPemFileName)
+	if os.IsNotExist(err) {
+		log.Debug("no additional validator keys file found, the node will only manage its validator key",
+			"file", ccf.allValidatorKeysPemFileName)
+		return managedPeersHolder, nil
+	}
+
+	for index := 0; ; index++ {
+		skBytes, pkBytes, errLoad := ccf.loadSkPk(ccf.allValidatorKeysPemFileName, index)
+		if errors.Is(errLoad, core.ErrInvalidIndex) {
+			break
+		}
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w while loading the key with index %d from %s", errLoad, index, ccf.allValidatorKeysPemFileName)
+		}
+		if bytes.Equal(pkBytes, cp.publicKeyBytes) {
+			continue
+		}
+
+		err = ccf.addManagedPeer(managedPeersHolder, keygen, skBytes, pkBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w while adding the key with index %d from %s", err, index, ccf.allValidatorKeysPemFileName)
+		}
+	}
+
+	log.Info("managed validator keys loaded", "num keys", len(managedPeersHolder.GetManagedKeys()))
+
+	return managedPeersHolder, nil
+}
+
+func (ccf *cryptoComponentsFactory) addManagedPeer(
+	managedPeersHolder common.ManagedPeersHolder,
+	keygen crypto.KeyGenerator,
+	skBytes []byte,
+	readPk []byte,
+) error {
+	privateKey, err := keygen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pkBytes, readPk) {
+		return errErd.ErrPublicKeyMismatch
+	}
+
+	return managedPeersHolder.AddManagedPeer(skBytes)
+}
+
+// createSigningHandler creates the component used to sign on behalf of the managed keys. By default, the keys loaded
+// in memory are used, otherwise the signing is delegated to the remote signer which hosts all the managed keys
+func (ccf *cryptoComponentsFactory) createSigningHandler(
+	remoteSigningHandler remoteSignerHandler,
+	managedPeersHolder common.ManagedPeersHolder,
+	singleSigner crypto.SingleSigner,
+	peerSigHandler crypto.PeerSignatureHandler,
+) (common.SigningHandler, error) {
+	if !check.IfNil(remoteSigningHandler) {
+		cfg := ccf.config.RemoteSigner
+		log.Info("using remote signer", "network", cfg.Network, "address", cfg.Address)
+
+		return remoteSigningHandler, nil
+	}
+
+	return keysManagement.NewLocalSigningHandler(keysManagement.ArgsLocalSigningHandler{
+		ManagedPeersHolder:   managedPeersHolder,
+		SingleSigner:         singleSigner,
+		PeerSignatureHandler: peerSigHandler,
+	})
+}
+
+func (ccf *cryptoComponentsFactory) createRemoteSigningHandler() (remoteSignerHandler, error) {
+	cfg := ccf.config.RemoteSigner
+	tlsConfig, err := remoteSigner.NewClientTLSConfig(remoteSigner.ArgsTLSConfig{
+		CertificateFile:   cfg.CertificateFile,
+		PrivateKeyFile:    cfg.PrivateKeyFile,
+		CACertificateFile: cfg.CACertificateFile,
+		ServerName:        cfg.ServerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the remote signer TLS certificates", err)
+	}
+
+	return remoteSigner.NewRemoteSigningHandler(remoteSigner.ArgsRemoteSigningHandler{
+		Network:        cfg.Network,
+		Address:        cfg.Address,
+		TLSConfig:      tlsConfig,
+		RequestTimeout: time.Duration(cfg.RequestTimeoutInMilliseconds) * time.Millisecond,
+	})
+}
+
+// getHostedKeys returns the keys managed by the node on the remote signer, the node's own key being the first one.
+// Only their public part is requested from the signer. Returns nil if no remote signer is used
+func (ccf *cryptoComponentsFactory) getHostedKeys(
+	keygen crypto.KeyGenerator,
+	remoteSigningHandler remoteSignerHandler,
+) ([]crypto.PrivateKey, error) {
+	if check.IfNil(remoteSigningHandler) {
+		return nil, nil
+	}
+
+	remoteKeys, err := remoteSigningHandler.GetPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys, err := ccf.selectHostedPublicKeys(remoteKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	hostedKeys := make([]crypto.PrivateKey, 0, len(publicKeys))
+	for _, pkBytes := range publicKeys {
+		publicKey, errDecode := keygen.PublicKeyFromByteArray(pkBytes)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for the hosted public key %s", errDecode, validatorKeyConverter.Encode(pkBytes))
+		}
+
+		hostedKey, errCreate := remoteSigner.NewHostedKey(publicKey)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		hostedKeys = append(hostedKeys, hostedKey)
+	}
+
+	return hostedKeys, nil
+}
+
+// selectHostedPublicKeys returns the configured public keys, after checking that the remote signer hosts all of them,
+// or all the keys hosted by the remote signer if none is configured
+func (ccf *cryptoComponentsFactory) selectHostedPublicKeys(remoteKeys [][]byte) ([][]byte, error) {
+	if len(remoteKeys) == 0 {
+		return nil, errErd.ErrNoKeysHostedByRemoteSigner
+	}
+
+	configuredKeys := ccf.config.RemoteSigner.PublicKeys
+	if len(configuredKeys) == 0 {
+		return remoteKeys, nil
+	}
+
+	hostedKeys := make(map[string]struct{}, len(remoteKeys))
+	for _, pkBytes := range remoteKeys {
+		hostedKeys[string(pkBytes)] = struct{}{}
+	}
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	publicKeys := make([][]byte, 0, len(configuredKeys))
+	for _, encodedKey := range configuredKeys {
+		pkBytes, err := validatorKeyConverter.Decode(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the remote signer public key %s", err, encodedKey)
+		}
+
+		_, found := hostedKeys[string(pkBytes)]
+		if !found {
+			return nil, fmt.Errorf("%w, public key %s", errErd.ErrKeyNotHostedByRemoteSigner, encodedKey)
+		}
+
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	return publicKeys, nil
+}
+
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
+	closer, ok := cc.signingHandler.(io.Closer)
+	if ok {
+		return closer.Close()
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
@@ -104,6 +105,12 @@ func (mcc *managedCryptoComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.cryptoComponents.messageSignVerifier) {
 		return errors.ErrNilMessageSignVerifier
 	}
+	if check.IfNil(mcc.cryptoComponents.managedPeersHolder) {
+		return errors.ErrNilManagedPeersHolder
+	}
+	if check.IfNil(mcc.cryptoComponents.signingHandler) {
+		return errors.ErrNilSigningHandler
+	}
 
 	return nil
 }
@@ -265,6 +272,30 @@ func (mcc *managedCryptoComponents) MessageSignVerifier() vm.MessageSignVerifier
 	return mcc.cryptoComponents.messageSignVerifier
 }
 
+// ManagedPeersHolder returns the holder of all the BLS keys managed by the node
+func (mcc *managedCryptoComponents) ManagedPeersHolder() common.ManagedPeersHolder {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.managedPeersHolder
+}
+
+// SigningHandler returns the component used to sign on behalf of the managed keys
+func (mcc *managedCryptoComponents) SigningHandler() common.SigningHandler {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.signingHandler
+}
+
 // Clone creates a shallow clone of a managedCryptoComponents
 func (mcc *managedCryptoComponents) Clone() interface{} {
 	cryptoComp := (*cryptoComponents)(nil)
@@ -277,6 +308,8 @@ func (mcc *managedCryptoComponents) Clone() interface{} {
 			blockSignKeyGen:     mcc.BlockSignKeyGen(),
 			txSignKeyGen:        mcc.TxSignKeyGen(),
 			messageSignVerifier: mcc.MessageSignVerifier(),
+			managedPeersHolder:  mcc.ManagedPeersHolder(),
+			signingHandler:      mcc.SigningHandler(),
 			cryptoParams:        mcc.cryptoParams,
 		}
 	}
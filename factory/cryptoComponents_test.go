@@ -7,6 +7,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	errErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory"
@@ -492,3 +494,119 @@ func dummyLoadSkPkFromPemFile(sk []byte, pk string, err error) LoadKeysFunc {
 		return sk, pk, err
 	}
 }
+
+type remoteSignerHandlerStub struct {
+	publicKeys [][]byte
+}
+
+func (stub *remoteSignerHandlerStub) Sign(_ *common.SigningRequest) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (stub *remoteSignerHandlerStub) GetPublicKeys() ([][]byte, error) {
+	return stub.publicKeys, nil
+}
+
+func (stub *remoteSignerHandlerStub) Close() error {
+	return nil
+}
+
+func (stub *remoteSignerHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createHostedPublicKeys(t *testing.T, numKeys int) [][]byte {
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	publicKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		_, pk := keyGenerator.GeneratePair()
+		pkBytes, err := pk.ToByteArray()
+		require.Nil(t, err)
+
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	return publicKeys
+}
+
+func TestCryptoComponentsFactory_CreateWithRemoteSigningHandler(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	keyLoaderThatShouldNotBeCalled := &mock.KeyLoaderStub{
+		LoadKeyCalled: func(_ string, _ int) ([]byte, string, error) {
+			require.Fail(t, "no key should be loaded when the remote signer is used")
+			return nil, "", nil
+		},
+	}
+
+	t.Run("no hosted keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getCryptoArgs(getCoreComponents())
+		args.KeyLoader = keyLoaderThatShouldNotBeCalled
+		ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.CreateWithRemoteSigningHandler(&remoteSignerHandlerStub{})
+		require.Nil(t, cc)
+		require.Equal(t, errErd.ErrNoKeysHostedByRemoteSigner, err)
+	})
+	t.Run("configured key not hosted should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getCryptoArgs(getCoreComponents())
+		args.KeyLoader = keyLoaderThatShouldNotBeCalled
+		args.Config.RemoteSigner.PublicKeys = []string{hex.EncodeToString(createHostedPublicKeys(t, 1)[0])}
+		ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+		handler := &remoteSignerHandlerStub{publicKeys: createHostedPublicKeys(t, 2)}
+		cc, err := ccf.CreateWithRemoteSigningHandler(handler)
+		require.Nil(t, cc)
+		require.True(t, errors.Is(err, errErd.ErrKeyNotHostedByRemoteSigner))
+	})
+	t.Run("should manage all the hosted keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := getCryptoArgs(getCoreComponents())
+		args.KeyLoader = keyLoaderThatShouldNotBeCalled
+		ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+		hostedKeys := createHostedPublicKeys(t, 3)
+		handler := &remoteSignerHandlerStub{publicKeys: hostedKeys}
+		cc, err := ccf.CreateWithRemoteSigningHandler(handler)
+		require.Nil(t, err)
+		require.Equal(t, hostedKeys[0], cc.PublicKeyBytes())
+		require.True(t, handler == cc.SigningHandler())
+
+		managedPeersHolder := cc.ManagedPeersHolder()
+		require.Equal(t, 3, len(managedPeersHolder.GetManagedKeys()))
+		for _, pkBytes := range hostedKeys {
+			require.True(t, managedPeersHolder.IsKeyManagedByCurrentNode(pkBytes))
+
+			sk, errGet := managedPeersHolder.GetPrivateKey(pkBytes)
+			require.Nil(t, errGet)
+			skBytes, errGet := sk.ToByteArray()
+			require.NotNil(t, errGet)
+			require.Nil(t, skBytes)
+		}
+	})
+	t.Run("should manage only the configured keys", func(t *testing.T) {
+		t.Parallel()
+
+		hostedKeys := createHostedPublicKeys(t, 3)
+		args := getCryptoArgs(getCoreComponents())
+		args.KeyLoader = keyLoaderThatShouldNotBeCalled
+		args.Config.RemoteSigner.PublicKeys = []string{hex.EncodeToString(hostedKeys[2]), hex.EncodeToString(hostedKeys[0])}
+		ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.CreateWithRemoteSigningHandler(&remoteSignerHandlerStub{publicKeys: hostedKeys})
+		require.Nil(t, err)
+		require.Equal(t, hostedKeys[2], cc.PublicKeyBytes())
+
+		managedPeersHolder := cc.ManagedPeersHolder()
+		require.Equal(t, 2, len(managedPeersHolder.GetManagedKeys()))
+		require.False(t, managedPeersHolder.IsKeyManagedByCurrentNode(hostedKeys[1]))
+	})
+}
@@ -34,7 +34,27 @@ func (ccf *cryptoComponentsFactory) CreateDummyCryptoParams() *cryptoParams {
 
 // CreateCryptoParams -
 func (ccf *cryptoComponentsFactory) CreateCryptoParams(blockSignKeyGen crypto.KeyGenerator) (*cryptoParams, error) {
-	return ccf.createCryptoParams(blockSignKeyGen)
+	return ccf.createCryptoParams(blockSignKeyGen, nil)
+}
+
+// CreateWithRemoteSigningHandler -
+func (ccf *cryptoComponentsFactory) CreateWithRemoteSigningHandler(handler remoteSignerHandler) (*cryptoComponents, error) {
+	return ccf.createCryptoComponents(handler)
+}
+
+// PublicKeyBytes -
+func (cc *cryptoComponents) PublicKeyBytes() []byte {
+	return cc.publicKeyBytes
+}
+
+// ManagedPeersHolder -
+func (cc *cryptoComponents) ManagedPeersHolder() common.ManagedPeersHolder {
+	return cc.managedPeersHolder
+}
+
+// SigningHandler -
+func (cc *cryptoComponents) SigningHandler() common.SigningHandler {
+	return cc.signingHandler
 }
 
 // CreateMultiSigner -
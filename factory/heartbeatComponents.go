@@ -151,6 +151,8 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 		HardforkTrigger:      hcf.hardforkTrigger,
 		CurrentBlockProvider: hcf.dataComponents.Blockchain(),
 		RedundancyHandler:    hcf.redundancyHandler,
+		ManagedPeersHolder:   hcf.cryptoComponents.ManagedPeersHolder(),
+		SigningHandler:       hcf.cryptoComponents.SigningHandler(),
 	}
 
 	hbc.sender, err = heartbeatProcess.NewSender(argSender)
@@ -158,6 +158,8 @@ type CryptoComponentsHolder interface {
 	BlockSignKeyGen() crypto.KeyGenerator
 	TxSignKeyGen() crypto.KeyGenerator
 	MessageSignVerifier() vm.MessageSignVerifier
+	ManagedPeersHolder() common.ManagedPeersHolder
+	SigningHandler() common.SigningHandler
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
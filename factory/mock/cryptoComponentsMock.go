@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +22,8 @@ type CryptoComponentsMock struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	ManagedPeers    common.ManagedPeersHolder
+	Signing         common.SigningHandler
 	mutMultiSig     sync.RWMutex
 }
 
@@ -99,6 +102,16 @@ func (ccm *CryptoComponentsMock) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccm.MsgSigVerifier
 }
 
+// ManagedPeersHolder -
+func (ccm *CryptoComponentsMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccm.ManagedPeers
+}
+
+// SigningHandler -
+func (ccm *CryptoComponentsMock) SigningHandler() common.SigningHandler {
+	return ccm.Signing
+}
+
 // Clone -
 func (ccm *CryptoComponentsMock) Clone() interface{} {
 	return &CryptoComponentsMock{
@@ -114,6 +127,8 @@ func (ccm *CryptoComponentsMock) Clone() interface{} {
 		BlKeyGen:        ccm.BlKeyGen,
 		TxKeyGen:        ccm.TxKeyGen,
 		MsgSigVerifier:  ccm.MsgSigVerifier,
+		ManagedPeers:    ccm.ManagedPeers,
+		Signing:         ccm.Signing,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
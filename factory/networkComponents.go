@@ -3,12 +3,14 @@ package factory
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/peersholder"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug/antiflood"
@@ -19,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
 	antifloodFactory "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -35,6 +38,9 @@ type NetworkComponentsFactoryArgs struct {
 	PreferredPublicKeys [][]byte
 	BootstrapWaitTime   time.Duration
 	NodeOperationMode   p2p.NodeOperation
+	WorkingDir          string
+	EpochNotifier       process.EpochNotifier
+	EnableEpochs        config.EnableEpochs
 }
 
 type networkComponentsFactory struct {
@@ -48,6 +54,9 @@ type networkComponentsFactory struct {
 	preferredPublicKeys [][]byte
 	bootstrapWaitTime   time.Duration
 	nodeOperationMode   p2p.NodeOperation
+	workingDir          string
+	epochNotifier       process.EpochNotifier
+	enableEpochs        config.EnableEpochs
 }
 
 // networkComponents struct holds the network components
@@ -63,6 +72,7 @@ type networkComponents struct {
 	peerHonestyHandler     consensus.PeerHonestyHandler
 	peersHolder            PreferredPeersHolderHandler
 	peersRatingHandler     p2p.PeersRatingHandler
+	peersRatingStorer      storage.Storer
 	closeFunc              context.CancelFunc
 }
 
@@ -79,6 +89,9 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(args.Syncer) {
 		return nil, errors.ErrNilSyncTimer
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", errors.ErrNilEpochNotifier)
+	}
 
 	return &networkComponentsFactory{
 		p2pConfig:           args.P2pConfig,
@@ -91,6 +104,9 @@ func NewNetworkComponentsFactory(
 		bootstrapWaitTime:   args.BootstrapWaitTime,
 		preferredPublicKeys: args.PreferredPublicKeys,
 		nodeOperationMode:   args.NodeOperationMode,
+		workingDir:          args.WorkingDir,
+		epochNotifier:       args.EpochNotifier,
+		enableEpochs:        args.EnableEpochs,
 	}, nil
 }
 
@@ -104,30 +120,47 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 	if err != nil {
 		return nil, err
 	}
+	peersRatingStorer, err := ncf.createPeersRatingStorer()
+	if err != nil {
+		return nil, err
+	}
 	argsPeersRatingHandler := rating.ArgPeersRatingHandler{
-		TopRatedCache: topRatedCache,
-		BadRatedCache: badRatedCache,
+		TopRatedCache:        topRatedCache,
+		BadRatedCache:        badRatedCache,
+		Storer:               peersRatingStorer,
+		PersistIntervalInSec: ncf.mainConfig.PeersRatingConfig.PersistIntervalInSec,
+		DecayIntervalInSec:   ncf.mainConfig.PeersRatingConfig.DecayIntervalInSec,
+		DecayPercent:         ncf.mainConfig.PeersRatingConfig.DecayPercent,
 	}
 	peersRatingHandler, err := rating.NewPeersRatingHandler(argsPeersRatingHandler)
 	if err != nil {
+		log.LogIfError(peersRatingStorer.Close())
 		return nil, err
 	}
+	defer func() {
+		if err != nil {
+			log.LogIfError(peersRatingHandler.Close())
+			log.LogIfError(peersRatingStorer.Close())
+		}
+	}()
 
 	peersHolder := peersholder.NewPeersHolder(ncf.preferredPublicKeys)
 	arg := libp2p.ArgsNetworkMessenger{
-		Marshalizer:          ncf.marshalizer,
-		ListenAddress:        ncf.listenAddress,
-		P2pConfig:            ncf.p2pConfig,
-		SyncTimer:            ncf.syncer,
-		PreferredPeersHolder: peersHolder,
-		NodeOperationMode:    ncf.nodeOperationMode,
-		PeersRatingHandler:   peersRatingHandler,
+		Marshalizer:            ncf.marshalizer,
+		ListenAddress:          ncf.listenAddress,
+		P2pConfig:              ncf.p2pConfig,
+		SyncTimer:              ncf.syncer,
+		PreferredPeersHolder:   peersHolder,
+		NodeOperationMode:      ncf.nodeOperationMode,
+		PeersRatingHandler:     peersRatingHandler,
+		CompressionEnableEpoch: ncf.enableEpochs.P2PCompressionEnableEpoch,
 	}
 
 	netMessenger, err := libp2p.NewNetworkMessenger(arg)
 	if err != nil {
 		return nil, err
 	}
+	ncf.epochNotifier.RegisterNotifyHandler(netMessenger)
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	defer func() {
@@ -203,10 +236,22 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerHonestyHandler:     peerHonestyHandler,
 		peersHolder:            peersHolder,
 		peersRatingHandler:     peersRatingHandler,
+		peersRatingStorer:      peersRatingStorer,
 		closeFunc:              cancelFunc,
 	}, nil
 }
 
+func (ncf *networkComponentsFactory) createPeersRatingStorer() (storage.Storer, error) {
+	storageConfig := ncf.mainConfig.PeersRatingConfig.Storage
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(ncf.workingDir, common.DefaultDBPath, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+	)
+}
+
 func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
@@ -244,5 +289,13 @@ func (nc *networkComponents) Close() error {
 		log.LogIfError(err)
 	}
 
+	// the ratings are saved on close so the storer should be closed afterwards
+	if !check.IfNil(nc.peersRatingHandler) {
+		log.LogIfError(nc.peersRatingHandler.Close())
+	}
+	if !check.IfNil(nc.peersRatingStorer) {
+		log.LogIfError(nc.peersRatingStorer.Close())
+	}
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
@@ -40,6 +41,19 @@ func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 	require.True(t, errors.Is(err, errErd.ErrNilMarshalizer))
 }
 
+func TestNewNetworkComponentsFactory_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	args := getNetworkArgs()
+	args.EpochNotifier = nil
+	ncf, err := factory.NewNetworkComponentsFactory(args)
+	require.Nil(t, ncf)
+	require.True(t, errors.Is(err, errErd.ErrNilEpochNotifier))
+}
+
 func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 	if testing.Short() {
@@ -146,6 +160,15 @@ func getNetworkArgs() factory.NetworkComponentsFactoryArgs {
 		PeersRatingConfig: config.PeersRatingConfig{
 			TopRatedCacheCapacity: 1000,
 			BadRatedCacheCapacity: 1000,
+			Storage: config.StorageConfig{
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 1000,
+				},
+				DB: config.DBConfig{
+					Type: "MemoryDB",
+				},
+			},
 		},
 	}
 
@@ -171,5 +194,6 @@ func getNetworkArgs() factory.NetworkComponentsFactoryArgs {
 		},
 		Syncer:            &libp2p.LocalSyncTimer{},
 		NodeOperationMode: p2p.NormalOperation,
+		EpochNotifier:     &epochNotifier.EpochNotifierStub{},
 	}
 }
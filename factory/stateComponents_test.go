@@ -140,6 +140,7 @@ func getGeneralConfig() config.Config {
 			Type:            "hex",
 			SignatureLength: 0,
 		},
+		EpochStartConfig: getEpochStartConfig(),
 		StateTriesConfig: config.StateTriesConfig{
 			CheckpointRoundsModulus:     5,
 			AccountsStatePruningEnabled: true,
@@ -25,6 +25,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 )
 
+// maxPeersInBandwidthMetric is the number of the busiest peers displayed in the per peer bandwidth metric
+const maxPeersInBandwidthMetric = 10
+
 var _ ComponentHandler = (*managedStatusComponents)(nil)
 var _ StatusComponentsHolder = (*managedStatusComponents)(nil)
 var _ StatusComponentsHandler = (*managedStatusComponents)(nil)
@@ -269,6 +272,8 @@ func computeConnectedPeers(
 
 	setP2pConnectedPeersMetrics(appStatusHandler, peersInfo)
 	setCurrentP2pNodeAddresses(appStatusHandler, netMessenger)
+	setP2pCompressionMetrics(appStatusHandler, netMessenger.GetCompressionStatistics())
+	setP2pBandwidthMetrics(appStatusHandler, netMessenger.GetBandwidthReport())
 }
 
 func setP2pConnectedPeersMetrics(appStatusHandler core.AppStatusHandler, info *p2p.ConnectedPeersInfo) {
@@ -278,6 +283,8 @@ func setP2pConnectedPeersMetrics(appStatusHandler core.AppStatusHandler, info *p
 	appStatusHandler.SetStringValue(common.MetricP2PCrossShardValidators, mapToString(info.CrossShardValidators))
 	appStatusHandler.SetStringValue(common.MetricP2PCrossShardObservers, mapToString(info.CrossShardObservers))
 	appStatusHandler.SetStringValue(common.MetricP2PFullHistoryObservers, mapToString(info.FullHistoryObservers))
+	appStatusHandler.SetStringValue(common.MetricP2PConnectionPolicy, info.ConnectionPolicy)
+	appStatusHandler.SetUInt64Value(common.MetricP2PNumDeniedConnections, info.NumDeniedConnections)
 }
 
 func sliceToString(input []string) string {
@@ -309,6 +316,74 @@ func setCurrentP2pNodeAddresses(
 	appStatusHandler.SetStringValue(common.MetricP2PPeerInfo, sliceToString(netMessenger.Addresses()))
 }
 
+func setP2pCompressionMetrics(appStatusHandler core.AppStatusHandler, statistics map[string]p2p.CompressionStatistics) {
+	topics := make([]string, 0, len(statistics))
+	for topic := range statistics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	totalBytesSaved := uint64(0)
+	strs := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topicStatistics := statistics[topic]
+		totalBytesSaved += topicStatistics.BytesSavedOnSend + topicStatistics.BytesSavedOnReceive
+		strs = append(strs, fmt.Sprintf("%s: sent %d, received %d",
+			topic, topicStatistics.BytesSavedOnSend, topicStatistics.BytesSavedOnReceive))
+	}
+
+	appStatusHandler.SetUInt64Value(common.MetricP2PCompressionBytesSaved, totalBytesSaved)
+	appStatusHandler.SetStringValue(common.MetricP2PCompressionBytesSavedPerTopic, strings.Join(strs, ","))
+}
+
+func setP2pBandwidthMetrics(appStatusHandler core.AppStatusHandler, report *p2p.BandwidthReport) {
+	if report == nil {
+		return
+	}
+
+	appStatusHandler.SetUInt64Value(common.MetricP2PInboundBytesPerSec, report.Total.InboundBytesPerSec)
+	appStatusHandler.SetUInt64Value(common.MetricP2POutboundBytesPerSec, report.Total.OutboundBytesPerSec)
+	appStatusHandler.SetUInt64Value(common.MetricP2POutboundDroppedBytes, report.Total.DroppedOutboundBytes)
+
+	topics := make([]string, 0, len(report.Topics))
+	for topic := range report.Topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	strs := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		strs = append(strs, bandwidthStatisticsToString(topic, report.Topics[topic]))
+	}
+	appStatusHandler.SetStringValue(common.MetricP2PBandwidthPerTopic, strings.Join(strs, ","))
+
+	pids := make([]core.PeerID, 0, len(report.Peers))
+	for pid := range report.Peers {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return totalBytesPerSec(report.Peers[pids[i]]) > totalBytesPerSec(report.Peers[pids[j]])
+	})
+	if len(pids) > maxPeersInBandwidthMetric {
+		pids = pids[:maxPeersInBandwidthMetric]
+	}
+
+	strs = make([]string, 0, len(pids))
+	for _, pid := range pids {
+		strs = append(strs, bandwidthStatisticsToString(pid.Pretty(), report.Peers[pid]))
+	}
+	appStatusHandler.SetStringValue(common.MetricP2PBandwidthPerPeer, strings.Join(strs, ","))
+}
+
+func totalBytesPerSec(statistics p2p.BandwidthStatistics) uint64 {
+	return statistics.InboundBytesPerSec + statistics.OutboundBytesPerSec
+}
+
+func bandwidthStatisticsToString(name string, statistics p2p.BandwidthStatistics) string {
+	return fmt.Sprintf("%s: in %d B/s / out %d B/s / dropped %d B",
+		name, statistics.InboundBytesPerSec, statistics.OutboundBytesPerSec, statistics.DroppedOutboundBytes)
+}
+
 func registerPollProbableHighestNonce(
 	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
 	forkDetector process.ForkDetector,
@@ -104,3 +104,12 @@ var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
 
 // ErrNilRedundancyHandler signals that a nil redundancy handler was provided
 var ErrNilRedundancyHandler = errors.New("nil redundancy handler")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder was provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
+// ErrNilCacher signals that a nil cacher has been provided
+var ErrNilCacher = errors.New("nil cacher")
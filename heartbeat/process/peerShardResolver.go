@@ -0,0 +1,122 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgPeerShardResolver is the DTO used to create a new instance of PeerShardResolver
+type ArgPeerShardResolver struct {
+	Marshalizer          marshal.Marshalizer
+	PeerSignatureHandler crypto.PeerSignatureHandler
+	Cacher               storage.Cacher
+}
+
+type advertisedPeerInfo struct {
+	pk          []byte
+	shardID     uint32
+	peerSubType core.P2PPeerSubType
+}
+
+// PeerShardResolver keeps the shard advertised by each peer in its heartbeat messages. It is meant for the components
+// without a nodes coordinator, such as the seednode, so it can not tell the validators from the observers and the
+// peers are reported as unknown peers in their advertised shard
+type PeerShardResolver struct {
+	messageHandler heartbeat.MessageHandler
+	cacher         storage.Cacher
+}
+
+// NewPeerShardResolver creates a new instance of PeerShardResolver
+func NewPeerShardResolver(arg ArgPeerShardResolver) (*PeerShardResolver, error) {
+	if check.IfNil(arg.Cacher) {
+		return nil, heartbeat.ErrNilCacher
+	}
+
+	psr := &PeerShardResolver{
+		cacher: arg.Cacher,
+	}
+
+	var err error
+	psr.messageHandler, err = NewMessageProcessor(arg.PeerSignatureHandler, arg.Marshalizer, psr)
+	if err != nil {
+		return nil, err
+	}
+
+	return psr, nil
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called by the p2p subsystem each
+// time a new heartbeat message arrives
+func (psr *PeerShardResolver) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	hbRecv, err := psr.messageHandler.CreateHeartbeatFromP2PMessage(message)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hbRecv.Pid, message.Peer().Bytes()) {
+		return fmt.Errorf("%w heartbeat pid %s, message pid %s",
+			heartbeat.ErrHeartbeatPidMismatch,
+			p2p.PeerIdToShortString(core.PeerID(hbRecv.Pid)),
+			p2p.PeerIdToShortString(message.Peer()),
+		)
+	}
+
+	return nil
+}
+
+// UpdatePeerIDInfo saves the public key and the shard advertised by the provided peer
+func (psr *PeerShardResolver) UpdatePeerIDInfo(pid core.PeerID, pk []byte, shardID uint32) {
+	info := psr.getPeerInfo(pid)
+	info.pk = pk
+	info.shardID = shardID
+
+	psr.cacher.Put(pid.Bytes(), info, 0)
+}
+
+// UpdatePeerIdSubType saves the sub type advertised by the provided peer
+func (psr *PeerShardResolver) UpdatePeerIdSubType(pid core.PeerID, peerSubType core.P2PPeerSubType) {
+	info := psr.getPeerInfo(pid)
+	info.peerSubType = peerSubType
+
+	psr.cacher.Put(pid.Bytes(), info, 0)
+}
+
+func (psr *PeerShardResolver) getPeerInfo(pid core.PeerID) advertisedPeerInfo {
+	value, found := psr.cacher.Get(pid.Bytes())
+	if !found {
+		return advertisedPeerInfo{}
+	}
+
+	info, ok := value.(advertisedPeerInfo)
+	if !ok {
+		return advertisedPeerInfo{}
+	}
+
+	return info
+}
+
+// GetPeerInfo returns the information advertised by the provided peer. The peers that did not send any heartbeat
+// message are reported as unknown peers in shard 0, same as when no resolver is set
+func (psr *PeerShardResolver) GetPeerInfo(pid core.PeerID) core.P2PPeerInfo {
+	info := psr.getPeerInfo(pid)
+
+	return core.P2PPeerInfo{
+		PeerType:    core.UnknownPeer,
+		PeerSubType: info.peerSubType,
+		ShardID:     info.shardID,
+		PkBytes:     info.pk,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psr *PeerShardResolver) IsInterfaceNil() bool {
+	return psr == nil
+}
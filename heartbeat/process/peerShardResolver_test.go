@@ -0,0 +1,103 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgPeerShardResolver() process.ArgPeerShardResolver {
+	return process.ArgPeerShardResolver{
+		Marshalizer:          &marshal.GogoProtoMarshalizer{},
+		PeerSignatureHandler: &mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		Cacher:               testscommon.NewCacherMock(),
+	}
+}
+
+func createHeartbeatMessage(t *testing.T, pid core.PeerID, hb *data.Heartbeat) *mock.P2PMessageStub {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(hb)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewPeerShardResolver(t *testing.T) {
+	t.Parallel()
+
+	arg := createArgPeerShardResolver()
+	arg.Cacher = nil
+	psr, err := process.NewPeerShardResolver(arg)
+	assert.Nil(t, psr)
+	assert.Equal(t, heartbeat.ErrNilCacher, err)
+
+	arg = createArgPeerShardResolver()
+	arg.PeerSignatureHandler = nil
+	psr, err = process.NewPeerShardResolver(arg)
+	assert.Nil(t, psr)
+	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+
+	arg = createArgPeerShardResolver()
+	psr, err = process.NewPeerShardResolver(arg)
+	assert.Nil(t, err)
+	assert.False(t, psr.IsInterfaceNil())
+}
+
+func TestPeerShardResolver_ProcessReceivedMessageShouldSaveTheAdvertisedShard(t *testing.T) {
+	t.Parallel()
+
+	psr, _ := process.NewPeerShardResolver(createArgPeerShardResolver())
+	pid := core.PeerID("pid")
+
+	peerInfo := psr.GetPeerInfo(pid)
+	assert.Equal(t, core.UnknownPeer, peerInfo.PeerType)
+	assert.Equal(t, uint32(0), peerInfo.ShardID)
+
+	hb := CreateHeartbeat()
+	hb.Signature = []byte("signed")
+	hb.Pid = pid.Bytes()
+	hb.ShardID = core.MetachainShardId
+	hb.PeerSubType = uint32(core.FullHistoryObserver)
+	err := psr.ProcessReceivedMessage(createHeartbeatMessage(t, pid, hb), "from")
+	require.Nil(t, err)
+
+	peerInfo = psr.GetPeerInfo(pid)
+	assert.Equal(t, core.UnknownPeer, peerInfo.PeerType)
+	assert.Equal(t, core.MetachainShardId, peerInfo.ShardID)
+	assert.Equal(t, core.FullHistoryObserver, peerInfo.PeerSubType)
+	assert.Equal(t, hb.Pubkey, peerInfo.PkBytes)
+}
+
+func TestPeerShardResolver_ProcessReceivedMessageInvalidHeartbeatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psr, _ := process.NewPeerShardResolver(createArgPeerShardResolver())
+	pid := core.PeerID("pid")
+
+	t.Run("invalid signature", func(t *testing.T) {
+		hb := CreateHeartbeat()
+		hb.Pid = pid.Bytes()
+		hb.ShardID = 2
+		err := psr.ProcessReceivedMessage(createHeartbeatMessage(t, pid, hb), "from")
+		assert.NotNil(t, err)
+		assert.Equal(t, uint32(0), psr.GetPeerInfo(pid).ShardID)
+	})
+	t.Run("pid mismatch", func(t *testing.T) {
+		hb := CreateHeartbeat()
+		hb.Signature = []byte("signed")
+		hb.Pid = []byte("other pid")
+		err := psr.ProcessReceivedMessage(createHeartbeatMessage(t, pid, hb), "from")
+		assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatPidMismatch))
+	})
+}
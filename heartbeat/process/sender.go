@@ -1,6 +1,8 @@
 package process
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -33,13 +35,14 @@ type ArgHeartbeatSender struct {
 	HardforkTrigger      heartbeat.HardforkTrigger
 	CurrentBlockProvider heartbeat.CurrentBlockProvider
 	RedundancyHandler    heartbeat.NodeRedundancyHandler
+	ManagedPeersHolder   common.ManagedPeersHolder
+	SigningHandler       common.SigningHandler
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
 type Sender struct {
 	peerMessenger        heartbeat.P2PMessenger
 	peerSignatureHandler crypto.PeerSignatureHandler
-	privKey              crypto.PrivateKey
 	publicKey            crypto.PublicKey
 	observerPublicKey    crypto.PublicKey
 	marshalizer          marshal.Marshalizer
@@ -54,6 +57,8 @@ type Sender struct {
 	hardforkTrigger      heartbeat.HardforkTrigger
 	currentBlockProvider heartbeat.CurrentBlockProvider
 	redundancy           heartbeat.NodeRedundancyHandler
+	managedPeersHolder   common.ManagedPeersHolder
+	signingHandler       common.SigningHandler
 }
 
 // NewSender will create a new sender instance
@@ -88,6 +93,12 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.RedundancyHandler) {
 		return nil, heartbeat.ErrNilRedundancyHandler
 	}
+	if check.IfNil(arg.ManagedPeersHolder) {
+		return nil, heartbeat.ErrNilManagedPeersHolder
+	}
+	if check.IfNil(arg.SigningHandler) {
+		return nil, heartbeat.ErrNilSigningHandler
+	}
 	err := VerifyHeartbeatPropertyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
@@ -101,7 +112,6 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	sender := &Sender{
 		peerMessenger:        arg.PeerMessenger,
 		peerSignatureHandler: arg.PeerSignatureHandler,
-		privKey:              arg.PrivKey,
 		publicKey:            arg.PrivKey.GeneratePublic(),
 		observerPublicKey:    observerPrivateKey.GeneratePublic(),
 		marshalizer:          arg.Marshalizer,
@@ -116,6 +126,8 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		hardforkTrigger:      arg.HardforkTrigger,
 		currentBlockProvider: arg.CurrentBlockProvider,
 		redundancy:           arg.RedundancyHandler,
+		managedPeersHolder:   arg.ManagedPeersHolder,
+		signingHandler:       arg.SigningHandler,
 	}
 
 	return sender, nil
@@ -171,11 +183,65 @@ func (s *Sender) SendHeartbeat() error {
 
 	s.peerMessenger.Broadcast(s.topic, buffToSend)
 
+	if !s.shouldUseOriginalKeys() {
+		return nil
+	}
+
+	s.managedPeersHolder.RecordHeartbeat(hb.Pubkey)
+	s.sendHeartbeatsForManagedKeys(hb)
+
+	return nil
+}
+
+// sendHeartbeatsForManagedKeys sends, beside the node's own heartbeat, one heartbeat message for each of the
+// other managed keys, so the keys can be monitored independently
+func (s *Sender) sendHeartbeatsForManagedKeys(hb *heartbeatData.Heartbeat) {
+	for _, pkBytes := range s.managedPeersHolder.GetManagedKeys() {
+		if bytes.Equal(pkBytes, hb.Pubkey) {
+			continue
+		}
+
+		err := s.sendHeartbeatForManagedKey(hb, pkBytes)
+		if err != nil {
+			log.Warn("sender: could not send the heartbeat message for a managed key",
+				"pk", hex.EncodeToString(pkBytes), "error", err)
+			continue
+		}
+
+		s.managedPeersHolder.RecordHeartbeat(pkBytes)
+	}
+}
+
+func (s *Sender) sendHeartbeatForManagedKey(hb *heartbeatData.Heartbeat, pkBytes []byte) error {
+	var err error
+	managedHb := &heartbeatData.Heartbeat{
+		Payload:         hb.Payload,
+		Pubkey:          pkBytes,
+		ShardID:         hb.ShardID,
+		VersionNumber:   hb.VersionNumber,
+		NodeDisplayName: hb.NodeDisplayName,
+		Identity:        hb.Identity,
+		Pid:             hb.Pid,
+		Nonce:           hb.Nonce,
+		PeerSubType:     hb.PeerSubType,
+	}
+	managedHb.Signature, err = s.signPid(managedHb.Pubkey, managedHb.Pid)
+	if err != nil {
+		return err
+	}
+
+	buffToSend, err := s.marshalizer.Marshal(managedHb)
+	if err != nil {
+		return err
+	}
+
+	s.peerMessenger.Broadcast(s.topic, buffToSend)
+
 	return nil
 }
 
 func (s *Sender) finalizeMessageConstruction(hb *heartbeatData.Heartbeat) error {
-	sk, pk := s.getCurrentPrivateAndPublicKeys()
+	pk := s.getCurrentPublicKey()
 
 	var err error
 	hb.Pubkey, err = pk.ToByteArray()
@@ -191,18 +257,35 @@ func (s *Sender) finalizeMessageConstruction(hb *heartbeatData.Heartbeat) error
 		trimLengths(hb)
 	}
 
-	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(sk, hb.Pid)
+	hb.Signature, err = s.signPid(hb.Pubkey, hb.Pid)
 
 	return err
 }
 
-func (s *Sender) getCurrentPrivateAndPublicKeys() (crypto.PrivateKey, crypto.PublicKey) {
-	shouldUseOriginalKeys := !s.redundancy.IsRedundancyNode() || (s.redundancy.IsRedundancyNode() && !s.redundancy.IsMainMachineActive())
-	if shouldUseOriginalKeys {
-		return s.privKey, s.publicKey
+// signPid signs the peer ID on behalf of the provided key. The node's keys sign through the signing handler, while
+// the observer key of a backup machine is always held in memory
+func (s *Sender) signPid(pkBytes []byte, pid []byte) ([]byte, error) {
+	if !s.shouldUseOriginalKeys() {
+		return s.peerSignatureHandler.GetPeerSignature(s.redundancy.ObserverPrivateKey(), pid)
+	}
+
+	return s.signingHandler.Sign(&common.SigningRequest{
+		PublicKey: pkBytes,
+		Message:   pid,
+		Kind:      common.PeerSignatureSigning,
+	})
+}
+
+func (s *Sender) getCurrentPublicKey() crypto.PublicKey {
+	if s.shouldUseOriginalKeys() {
+		return s.publicKey
 	}
 
-	return s.redundancy.ObserverPrivateKey(), s.observerPublicKey
+	return s.observerPublicKey
+}
+
+func (s *Sender) shouldUseOriginalKeys() bool {
+	return !s.redundancy.IsRedundancyNode() || (s.redundancy.IsRedundancyNode() && !s.redundancy.IsMainMachineActive())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
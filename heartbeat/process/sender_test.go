@@ -9,10 +9,12 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -40,6 +42,18 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		ManagedPeersHolder:   &cryptoMocks.ManagedPeersHolderStub{},
+		SigningHandler:       &cryptoMocks.SigningHandlerStub{},
+	}
+}
+
+// createLocalSigningHandler returns a signing handler that signs with the argument's private key through the
+// argument's peer signature handler, as the node does when no remote signer is used
+func createLocalSigningHandler(arg *process.ArgHeartbeatSender) *cryptoMocks.SigningHandlerStub {
+	return &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			return arg.PeerSignatureHandler.GetPeerSignature(arg.PrivKey, request.Message)
+		},
 	}
 }
 
@@ -164,6 +178,28 @@ func TestNewSender_NilRedundancyHandlerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, heartbeat.ErrNilRedundancyHandler))
 }
 
+func TestNewSender_NilManagedPeersHolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.ManagedPeersHolder = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilManagedPeersHolder))
+}
+
+func TestNewSender_NilSigningHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.SigningHandler = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilSigningHandler))
+}
+
 func TestNewSender_RedundancyHandlerReturnsANilObserverPrivateKeyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -239,6 +275,7 @@ func testSendHeartbeat(t *testing.T, pubKeyErr, signErr, marshalErr error) {
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.Marshalizer = &mock.MarshalizerStub{
 		MarshalHandler: func(obj interface{}) (i []byte, e error) {
@@ -287,6 +324,7 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -321,6 +359,94 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, marshalCalled)
 }
 
+func TestSender_SendHeartbeatWithManagedKeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ownPkBytes := []byte("own pub key")
+	managedPkBytes := []byte("managed pub key")
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return ownPkBytes, nil
+				},
+			}
+		},
+	}
+	signedKeys := make([]string, 0)
+	arg.SigningHandler = &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			assert.Equal(t, common.PeerSignatureSigning, request.Kind)
+			signedKeys = append(signedKeys, string(request.PublicKey))
+			return []byte("signature"), nil
+		},
+	}
+	sentPubKeys := make([]string, 0)
+	arg.Marshalizer = &mock.MarshalizerStub{
+		MarshalHandler: func(obj interface{}) ([]byte, error) {
+			hb := obj.(*data.Heartbeat)
+			sentPubKeys = append(sentPubKeys, string(hb.Pubkey))
+			return hb.Pubkey, nil
+		},
+	}
+	recordedHeartbeats := make([]string, 0)
+	arg.ManagedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+		GetManagedKeysCalled: func() [][]byte {
+			return [][]byte{ownPkBytes, managedPkBytes}
+		},
+		RecordHeartbeatCalled: func(pkBytes []byte) {
+			recordedHeartbeats = append(recordedHeartbeats, string(pkBytes))
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+	expectedKeys := []string{string(ownPkBytes), string(managedPkBytes)}
+	assert.Equal(t, expectedKeys, signedKeys)
+	assert.Equal(t, expectedKeys, sentPubKeys)
+	assert.Equal(t, expectedKeys, recordedHeartbeats)
+}
+
+func TestSender_SendHeartbeatBackupNodeShouldNotSendForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.RedundancyHandler = &mock.RedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		IsMainMachineActiveCalled: func() bool {
+			return true
+		},
+		ObserverPrivateKeyCalled: func() crypto.PrivateKey {
+			return &mock.PrivateKeyStub{
+				GeneratePublicHandler: func() crypto.PublicKey {
+					return &mock.PublicKeyMock{
+						ToByteArrayHandler: func() ([]byte, error) {
+							return []byte("observer pub key"), nil
+						},
+					}
+				},
+			}
+		},
+	}
+	arg.ManagedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+		GetManagedKeysCalled: func() [][]byte {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+		RecordHeartbeatCalled: func(pkBytes []byte) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+}
+
 func TestSender_SendHeartbeatNotABackupNodeShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -356,6 +482,7 @@ func TestSender_SendHeartbeatNotABackupNodeShouldWork(t *testing.T) {
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -428,6 +555,7 @@ func TestSender_SendHeartbeatBackupNodeShouldWork(t *testing.T) {
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -500,6 +628,7 @@ func TestSender_SendHeartbeatIsBackupNodeButMainIsNotActiveShouldWork(t *testing
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -554,6 +683,7 @@ func TestSender_SendHeartbeatAfterTriggerShouldWork(t *testing.T) {
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -638,6 +768,7 @@ func TestSender_SendHeartbeatAfterTriggerWithRecorededPayloadShouldWork(t *testi
 		},
 	}
 	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
+	arg.SigningHandler = createLocalSigningHandler(&arg)
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -7,14 +7,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const consensusTimeBetweenRounds = time.Second
@@ -38,6 +42,7 @@ func initNodesAndTest(
 	numInvalid uint32,
 	roundTime uint64,
 	consensusType string,
+	createMessenger func() p2p.Messenger,
 ) ([]*testNode, *sync.Map) {
 
 	fmt.Println("Step 1. Setup nodes...")
@@ -49,6 +54,7 @@ func initNodesAndTest(
 		int(consensusSize),
 		roundTime,
 		consensusType,
+		createMessenger,
 	)
 
 	for _, nodesList := range nodes {
@@ -196,7 +202,7 @@ func runFullConsensusTest(t *testing.T, consensusType string) {
 	roundTime := uint64(1000)
 	numCommBlock := uint64(8)
 
-	nodes, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType)
+	nodes, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType, integrationTests.CreateMessengerWithNoDiscovery)
 
 	mutex := &sync.Mutex{}
 	defer func() {
@@ -243,7 +249,7 @@ func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
 	consensusSize := uint32(4)
 	numInvalid := uint32(2)
 	roundTime := uint64(1000)
-	nodes, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType)
+	nodes, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType, integrationTests.CreateMessengerWithNoDiscovery)
 
 	mutex := &sync.Mutex{}
 	defer func() {
@@ -277,3 +283,69 @@ func TestConsensusBLSNotEnoughValidators(t *testing.T) {
 
 	runConsensusWithNotEnoughValidators(t, blsConsensusType)
 }
+
+func runConsensusWithPartitionedNetwork(t *testing.T, consensusType string) {
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	numInvalid := uint32(0)
+	roundTime := uint64(1000)
+
+	simulator, err := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{
+		Seed: 37,
+		DefaultConditions: memp2p.LinkConditions{
+			Latency:            time.Millisecond * 20,
+			Jitter:             time.Millisecond * 10,
+			JitterDistribution: memp2p.NormalJitter,
+		},
+	})
+	require.Nil(t, err)
+	network, err := memp2p.NewNetworkWithSimulator(simulator)
+	require.Nil(t, err)
+
+	createMessenger := func() p2p.Messenger {
+		return integrationTests.CreateInMemoryMessenger(network)
+	}
+	nodes, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType, createMessenger)
+
+	mutex := &sync.Mutex{}
+	defer func() {
+		for _, n := range nodes {
+			_ = n.messenger.Close()
+		}
+		_ = simulator.Close()
+	}()
+
+	// the 3 connected validators still reach the 2/3+1 threshold while the isolated one misses all the blocks
+	isolatedNode := nodes[len(nodes)-1]
+	partitionDuration := time.Duration(roundTime) * time.Millisecond * 6
+	simulator.RunSchedule([]memp2p.PartitionEvent{
+		{
+			At:     0,
+			Groups: [][]core.PeerID{{isolatedNode.messenger.ID()}},
+		},
+		{
+			At: partitionDuration,
+		},
+	})
+
+	nonceForRoundMap := make(map[uint64]uint64)
+	totalCalled := 0
+	err = startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	assert.Nil(t, err)
+
+	time.Sleep(partitionDuration - time.Second)
+
+	mutex.Lock()
+	assert.True(t, totalCalled > 0)
+	mutex.Unlock()
+	assert.Equal(t, uint32(0), isolatedNode.blkProcessor.NrCommitBlockCalled)
+	assert.True(t, simulator.NumDroppedMessages() > 0)
+}
+
+func TestConsensusBLSWithPartitionedNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusWithPartitionedNetwork(t, blsConsensusType)
+}
@@ -260,6 +260,7 @@ func createConsensusOnlyNode(
 	testKeyGen crypto.KeyGenerator,
 	consensusType string,
 	epochStartRegistrationHandler mainFactory.EpochStartNotifier,
+	messenger p2p.Messenger,
 ) (
 	*node.Node,
 	p2p.Messenger,
@@ -269,7 +270,6 @@ func createConsensusOnlyNode(
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.GogoProtoMarshalizer{}
 
-	messenger := integrationTests.CreateMessengerWithNoDiscovery()
 	rootHash := []byte("roothash")
 
 	blockChain := createTestBlockChain()
@@ -482,6 +482,7 @@ func createNodes(
 	consensusSize int,
 	roundTime uint64,
 	consensusType string,
+	createMessenger func() p2p.Messenger,
 ) map[uint32][]*testNode {
 
 	nodes := make(map[uint32][]*testNode)
@@ -543,6 +544,7 @@ func createNodes(
 			cp.keyGen,
 			consensusType,
 			epochStartRegistrationHandler,
+			createMessenger(),
 		)
 
 		testNodeObject.node = n
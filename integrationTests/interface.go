@@ -63,6 +63,7 @@ type Facade interface {
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
 	GetESDTsRoles(address string) (map[string][]string, error)
 	GetKeyValuePairs(address string) (map[string]string, error)
+	GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*dataApi.Block, error)
@@ -71,12 +72,24 @@ type Facade interface {
 	GetTotalStakedValue() (*dataApi.StakeValues, error)
 	GetDirectStakedList() ([]*dataApi.DirectStakedValue, error)
 	GetDelegatorsList() ([]*dataApi.Delegator, error)
+	GetTokenHolders(tokenIdentifier string, nonce uint64) (*common.TokenHoldersAPIResponse, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalAPIResponse, error)
+	GetDelegationSnapshot(contractAddress string) (*common.DelegationSnapshotAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
-	GetTokenSupply(token string) (*dataApi.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error)
+	GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersRatings() (map[string]int32, error)
+	GetManagedKeysStatus() []*common.ManagedKeyStatus
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +22,8 @@ type CryptoComponentsStub struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	ManagedPeers    common.ManagedPeersHolder
+	Signing         common.SigningHandler
 	mutMultiSig     sync.RWMutex
 }
 
@@ -114,6 +117,16 @@ func (ccs *CryptoComponentsStub) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccs.MsgSigVerifier
 }
 
+// ManagedPeersHolder -
+func (ccs *CryptoComponentsStub) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccs.ManagedPeers
+}
+
+// SigningHandler -
+func (ccs *CryptoComponentsStub) SigningHandler() common.SigningHandler {
+	return ccs.Signing
+}
+
 // Clone -
 func (ccs *CryptoComponentsStub) Clone() interface{} {
 	return &CryptoComponentsStub{
@@ -129,6 +142,8 @@ func (ccs *CryptoComponentsStub) Clone() interface{} {
 		BlKeyGen:        ccs.BlKeyGen,
 		TxKeyGen:        ccs.TxKeyGen,
 		MsgSigVerifier:  ccs.MsgSigVerifier,
+		ManagedPeers:    ccs.ManagedPeers,
+		Signing:         ccs.Signing,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
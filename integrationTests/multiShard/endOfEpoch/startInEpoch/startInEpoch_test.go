@@ -1,8 +1,10 @@
 package startInEpoch
 
 import (
+	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -202,7 +204,8 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 	coreComponents.HasherField = integrationTests.TestHasher
 	coreComponents.AddressPubKeyConverterField = integrationTests.TestAddressPubkeyConverter
 	coreComponents.Uint64ByteSliceConverterField = uint64Converter
-	coreComponents.PathHandlerField = &testscommon.PathManagerStub{}
+	pathManager := createTempDirPathManager(t)
+	coreComponents.PathHandlerField = pathManager
 	coreComponents.ChainIdCalled = func() string {
 		return string(integrationTests.ChainID)
 	}
@@ -261,7 +264,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		&generalConfig,
 		&prefsConfig,
 		shardC,
-		&testscommon.PathManagerStub{},
+		pathManager,
 		notifier.NewEpochStartSubscriptionHandler(),
 		&nodeTypeProviderMock.NodeTypeProviderStub{},
 		0,
@@ -347,3 +350,18 @@ func getGeneralConfig() config.Config {
 
 	return generalConfig
 }
+
+// createTempDirPathManager returns a path manager that places the storers created by the test in its temporary
+// directory, so no leftover databases remain in the package directory
+func createTempDirPathManager(t *testing.T) *testscommon.PathManagerStub {
+	tempDir := t.TempDir()
+
+	return &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(tempDir, fmt.Sprintf("Epoch_%d", epoch), "Shard_"+shardId, identifier)
+		},
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			return filepath.Join(tempDir, "Static", "Shard_"+shardId, identifier)
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	mock2 "github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	mclsig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
@@ -209,10 +211,11 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 	keyGen := signing.NewKeyGenerator(suite)
 	sk, pk := keyGen.GeneratePair()
 	version := "v01"
+	peerSignatureHandler := &mock2.PeerSignatureHandler{Signer: signer}
 
 	argSender := process.ArgHeartbeatSender{
 		PeerMessenger:        messenger,
-		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
+		PeerSignatureHandler: peerSignatureHandler,
 		PrivKey:              sk,
 		Marshalizer:          integrationTests.TestMarshalizer,
 		Topic:                topic,
@@ -224,6 +227,12 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &testscommon.ChainHandlerStub{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		ManagedPeersHolder:   &cryptoMocks.ManagedPeersHolderStub{},
+		SigningHandler: &cryptoMocks.SigningHandlerStub{
+			SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+				return peerSignatureHandler.GetPeerSignature(sk, request.Message)
+			},
+		},
 	}
 
 	sender, _ := process.NewSender(argSender)
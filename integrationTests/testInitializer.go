@@ -42,6 +42,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
@@ -276,6 +277,15 @@ func CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHanlder p2p.
 	return CreateMessengerFromConfigWithPeersRatingHandler(p2pConfig, peersRatingHanlder)
 }
 
+// CreateInMemoryMessenger creates a new in-memory messenger registered on the provided network. The network can be
+// created with a memp2p.NetworkSimulator in order to run the scenarios under adverse network conditions
+func CreateInMemoryMessenger(network *memp2p.Network) p2p.Messenger {
+	messenger, err := memp2p.NewMessenger(network)
+	log.LogIfError(err)
+
+	return messenger
+}
+
 // CreateFixedNetworkOf8Peers assembles a network as following:
 //
 //                             0------------------- 1
@@ -408,6 +408,7 @@ func newBaseTestProcessorNode(
 		p2pRating.ArgPeersRatingHandler{
 			TopRatedCache: testscommon.NewCacherMock(),
 			BadRatedCache: testscommon.NewCacherMock(),
+			Storer:        testscommon.CreateMemUnit(),
 		})
 
 	messenger := CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHandler)
@@ -571,6 +572,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		tpn.createConsensusSigningHandler(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -592,6 +594,7 @@ func NewTestProcessorNodeWithCustomDataPool(maxShards uint32, nodeShardId uint32
 		p2pRating.ArgPeersRatingHandler{
 			TopRatedCache: testscommon.NewCacherMock(),
 			BadRatedCache: testscommon.NewCacherMock(),
+			Storer:        testscommon.CreateMemUnit(),
 		})
 
 	messenger := CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHandler)
@@ -778,6 +781,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		tpn.createConsensusSigningHandler(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -828,6 +832,7 @@ func (tpn *TestProcessorNode) initTestNodeWithTrieDBAndGasModel(trieStore storag
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		tpn.createConsensusSigningHandler(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -1004,6 +1009,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		tpn.createConsensusSigningHandler(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -2123,10 +2129,11 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 				NodesConfigProvider:           tpn.NodesCoordinator,
 				UserAccountsDB:                tpn.AccntState,
 			},
-			StakingDataProvider:   stakingDataProvider,
-			RewardsHandler:        tpn.EconomicsData,
-			EconomicsDataProvider: economicsDataProvider,
-			EpochEnableV2:         StakingV2Epoch,
+			StakingDataProvider:     stakingDataProvider,
+			RewardsHandler:          tpn.EconomicsData,
+			EconomicsDataProvider:   economicsDataProvider,
+			RewardsBreakdownHandler: metachain.NewDisabledRewardsBreakdownStorer(),
+			EpochEnableV2:           StakingV2Epoch,
 		}
 		epochStartRewards, _ := metachain.NewRewardsCreatorProxy(argsEpochRewards)
 
@@ -2223,6 +2230,16 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 	}
 }
 
+// createConsensusSigningHandler returns a signing handler that issues the consensus messages peer signatures with the
+// node's own account key
+func (tpn *TestProcessorNode) createConsensusSigningHandler() common.SigningHandler {
+	return &cryptoMocks.SigningHandlerStub{
+		SignCalled: func(request *common.SigningRequest) ([]byte, error) {
+			return tpn.OwnAccount.PeerSigHandler.GetPeerSignature(tpn.OwnAccount.SkTxSign, request.Message)
+		},
+	}
+}
+
 func (tpn *TestProcessorNode) setGenesisBlock() {
 	genesisBlock := tpn.GenesisBlocks[tpn.ShardCoordinator.SelfId()]
 	_ = tpn.BlockChain.SetGenesisHeader(genesisBlock)
@@ -3024,6 +3041,8 @@ func GetDefaultCryptoComponents() *mock.CryptoComponentsStub {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		ManagedPeers:    &cryptoMocks.ManagedPeersHolderStub{},
+		Signing:         &cryptoMocks.SigningHandlerStub{},
 	}
 }
 
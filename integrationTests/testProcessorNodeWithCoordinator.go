@@ -207,6 +207,7 @@ func newTestProcessorNodeWithCustomNodesCoordinator(
 		p2pRating.ArgPeersRatingHandler{
 			TopRatedCache: testscommon.NewCacherMock(),
 			BadRatedCache: testscommon.NewCacherMock(),
+			Storer:        testscommon.CreateMemUnit(),
 		})
 
 	messenger := CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHandler)
@@ -59,6 +59,7 @@ func NewTestProcessorNodeWithCustomNodesCoordinator(
 		p2pRating.ArgPeersRatingHandler{
 			TopRatedCache: testscommon.NewCacherMock(),
 			BadRatedCache: testscommon.NewCacherMock(),
+			Storer:        testscommon.CreateMemUnit(),
 		})
 	messenger := CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHandler)
 	tpn := &TestProcessorNode{
@@ -252,6 +253,7 @@ func CreateNodeWithBLSAndTxKeys(
 		p2pRating.ArgPeersRatingHandler{
 			TopRatedCache: testscommon.NewCacherMock(),
 			BadRatedCache: testscommon.NewCacherMock(),
+			Storer:        testscommon.CreateMemUnit(),
 		})
 	messenger := CreateMessengerWithNoDiscoveryAndPeersRatingHandler(peersRatingHandler)
 	tpn := &TestProcessorNode{
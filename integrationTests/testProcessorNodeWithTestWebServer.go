@@ -190,6 +190,30 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	argsTokenHolders := trieIterators.ArgTokenHoldersProcessor{
+		ArgTrieIteratorProcessor: args,
+		Marshalizer:              TestMarshalizer,
+	}
+	tokenHoldersHandler, err := factory.CreateTokenHoldersHandler(argsTokenHolders)
+	log.LogIfError(err)
+
+	argsGovernance := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: args,
+		Marshalizer:              TestMarshalizer,
+		BlockChain:               tpn.BlockChain,
+	}
+	governanceHandler, err := factory.CreateGovernanceHandler(argsGovernance)
+	log.LogIfError(err)
+
+	argsDelegationSnapshot := trieIterators.ArgDelegationSnapshotProcessor{
+		ArgTrieIteratorProcessor: args,
+		Marshalizer:              TestMarshalizer,
+		BlockChain:               tpn.BlockChain,
+		MaxServiceFee:            100000,
+	}
+	delegationSnapshotHandler, err := factory.CreateDelegationSnapshotHandler(argsDelegationSnapshot)
+	log.LogIfError(err)
+
 	argsApiTransactionProc := &transactionAPI.ArgAPITransactionProcessor{
 		Marshalizer:              TestMarshalizer,
 		AddressPubKeyConverter:   TestAddressPubkeyConverter,
@@ -223,17 +247,20 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	log.LogIfError(err)
 
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           tpn.SCQueryService,
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		TxCostHandler:            txCostHandler,
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionHandler,
-		APIBlockHandler:          blockAPIHandler,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: &mock.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		SCQueryService:            tpn.SCQueryService,
+		StatusMetricsHandler:      &testscommon.StatusMetricsStub{},
+		TxCostHandler:             txCostHandler,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		TokenHoldersHandler:       tokenHoldersHandler,
+		GovernanceHandler:         governanceHandler,
+		DelegationSnapshotHandler: delegationSnapshotHandler,
+		APITransactionHandler:     apiTransactionHandler,
+		APIBlockHandler:           blockAPIHandler,
+		APIInternalBlockHandler:   apiInternalBlockProcessor,
+		GenesisNodesSetupHandler:  &mock.NodesSetupStub{},
+		ValidatorPubKeyConverter:  &testscommon.PubkeyConverterMock{},
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -0,0 +1,30 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrDuplicatedKey signals that a key is already managed by the current node
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPublicKeyDefinition signals that a public key is not managed by the current node
+var ErrMissingPublicKeyDefinition = errors.New("missing public key definition")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPeerSignatureHandler signals that a nil peer signature handler has been provided
+var ErrNilPeerSignatureHandler = errors.New("nil peer signature handler")
+
+// ErrNilSigningRequest signals that a nil signing request has been provided
+var ErrNilSigningRequest = errors.New("nil signing request")
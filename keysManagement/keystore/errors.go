@@ -0,0 +1,27 @@
+package keystore
+
+import "errors"
+
+// ErrEmptyPassword signals that an empty password has been provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrEmptySecret signals that an empty secret has been provided
+var ErrEmptySecret = errors.New("empty secret")
+
+// ErrUnsupportedCipher signals that the keystore uses an unsupported cipher
+var ErrUnsupportedCipher = errors.New("unsupported cipher")
+
+// ErrUnsupportedKDF signals that the keystore uses an unsupported key derivation function
+var ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+
+// ErrInvalidPassword signals that the keystore could not be decrypted with the provided password
+var ErrInvalidPassword = errors.New("invalid password")
+
+// ErrNilPemKeyLoader signals that a nil PEM key loader has been provided
+var ErrNilPemKeyLoader = errors.New("nil PEM key loader")
+
+// ErrNilPasswordProvider signals that a nil password provider has been provided
+var ErrNilPasswordProvider = errors.New("nil password provider")
+
+// ErrNoPasswordSource signals that the password could not be read from any of the configured sources
+var ErrNoPasswordSource = errors.New("no password source available")
@@ -0,0 +1,12 @@
+package keystore
+
+// PemKeyLoader defines the component able to load a key from a PEM file
+type PemKeyLoader interface {
+	LoadKey(relativePath string, skIndex int) ([]byte, string, error)
+}
+
+// PasswordProvider defines the component able to provide the password of the key files
+type PasswordProvider interface {
+	Password() (string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,64 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// ArgsKeyLoader holds the arguments needed to create a key loader
+type ArgsKeyLoader struct {
+	PemKeyLoader     PemKeyLoader
+	PasswordProvider PasswordProvider
+}
+
+type keyLoader struct {
+	pemKeyLoader     PemKeyLoader
+	passwordProvider PasswordProvider
+}
+
+// NewKeyLoader creates a key loader able to read both key files and PEM files. The password is requested only when
+// a key file has to be decrypted
+func NewKeyLoader(args ArgsKeyLoader) (*keyLoader, error) {
+	if args.PemKeyLoader == nil {
+		return nil, ErrNilPemKeyLoader
+	}
+	if check.IfNil(args.PasswordProvider) {
+		return nil, ErrNilPasswordProvider
+	}
+
+	return &keyLoader{
+		pemKeyLoader:     args.PemKeyLoader,
+		passwordProvider: args.PasswordProvider,
+	}, nil
+}
+
+// LoadKey returns the hex encoded secret key and the public key found at the provided path, in the same format as
+// the PEM key loader. A key file holds a single key so only the 0 index is valid for it
+func (kl *keyLoader) LoadKey(relativePath string, skIndex int) ([]byte, string, error) {
+	if !IsKeyFile(relativePath) {
+		return kl.pemKeyLoader.LoadKey(relativePath, skIndex)
+	}
+	if skIndex != 0 {
+		return nil, "", fmt.Errorf("%w while reading %s key file, invalid index %d", core.ErrInvalidIndex, relativePath, skIndex)
+	}
+
+	password, err := kl.passwordProvider.Password()
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, address, err := LoadKeyFile(relativePath, password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []byte(hex.EncodeToString(secret)), address, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
@@ -0,0 +1,152 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pemKeyLoaderStub struct {
+	LoadKeyCalled func(relativePath string, skIndex int) ([]byte, string, error)
+}
+
+func (stub *pemKeyLoaderStub) LoadKey(relativePath string, skIndex int) ([]byte, string, error) {
+	return stub.LoadKeyCalled(relativePath, skIndex)
+}
+
+type passwordProviderStub struct {
+	PasswordCalled func() (string, error)
+}
+
+func (stub *passwordProviderStub) Password() (string, error) {
+	return stub.PasswordCalled()
+}
+
+func (stub *passwordProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsKeyLoader() ArgsKeyLoader {
+	return ArgsKeyLoader{
+		PemKeyLoader: &pemKeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				return []byte("pem"), "pem address", nil
+			},
+		},
+		PasswordProvider: &passwordProviderStub{
+			PasswordCalled: func() (string, error) {
+				return "password", nil
+			},
+		},
+	}
+}
+
+func TestNewKeyLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil PEM key loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeyLoader()
+		args.PemKeyLoader = nil
+		kl, err := NewKeyLoader(args)
+		assert.Equal(t, ErrNilPemKeyLoader, err)
+		assert.True(t, kl.IsInterfaceNil())
+	})
+	t.Run("nil password provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeyLoader()
+		args.PasswordProvider = nil
+		kl, err := NewKeyLoader(args)
+		assert.Equal(t, ErrNilPasswordProvider, err)
+		assert.True(t, kl.IsInterfaceNil())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kl, err := NewKeyLoader(createMockArgsKeyLoader())
+		assert.Nil(t, err)
+		assert.False(t, kl.IsInterfaceNil())
+	})
+}
+
+func TestKeyLoader_LoadKey(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret key bytes")
+	path := filepath.Join(t.TempDir(), "key.json")
+	keyFile, _ := Encrypt(secret, "address", "", "password")
+	require.Nil(t, SaveKeyFile(path, keyFile))
+
+	t.Run("PEM file should be loaded by the PEM key loader without requesting the password", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeyLoader()
+		args.PasswordProvider = &passwordProviderStub{
+			PasswordCalled: func() (string, error) {
+				assert.Fail(t, "should have not requested the password")
+				return "", nil
+			},
+		}
+		kl, _ := NewKeyLoader(args)
+
+		sk, address, err := kl.LoadKey(filepath.Join(t.TempDir(), "key.pem"), 3)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("pem"), sk)
+		assert.Equal(t, "pem address", address)
+	})
+	t.Run("key file with non-zero index should error", func(t *testing.T) {
+		t.Parallel()
+
+		kl, _ := NewKeyLoader(createMockArgsKeyLoader())
+
+		sk, address, err := kl.LoadKey(path, 1)
+		assert.True(t, errors.Is(err, core.ErrInvalidIndex))
+		assert.Nil(t, sk)
+		assert.Empty(t, address)
+	})
+	t.Run("password provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeyLoader()
+		args.PasswordProvider = &passwordProviderStub{
+			PasswordCalled: func() (string, error) {
+				return "", ErrNoPasswordSource
+			},
+		}
+		kl, _ := NewKeyLoader(args)
+
+		_, _, err := kl.LoadKey(path, 0)
+		assert.Equal(t, ErrNoPasswordSource, err)
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeyLoader()
+		args.PasswordProvider = &passwordProviderStub{
+			PasswordCalled: func() (string, error) {
+				return "wrong", nil
+			},
+		}
+		kl, _ := NewKeyLoader(args)
+
+		_, _, err := kl.LoadKey(path, 0)
+		assert.True(t, errors.Is(err, ErrInvalidPassword))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kl, _ := NewKeyLoader(createMockArgsKeyLoader())
+
+		sk, address, err := kl.LoadKey(path, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(hex.EncodeToString(secret)), sk)
+		assert.Equal(t, "address", address)
+	})
+}
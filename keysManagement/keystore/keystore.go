@@ -0,0 +1,256 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreVersion = 4
+	secretKeyKind   = "secretKey"
+	cipherName      = "aes-128-ctr"
+	kdfName         = "scrypt"
+	scryptN         = 4096
+	scryptR         = 8
+	scryptP         = 1
+	derivedKeyLen   = 32
+	saltLen         = 32
+	ivLen           = 16
+	filePermissions = 0600
+)
+
+// KeyFile is the JSON representation of a password protected key, compatible with the wallet keystore files
+// used in the ecosystem
+type KeyFile struct {
+	Version int        `json:"version"`
+	Kind    string     `json:"kind"`
+	ID      string     `json:"id"`
+	Address string     `json:"address"`
+	Bech32  string     `json:"bech32,omitempty"`
+	Crypto  CryptoData `json:"crypto"`
+}
+
+// CryptoData holds the encrypted secret alongside the parameters needed to decrypt it
+type CryptoData struct {
+	Ciphertext   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Cipher       string       `json:"cipher"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+// CipherParams holds the cipher parameters
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KDFParams holds the scrypt key derivation parameters
+type KDFParams struct {
+	DkLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// Encrypt creates the key file holding the provided secret, encrypted with a key derived from the password. The
+// address is the hex encoded public key and bech32 is the optional bech32 address of a wallet key
+func Encrypt(secret []byte, address string, bech32 string, password string) (*KeyFile, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(ivLen)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := KDFParams{
+		DkLen: derivedKeyLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	derivedKey, err := deriveKey(password, salt, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := applyCipher(derivedKey[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyFile{
+		Version: keystoreVersion,
+		Kind:    secretKeyKind,
+		ID:      id,
+		Address: address,
+		Bech32:  bech32,
+		Crypto: CryptoData{
+			Ciphertext:   hex.EncodeToString(ciphertext),
+			CipherParams: CipherParams{IV: hex.EncodeToString(iv)},
+			Cipher:       cipherName,
+			KDF:          kdfName,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(computeMAC(derivedKey[16:32], ciphertext)),
+		},
+	}, nil
+}
+
+// Decrypt returns the secret held by the key file. Errors if the password does not match
+func Decrypt(keyFile *KeyFile, password string) ([]byte, error) {
+	if keyFile.Crypto.Cipher != cipherName {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCipher, keyFile.Crypto.Cipher)
+	}
+	if keyFile.Crypto.KDF != kdfName {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKDF, keyFile.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(keyFile.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the salt", err)
+	}
+	iv, err := hex.DecodeString(keyFile.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the iv", err)
+	}
+	ciphertext, err := hex.DecodeString(keyFile.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the ciphertext", err)
+	}
+	mac, err := hex.DecodeString(keyFile.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the mac", err)
+	}
+
+	derivedKey, err := deriveKey(password, salt, keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(derivedKey) < 32 {
+		return nil, fmt.Errorf("%w, derived key length %d", ErrUnsupportedKDF, len(derivedKey))
+	}
+
+	if !hmac.Equal(mac, computeMAC(derivedKey[16:32], ciphertext)) {
+		return nil, ErrInvalidPassword
+	}
+
+	return applyCipher(derivedKey[:16], iv, ciphertext)
+}
+
+// LoadKeyFile reads and decrypts the key file found at the provided path, returning the secret and the address
+func LoadKeyFile(path string, password string) ([]byte, string, error) {
+	keyFile, err := ReadKeyFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := Decrypt(keyFile, password)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w for the key file %s", err, path)
+	}
+
+	return secret, keyFile.Address, nil
+}
+
+// ReadKeyFile reads and parses, without decrypting, the key file found at the provided path
+func ReadKeyFile(path string) (*KeyFile, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile := &KeyFile{}
+	err = json.Unmarshal(buff, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the key file %s", err, path)
+	}
+
+	return keyFile, nil
+}
+
+// IsKeyFile returns true if the file found at the provided path is a key file and not, for example, a PEM file
+func IsKeyFile(path string) bool {
+	keyFile, err := ReadKeyFile(path)
+
+	return err == nil && len(keyFile.Crypto.Ciphertext) > 0
+}
+
+// SaveKeyFile writes the key file at the provided path, readable only by the current user
+func SaveKeyFile(path string, keyFile *KeyFile) error {
+	buff, err := json.MarshalIndent(keyFile, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buff, filePermissions)
+}
+
+func deriveKey(password string, salt []byte, params KDFParams) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DkLen)
+}
+
+func applyCipher(key []byte, iv []byte, input []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("%w, iv length %d", ErrUnsupportedCipher, len(iv))
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
+
+// computeMAC returns the HMAC-SHA256 of the ciphertext, as computed by the wallets in the ecosystem
+func computeMAC(key []byte, ciphertext []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(ciphertext)
+
+	return h.Sum(nil)
+}
+
+func randomBytes(size int) ([]byte, error) {
+	buff := make([]byte, size)
+	_, err := rand.Read(buff)
+
+	return buff, err
+}
+
+func newUUID() (string, error) {
+	buff, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+
+	buff[6] = (buff[6] & 0x0f) | 0x40
+	buff[8] = (buff[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buff[0:4], buff[4:6], buff[6:8], buff[8:10], buff[10:16]), nil
+}
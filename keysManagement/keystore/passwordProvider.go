@@ -0,0 +1,102 @@
+package keystore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// ArgsPasswordProvider holds the sources the password can be read from. The sources are tried in order: the file,
+// the environment variable and, if the process is attached to a terminal, an interactive prompt
+type ArgsPasswordProvider struct {
+	FilePath      string
+	EnvVariable   string
+	PromptMessage string
+}
+
+type passwordProvider struct {
+	filePath         string
+	envVariable      string
+	promptMessage    string
+	readFromTerminal func(promptMessage string) (string, error)
+
+	mut      sync.Mutex
+	password string
+}
+
+// NewPasswordProvider creates a password provider that resolves the password only once, on the first request
+func NewPasswordProvider(args ArgsPasswordProvider) *passwordProvider {
+	return &passwordProvider{
+		filePath:         args.FilePath,
+		envVariable:      args.EnvVariable,
+		promptMessage:    args.PromptMessage,
+		readFromTerminal: readFromTerminal,
+	}
+}
+
+// Password returns the password read from the first available source
+func (pp *passwordProvider) Password() (string, error) {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	if len(pp.password) > 0 {
+		return pp.password, nil
+	}
+
+	password, err := pp.resolvePassword()
+	if err != nil {
+		return "", err
+	}
+	if len(password) == 0 {
+		return "", ErrEmptyPassword
+	}
+
+	pp.password = password
+
+	return password, nil
+}
+
+func (pp *passwordProvider) resolvePassword() (string, error) {
+	if len(pp.filePath) > 0 {
+		buff, err := ioutil.ReadFile(pp.filePath)
+		if err != nil {
+			return "", fmt.Errorf("%w while reading the password file", err)
+		}
+
+		return strings.TrimRight(string(buff), "\r\n"), nil
+	}
+
+	if len(pp.envVariable) > 0 {
+		password, found := os.LookupEnv(pp.envVariable)
+		if found {
+			return password, nil
+		}
+	}
+
+	return pp.readFromTerminal(pp.promptMessage)
+}
+
+func readFromTerminal(promptMessage string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", ErrNoPasswordSource
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, promptMessage)
+	buff, err := term.ReadPassword(fd)
+	_, _ = fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buff), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *passwordProvider) IsInterfaceNil() bool {
+	return pp == nil
+}
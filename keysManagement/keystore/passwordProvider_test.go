@@ -0,0 +1,109 @@
+package keystore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestPasswordProvider(args ArgsPasswordProvider, terminalPassword string) *passwordProvider {
+	pp := NewPasswordProvider(args)
+	pp.readFromTerminal = func(promptMessage string) (string, error) {
+		if len(terminalPassword) == 0 {
+			return "", ErrNoPasswordSource
+		}
+		return terminalPassword, nil
+	}
+
+	return pp
+}
+
+func TestPasswordProvider_Password(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file should have priority", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "password")
+		_ = ioutil.WriteFile(path, []byte("from file\n"), 0600)
+		envVariable := "KEYSTORE_TEST_PASSWORD_FILE_PRIORITY"
+		_ = os.Setenv(envVariable, "from env")
+		defer func() {
+			_ = os.Unsetenv(envVariable)
+		}()
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{FilePath: path, EnvVariable: envVariable}, "from terminal")
+		password, err := pp.Password()
+		assert.Nil(t, err)
+		assert.Equal(t, "from file", password)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{FilePath: filepath.Join(t.TempDir(), "missing")}, "")
+		password, err := pp.Password()
+		assert.NotNil(t, err)
+		assert.Empty(t, password)
+	})
+	t.Run("environment variable should have priority over the terminal", func(t *testing.T) {
+		t.Parallel()
+
+		envVariable := "KEYSTORE_TEST_PASSWORD_ENV_PRIORITY"
+		_ = os.Setenv(envVariable, "from env")
+		defer func() {
+			_ = os.Unsetenv(envVariable)
+		}()
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{EnvVariable: envVariable}, "from terminal")
+		password, err := pp.Password()
+		assert.Nil(t, err)
+		assert.Equal(t, "from env", password)
+	})
+	t.Run("unset environment variable should fall back to the terminal", func(t *testing.T) {
+		t.Parallel()
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{EnvVariable: "KEYSTORE_TEST_PASSWORD_NOT_SET"}, "from terminal")
+		password, err := pp.Password()
+		assert.Nil(t, err)
+		assert.Equal(t, "from terminal", password)
+	})
+	t.Run("no source should error", func(t *testing.T) {
+		t.Parallel()
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{}, "")
+		password, err := pp.Password()
+		assert.True(t, errors.Is(err, ErrNoPasswordSource))
+		assert.Empty(t, password)
+	})
+	t.Run("empty password should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "password")
+		_ = ioutil.WriteFile(path, []byte("\n"), 0600)
+
+		pp := createTestPasswordProvider(ArgsPasswordProvider{FilePath: path}, "")
+		password, err := pp.Password()
+		assert.Equal(t, ErrEmptyPassword, err)
+		assert.Empty(t, password)
+	})
+	t.Run("password should be resolved only once", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		pp := NewPasswordProvider(ArgsPasswordProvider{})
+		pp.readFromTerminal = func(promptMessage string) (string, error) {
+			numCalls++
+			return "from terminal", nil
+		}
+
+		_, _ = pp.Password()
+		password, err := pp.Password()
+		assert.Nil(t, err)
+		assert.Equal(t, "from terminal", password)
+		assert.Equal(t, 1, numCalls)
+	})
+}
@@ -0,0 +1,63 @@
+package keysManagement
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsLocalSigningHandler represents the argument for the local signing handler
+type ArgsLocalSigningHandler struct {
+	ManagedPeersHolder   common.ManagedPeersHolder
+	SingleSigner         crypto.SingleSigner
+	PeerSignatureHandler crypto.PeerSignatureHandler
+}
+
+type localSigningHandler struct {
+	managedPeersHolder   common.ManagedPeersHolder
+	singleSigner         crypto.SingleSigner
+	peerSignatureHandler crypto.PeerSignatureHandler
+}
+
+// NewLocalSigningHandler creates a signing handler that uses the BLS keys loaded in the node's memory
+func NewLocalSigningHandler(args ArgsLocalSigningHandler) (*localSigningHandler, error) {
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.PeerSignatureHandler) {
+		return nil, ErrNilPeerSignatureHandler
+	}
+
+	return &localSigningHandler{
+		managedPeersHolder:   args.ManagedPeersHolder,
+		singleSigner:         args.SingleSigner,
+		peerSignatureHandler: args.PeerSignatureHandler,
+	}, nil
+}
+
+// Sign signs the message of the request with the private key of the requested public key. The peer signatures are
+// issued through the peer signature handler so they can be reused
+func (handler *localSigningHandler) Sign(request *common.SigningRequest) ([]byte, error) {
+	if request == nil {
+		return nil, ErrNilSigningRequest
+	}
+
+	privateKey, err := handler.managedPeersHolder.GetPrivateKey(request.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Kind == common.PeerSignatureSigning {
+		return handler.peerSignatureHandler.GetPeerSignature(privateKey, request.Message)
+	}
+
+	return handler.singleSigner.Sign(privateKey, request.Message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *localSigningHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
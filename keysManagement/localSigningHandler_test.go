@@ -0,0 +1,149 @@
+package keysManagement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsLocalSigningHandler() ArgsLocalSigningHandler {
+	return ArgsLocalSigningHandler{
+		ManagedPeersHolder:   &cryptoMocks.ManagedPeersHolderStub{},
+		SingleSigner:         &cryptoMocks.SingleSignerStub{},
+		PeerSignatureHandler: &cryptoMocks.PeerSignatureHandlerStub{},
+	}
+}
+
+func TestNewLocalSigningHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.ManagedPeersHolder = nil
+		handler, err := NewLocalSigningHandler(args)
+		assert.Equal(t, ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.SingleSigner = nil
+		handler, err := NewLocalSigningHandler(args)
+		assert.Equal(t, ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("nil peer signature handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.PeerSignatureHandler = nil
+		handler, err := NewLocalSigningHandler(args)
+		assert.Equal(t, ErrNilPeerSignatureHandler, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewLocalSigningHandler(createMockArgsLocalSigningHandler())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(handler))
+	})
+}
+
+func TestLocalSigningHandler_Sign(t *testing.T) {
+	t.Parallel()
+
+	pkBytes := []byte("pk")
+	message := []byte("message")
+	sk := &cryptoMocks.PrivateKeyStub{}
+
+	t.Run("nil request should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewLocalSigningHandler(createMockArgsLocalSigningHandler())
+		sig, err := handler.Sign(nil)
+		assert.Equal(t, ErrNilSigningRequest, err)
+		assert.Nil(t, sig)
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsLocalSigningHandler()
+		args.ManagedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+			GetPrivateKeyCalled: func(pk []byte) (crypto.PrivateKey, error) {
+				return nil, expectedErr
+			},
+		}
+		handler, _ := NewLocalSigningHandler(args)
+		sig, err := handler.Sign(&common.SigningRequest{PublicKey: pkBytes, Message: message})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, sig)
+	})
+	t.Run("peer signature should use the peer signature handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.ManagedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+			GetPrivateKeyCalled: func(pk []byte) (crypto.PrivateKey, error) {
+				assert.Equal(t, pkBytes, pk)
+				return sk, nil
+			},
+		}
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				assert.Fail(t, "should have not called the single signer")
+				return nil, nil
+			},
+		}
+		args.PeerSignatureHandler = &cryptoMocks.PeerSignatureHandlerStub{
+			GetPeerSignatureCalled: func(key crypto.PrivateKey, pid []byte) ([]byte, error) {
+				assert.True(t, key == sk)
+				assert.Equal(t, message, pid)
+				return []byte("peer signature"), nil
+			},
+		}
+		handler, _ := NewLocalSigningHandler(args)
+		sig, err := handler.Sign(&common.SigningRequest{
+			PublicKey: pkBytes,
+			Message:   message,
+			Kind:      common.PeerSignatureSigning,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("peer signature"), sig)
+	})
+	t.Run("other kinds should use the single signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.ManagedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+			GetPrivateKeyCalled: func(pk []byte) (crypto.PrivateKey, error) {
+				return sk, nil
+			},
+		}
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				assert.True(t, private == sk)
+				assert.Equal(t, message, msg)
+				return []byte("signature"), nil
+			},
+		}
+		handler, _ := NewLocalSigningHandler(args)
+		sig, err := handler.Sign(&common.SigningRequest{
+			PublicKey: pkBytes,
+			Message:   message,
+			Kind:      common.SignatureShareSigning,
+			Round:     10,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("signature"), sig)
+	})
+}
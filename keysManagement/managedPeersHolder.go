@@ -0,0 +1,269 @@
+package keysManagement
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// ArgsManagedPeersHolder represents the argument for the managed peers holder
+type ArgsManagedPeersHolder struct {
+	KeyGenerator             crypto.KeyGenerator
+	PrivateKey               crypto.PrivateKey
+	ValidatorPubKeyConverter core.PubkeyConverter
+}
+
+type peerInfo struct {
+	privateKey             crypto.PrivateKey
+	pkBytes                []byte
+	numRoundsInConsensus   uint64
+	numRoundsAsLeader      uint64
+	numProposedBlocks      uint64
+	numSignatures          uint64
+	lastRoundInConsensus   int64
+	lastProposedRound      int64
+	lastSignedRound        int64
+	numHeartbeatsSent      uint64
+	lastHeartbeatTimestamp int64
+}
+
+type managedPeersHolder struct {
+	mut                      sync.RWMutex
+	keyGenerator             crypto.KeyGenerator
+	validatorPubKeyConverter core.PubkeyConverter
+	selfPkBytes              []byte
+	data                     map[string]*peerInfo
+	getTimeHandler           func() time.Time
+}
+
+// NewManagedPeersHolder creates a component able to hold all the BLS keys managed by the current node process. The
+// node's own key is always managed
+func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.PrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	holder := &managedPeersHolder{
+		keyGenerator:             args.KeyGenerator,
+		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
+		data:                     make(map[string]*peerInfo),
+		getTimeHandler:           time.Now,
+	}
+
+	pkBytes, err := holder.addPrivateKey(args.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	holder.selfPkBytes = pkBytes
+
+	return holder, nil
+}
+
+// AddManagedPeer adds the private key to the managed keys. Errors if the key is invalid or already managed
+func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the private key", err)
+	}
+
+	pkBytes, err := holder.addPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("added new managed key", "pk", holder.validatorPubKeyConverter.Encode(pkBytes))
+
+	return nil
+}
+
+// AddManagedKey adds an already decoded key to the managed keys, for example a key hosted by a remote signer that only
+// exposes its public part. Errors if the key is already managed
+func (holder *managedPeersHolder) AddManagedKey(privateKey crypto.PrivateKey) error {
+	if check.IfNil(privateKey) {
+		return ErrNilPrivateKey
+	}
+
+	pkBytes, err := holder.addPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("added new managed key", "pk", holder.validatorPubKeyConverter.Encode(pkBytes))
+
+	return nil
+}
+
+func (holder *managedPeersHolder) addPrivateKey(privateKey crypto.PrivateKey) ([]byte, error) {
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	_, found := holder.data[string(pkBytes)]
+	if found {
+		return nil, fmt.Errorf("%w, public key %s", ErrDuplicatedKey, holder.validatorPubKeyConverter.Encode(pkBytes))
+	}
+
+	holder.data[string(pkBytes)] = &peerInfo{
+		privateKey: privateKey,
+		pkBytes:    pkBytes,
+	}
+
+	return pkBytes, nil
+}
+
+// GetPrivateKey returns the private key of the provided public key, if it is managed by the current node
+func (holder *managedPeersHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return nil, fmt.Errorf("%w, public key %s", ErrMissingPublicKeyDefinition, holder.validatorPubKeyConverter.Encode(pkBytes))
+	}
+
+	return pInfo.privateKey, nil
+}
+
+// GetManagedKeys returns the public keys managed by the current node, sorted, with the node's own key first
+func (holder *managedPeersHolder) GetManagedKeys() [][]byte {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	keys := make([][]byte, 0, len(holder.data))
+	for _, pInfo := range holder.data {
+		keys = append(keys, pInfo.pkBytes)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		isSelfI := bytes.Equal(keys[i], holder.selfPkBytes)
+		isSelfJ := bytes.Equal(keys[j], holder.selfPkBytes)
+		if isSelfI != isSelfJ {
+			return isSelfI
+		}
+
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	return keys
+}
+
+// IsKeyManagedByCurrentNode returns true if the provided public key is managed by the current node
+func (holder *managedPeersHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	_, found := holder.data[string(pkBytes)]
+
+	return found
+}
+
+// IsMultiKeyMode returns true if the current node manages other keys beside its own key
+func (holder *managedPeersHolder) IsMultiKeyMode() bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	return len(holder.data) > 1
+}
+
+// RecordConsensusRound records that the provided key was part of the consensus group in the provided round
+func (holder *managedPeersHolder) RecordConsensusRound(pkBytes []byte, round int64, isLeader bool) {
+	holder.updatePeerInfo(pkBytes, func(pInfo *peerInfo) {
+		pInfo.numRoundsInConsensus++
+		pInfo.lastRoundInConsensus = round
+		if isLeader {
+			pInfo.numRoundsAsLeader++
+		}
+	})
+}
+
+// RecordProposedBlock records that the provided key proposed a block in the provided round
+func (holder *managedPeersHolder) RecordProposedBlock(pkBytes []byte, round int64) {
+	holder.updatePeerInfo(pkBytes, func(pInfo *peerInfo) {
+		pInfo.numProposedBlocks++
+		pInfo.lastProposedRound = round
+	})
+}
+
+// RecordSignature records that the provided key signed a block in the provided round
+func (holder *managedPeersHolder) RecordSignature(pkBytes []byte, round int64) {
+	holder.updatePeerInfo(pkBytes, func(pInfo *peerInfo) {
+		pInfo.numSignatures++
+		pInfo.lastSignedRound = round
+	})
+}
+
+// RecordHeartbeat records that a heartbeat message was sent for the provided key
+func (holder *managedPeersHolder) RecordHeartbeat(pkBytes []byte) {
+	holder.updatePeerInfo(pkBytes, func(pInfo *peerInfo) {
+		pInfo.numHeartbeatsSent++
+		pInfo.lastHeartbeatTimestamp = holder.getTimeHandler().Unix()
+	})
+}
+
+func (holder *managedPeersHolder) updatePeerInfo(pkBytes []byte, handler func(pInfo *peerInfo)) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return
+	}
+
+	handler(pInfo)
+}
+
+// GetManagedKeysStatus returns the activity of all the managed keys, in the same order as GetManagedKeys
+func (holder *managedPeersHolder) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	keys := holder.GetManagedKeys()
+
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	statuses := make([]*common.ManagedKeyStatus, 0, len(keys))
+	for _, pkBytes := range keys {
+		pInfo, found := holder.data[string(pkBytes)]
+		if !found {
+			continue
+		}
+
+		statuses = append(statuses, &common.ManagedKeyStatus{
+			PublicKey:              holder.validatorPubKeyConverter.Encode(pkBytes),
+			NumRoundsInConsensus:   pInfo.numRoundsInConsensus,
+			NumRoundsAsLeader:      pInfo.numRoundsAsLeader,
+			NumProposedBlocks:      pInfo.numProposedBlocks,
+			NumSignatures:          pInfo.numSignatures,
+			LastRoundInConsensus:   pInfo.lastRoundInConsensus,
+			LastProposedRound:      pInfo.lastProposedRound,
+			LastSignedRound:        pInfo.lastSignedRound,
+			NumHeartbeatsSent:      pInfo.numHeartbeatsSent,
+			LastHeartbeatTimestamp: pInfo.lastHeartbeatTimestamp,
+		})
+	}
+
+	return statuses
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
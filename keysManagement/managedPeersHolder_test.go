@@ -0,0 +1,244 @@
+package keysManagement
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsManagedPeersHolder() (ArgsManagedPeersHolder, crypto.KeyGenerator) {
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, _ := keyGenerator.GeneratePair()
+
+	return ArgsManagedPeersHolder{
+		KeyGenerator:             keyGenerator,
+		PrivateKey:               sk,
+		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(96),
+	}, keyGenerator
+}
+
+func generateKey(t *testing.T, keyGenerator crypto.KeyGenerator) ([]byte, []byte) {
+	sk, pk := keyGenerator.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	return skBytes, pkBytes
+}
+
+func TestNewManagedPeersHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		args.KeyGenerator = nil
+		holder, err := NewManagedPeersHolder(args)
+		assert.Equal(t, ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		args.PrivateKey = nil
+		holder, err := NewManagedPeersHolder(args)
+		assert.Equal(t, ErrNilPrivateKey, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("nil public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		args.ValidatorPubKeyConverter = nil
+		holder, err := NewManagedPeersHolder(args)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("should work and manage the own key", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		holder, err := NewManagedPeersHolder(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(holder))
+
+		selfPkBytes, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+		assert.True(t, holder.IsKeyManagedByCurrentNode(selfPkBytes))
+		assert.False(t, holder.IsMultiKeyMode())
+		assert.Equal(t, [][]byte{selfPkBytes}, holder.GetManagedKeys())
+	})
+}
+
+func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		err := holder.AddManagedPeer([]byte("invalid"))
+		assert.NotNil(t, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, keyGenerator := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		skBytes, _ := generateKey(t, keyGenerator)
+
+		err := holder.AddManagedPeer(skBytes)
+		assert.Nil(t, err)
+		err = holder.AddManagedPeer(skBytes)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+
+		selfSkBytes, _ := args.PrivateKey.ToByteArray()
+		err = holder.AddManagedPeer(selfSkBytes)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, keyGenerator := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		skBytes, pkBytes := generateKey(t, keyGenerator)
+
+		err := holder.AddManagedPeer(skBytes)
+		assert.Nil(t, err)
+		assert.True(t, holder.IsMultiKeyMode())
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+		sk, err := holder.GetPrivateKey(pkBytes)
+		assert.Nil(t, err)
+		recoveredSkBytes, _ := sk.ToByteArray()
+		assert.Equal(t, skBytes, recoveredSkBytes)
+	})
+}
+
+func TestManagedPeersHolder_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		err := holder.AddManagedKey(nil)
+		assert.Equal(t, ErrNilPrivateKey, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		err := holder.AddManagedKey(args.PrivateKey)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, keyGenerator := createMockArgsManagedPeersHolder()
+		holder, _ := NewManagedPeersHolder(args)
+		sk, pk := keyGenerator.GeneratePair()
+		pkBytes, _ := pk.ToByteArray()
+
+		err := holder.AddManagedKey(sk)
+		assert.Nil(t, err)
+		assert.True(t, holder.IsMultiKeyMode())
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+		recoveredSk, err := holder.GetPrivateKey(pkBytes)
+		assert.Nil(t, err)
+		assert.True(t, sk == recoveredSk)
+	})
+}
+
+func TestManagedPeersHolder_GetPrivateKeyMissingKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	args, keyGenerator := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+	_, pkBytes := generateKey(t, keyGenerator)
+
+	sk, err := holder.GetPrivateKey(pkBytes)
+	assert.True(t, errors.Is(err, ErrMissingPublicKeyDefinition))
+	assert.Nil(t, sk)
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+}
+
+func TestManagedPeersHolder_GetManagedKeysShouldReturnTheOwnKeyFirst(t *testing.T) {
+	t.Parallel()
+
+	args, keyGenerator := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+	selfPkBytes, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+
+	numKeys := 5
+	for i := 0; i < numKeys; i++ {
+		skBytes, _ := generateKey(t, keyGenerator)
+		require.Nil(t, holder.AddManagedPeer(skBytes))
+	}
+
+	keys := holder.GetManagedKeys()
+	require.Equal(t, numKeys+1, len(keys))
+	assert.Equal(t, selfPkBytes, keys[0])
+	for i := 2; i < len(keys); i++ {
+		assert.True(t, bytes.Compare(keys[i-1], keys[i]) < 0)
+	}
+}
+
+func TestManagedPeersHolder_GetManagedKeysStatus(t *testing.T) {
+	t.Parallel()
+
+	args, keyGenerator := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+	holder.getTimeHandler = func() time.Time {
+		return time.Unix(1000, 0)
+	}
+	selfPkBytes, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+	skBytes, pkBytes := generateKey(t, keyGenerator)
+	require.Nil(t, holder.AddManagedPeer(skBytes))
+	_, unmanagedPkBytes := generateKey(t, keyGenerator)
+
+	holder.RecordConsensusRound(pkBytes, 10, true)
+	holder.RecordProposedBlock(pkBytes, 10)
+	holder.RecordSignature(pkBytes, 10)
+	holder.RecordConsensusRound(pkBytes, 12, false)
+	holder.RecordSignature(pkBytes, 12)
+	holder.RecordHeartbeat(pkBytes)
+	holder.RecordConsensusRound(selfPkBytes, 11, false)
+	holder.RecordConsensusRound(unmanagedPkBytes, 11, true)
+
+	converter := args.ValidatorPubKeyConverter
+	statuses := holder.GetManagedKeysStatus()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, converter.Encode(selfPkBytes), statuses[0].PublicKey)
+	assert.Equal(t, uint64(1), statuses[0].NumRoundsInConsensus)
+	assert.Equal(t, int64(11), statuses[0].LastRoundInConsensus)
+	assert.Equal(t, uint64(0), statuses[0].NumRoundsAsLeader)
+
+	assert.Equal(t, converter.Encode(pkBytes), statuses[1].PublicKey)
+	assert.Equal(t, uint64(2), statuses[1].NumRoundsInConsensus)
+	assert.Equal(t, uint64(1), statuses[1].NumRoundsAsLeader)
+	assert.Equal(t, uint64(1), statuses[1].NumProposedBlocks)
+	assert.Equal(t, uint64(2), statuses[1].NumSignatures)
+	assert.Equal(t, int64(12), statuses[1].LastRoundInConsensus)
+	assert.Equal(t, int64(10), statuses[1].LastProposedRound)
+	assert.Equal(t, int64(12), statuses[1].LastSignedRound)
+	assert.Equal(t, uint64(1), statuses[1].NumHeartbeatsSent)
+	assert.Equal(t, int64(1000), statuses[1].LastHeartbeatTimestamp)
+}
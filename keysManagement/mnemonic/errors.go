@@ -0,0 +1,9 @@
+package mnemonic
+
+import "errors"
+
+// ErrInvalidMnemonic signals that the provided mnemonic is not a valid BIP39 mnemonic
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ErrInvalidSeed signals that the provided seed has an invalid length
+var ErrInvalidSeed = errors.New("invalid seed")
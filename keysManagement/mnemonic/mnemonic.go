@@ -0,0 +1,93 @@
+package mnemonic
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	entropyBitSize = 256
+	seedLen        = 64
+
+	// the ed25519 curve only allows hardened derivation, see SLIP-0010
+	hardenedOffset = uint32(0x80000000)
+	masterKeyLabel = "ed25519 seed"
+
+	purpose  = uint32(44)
+	coinType = uint32(508)
+	account  = uint32(0)
+	change   = uint32(0)
+)
+
+// Generate returns a new 24 words BIP39 mnemonic created from fresh entropy
+func Generate() (string, error) {
+	entropy, err := bip39.NewEntropy(entropyBitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// Normalize trims and collapses the whitespace characters of the mnemonic, as usually found in backup files
+func Normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// NewSeed validates the mnemonic and returns the BIP39 seed obtained from the mnemonic and the optional passphrase
+func NewSeed(mnemonic string, passphrase string) ([]byte, error) {
+	mnemonic = Normalize(mnemonic)
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// DeriveWalletKey returns the ed25519 private key found at the m/44'/508'/0'/0'/addressIndex' path, the derivation
+// path used by the wallets in the ecosystem. The key is returned in the 64 bytes form, seed followed by public key
+func DeriveWalletKey(seed []byte, addressIndex uint32) ([]byte, error) {
+	if len(seed) != seedLen {
+		return nil, fmt.Errorf("%w, expected length %d, got %d", ErrInvalidSeed, seedLen, len(seed))
+	}
+
+	path := []uint32{purpose, coinType, account, change, addressIndex}
+	keySeed, chainCode := masterKey(seed)
+	for _, index := range path {
+		keySeed, chainCode = hardenedChild(keySeed, chainCode, index)
+	}
+
+	return ed25519.NewKeyFromSeed(keySeed), nil
+}
+
+func masterKey(seed []byte) ([]byte, []byte) {
+	digest := hmacSHA512([]byte(masterKeyLabel), seed)
+
+	return digest[:32], digest[32:]
+}
+
+func hardenedChild(keySeed []byte, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 0, 1+len(keySeed)+4)
+	data = append(data, 0)
+	data = append(data, keySeed...)
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index|hardenedOffset)
+	data = append(data, indexBytes...)
+
+	digest := hmacSHA512(chainCode, data)
+
+	return digest[:32], digest[32:]
+}
+
+func hmacSHA512(key []byte, data []byte) []byte {
+	h := hmac.New(sha512.New, key)
+	_, _ = h.Write(data)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,95 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the mnemonic of the test wallets used across the ecosystem's tooling
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather " +
+	"prepare woman film husband gravity behind test tiger improve"
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	first, err := Generate()
+	require.Nil(t, err)
+	second, err := Generate()
+	require.Nil(t, err)
+
+	assert.Equal(t, 24, len(strings.Fields(first)))
+	assert.NotEqual(t, first, second)
+
+	_, err = NewSeed(first, "")
+	assert.Nil(t, err)
+}
+
+func TestNewSeed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidChecksum := strings.Replace(testMnemonic, "improve", "abandon", 1)
+		seed, err := NewSeed(invalidChecksum, "")
+		assert.Equal(t, ErrInvalidMnemonic, err)
+		assert.Nil(t, seed)
+
+		seed, err = NewSeed("not a mnemonic", "")
+		assert.Equal(t, ErrInvalidMnemonic, err)
+		assert.Nil(t, seed)
+	})
+	t.Run("extra whitespaces should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		seed, err := NewSeed(testMnemonic, "")
+		require.Nil(t, err)
+
+		spaced := "  " + strings.Replace(testMnemonic, " ", " \n\t", -1) + "\n"
+		spacedSeed, err := NewSeed(spaced, "")
+		assert.Nil(t, err)
+		assert.Equal(t, seed, spacedSeed)
+	})
+	t.Run("passphrase should change the seed", func(t *testing.T) {
+		t.Parallel()
+
+		seed, _ := NewSeed(testMnemonic, "")
+		seedWithPassphrase, err := NewSeed(testMnemonic, "passphrase")
+		assert.Nil(t, err)
+		assert.Equal(t, seedLen, len(seedWithPassphrase))
+		assert.NotEqual(t, seed, seedWithPassphrase)
+	})
+}
+
+func TestDeriveWalletKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid seed should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := DeriveWalletKey(make([]byte, 32), 0)
+		assert.True(t, errors.Is(err, ErrInvalidSeed))
+		assert.Nil(t, key)
+	})
+	t.Run("should derive the ecosystem test wallets", func(t *testing.T) {
+		t.Parallel()
+
+		seed, err := NewSeed(testMnemonic, "")
+		require.Nil(t, err)
+
+		alice, err := DeriveWalletKey(seed, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 64, len(alice))
+		assert.Equal(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(alice[:32]))
+		assert.Equal(t, "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1", hex.EncodeToString(alice[32:]))
+
+		bob, err := DeriveWalletKey(seed, 1)
+		require.Nil(t, err)
+		assert.NotEqual(t, alice, bob)
+	})
+}
@@ -0,0 +1,260 @@
+package consensusGroupsAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// maxRoundsLookBack is the maximum number of rounds searched backwards for the last proposed block when the
+// requested round has no block
+const maxRoundsLookBack = 100
+
+// ArgsConsensusGroupsProcessor holds the arguments needed to create a consensus groups processor
+type ArgsConsensusGroupsProcessor struct {
+	SelfShardID                uint32
+	Store                      dataRetriever.StorageService
+	Marshalizer                marshal.Marshalizer
+	Uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	HistoricalNodesCoordinator HistoricalNodesCoordinator
+	ValidatorPubKeyConverter   core.PubkeyConverter
+}
+
+type roundHeader struct {
+	hash   []byte
+	header data.HeaderHandler
+}
+
+type consensusGroupsProcessor struct {
+	selfShardID                uint32
+	store                      dataRetriever.StorageService
+	marshalizer                marshal.Marshalizer
+	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	historicalNodesCoordinator HistoricalNodesCoordinator
+	validatorPubKeyConverter   core.PubkeyConverter
+}
+
+// NewConsensusGroupsProcessor creates a component able to return the nodes lists of past epochs and the consensus
+// groups of the past rounds of the node's own shard
+func NewConsensusGroupsProcessor(args ArgsConsensusGroupsProcessor) (*consensusGroupsProcessor, error) {
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.HistoricalNodesCoordinator) {
+		return nil, ErrNilHistoricalNodesCoordinator
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+
+	return &consensusGroupsProcessor{
+		selfShardID:                args.SelfShardID,
+		store:                      args.Store,
+		marshalizer:                args.Marshalizer,
+		uint64ByteSliceConverter:   args.Uint64ByteSliceConverter,
+		historicalNodesCoordinator: args.HistoricalNodesCoordinator,
+		validatorPubKeyConverter:   args.ValidatorPubKeyConverter,
+	}, nil
+}
+
+// GetConsensusGroups returns the nodes lists of the requested epoch. When a round is provided, the consensus group
+// of that round is also returned and the epoch is the one in which the round was produced. For a round without a
+// block, the consensus group is computed from the last block proposed before it
+func (cgp *consensusGroupsProcessor) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	if request == nil || (request.Epoch == nil && request.Round == nil) {
+		return nil, ErrMissingEpochOrRound
+	}
+
+	var consensusGroup *common.ConsensusGroupAPIResponse
+	var epoch uint32
+	var err error
+	if request.Round != nil {
+		consensusGroup, err = cgp.getConsensusGroup(*request.Round, request.ShardID)
+		if err != nil {
+			return nil, err
+		}
+
+		epoch = consensusGroup.Epoch
+		if request.Epoch != nil && *request.Epoch != epoch {
+			return nil, fmt.Errorf("%w, round %d is in epoch %d", ErrEpochMismatch, *request.Round, epoch)
+		}
+	} else {
+		epoch = *request.Epoch
+	}
+
+	epochValidators, err := cgp.historicalNodesCoordinator.GetEpochValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.ConsensusGroupsAPIResponse{
+		Epoch:          epoch,
+		Eligible:       cgp.encodePublicKeysMap(epochValidators.Eligible),
+		Waiting:        cgp.encodePublicKeysMap(epochValidators.Waiting),
+		Leaving:        cgp.encodePublicKeysMap(epochValidators.Leaving),
+		ConsensusGroup: consensusGroup,
+	}
+	if request.ShardID != nil {
+		return filterResponseByShard(response, *request.ShardID)
+	}
+
+	return response, nil
+}
+
+func (cgp *consensusGroupsProcessor) getConsensusGroup(round uint64, shardID *uint32) (*common.ConsensusGroupAPIResponse, error) {
+	if shardID != nil && *shardID != cgp.selfShardID {
+		return nil, ErrRoundNotAvailableForShard
+	}
+
+	header, blockProposed, err := cgp.searchHeaderForRound(round)
+	if err != nil {
+		return nil, err
+	}
+
+	// the leader of a round is selected with the randomness of the previous block, while a start of epoch block is
+	// still proposed by the consensus group of the previous epoch
+	randomness := header.header.GetRandSeed()
+	epoch := header.header.GetEpoch()
+	if blockProposed {
+		randomness = header.header.GetPrevRandSeed()
+		if header.header.IsStartOfEpochBlock() && epoch > 0 {
+			epoch--
+		}
+	}
+
+	validators, err := cgp.historicalNodesCoordinator.ComputeConsensusGroup(randomness, round, cgp.selfShardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("%w for round %d", process.ErrEmptyConsensusGroup, round)
+	}
+
+	consensusGroup := make([]string, 0, len(validators))
+	for _, v := range validators {
+		consensusGroup = append(consensusGroup, cgp.validatorPubKeyConverter.Encode(v.PubKey()))
+	}
+
+	response := &common.ConsensusGroupAPIResponse{
+		Round:          round,
+		ShardID:        cgp.selfShardID,
+		Epoch:          epoch,
+		Randomness:     hex.EncodeToString(randomness),
+		BlockProposed:  blockProposed,
+		Leader:         consensusGroup[0],
+		ConsensusGroup: consensusGroup,
+	}
+	if blockProposed {
+		response.BlockHash = hex.EncodeToString(header.hash)
+	}
+
+	return response, nil
+}
+
+// searchHeaderForRound returns the header proposed in the provided round or, if the round has no block, the last
+// header proposed before it
+func (cgp *consensusGroupsProcessor) searchHeaderForRound(round uint64) (*roundHeader, bool, error) {
+	header, err := cgp.getHeaderByRound(round)
+	if err == nil {
+		return header, true, nil
+	}
+
+	for i := uint64(1); i <= maxRoundsLookBack && i <= round; i++ {
+		header, err = cgp.getHeaderByRound(round - i)
+		if err == nil {
+			return header, false, nil
+		}
+	}
+
+	return nil, false, fmt.Errorf("%w, round %d", ErrRoundNotFound, round)
+}
+
+func (cgp *consensusGroupsProcessor) getHeaderByRound(round uint64) (*roundHeader, error) {
+	roundToByteSlice := cgp.uint64ByteSliceConverter.ToByteSlice(round)
+	headerHash, err := cgp.store.Get(dataRetriever.RoundHdrHashDataUnit, roundToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	if cgp.selfShardID == core.MetachainShardId {
+		headerBytes, errGet := cgp.store.Get(dataRetriever.MetaBlockUnit, headerHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		metaBlock := &block.MetaBlock{}
+		err = cgp.marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &roundHeader{hash: headerHash, header: metaBlock}, nil
+	}
+
+	headerBytes, err := cgp.store.Get(dataRetriever.BlockHeaderUnit, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	shardHeader, err := process.CreateShardHeader(cgp.marshalizer, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundHeader{hash: headerHash, header: shardHeader}, nil
+}
+
+func (cgp *consensusGroupsProcessor) encodePublicKeysMap(publicKeys map[uint32][][]byte) map[uint32][]string {
+	encodedMap := make(map[uint32][]string, len(publicKeys))
+	for shardID, shardPublicKeys := range publicKeys {
+		encodedKeys := make([]string, 0, len(shardPublicKeys))
+		for _, pk := range shardPublicKeys {
+			encodedKeys = append(encodedKeys, cgp.validatorPubKeyConverter.Encode(pk))
+		}
+		encodedMap[shardID] = encodedKeys
+	}
+
+	return encodedMap
+}
+
+func filterResponseByShard(response *common.ConsensusGroupsAPIResponse, shardID uint32) (*common.ConsensusGroupsAPIResponse, error) {
+	eligible, ok := response.Eligible[shardID]
+	if !ok {
+		return nil, fmt.Errorf("%w, shard %d, epoch %d", ErrShardNotFound, shardID, response.Epoch)
+	}
+
+	response.Eligible = map[uint32][]string{shardID: eligible}
+	response.Waiting = map[uint32][]string{shardID: getShardPublicKeys(response.Waiting, shardID)}
+	response.Leaving = map[uint32][]string{shardID: getShardPublicKeys(response.Leaving, shardID)}
+
+	return response, nil
+}
+
+func getShardPublicKeys(publicKeys map[uint32][]string, shardID uint32) []string {
+	shardPublicKeys, ok := publicKeys[shardID]
+	if !ok {
+		return make([]string, 0)
+	}
+
+	return shardPublicKeys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cgp *consensusGroupsProcessor) IsInterfaceNil() bool {
+	return cgp == nil
+}
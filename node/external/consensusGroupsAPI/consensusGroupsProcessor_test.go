@@ -0,0 +1,384 @@
+package consensusGroupsAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+type blocksStorage struct {
+	units map[dataRetriever.UnitType]map[string][]byte
+}
+
+func newBlocksStorage() *blocksStorage {
+	return &blocksStorage{
+		units: make(map[dataRetriever.UnitType]map[string][]byte),
+	}
+}
+
+func (bs *blocksStorage) put(unit dataRetriever.UnitType, key []byte, value []byte) {
+	if bs.units[unit] == nil {
+		bs.units[unit] = make(map[string][]byte)
+	}
+	bs.units[unit][string(key)] = value
+}
+
+func (bs *blocksStorage) get(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	value, ok := bs.units[unit][string(key)]
+	if !ok {
+		return nil, expectedErr
+	}
+
+	return value, nil
+}
+
+func (bs *blocksStorage) saveHeader(t *testing.T, args ArgsConsensusGroupsProcessor, round uint64, hash []byte, header interface{}) {
+	headerBytes, err := args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	unit := dataRetriever.BlockHeaderUnit
+	if args.SelfShardID == core.MetachainShardId {
+		unit = dataRetriever.MetaBlockUnit
+	}
+	bs.put(unit, hash, headerBytes)
+	bs.put(dataRetriever.RoundHdrHashDataUnit, args.Uint64ByteSliceConverter.ToByteSlice(round), hash)
+}
+
+func createMockArgsConsensusGroupsProcessor(storage *blocksStorage) ArgsConsensusGroupsProcessor {
+	return ArgsConsensusGroupsProcessor{
+		SelfShardID: 0,
+		Store: &mock.ChainStorerMock{
+			GetCalled: storage.get,
+		},
+		Marshalizer:                &mock.MarshalizerFake{},
+		Uint64ByteSliceConverter:   mock.NewNonceHashConverterMock(),
+		HistoricalNodesCoordinator: &mock.HistoricalNodesCoordinatorStub{},
+		ValidatorPubKeyConverter:   mock.NewPubkeyConverterMock(32),
+	}
+}
+
+func createValidators(pubKeys ...string) []nodesCoordinator.Validator {
+	validators := make([]nodesCoordinator.Validator, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		v, _ := nodesCoordinator.NewValidator([]byte(pk), 1, 0)
+		validators = append(validators, v)
+	}
+
+	return validators
+}
+
+func createEpochValidatorsPublicKeys() *nodesCoordinator.EpochValidatorsPublicKeys {
+	return &nodesCoordinator.EpochValidatorsPublicKeys{
+		Eligible: map[uint32][][]byte{
+			0:                     {[]byte("eligible0")},
+			core.MetachainShardId: {[]byte("eligibleMeta")},
+		},
+		Waiting: map[uint32][][]byte{
+			0: {[]byte("waiting0")},
+		},
+		Leaving: map[uint32][][]byte{},
+	}
+}
+
+func hexStrings(values ...string) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString([]byte(value)))
+	}
+
+	return encoded
+}
+
+func TestNewConsensusGroupsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.Store = nil
+		cgp, err := NewConsensusGroupsProcessor(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(cgp))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.Marshalizer = nil
+		cgp, err := NewConsensusGroupsProcessor(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(cgp))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.Uint64ByteSliceConverter = nil
+		cgp, err := NewConsensusGroupsProcessor(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(cgp))
+	})
+	t.Run("nil historical nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.HistoricalNodesCoordinator = nil
+		cgp, err := NewConsensusGroupsProcessor(args)
+		assert.Equal(t, ErrNilHistoricalNodesCoordinator, err)
+		assert.True(t, check.IfNil(cgp))
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.ValidatorPubKeyConverter = nil
+		cgp, err := NewConsensusGroupsProcessor(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(cgp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cgp, err := NewConsensusGroupsProcessor(createMockArgsConsensusGroupsProcessor(newBlocksStorage()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(cgp))
+	})
+}
+
+func TestConsensusGroupsProcessor_GetConsensusGroupsByEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch and round should error", func(t *testing.T) {
+		t.Parallel()
+
+		cgp, _ := NewConsensusGroupsProcessor(createMockArgsConsensusGroupsProcessor(newBlocksStorage()))
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{})
+		assert.Equal(t, ErrMissingEpochOrRound, err)
+		assert.Nil(t, response)
+
+		response, err = cgp.GetConsensusGroups(nil)
+		assert.Equal(t, ErrMissingEpochOrRound, err)
+		assert.Nil(t, response)
+	})
+	t.Run("nodes coordinator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			GetEpochValidatorsPublicKeysCalled: func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+				return nil, expectedErr
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		epoch := uint32(4)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Epoch: &epoch})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			GetEpochValidatorsPublicKeysCalled: func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+				assert.Equal(t, uint32(4), epoch)
+				return createEpochValidatorsPublicKeys(), nil
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		epoch := uint32(4)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Epoch: &epoch})
+		require.Nil(t, err)
+		expectedResponse := &common.ConsensusGroupsAPIResponse{
+			Epoch: 4,
+			Eligible: map[uint32][]string{
+				0:                     hexStrings("eligible0"),
+				core.MetachainShardId: hexStrings("eligibleMeta"),
+			},
+			Waiting: map[uint32][]string{
+				0: hexStrings("waiting0"),
+			},
+			Leaving: map[uint32][]string{},
+		}
+		assert.Equal(t, expectedResponse, response)
+	})
+	t.Run("filtered by shard should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusGroupsProcessor(newBlocksStorage())
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			GetEpochValidatorsPublicKeysCalled: func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+				return createEpochValidatorsPublicKeys(), nil
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		epoch := uint32(4)
+		shardID := core.MetachainShardId
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Epoch: &epoch, ShardID: &shardID})
+		require.Nil(t, err)
+		assert.Equal(t, map[uint32][]string{core.MetachainShardId: hexStrings("eligibleMeta")}, response.Eligible)
+		assert.Equal(t, map[uint32][]string{core.MetachainShardId: {}}, response.Waiting)
+		assert.Equal(t, map[uint32][]string{core.MetachainShardId: {}}, response.Leaving)
+
+		shardID = 5
+		response, err = cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Epoch: &epoch, ShardID: &shardID})
+		assert.True(t, errors.Is(err, ErrShardNotFound))
+		assert.Nil(t, response)
+	})
+}
+
+func TestConsensusGroupsProcessor_GetConsensusGroupsByRound(t *testing.T) {
+	t.Parallel()
+
+	t.Run("other shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		cgp, _ := NewConsensusGroupsProcessor(createMockArgsConsensusGroupsProcessor(newBlocksStorage()))
+
+		round := uint64(10)
+		shardID := uint32(1)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round, ShardID: &shardID})
+		assert.Equal(t, ErrRoundNotAvailableForShard, err)
+		assert.Nil(t, response)
+	})
+	t.Run("round not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		cgp, _ := NewConsensusGroupsProcessor(createMockArgsConsensusGroupsProcessor(newBlocksStorage()))
+
+		round := uint64(10)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round})
+		assert.True(t, errors.Is(err, ErrRoundNotFound))
+		assert.Nil(t, response)
+	})
+	t.Run("proposed block should use the previous randomness", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newBlocksStorage()
+		args := createMockArgsConsensusGroupsProcessor(storage)
+		storage.saveHeader(t, args, 10, []byte("hash10"), &block.Header{
+			Round:        10,
+			Epoch:        3,
+			RandSeed:     []byte("rand seed"),
+			PrevRandSeed: []byte("prev rand seed"),
+		})
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			ComputeConsensusGroupCalled: func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				assert.Equal(t, []byte("prev rand seed"), randomness)
+				assert.Equal(t, uint64(10), round)
+				assert.Equal(t, uint32(0), shardID)
+				assert.Equal(t, uint32(3), epoch)
+				return createValidators("leader", "validator"), nil
+			},
+			GetEpochValidatorsPublicKeysCalled: func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+				assert.Equal(t, uint32(3), epoch)
+				return createEpochValidatorsPublicKeys(), nil
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		round := uint64(10)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round})
+		require.Nil(t, err)
+		assert.Equal(t, uint32(3), response.Epoch)
+		expectedConsensusGroup := &common.ConsensusGroupAPIResponse{
+			Round:          10,
+			ShardID:        0,
+			Epoch:          3,
+			Randomness:     hex.EncodeToString([]byte("prev rand seed")),
+			BlockProposed:  true,
+			BlockHash:      hex.EncodeToString([]byte("hash10")),
+			Leader:         hex.EncodeToString([]byte("leader")),
+			ConsensusGroup: hexStrings("leader", "validator"),
+		}
+		assert.Equal(t, expectedConsensusGroup, response.ConsensusGroup)
+
+		epoch := uint32(2)
+		response, err = cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round, Epoch: &epoch})
+		assert.True(t, errors.Is(err, ErrEpochMismatch))
+		assert.Nil(t, response)
+	})
+	t.Run("start of epoch block should use the previous epoch", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newBlocksStorage()
+		args := createMockArgsConsensusGroupsProcessor(storage)
+		args.SelfShardID = core.MetachainShardId
+		storage.saveHeader(t, args, 10, []byte("hash10"), &block.MetaBlock{
+			Round:        10,
+			Epoch:        3,
+			PrevRandSeed: []byte("prev rand seed"),
+			EpochStart:   block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		})
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			ComputeConsensusGroupCalled: func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				assert.Equal(t, core.MetachainShardId, shardID)
+				assert.Equal(t, uint32(2), epoch)
+				return createValidators("leader"), nil
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		round := uint64(10)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round})
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), response.Epoch)
+		assert.True(t, response.ConsensusGroup.BlockProposed)
+	})
+	t.Run("round without block should use the last proposed block", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newBlocksStorage()
+		args := createMockArgsConsensusGroupsProcessor(storage)
+		storage.saveHeader(t, args, 7, []byte("hash7"), &block.Header{
+			Round:        7,
+			Epoch:        3,
+			RandSeed:     []byte("rand seed"),
+			PrevRandSeed: []byte("prev rand seed"),
+		})
+		args.HistoricalNodesCoordinator = &mock.HistoricalNodesCoordinatorStub{
+			ComputeConsensusGroupCalled: func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				assert.Equal(t, []byte("rand seed"), randomness)
+				assert.Equal(t, uint64(10), round)
+				return createValidators("leader"), nil
+			},
+		}
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		round := uint64(10)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round})
+		require.Nil(t, err)
+		assert.False(t, response.ConsensusGroup.BlockProposed)
+		assert.Empty(t, response.ConsensusGroup.BlockHash)
+		assert.Equal(t, hex.EncodeToString([]byte("leader")), response.ConsensusGroup.Leader)
+	})
+	t.Run("empty consensus group should error", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newBlocksStorage()
+		args := createMockArgsConsensusGroupsProcessor(storage)
+		storage.saveHeader(t, args, 10, []byte("hash10"), &block.Header{Round: 10, PrevRandSeed: []byte("seed")})
+		cgp, _ := NewConsensusGroupsProcessor(args)
+
+		round := uint64(10)
+		response, err := cgp.GetConsensusGroups(&common.ConsensusGroupsRequest{Round: &round})
+		assert.NotNil(t, err)
+		assert.Nil(t, response)
+	})
+}
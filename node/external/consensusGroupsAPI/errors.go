@@ -0,0 +1,22 @@
+package consensusGroupsAPI
+
+import "errors"
+
+// ErrNilHistoricalNodesCoordinator signals that a nil historical nodes coordinator has been provided
+var ErrNilHistoricalNodesCoordinator = errors.New("nil historical nodes coordinator")
+
+// ErrMissingEpochOrRound signals that neither the epoch nor the round was provided
+var ErrMissingEpochOrRound = errors.New("either the epoch or the round should be provided")
+
+// ErrRoundNotAvailableForShard signals that the consensus group of a round was requested for a shard whose blocks
+// are not stored by this node
+var ErrRoundNotAvailableForShard = errors.New("the consensus groups of past rounds are only available for the node's own shard")
+
+// ErrEpochMismatch signals that the provided epoch does not match the epoch of the provided round
+var ErrEpochMismatch = errors.New("the provided epoch does not match the epoch of the round")
+
+// ErrRoundNotFound signals that no block could be found for the provided round or for any of the rounds before it
+var ErrRoundNotFound = errors.New("no block found for the round or for the rounds preceding it")
+
+// ErrShardNotFound signals that the provided shard does not exist in the requested epoch
+var ErrShardNotFound = errors.New("shard not found in the nodes lists of the epoch")
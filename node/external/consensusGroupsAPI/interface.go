@@ -0,0 +1,12 @@
+package consensusGroupsAPI
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+)
+
+// HistoricalNodesCoordinator defines the nodes coordinator queries that can be answered for past epochs
+type HistoricalNodesCoordinator interface {
+	GetEpochValidatorsPublicKeys(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error)
+	ComputeConsensusGroup(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error)
+	IsInterfaceNil() bool
+}
@@ -20,6 +20,9 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilTokenHoldersHandler signals that a nil token holders handler has been provided
+var ErrNilTokenHoldersHandler = errors.New("nil token holders handler")
+
 // ErrNilVmContainer signals that a nil vm container has been provided
 var ErrNilVmContainer = errors.New("nil vm container")
 
@@ -40,3 +43,21 @@ var ErrNilGenesisNodesSetupHandler = errors.New("nil genesis nodes setup handler
 
 // ErrNilValidatorPubKeyConverter signals that a nil validator pubkey converter has been provided
 var ErrNilValidatorPubKeyConverter = errors.New("nil validator public key converter")
+
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilDelegationSnapshotHandler signals that a nil delegation snapshot handler has been provided
+var ErrNilDelegationSnapshotHandler = errors.New("nil delegation snapshot handler")
+
+// ErrNilRewardsBreakdownHandler signals that a nil rewards breakdown handler has been provided
+var ErrNilRewardsBreakdownHandler = errors.New("nil rewards breakdown handler")
+
+// ErrNilNodesShufflingPreviewer signals that a nil nodes shuffling previewer has been provided
+var ErrNilNodesShufflingPreviewer = errors.New("nil nodes shuffling previewer")
+
+// ErrNilConsensusGroupsHandler signals that a nil consensus groups handler has been provided
+var ErrNilConsensusGroupsHandler = errors.New("nil consensus groups handler")
+
+// ErrNilValidatorPerformanceHandler signals that a nil validator performance handler has been provided
+var ErrNilValidatorPerformanceHandler = errors.New("nil validator performance handler")
@@ -55,6 +55,52 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TokenHoldersHandler defines the behavior of a component able to return the holders of a token
+type TokenHoldersHandler interface {
+	GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and their votes
+type GovernanceHandler interface {
+	GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationSnapshotHandler defines the behavior of a component able to return the decoded snapshot of a delegation contract
+type DelegationSnapshotHandler interface {
+	GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// RewardsBreakdownHandler defines the behavior of a component able to return the saved rewards breakdowns
+type RewardsBreakdownHandler interface {
+	GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// NodesShufflingPreviewer defines the behavior of a component able to preview the nodes shuffling of the next epoch
+type NodesShufflingPreviewer interface {
+	PreviewShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ConsensusGroupsHandler defines the behavior of a component able to return the nodes lists and the consensus groups
+// of past epochs and rounds
+type ConsensusGroupsHandler interface {
+	GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorPerformanceHandler defines the behavior of a component able to build the performance report of a
+// validator in an epoch
+type ValidatorPerformanceHandler interface {
+	GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
@@ -18,32 +18,46 @@ import (
 
 // ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
 type ArgNodeApiResolver struct {
-	SCQueryService           SCQueryService
-	StatusMetricsHandler     StatusMetricsHandler
-	TxCostHandler            TransactionCostHandler
-	TotalStakedValueHandler  TotalStakedValueHandler
-	DirectStakedListHandler  DirectStakedListHandler
-	DelegatedListHandler     DelegatedListHandler
-	APITransactionHandler    APITransactionHandler
-	APIBlockHandler          blockAPI.APIBlockHandler
-	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	ValidatorPubKeyConverter core.PubkeyConverter
+	SCQueryService              SCQueryService
+	StatusMetricsHandler        StatusMetricsHandler
+	TxCostHandler               TransactionCostHandler
+	TotalStakedValueHandler     TotalStakedValueHandler
+	DirectStakedListHandler     DirectStakedListHandler
+	DelegatedListHandler        DelegatedListHandler
+	TokenHoldersHandler         TokenHoldersHandler
+	GovernanceHandler           GovernanceHandler
+	DelegationSnapshotHandler   DelegationSnapshotHandler
+	RewardsBreakdownHandler     RewardsBreakdownHandler
+	NodesShufflingPreviewer     NodesShufflingPreviewer
+	ConsensusGroupsHandler      ConsensusGroupsHandler
+	ValidatorPerformanceHandler ValidatorPerformanceHandler
+	APITransactionHandler       APITransactionHandler
+	APIBlockHandler             blockAPI.APIBlockHandler
+	APIInternalBlockHandler     blockAPI.APIInternalBlockHandler
+	GenesisNodesSetupHandler    sharding.GenesisNodesSetupHandler
+	ValidatorPubKeyConverter    core.PubkeyConverter
 }
 
 // nodeApiResolver can resolve API requests
 type nodeApiResolver struct {
-	scQueryService           SCQueryService
-	statusMetricsHandler     StatusMetricsHandler
-	txCostHandler            TransactionCostHandler
-	totalStakedValueHandler  TotalStakedValueHandler
-	directStakedListHandler  DirectStakedListHandler
-	delegatedListHandler     DelegatedListHandler
-	apiTransactionHandler    APITransactionHandler
-	apiBlockHandler          blockAPI.APIBlockHandler
-	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	validatorPubKeyConverter core.PubkeyConverter
+	scQueryService              SCQueryService
+	statusMetricsHandler        StatusMetricsHandler
+	txCostHandler               TransactionCostHandler
+	totalStakedValueHandler     TotalStakedValueHandler
+	directStakedListHandler     DirectStakedListHandler
+	delegatedListHandler        DelegatedListHandler
+	tokenHoldersHandler         TokenHoldersHandler
+	governanceHandler           GovernanceHandler
+	delegationSnapshotHandler   DelegationSnapshotHandler
+	rewardsBreakdownHandler     RewardsBreakdownHandler
+	nodesShufflingPreviewer     NodesShufflingPreviewer
+	consensusGroupsHandler      ConsensusGroupsHandler
+	validatorPerformanceHandler ValidatorPerformanceHandler
+	apiTransactionHandler       APITransactionHandler
+	apiBlockHandler             blockAPI.APIBlockHandler
+	apiInternalBlockHandler     blockAPI.APIInternalBlockHandler
+	genesisNodesSetupHandler    sharding.GenesisNodesSetupHandler
+	validatorPubKeyConverter    core.PubkeyConverter
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -66,6 +80,27 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.TokenHoldersHandler) {
+		return nil, ErrNilTokenHoldersHandler
+	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationSnapshotHandler) {
+		return nil, ErrNilDelegationSnapshotHandler
+	}
+	if check.IfNil(arg.RewardsBreakdownHandler) {
+		return nil, ErrNilRewardsBreakdownHandler
+	}
+	if check.IfNil(arg.NodesShufflingPreviewer) {
+		return nil, ErrNilNodesShufflingPreviewer
+	}
+	if check.IfNil(arg.ConsensusGroupsHandler) {
+		return nil, ErrNilConsensusGroupsHandler
+	}
+	if check.IfNil(arg.ValidatorPerformanceHandler) {
+		return nil, ErrNilValidatorPerformanceHandler
+	}
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
@@ -83,17 +118,24 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	}
 
 	return &nodeApiResolver{
-		scQueryService:           arg.SCQueryService,
-		statusMetricsHandler:     arg.StatusMetricsHandler,
-		txCostHandler:            arg.TxCostHandler,
-		totalStakedValueHandler:  arg.TotalStakedValueHandler,
-		directStakedListHandler:  arg.DirectStakedListHandler,
-		delegatedListHandler:     arg.DelegatedListHandler,
-		apiBlockHandler:          arg.APIBlockHandler,
-		apiTransactionHandler:    arg.APITransactionHandler,
-		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
-		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
-		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+		scQueryService:              arg.SCQueryService,
+		statusMetricsHandler:        arg.StatusMetricsHandler,
+		txCostHandler:               arg.TxCostHandler,
+		totalStakedValueHandler:     arg.TotalStakedValueHandler,
+		directStakedListHandler:     arg.DirectStakedListHandler,
+		delegatedListHandler:        arg.DelegatedListHandler,
+		tokenHoldersHandler:         arg.TokenHoldersHandler,
+		governanceHandler:           arg.GovernanceHandler,
+		delegationSnapshotHandler:   arg.DelegationSnapshotHandler,
+		rewardsBreakdownHandler:     arg.RewardsBreakdownHandler,
+		nodesShufflingPreviewer:     arg.NodesShufflingPreviewer,
+		consensusGroupsHandler:      arg.ConsensusGroupsHandler,
+		validatorPerformanceHandler: arg.ValidatorPerformanceHandler,
+		apiBlockHandler:             arg.APIBlockHandler,
+		apiTransactionHandler:       arg.APITransactionHandler,
+		apiInternalBlockHandler:     arg.APIInternalBlockHandler,
+		genesisNodesSetupHandler:    arg.GenesisNodesSetupHandler,
+		validatorPubKeyConverter:    arg.ValidatorPubKeyConverter,
 	}, nil
 }
 
@@ -132,6 +174,53 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetTokenHolders will return the holders of the provided token and their balances
+func (nar *nodeApiResolver) GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error) {
+	return nar.tokenHoldersHandler.GetTokenHolders(tokenIdentifier, nonce, ctx)
+}
+
+// GetGovernanceProposals will return the governance proposals along with their tallies and voters
+func (nar *nodeApiResolver) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposals(ctx)
+}
+
+// GetGovernanceProposal will return the governance proposal identified by the provided reference
+func (nar *nodeApiResolver) GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposal(reference, ctx)
+}
+
+// GetDelegationSnapshot will return the decoded snapshot of the provided delegation contract
+func (nar *nodeApiResolver) GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+	return nar.delegationSnapshotHandler.GetDelegationSnapshot(contractAddress, ctx)
+}
+
+// GetRewardsBreakdown will return the breakdown of the rewards distributed at the start of the provided epoch
+func (nar *nodeApiResolver) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	return nar.rewardsBreakdownHandler.GetRewardsBreakdown(epoch)
+}
+
+// GetNodeRewardsBreakdown will return the breakdown of the rewards distributed at the start of the provided epoch
+// for the provided node
+func (nar *nodeApiResolver) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	return nar.rewardsBreakdownHandler.GetNodeRewardsBreakdown(epoch, blsKey)
+}
+
+// PreviewNodesShuffling will return the previewed nodes lists of the next epoch, optionally after applying the
+// hypothetical stake and unstake sets of the request
+func (nar *nodeApiResolver) PreviewNodesShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nar.nodesShufflingPreviewer.PreviewShuffling(request)
+}
+
+// GetConsensusGroups will return the nodes lists of a past epoch and, optionally, the consensus group of a past round
+func (nar *nodeApiResolver) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	return nar.consensusGroupsHandler.GetConsensusGroups(request)
+}
+
+// GetValidatorPerformance will return the performance report of a validator in an epoch
+func (nar *nodeApiResolver) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	return nar.validatorPerformanceHandler.GetValidatorPerformance(request)
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
@@ -23,17 +23,24 @@ import (
 
 func createMockArgs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
-		SCQueryService:           &mock.SCQueryServiceStub{},
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		TxCostHandler:            &mock.TransactionCostEstimatorMock{},
-		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
-		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
-		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
-		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
-		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
-		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
-		GenesisNodesSetupHandler: &testscommon.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		SCQueryService:              &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:        &testscommon.StatusMetricsStub{},
+		TxCostHandler:               &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler:     &mock.StakeValuesProcessorStub{},
+		DirectStakedListHandler:     &mock.DirectStakedListProcessorStub{},
+		DelegatedListHandler:        &mock.DelegatedListProcessorStub{},
+		TokenHoldersHandler:         &mock.TokenHoldersProcessorStub{},
+		GovernanceHandler:           &mock.GovernanceProcessorStub{},
+		DelegationSnapshotHandler:   &mock.DelegationSnapshotProcessorStub{},
+		RewardsBreakdownHandler:     &mock.RewardsBreakdownHandlerStub{},
+		NodesShufflingPreviewer:     &mock.NodesShufflingPreviewerStub{},
+		ConsensusGroupsHandler:      &mock.ConsensusGroupsHandlerStub{},
+		ValidatorPerformanceHandler: &mock.ValidatorPerformanceHandlerStub{},
+		APIBlockHandler:             &mock.BlockAPIHandlerStub{},
+		APITransactionHandler:       &mock.TransactionAPIHandlerStub{},
+		APIInternalBlockHandler:     &mock.InternalBlockApiHandlerStub{},
+		GenesisNodesSetupHandler:    &testscommon.NodesSetupStub{},
+		ValidatorPubKeyConverter:    &testscommon.PubkeyConverterMock{},
 	}
 }
 
@@ -103,6 +110,83 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilTokenHoldersHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.TokenHoldersHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTokenHoldersHandler, err)
+}
+
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationSnapshotHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.DelegationSnapshotHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationSnapshotHandler, err)
+}
+
+func TestNewNodeApiResolver_NilRewardsBreakdownHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.RewardsBreakdownHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRewardsBreakdownHandler, err)
+}
+
+func TestNewNodeApiResolver_NilNodesShufflingPreviewer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.NodesShufflingPreviewer = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilNodesShufflingPreviewer, err)
+}
+
+func TestNewNodeApiResolver_NilConsensusGroupsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ConsensusGroupsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilConsensusGroupsHandler, err)
+}
+
+func TestNewNodeApiResolver_NilValidatorPerformanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ValidatorPerformanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilValidatorPerformanceHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -244,6 +328,176 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	expectedResponse := &common.TokenHoldersAPIResponse{Token: "TKN-abcdef", Nonce: 2}
+	arg.TokenHoldersHandler = &mock.TokenHoldersProcessorStub{
+		GetTokenHoldersCalled: func(tokenIdentifier string, nonce uint64, _ context.Context) (*common.TokenHoldersAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "TKN-abcdef", tokenIdentifier)
+			assert.Equal(t, uint64(2), nonce)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetTokenHolders("TKN-abcdef", 2, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	expectedResponse := []*common.GovernanceProposalAPIResponse{{Reference: "reference"}}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalsCalled: func(_ context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+			wasCalled = true
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetGovernanceProposals(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetDelegationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	expectedResponse := &common.DelegationSnapshotAPIResponse{Contract: "contract"}
+	arg.DelegationSnapshotHandler = &mock.DelegationSnapshotProcessorStub{
+		GetDelegationSnapshotCalled: func(contractAddress string, _ context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "contract", contractAddress)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetDelegationSnapshot("contract", context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	expectedResponse := &common.RewardsBreakdownAPIResponse{Epoch: 7}
+	expectedNodeResponse := &common.NodeRewardsBreakdownAPIResponse{BLSKey: "blsKey"}
+	arg.RewardsBreakdownHandler = &mock.RewardsBreakdownHandlerStub{
+		GetRewardsBreakdownCalled: func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+			assert.Equal(t, uint32(7), epoch)
+			return expectedResponse, nil
+		},
+		GetNodeRewardsBreakdownCalled: func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+			assert.Equal(t, uint32(7), epoch)
+			assert.Equal(t, "blsKey", blsKey)
+			return expectedNodeResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetRewardsBreakdown(7)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+
+	nodeResponse, err := nar.GetNodeRewardsBreakdown(7, "blsKey")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedNodeResponse, nodeResponse)
+}
+
+func TestNodeApiResolver_PreviewNodesShuffling(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	expectedRequest := &common.NodesShufflingPreviewRequest{Randomness: "aa"}
+	expectedResponse := &common.NodesShufflingPreviewAPIResponse{Epoch: 4}
+	arg.NodesShufflingPreviewer = &mock.NodesShufflingPreviewerStub{
+		PreviewShufflingCalled: func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.PreviewNodesShuffling(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeApiResolver_GetConsensusGroups(t *testing.T) {
+	t.Parallel()
+
+	round := uint64(100)
+	arg := createMockArgs()
+	expectedRequest := &common.ConsensusGroupsRequest{Round: &round}
+	expectedResponse := &common.ConsensusGroupsAPIResponse{Epoch: 4}
+	arg.ConsensusGroupsHandler = &mock.ConsensusGroupsHandlerStub{
+		GetConsensusGroupsCalled: func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetConsensusGroups(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeApiResolver_GetValidatorPerformance(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	expectedRequest := &common.ValidatorPerformanceRequest{BLSKey: "blsKey"}
+	expectedResponse := &common.ValidatorPerformanceAPIResponse{BLSKey: "blsKey", Epoch: 4}
+	arg.ValidatorPerformanceHandler = &mock.ValidatorPerformanceHandlerStub{
+		GetValidatorPerformanceCalled: func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+			assert.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetValidatorPerformance(expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeApiResolver_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	expectedResponse := &common.GovernanceProposalAPIResponse{Reference: "reference"}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalCalled: func(reference string, _ context.Context) (*common.GovernanceProposalAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "reference", reference)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	response, err := nar.GetGovernanceProposal("reference", context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
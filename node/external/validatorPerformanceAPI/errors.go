@@ -0,0 +1,30 @@
+package validatorPerformanceAPI
+
+import "errors"
+
+// ErrNilHistoricalNodesCoordinator signals that a nil historical nodes coordinator has been provided
+var ErrNilHistoricalNodesCoordinator = errors.New("nil historical nodes coordinator")
+
+// ErrNilValidatorsProvider signals that a nil validators provider has been provided
+var ErrNilValidatorsProvider = errors.New("nil validators provider")
+
+// ErrInvalidRoundsPerEpoch signals that an invalid number of rounds per epoch has been provided
+var ErrInvalidRoundsPerEpoch = errors.New("invalid rounds per epoch")
+
+// ErrNilRequest signals that a nil request has been provided
+var ErrNilRequest = errors.New("nil request")
+
+// ErrNoCurrentBlock signals that the node has not yet committed any block
+var ErrNoCurrentBlock = errors.New("no current block")
+
+// ErrEpochNotStarted signals that the requested epoch is after the current epoch
+var ErrEpochNotStarted = errors.New("the requested epoch has not started yet")
+
+// ErrValidatorNotEligible signals that the validator was not eligible in the requested epoch
+var ErrValidatorNotEligible = errors.New("the validator is not eligible in the requested epoch")
+
+// ErrValidatorNotInSelfShard signals that the validator was eligible in a shard whose blocks are not stored by this node
+var ErrValidatorNotInSelfShard = errors.New("the performance report is only available for the validators of the node's own shard")
+
+// ErrEpochStartNotFound signals that the start of epoch block of the requested epoch could not be found
+var ErrEpochStartNotFound = errors.New("start of epoch block not found")
@@ -0,0 +1,12 @@
+package validatorPerformanceAPI
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+)
+
+// HistoricalNodesCoordinator defines the nodes coordinator queries needed to rebuild the consensus groups of an epoch
+type HistoricalNodesCoordinator interface {
+	GetEpochValidatorsPublicKeys(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error)
+	ComputeConsensusGroup(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error)
+	IsInterfaceNil() bool
+}
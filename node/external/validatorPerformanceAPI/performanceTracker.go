@@ -0,0 +1,118 @@
+package validatorPerformanceAPI
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+)
+
+// performanceTracker accumulates the outcome of the rounds in which a validator was part of the consensus group
+type performanceTracker struct {
+	blsKey            []byte
+	shardID           uint32
+	pubKeyConverter   core.PubkeyConverter
+	consecutiveMisses uint32
+	numRounds         uint64
+	ratingReplay      *ratingReplay
+	report            *common.ValidatorPerformanceAPIResponse
+}
+
+func newPerformanceTracker(blsKey []byte, shardID uint32, pubKeyConverter core.PubkeyConverter) *performanceTracker {
+	return &performanceTracker{
+		blsKey:          blsKey,
+		shardID:         shardID,
+		pubKeyConverter: pubKeyConverter,
+		report: &common.ValidatorPerformanceAPIResponse{
+			ShardID:          shardID,
+			MissedProposals:  make([]*common.MissedProposalAPIResponse, 0),
+			MissedSignatures: make([]*common.MissedSignatureAPIResponse, 0),
+		},
+	}
+}
+
+func (pt *performanceTracker) processProposedBlock(round uint64, header *roundHeader, consensusGroup []nodesCoordinator.Validator) {
+	pt.markRound(round)
+
+	index := getValidatorIndex(consensusGroup, pt.blsKey)
+	if index < 0 {
+		pt.sampleRating(round)
+		return
+	}
+
+	pt.report.NumRoundsInConsensus++
+	bitmap := header.header.GetPubKeysBitmap()
+	signed := index/8 < len(bitmap) && (bitmap[index/8]&(1<<(uint16(index)%8))) != 0
+
+	switch {
+	case index == 0:
+		pt.report.NumProposedBlocks++
+		if signed {
+			pt.consecutiveMisses = 0
+			pt.ratingReplay.increaseProposer()
+		}
+	case signed:
+		pt.report.NumSignedBlocks++
+		pt.ratingReplay.increaseValidator()
+	default:
+		pt.report.NumMissedSignatures++
+		pt.report.MissedSignatures = append(pt.report.MissedSignatures, &common.MissedSignatureAPIResponse{
+			Round:     round,
+			BlockHash: hex.EncodeToString(header.hash),
+			Leader:    pt.pubKeyConverter.Encode(consensusGroup[0].PubKey()),
+		})
+		// an ignored signature still increases the rating, but counts against the signed blocks threshold
+		pt.ratingReplay.increaseValidator()
+	}
+
+	pt.sampleRating(round)
+}
+
+func (pt *performanceTracker) processMissedRound(round uint64, previousHeader *roundHeader, consensusGroup []nodesCoordinator.Validator) {
+	pt.markRound(round)
+
+	index := getValidatorIndex(consensusGroup, pt.blsKey)
+	if index < 0 {
+		pt.sampleRating(round)
+		return
+	}
+
+	pt.report.NumRoundsInConsensus++
+	if index == 0 {
+		pt.report.NumMissedProposals++
+		pt.report.MissedProposals = append(pt.report.MissedProposals, &common.MissedProposalAPIResponse{
+			Round:              round,
+			ConsecutiveMisses:  pt.consecutiveMisses,
+			PreviousBlockRound: previousHeader.header.GetRound(),
+			PreviousBlockHash:  hex.EncodeToString(previousHeader.hash),
+		})
+		pt.ratingReplay.decreaseProposer(pt.consecutiveMisses)
+		pt.consecutiveMisses++
+	} else {
+		pt.report.NumMissedBlocksAsValidator++
+		pt.ratingReplay.decreaseValidator()
+	}
+
+	pt.sampleRating(round)
+}
+
+func (pt *performanceTracker) markRound(round uint64) {
+	if pt.numRounds == 0 {
+		pt.report.FirstRound = round
+	}
+	pt.report.LastRound = round
+	pt.numRounds++
+}
+
+func (pt *performanceTracker) sampleRating(round uint64) {
+	pt.ratingReplay.sample(round, false)
+}
+
+func (pt *performanceTracker) finish() {
+	if pt.numRounds == 0 {
+		return
+	}
+
+	pt.ratingReplay.sample(pt.report.LastRound, true)
+}
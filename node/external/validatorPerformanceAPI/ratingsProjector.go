@@ -0,0 +1,229 @@
+package validatorPerformanceAPI
+
+import (
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	// ratingTrendSampleRounds is the number of replayed rounds between two consecutive points of the rating trend
+	ratingTrendSampleRounds = 100
+	// maxSimulatedMissedProposals caps the number of consecutive missed proposals simulated to reach the jail threshold
+	maxSimulatedMissedProposals = 1000
+)
+
+const (
+	// jailingRiskCertain means that the rating is already below the jail threshold
+	jailingRiskCertain = "certain"
+	// jailingRiskHigh means that the rating is projected to fall below the jail threshold by the end of the epoch
+	jailingRiskHigh = "high"
+	// jailingRiskMedium means that the rating is decreasing, but is projected to stay above the jail threshold
+	jailingRiskMedium = "medium"
+	// jailingRiskLow means that the rating is not decreasing
+	jailingRiskLow = "low"
+)
+
+// ratingsProjector applies the rating steps of the ratings configuration in the same way the validator statistics
+// processor does. A validator whose rating has a lower selection chance than a zero rating is jailed at the end of
+// the epoch
+type ratingsProjector struct {
+	rater         sharding.PeerAccountListAndRatingHandler
+	minRating     uint32
+	maxRating     uint32
+	minChance     uint32
+	jailThreshold uint32
+}
+
+func newRatingsProjector(rater sharding.PeerAccountListAndRatingHandler, ratingsData process.RatingsInfoHandler) *ratingsProjector {
+	minChance := rater.GetChance(0)
+	jailThreshold := uint32(0)
+	for _, chance := range ratingsData.SelectionChances() {
+		if chance.GetChancePercent() < minChance && chance.GetMaxThreshold() > jailThreshold {
+			jailThreshold = chance.GetMaxThreshold()
+		}
+	}
+
+	return &ratingsProjector{
+		rater:         rater,
+		minRating:     ratingsData.MinRating(),
+		maxRating:     ratingsData.MaxRating(),
+		minChance:     minChance,
+		jailThreshold: jailThreshold,
+	}
+}
+
+func (rp *ratingsProjector) newRatingReplay(shardID uint32, startRatingPercent float32) *ratingReplay {
+	return &ratingReplay{
+		projector: rp,
+		shardID:   shardID,
+		rating:    rp.percentToRating(startRatingPercent),
+		trend:     make([]*common.RatingTrendPointAPIResponse, 0),
+	}
+}
+
+// computeRating projects the current temp rating to the end of the epoch, assuming that the rating keeps changing at
+// the average pace of the elapsed rounds, and applies the end of epoch revert for the validators below the signed
+// blocks threshold
+func (rp *ratingsProjector) computeRating(
+	shardID uint32,
+	validatorInfo *state.ValidatorApiResponse,
+	replay *ratingReplay,
+	elapsedRounds uint64,
+	roundsPerEpoch uint64,
+) *common.ValidatorRatingAPIResponse {
+	startRating := rp.percentToRating(validatorInfo.Rating)
+	tempRating := rp.percentToRating(validatorInfo.TempRating)
+
+	ratingChangePerRound := float64(0)
+	if elapsedRounds > 0 {
+		ratingChangePerRound = float64(int64(tempRating)-int64(startRating)) / float64(elapsedRounds)
+	}
+
+	remainingRounds := uint64(0)
+	if roundsPerEpoch > elapsedRounds {
+		remainingRounds = roundsPerEpoch - elapsedRounds
+	}
+
+	projectedRating := rp.clampRating(float64(tempRating) + ratingChangePerRound*float64(remainingRounds))
+
+	validatorOccurrences := core.MaxUint32(1, validatorInfo.NumValidatorSuccess+validatorInfo.NumValidatorFailure+validatorInfo.NumValidatorIgnoredSignatures)
+	signedBlocksRatio := float32(validatorInfo.NumValidatorSuccess) / float32(validatorOccurrences)
+	belowSignedBlocksThreshold := signedBlocksRatio <= rp.rater.GetSignedBlocksThreshold()
+	if belowSignedBlocksThreshold {
+		increasedRatingTimes := validatorInfo.NumValidatorSuccess + validatorInfo.NumValidatorIgnoredSignatures
+		projectedRating = rp.rater.RevertIncreaseValidator(shardID, projectedRating, increasedRatingTimes)
+	}
+
+	return &common.ValidatorRatingAPIResponse{
+		StartRating:                validatorInfo.Rating,
+		TempRating:                 validatorInfo.TempRating,
+		RatingChangePerRound:       ratingChangePerRound * 100 / float64(rp.maxRating),
+		Trend:                      replay.trend,
+		RemainingRounds:            remainingRounds,
+		ProjectedRating:            rp.ratingToPercent(projectedRating),
+		SignedBlocksRatio:          signedBlocksRatio,
+		BelowSignedBlocksThreshold: belowSignedBlocksThreshold,
+		JailThreshold:              rp.ratingToPercent(rp.jailThreshold),
+		MissedProposalsToJail:      rp.computeMissedProposalsToJail(shardID, tempRating),
+		JailingRisk:                rp.computeJailingRisk(tempRating, projectedRating, ratingChangePerRound),
+	}
+}
+
+// computeMissedProposalsToJail returns the number of consecutive missed proposals that would bring the rating below
+// the jail threshold. It returns 0 if the rating is already below the threshold or if the threshold is not reached
+// within maxSimulatedMissedProposals
+func (rp *ratingsProjector) computeMissedProposalsToJail(shardID uint32, rating uint32) uint32 {
+	for consecutiveMisses := uint32(0); consecutiveMisses < maxSimulatedMissedProposals; consecutiveMisses++ {
+		if rp.isJailable(rating) {
+			return consecutiveMisses
+		}
+		rating = rp.rater.ComputeDecreaseProposer(shardID, rating, consecutiveMisses)
+	}
+
+	return 0
+}
+
+func (rp *ratingsProjector) computeJailingRisk(tempRating uint32, projectedRating uint32, ratingChangePerRound float64) string {
+	switch {
+	case rp.isJailable(tempRating):
+		return jailingRiskCertain
+	case rp.isJailable(projectedRating):
+		return jailingRiskHigh
+	case ratingChangePerRound < 0:
+		return jailingRiskMedium
+	default:
+		return jailingRiskLow
+	}
+}
+
+func (rp *ratingsProjector) isJailable(rating uint32) bool {
+	return rp.rater.GetChance(rating) < rp.minChance
+}
+
+func (rp *ratingsProjector) clampRating(rating float64) uint32 {
+	if rating < float64(rp.minRating) {
+		return rp.minRating
+	}
+	if rating > float64(rp.maxRating) {
+		return rp.maxRating
+	}
+
+	return uint32(rating)
+}
+
+// percentToRating converts the ratings reported by the validators provider, expressed as percentages of the
+// maximum rating, back to rating values
+func (rp *ratingsProjector) percentToRating(percent float32) uint32 {
+	return rp.clampRating(math.Round(float64(percent) * float64(rp.maxRating) / 100))
+}
+
+func (rp *ratingsProjector) ratingToPercent(rating uint32) float32 {
+	return float32(rating) * 100 / float32(rp.maxRating)
+}
+
+// ratingReplay applies the rating steps of the replayed rounds, starting from the rating of the start of the epoch
+type ratingReplay struct {
+	projector        *ratingsProjector
+	shardID          uint32
+	rating           uint32
+	lastSampledRound uint64
+	trend            []*common.RatingTrendPointAPIResponse
+}
+
+func (rr *ratingReplay) increaseProposer() {
+	if rr == nil {
+		return
+	}
+
+	rr.rating = rr.projector.rater.ComputeIncreaseProposer(rr.shardID, rr.rating)
+}
+
+func (rr *ratingReplay) decreaseProposer(consecutiveMisses uint32) {
+	if rr == nil {
+		return
+	}
+
+	rr.rating = rr.projector.rater.ComputeDecreaseProposer(rr.shardID, rr.rating, consecutiveMisses)
+}
+
+func (rr *ratingReplay) increaseValidator() {
+	if rr == nil {
+		return
+	}
+
+	rr.rating = rr.projector.rater.ComputeIncreaseValidator(rr.shardID, rr.rating)
+}
+
+func (rr *ratingReplay) decreaseValidator() {
+	if rr == nil {
+		return
+	}
+
+	rr.rating = rr.projector.rater.ComputeDecreaseValidator(rr.shardID, rr.rating)
+}
+
+// sample adds a point to the rating trend every ratingTrendSampleRounds rounds, or whenever forced
+func (rr *ratingReplay) sample(round uint64, force bool) {
+	if rr == nil {
+		return
+	}
+
+	isFirstSample := len(rr.trend) == 0
+	if !force && !isFirstSample && round-rr.lastSampledRound < ratingTrendSampleRounds {
+		return
+	}
+	if !isFirstSample && round == rr.lastSampledRound {
+		return
+	}
+
+	rr.lastSampledRound = round
+	rr.trend = append(rr.trend, &common.RatingTrendPointAPIResponse{
+		Round:  round,
+		Rating: rr.projector.ratingToPercent(rr.rating),
+	})
+}
@@ -0,0 +1,357 @@
+package validatorPerformanceAPI
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// maxRoundsLookBack is the maximum number of rounds searched backwards for the block preceding the first round of
+// the epoch
+const maxRoundsLookBack = 100
+
+const reportsCacheSize = 100
+
+// ArgsValidatorPerformanceProcessor holds the arguments needed to create a validator performance processor
+type ArgsValidatorPerformanceProcessor struct {
+	SelfShardID                uint32
+	RoundsPerEpoch             uint64
+	Store                      dataRetriever.StorageService
+	Marshalizer                marshal.Marshalizer
+	Uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	BlockChain                 data.ChainHandler
+	HistoricalNodesCoordinator HistoricalNodesCoordinator
+	ValidatorsProvider         process.ValidatorsProvider
+	Rater                      sharding.PeerAccountListAndRatingHandler
+	RatingsData                process.RatingsInfoHandler
+	ValidatorPubKeyConverter   core.PubkeyConverter
+}
+
+type roundHeader struct {
+	hash   []byte
+	header data.HeaderHandler
+}
+
+type validatorPerformanceProcessor struct {
+	selfShardID                uint32
+	roundsPerEpoch             uint64
+	store                      dataRetriever.StorageService
+	marshalizer                marshal.Marshalizer
+	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	blockChain                 data.ChainHandler
+	historicalNodesCoordinator HistoricalNodesCoordinator
+	validatorsProvider         process.ValidatorsProvider
+	ratingsProjector           *ratingsProjector
+	validatorPubKeyConverter   core.PubkeyConverter
+	reportsCache               storage.Cacher
+}
+
+// NewValidatorPerformanceProcessor creates a component able to build the performance report of a validator for an
+// epoch by replaying the consensus groups of the rounds of the node's own shard
+func NewValidatorPerformanceProcessor(args ArgsValidatorPerformanceProcessor) (*validatorPerformanceProcessor, error) {
+	if args.RoundsPerEpoch == 0 {
+		return nil, ErrInvalidRoundsPerEpoch
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.HistoricalNodesCoordinator) {
+		return nil, ErrNilHistoricalNodesCoordinator
+	}
+	if check.IfNil(args.ValidatorsProvider) {
+		return nil, ErrNilValidatorsProvider
+	}
+	if check.IfNil(args.Rater) {
+		return nil, process.ErrNilRater
+	}
+	if check.IfNil(args.RatingsData) {
+		return nil, process.ErrNilRatingsInfoHandler
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+
+	reportsCache, err := lrucache.NewCache(reportsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validatorPerformanceProcessor{
+		selfShardID:                args.SelfShardID,
+		roundsPerEpoch:             args.RoundsPerEpoch,
+		store:                      args.Store,
+		marshalizer:                args.Marshalizer,
+		uint64ByteSliceConverter:   args.Uint64ByteSliceConverter,
+		blockChain:                 args.BlockChain,
+		historicalNodesCoordinator: args.HistoricalNodesCoordinator,
+		validatorsProvider:         args.ValidatorsProvider,
+		ratingsProjector:           newRatingsProjector(args.Rater, args.RatingsData),
+		validatorPubKeyConverter:   args.ValidatorPubKeyConverter,
+		reportsCache:               reportsCache,
+	}, nil
+}
+
+// GetValidatorPerformance returns the performance report of a validator for the requested epoch. The missed
+// proposals and signatures are attributed to rounds and headers by recomputing the consensus group of each round.
+// The rating trend and the jailing projection are only available for the current epoch. The reports of the finished
+// epochs are cached per validator, while the reports of the current epoch are cached per last replayed round
+func (vpp *validatorPerformanceProcessor) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	if request == nil {
+		return nil, ErrNilRequest
+	}
+
+	blsKey, err := vpp.validatorPubKeyConverter.Decode(request.BLSKey)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHeader := vpp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, ErrNoCurrentBlock
+	}
+
+	currentEpoch := currentHeader.GetEpoch()
+	epoch := currentEpoch
+	if request.Epoch != nil {
+		epoch = *request.Epoch
+	}
+	if epoch > currentEpoch {
+		return nil, fmt.Errorf("%w, requested epoch %d, current epoch %d", ErrEpochNotStarted, epoch, currentEpoch)
+	}
+
+	lastReplayedRound := uint64(0)
+	if epoch == currentEpoch {
+		lastReplayedRound = currentHeader.GetRound()
+	}
+	cacheKey := computeReportsCacheKey(epoch, blsKey, lastReplayedRound)
+	cachedReport, found := vpp.reportsCache.Get(cacheKey)
+	if found {
+		report, ok := cachedReport.(*common.ValidatorPerformanceAPIResponse)
+		if ok {
+			return report, nil
+		}
+	}
+
+	err = vpp.checkValidatorInSelfShard(blsKey, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	firstRound, err := vpp.getEpochFirstRound(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedKey := vpp.validatorPubKeyConverter.Encode(blsKey)
+	tracker := newPerformanceTracker(blsKey, vpp.selfShardID, vpp.validatorPubKeyConverter)
+	tracker.report.BLSKey = encodedKey
+	tracker.report.Epoch = epoch
+
+	validatorInfo, ok := vpp.validatorsProvider.GetLatestValidators()[encodedKey]
+	isRatingAvailable := epoch == currentEpoch && ok && validatorInfo != nil
+	if isRatingAvailable {
+		tracker.ratingReplay = vpp.ratingsProjector.newRatingReplay(vpp.selfShardID, validatorInfo.Rating)
+	}
+
+	err = vpp.replayEpochRounds(tracker, epoch, firstRound, currentHeader.GetRound())
+	if err != nil {
+		return nil, err
+	}
+
+	if isRatingAvailable {
+		tracker.report.Rating = vpp.ratingsProjector.computeRating(vpp.selfShardID, validatorInfo, tracker.ratingReplay, tracker.numRounds, vpp.roundsPerEpoch)
+	}
+	vpp.reportsCache.Put(cacheKey, tracker.report, 0)
+
+	return tracker.report, nil
+}
+
+// computeReportsCacheKey returns the cache key of a report. The last replayed round is 0 for the finished epochs
+func computeReportsCacheKey(epoch uint32, blsKey []byte, lastReplayedRound uint64) []byte {
+	return []byte(fmt.Sprintf("%d_%x_%d", epoch, blsKey, lastReplayedRound))
+}
+
+func (vpp *validatorPerformanceProcessor) checkValidatorInSelfShard(blsKey []byte, epoch uint32) error {
+	epochValidators, err := vpp.historicalNodesCoordinator.GetEpochValidatorsPublicKeys(epoch)
+	if err != nil {
+		return err
+	}
+
+	for shardID, shardValidators := range epochValidators.Eligible {
+		for _, pk := range shardValidators {
+			if !bytes.Equal(pk, blsKey) {
+				continue
+			}
+			if shardID != vpp.selfShardID {
+				return fmt.Errorf("%w, the validator was eligible in shard %d", ErrValidatorNotInSelfShard, shardID)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, epoch %d", ErrValidatorNotEligible, epoch)
+}
+
+// getEpochFirstRound returns the round of the start of epoch metablock, which is the round from which all the shards
+// can switch to the new epoch
+func (vpp *validatorPerformanceProcessor) getEpochFirstRound(epoch uint32) (uint64, error) {
+	if epoch == 0 {
+		return 0, nil
+	}
+
+	metaBlockStorer := vpp.store.GetStorer(dataRetriever.MetaBlockUnit)
+	if check.IfNil(metaBlockStorer) {
+		return 0, process.ErrNilStorage
+	}
+
+	metaBlockBytes, err := metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return 0, fmt.Errorf("%w for epoch %d: %v", ErrEpochStartNotFound, epoch, err)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = vpp.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return metaBlock.GetRound(), nil
+}
+
+// replayEpochRounds recomputes the consensus group of each round of the epoch. A block is proposed with the
+// randomness of the previous block, while a round without a block is attributed to the consensus group computed with
+// the randomness of the last proposed block, in the same way the validator statistics processor decreases the
+// ratings for missed blocks. The rounds before the node's shard switched to the epoch are skipped and the replay
+// stops at the first round of the next epoch
+func (vpp *validatorPerformanceProcessor) replayEpochRounds(tracker *performanceTracker, epoch uint32, firstRound uint64, lastRound uint64) error {
+	lastHeader := vpp.searchHeaderBeforeRound(firstRound)
+	for round := firstRound; round <= lastRound; round++ {
+		header, err := vpp.getHeaderByRound(round)
+		blockProposed := err == nil
+
+		var randomness []byte
+		var roundEpoch uint32
+		switch {
+		case blockProposed:
+			randomness = header.header.GetPrevRandSeed()
+			roundEpoch = header.header.GetEpoch()
+			// a start of epoch block is still proposed by the consensus group of the previous epoch
+			if header.header.IsStartOfEpochBlock() && roundEpoch > 0 {
+				roundEpoch--
+			}
+		case lastHeader != nil:
+			randomness = lastHeader.header.GetRandSeed()
+			roundEpoch = lastHeader.header.GetEpoch()
+		default:
+			continue
+		}
+
+		if roundEpoch > epoch {
+			break
+		}
+		if roundEpoch == epoch {
+			consensusGroup, errCompute := vpp.historicalNodesCoordinator.ComputeConsensusGroup(randomness, round, vpp.selfShardID, roundEpoch)
+			if errCompute != nil {
+				return errCompute
+			}
+
+			if blockProposed {
+				tracker.processProposedBlock(round, header, consensusGroup)
+			} else {
+				tracker.processMissedRound(round, lastHeader, consensusGroup)
+			}
+		}
+
+		if blockProposed {
+			lastHeader = header
+		}
+	}
+
+	tracker.finish()
+
+	return nil
+}
+
+func (vpp *validatorPerformanceProcessor) searchHeaderBeforeRound(round uint64) *roundHeader {
+	for i := uint64(1); i <= maxRoundsLookBack && i <= round; i++ {
+		header, err := vpp.getHeaderByRound(round - i)
+		if err == nil {
+			return header
+		}
+	}
+
+	return nil
+}
+
+func (vpp *validatorPerformanceProcessor) getHeaderByRound(round uint64) (*roundHeader, error) {
+	roundToByteSlice := vpp.uint64ByteSliceConverter.ToByteSlice(round)
+	headerHash, err := vpp.store.Get(dataRetriever.RoundHdrHashDataUnit, roundToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpp.selfShardID == core.MetachainShardId {
+		headerBytes, errGet := vpp.store.Get(dataRetriever.MetaBlockUnit, headerHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		metaBlock := &block.MetaBlock{}
+		err = vpp.marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &roundHeader{hash: headerHash, header: metaBlock}, nil
+	}
+
+	headerBytes, err := vpp.store.Get(dataRetriever.BlockHeaderUnit, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	shardHeader, err := process.CreateShardHeader(vpp.marshalizer, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundHeader{hash: headerHash, header: shardHeader}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vpp *validatorPerformanceProcessor) IsInterfaceNil() bool {
+	return vpp == nil
+}
+
+func getValidatorIndex(consensusGroup []nodesCoordinator.Validator, blsKey []byte) int {
+	for i, v := range consensusGroup {
+		if bytes.Equal(v.PubKey(), blsKey) {
+			return i
+		}
+	}
+
+	return -1
+}
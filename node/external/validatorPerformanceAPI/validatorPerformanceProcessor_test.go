@@ -0,0 +1,649 @@
+package validatorPerformanceAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+const (
+	validatorKey = "validator"
+	otherKey     = "other"
+)
+
+type roundSetup struct {
+	header         *block.Header
+	consensusGroup []string
+}
+
+type performanceTestSetup struct {
+	args           ArgsValidatorPerformanceProcessor
+	rater          *testscommon.RaterMock
+	units          map[dataRetriever.UnitType]map[string][]byte
+	currentHeader  data.HeaderHandler
+	epochStartMeta map[string][]byte
+	validatorInfo  *state.ValidatorApiResponse
+	eligible       map[uint32][][]byte
+	consensus      map[uint64][]string
+}
+
+func newPerformanceTestSetup(t *testing.T) *performanceTestSetup {
+	setup := &performanceTestSetup{
+		units:          make(map[dataRetriever.UnitType]map[string][]byte),
+		epochStartMeta: make(map[string][]byte),
+		eligible: map[uint32][][]byte{
+			0: {[]byte(validatorKey), []byte(otherKey)},
+			1: {[]byte("validator in shard 1")},
+		},
+		consensus: make(map[uint64][]string),
+	}
+
+	setup.rater = testscommon.GetNewMockRater()
+	setup.rater.MinRating = 1
+	setup.rater.MaxRating = 1000
+	setup.rater.IncreaseProposer = 10
+	setup.rater.DecreaseProposer = -40
+	setup.rater.IncreaseValidator = 5
+	setup.rater.DecreaseValidator = -20
+	setup.rater.GetSignedBlocksThresholdCalled = func() float32 {
+		return 0.01
+	}
+	setup.rater.GetChancesCalled = func(rating uint32) uint32 {
+		switch {
+		case rating == 0:
+			return 5
+		case rating <= 100:
+			return 0
+		default:
+			return 10
+		}
+	}
+
+	marshalizer := &mock.MarshalizerFake{}
+	setup.args = ArgsValidatorPerformanceProcessor{
+		SelfShardID:    0,
+		RoundsPerEpoch: 20,
+		Store: &mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				value, ok := setup.units[unitType][string(key)]
+				if !ok {
+					return nil, expectedErr
+				}
+				return value, nil
+			},
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return &storageStubs.StorerStub{
+					SearchFirstCalled: func(key []byte) ([]byte, error) {
+						value, ok := setup.epochStartMeta[string(key)]
+						if !ok {
+							return nil, expectedErr
+						}
+						return value, nil
+					},
+				}
+			},
+		},
+		Marshalizer:              marshalizer,
+		Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return setup.currentHeader
+			},
+		},
+		HistoricalNodesCoordinator: &mock.HistoricalNodesCoordinatorStub{
+			GetEpochValidatorsPublicKeysCalled: func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+				return &nodesCoordinator.EpochValidatorsPublicKeys{Eligible: setup.eligible}, nil
+			},
+			ComputeConsensusGroupCalled: func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				assert.Equal(t, uint32(0), shardID)
+				return createValidators(setup.consensus[round]...), nil
+			},
+		},
+		ValidatorsProvider: &mock.ValidatorsProviderStub{
+			GetLatestValidatorsCalled: func() map[string]*state.ValidatorApiResponse {
+				if setup.validatorInfo == nil {
+					return nil
+				}
+				return map[string]*state.ValidatorApiResponse{
+					hex.EncodeToString([]byte(validatorKey)): setup.validatorInfo,
+				}
+			},
+		},
+		Rater: setup.rater,
+		RatingsData: &testscommon.RatingsInfoMock{
+			MinRatingProperty: 1,
+			MaxRatingProperty: 1000,
+			SelectionChancesProperty: []process.SelectionChance{
+				&rating.SelectionChance{MaxThreshold: 0, ChancePercent: 5},
+				&rating.SelectionChance{MaxThreshold: 100, ChancePercent: 0},
+				&rating.SelectionChance{MaxThreshold: 1000, ChancePercent: 10},
+			},
+		},
+		ValidatorPubKeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+
+	return setup
+}
+
+func (setup *performanceTestSetup) saveEpochStart(t *testing.T, epoch uint32, round uint64) {
+	metaBlockBytes, err := setup.args.Marshalizer.Marshal(&block.MetaBlock{Epoch: epoch, Round: round})
+	require.Nil(t, err)
+	setup.epochStartMeta[core.EpochStartIdentifier(epoch)] = metaBlockBytes
+}
+
+func (setup *performanceTestSetup) saveHeader(t *testing.T, header *block.Header) {
+	headerBytes, err := setup.args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	hash := []byte("hash" + string(setup.args.Uint64ByteSliceConverter.ToByteSlice(header.Round)))
+	setup.put(dataRetriever.BlockHeaderUnit, hash, headerBytes)
+	setup.put(dataRetriever.RoundHdrHashDataUnit, setup.args.Uint64ByteSliceConverter.ToByteSlice(header.Round), hash)
+}
+
+func (setup *performanceTestSetup) put(unit dataRetriever.UnitType, key []byte, value []byte) {
+	if setup.units[unit] == nil {
+		setup.units[unit] = make(map[string][]byte)
+	}
+	setup.units[unit][string(key)] = value
+}
+
+func headerHash(setup *performanceTestSetup, round uint64) string {
+	return hex.EncodeToString([]byte("hash" + string(setup.args.Uint64ByteSliceConverter.ToByteSlice(round))))
+}
+
+// createEpochRounds creates the following rounds of epoch 2:
+// 99 - last block of epoch 1
+// 100 - start of epoch block, proposed by the consensus group of epoch 1
+// 101 - proposed by the validator
+// 102, 103 - missed by the validator as leader
+// 104 - the validator did not sign
+// 105 - the validator signed
+// 106 - missed by the leader while the validator was in the consensus group
+// 107 - the validator is not in the consensus group
+func createEpochRounds(t *testing.T, setup *performanceTestSetup) {
+	setup.saveEpochStart(t, 2, 100)
+	setup.saveHeader(t, &block.Header{Round: 99, Epoch: 1, RandSeed: []byte("seed99"), PubKeysBitmap: []byte{1}})
+	setup.saveHeader(t, &block.Header{Round: 100, Epoch: 2, RandSeed: []byte("seed100"), PrevRandSeed: []byte("seed99"), EpochStartMetaHash: []byte("meta"), PubKeysBitmap: []byte{1}})
+	setup.saveHeader(t, &block.Header{Round: 101, Epoch: 2, RandSeed: []byte("seed101"), PrevRandSeed: []byte("seed100"), PubKeysBitmap: []byte{1}})
+	setup.saveHeader(t, &block.Header{Round: 104, Epoch: 2, RandSeed: []byte("seed104"), PrevRandSeed: []byte("seed101"), PubKeysBitmap: []byte{1}})
+	setup.saveHeader(t, &block.Header{Round: 105, Epoch: 2, RandSeed: []byte("seed105"), PrevRandSeed: []byte("seed104"), PubKeysBitmap: []byte{3}})
+	setup.saveHeader(t, &block.Header{Round: 107, Epoch: 2, RandSeed: []byte("seed107"), PrevRandSeed: []byte("seed105"), PubKeysBitmap: []byte{3}})
+
+	setup.consensus[101] = []string{validatorKey, otherKey}
+	setup.consensus[102] = []string{validatorKey, otherKey}
+	setup.consensus[103] = []string{validatorKey, otherKey}
+	setup.consensus[104] = []string{otherKey, validatorKey}
+	setup.consensus[105] = []string{otherKey, validatorKey}
+	setup.consensus[106] = []string{otherKey, validatorKey}
+	setup.consensus[107] = []string{otherKey, "third"}
+
+	setup.currentHeader = &block.Header{Round: 107, Epoch: 2}
+}
+
+func createValidators(pubKeys ...string) []nodesCoordinator.Validator {
+	validators := make([]nodesCoordinator.Validator, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		v, _ := nodesCoordinator.NewValidator([]byte(pk), 1, 0)
+		validators = append(validators, v)
+	}
+
+	return validators
+}
+
+func createRequest(epoch *uint32) *common.ValidatorPerformanceRequest {
+	return &common.ValidatorPerformanceRequest{
+		BLSKey: hex.EncodeToString([]byte(validatorKey)),
+		Epoch:  epoch,
+	}
+}
+
+func TestNewValidatorPerformanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid rounds per epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.RoundsPerEpoch = 0
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, ErrInvalidRoundsPerEpoch, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.Store = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilStorage, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.Marshalizer = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.Uint64ByteSliceConverter = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.BlockChain = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil historical nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.HistoricalNodesCoordinator = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, ErrNilHistoricalNodesCoordinator, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil validators provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.ValidatorsProvider = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, ErrNilValidatorsProvider, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil rater should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.Rater = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilRater, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil ratings data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.RatingsData = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilRatingsInfoHandler, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := newPerformanceTestSetup(t).args
+		args.ValidatorPubKeyConverter = nil
+		vpp, err := NewValidatorPerformanceProcessor(args)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(vpp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, err := NewValidatorPerformanceProcessor(newPerformanceTestSetup(t).args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(vpp))
+		assert.Equal(t, uint32(100), vpp.ratingsProjector.jailThreshold)
+	})
+}
+
+func TestValidatorPerformanceProcessor_GetValidatorPerformanceErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorPerformanceProcessor(newPerformanceTestSetup(t).args)
+		report, err := vpp.GetValidatorPerformance(nil)
+		assert.Equal(t, ErrNilRequest, err)
+		assert.Nil(t, report)
+	})
+	t.Run("invalid BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorPerformanceProcessor(newPerformanceTestSetup(t).args)
+		report, err := vpp.GetValidatorPerformance(&common.ValidatorPerformanceRequest{BLSKey: "not hex"})
+		assert.NotNil(t, err)
+		assert.Nil(t, report)
+	})
+	t.Run("no current block should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorPerformanceProcessor(newPerformanceTestSetup(t).args)
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		assert.Equal(t, ErrNoCurrentBlock, err)
+		assert.Nil(t, report)
+	})
+	t.Run("future epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		setup.currentHeader = &block.Header{Round: 10, Epoch: 2}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		epoch := uint32(3)
+		report, err := vpp.GetValidatorPerformance(createRequest(&epoch))
+		assert.True(t, errors.Is(err, ErrEpochNotStarted))
+		assert.Nil(t, report)
+	})
+	t.Run("validator of another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		setup.currentHeader = &block.Header{Round: 10, Epoch: 2}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(&common.ValidatorPerformanceRequest{
+			BLSKey: hex.EncodeToString([]byte("validator in shard 1")),
+		})
+		assert.True(t, errors.Is(err, ErrValidatorNotInSelfShard))
+		assert.Nil(t, report)
+	})
+	t.Run("validator not eligible should error", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		setup.currentHeader = &block.Header{Round: 10, Epoch: 2}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(&common.ValidatorPerformanceRequest{
+			BLSKey: hex.EncodeToString([]byte("unknown")),
+		})
+		assert.True(t, errors.Is(err, ErrValidatorNotEligible))
+		assert.Nil(t, report)
+	})
+	t.Run("missing start of epoch block should error", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		setup.currentHeader = &block.Header{Round: 10, Epoch: 2}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		assert.True(t, errors.Is(err, ErrEpochStartNotFound))
+		assert.Nil(t, report)
+	})
+	t.Run("consensus group computation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.args.HistoricalNodesCoordinator.(*mock.HistoricalNodesCoordinatorStub).ComputeConsensusGroupCalled =
+			func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				return nil, expectedErr
+			}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, report)
+	})
+}
+
+func TestValidatorPerformanceProcessor_GetValidatorPerformance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missed proposals and signatures should be attributed to rounds and headers", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		computedRounds := make(map[uint64][]byte)
+		setup.args.HistoricalNodesCoordinator.(*mock.HistoricalNodesCoordinatorStub).ComputeConsensusGroupCalled =
+			func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				assert.Equal(t, uint32(2), epoch)
+				computedRounds[round] = randomness
+				return createValidators(setup.consensus[round]...), nil
+			}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+
+		expectedRandomness := map[uint64][]byte{
+			101: []byte("seed100"),
+			102: []byte("seed101"),
+			103: []byte("seed101"),
+			104: []byte("seed101"),
+			105: []byte("seed104"),
+			106: []byte("seed105"),
+			107: []byte("seed105"),
+		}
+		assert.Equal(t, expectedRandomness, computedRounds)
+
+		expectedReport := &common.ValidatorPerformanceAPIResponse{
+			BLSKey:                     hex.EncodeToString([]byte(validatorKey)),
+			Epoch:                      2,
+			ShardID:                    0,
+			FirstRound:                 101,
+			LastRound:                  107,
+			NumRoundsInConsensus:       6,
+			NumProposedBlocks:          1,
+			NumMissedProposals:         2,
+			NumSignedBlocks:            1,
+			NumMissedSignatures:        1,
+			NumMissedBlocksAsValidator: 1,
+			MissedProposals: []*common.MissedProposalAPIResponse{
+				{Round: 102, ConsecutiveMisses: 0, PreviousBlockRound: 101, PreviousBlockHash: headerHash(setup, 101)},
+				{Round: 103, ConsecutiveMisses: 1, PreviousBlockRound: 101, PreviousBlockHash: headerHash(setup, 101)},
+			},
+			MissedSignatures: []*common.MissedSignatureAPIResponse{
+				{Round: 104, BlockHash: headerHash(setup, 104), Leader: hex.EncodeToString([]byte(otherKey))},
+			},
+		}
+		assert.Equal(t, expectedReport, report)
+	})
+	t.Run("past epoch should stop at the next epoch and have no rating", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.saveHeader(t, &block.Header{Round: 108, Epoch: 3, RandSeed: []byte("seed108"), PrevRandSeed: []byte("seed107"), PubKeysBitmap: []byte{1}})
+		setup.saveHeader(t, &block.Header{Round: 109, Epoch: 3, RandSeed: []byte("seed109"), PrevRandSeed: []byte("seed108"), PubKeysBitmap: []byte{1}})
+		setup.consensus[108] = []string{validatorKey}
+		setup.consensus[109] = []string{validatorKey}
+		setup.currentHeader = &block.Header{Round: 109, Epoch: 3}
+		setup.validatorInfo = &state.ValidatorApiResponse{Rating: 50, TempRating: 50}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		epoch := uint32(2)
+		report, err := vpp.GetValidatorPerformance(createRequest(&epoch))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(107), report.LastRound)
+		assert.Equal(t, uint32(1), report.NumProposedBlocks)
+		assert.Nil(t, report.Rating)
+	})
+	t.Run("current epoch should contain the rating trend and projection", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.validatorInfo = &state.ValidatorApiResponse{
+			Rating:                        50,
+			TempRating:                    42,
+			NumValidatorSuccess:           1,
+			NumValidatorFailure:           1,
+			NumValidatorIgnoredSignatures: 1,
+		}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		require.NotNil(t, report.Rating)
+
+		// 500 +10 (proposed) -40 -40 (missed proposals) +5 +5 (signatures) -20 (missed block as validator) = 420
+		expectedTrend := []*common.RatingTrendPointAPIResponse{
+			{Round: 101, Rating: 51},
+			{Round: 107, Rating: 42},
+		}
+		assert.Equal(t, expectedTrend, report.Rating.Trend)
+		assert.Equal(t, float32(50), report.Rating.StartRating)
+		assert.Equal(t, float32(42), report.Rating.TempRating)
+		assert.InDelta(t, -8.0/7, report.Rating.RatingChangePerRound, 0.0001)
+		assert.Equal(t, uint64(13), report.Rating.RemainingRounds)
+		// 420 - 80/7 * 13 = 271.43
+		assert.InDelta(t, 27.1, report.Rating.ProjectedRating, 0.0001)
+		assert.InDelta(t, 1.0/3, report.Rating.SignedBlocksRatio, 0.0001)
+		assert.False(t, report.Rating.BelowSignedBlocksThreshold)
+		assert.Equal(t, float32(10), report.Rating.JailThreshold)
+		// (420 - 100) / 40 = 8 missed proposals
+		assert.Equal(t, uint32(8), report.Rating.MissedProposalsToJail)
+		assert.Equal(t, jailingRiskMedium, report.Rating.JailingRisk)
+	})
+	t.Run("rating projected below the jail threshold should have high risk", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.args.RoundsPerEpoch = 100
+		setup.validatorInfo = &state.ValidatorApiResponse{Rating: 50, TempRating: 42}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.Equal(t, float32(0.1), report.Rating.ProjectedRating)
+		assert.Equal(t, jailingRiskHigh, report.Rating.JailingRisk)
+	})
+	t.Run("rating below the jail threshold should have certain risk", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.validatorInfo = &state.ValidatorApiResponse{Rating: 12, TempRating: 9}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(0), report.Rating.MissedProposalsToJail)
+		assert.Equal(t, jailingRiskCertain, report.Rating.JailingRisk)
+	})
+	t.Run("increasing rating should have low risk", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.validatorInfo = &state.ValidatorApiResponse{Rating: 50, TempRating: 51, NumValidatorSuccess: 10}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.Equal(t, jailingRiskLow, report.Rating.JailingRisk)
+	})
+	t.Run("below the signed blocks threshold should revert the validator increases", func(t *testing.T) {
+		t.Parallel()
+
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		setup.rater.GetSignedBlocksThresholdCalled = func() float32 {
+			return 0.5
+		}
+		setup.rater.RevertIncreaseValidatorCalled = func(shardId uint32, rating uint32, nrReverts uint32) uint32 {
+			return rating - uint32(setup.rater.IncreaseValidator)*nrReverts
+		}
+		setup.validatorInfo = &state.ValidatorApiResponse{
+			Rating:                        50,
+			TempRating:                    50,
+			NumValidatorSuccess:           1,
+			NumValidatorIgnoredSignatures: 3,
+		}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.True(t, report.Rating.BelowSignedBlocksThreshold)
+		// 500 - 4 reverted validator increases of 5
+		assert.Equal(t, float32(48), report.Rating.ProjectedRating)
+	})
+}
+
+func TestValidatorPerformanceProcessor_GetValidatorPerformanceShouldCacheReports(t *testing.T) {
+	t.Parallel()
+
+	createCountingSetup := func(t *testing.T) (*performanceTestSetup, *int) {
+		setup := newPerformanceTestSetup(t)
+		createEpochRounds(t, setup)
+		numComputed := 0
+		setup.args.HistoricalNodesCoordinator.(*mock.HistoricalNodesCoordinatorStub).ComputeConsensusGroupCalled =
+			func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				numComputed++
+				return createValidators(setup.consensus[round]...), nil
+			}
+
+		return setup, &numComputed
+	}
+
+	t.Run("finished epoch should be cached per validator", func(t *testing.T) {
+		t.Parallel()
+
+		setup, numComputed := createCountingSetup(t)
+		setup.currentHeader = &block.Header{Round: 110, Epoch: 3}
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		epoch := uint32(2)
+		report, err := vpp.GetValidatorPerformance(createRequest(&epoch))
+		require.Nil(t, err)
+		numComputedFirst := *numComputed
+		assert.True(t, numComputedFirst > 0)
+
+		setup.currentHeader = &block.Header{Round: 111, Epoch: 3}
+		cachedReport, err := vpp.GetValidatorPerformance(createRequest(&epoch))
+		require.Nil(t, err)
+		assert.True(t, report == cachedReport)
+		assert.Equal(t, numComputedFirst, *numComputed)
+	})
+	t.Run("current epoch should be cached per last replayed round", func(t *testing.T) {
+		t.Parallel()
+
+		setup, numComputed := createCountingSetup(t)
+		vpp, _ := NewValidatorPerformanceProcessor(setup.args)
+
+		report, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		numComputedFirst := *numComputed
+
+		cachedReport, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.True(t, report == cachedReport)
+		assert.Equal(t, numComputedFirst, *numComputed)
+
+		setup.saveHeader(t, &block.Header{Round: 108, Epoch: 2, RandSeed: []byte("seed108"), PrevRandSeed: []byte("seed107"), PubKeysBitmap: []byte{1}})
+		setup.consensus[108] = []string{validatorKey}
+		setup.currentHeader = &block.Header{Round: 108, Epoch: 2}
+		newReport, err := vpp.GetValidatorPerformance(createRequest(nil))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(108), newReport.LastRound)
+		assert.True(t, *numComputed > numComputedFirst)
+	})
+}
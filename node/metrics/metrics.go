@@ -49,6 +49,11 @@ func InitBaseMetrics(statusHandlerUtils StatusHandlersUtils) error {
 	appStatusHandler.SetUInt64Value(common.MetricRoundsPassedInCurrentEpoch, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNoncesPassedInCurrentEpoch, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNumConnectedPeers, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricP2PNumDeniedConnections, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricP2PCompressionBytesSaved, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricP2PInboundBytesPerSec, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricP2POutboundBytesPerSec, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricP2POutboundDroppedBytes, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricEpochForEconomicsData, initUint)
 
 	appStatusHandler.SetStringValue(common.MetricConsensusState, initString)
@@ -65,6 +70,10 @@ func InitBaseMetrics(statusHandlerUtils StatusHandlersUtils) error {
 	appStatusHandler.SetStringValue(common.MetricP2PCrossShardObservers, initString)
 	appStatusHandler.SetStringValue(common.MetricP2PFullHistoryObservers, initString)
 	appStatusHandler.SetStringValue(common.MetricP2PUnknownPeers, initString)
+	appStatusHandler.SetStringValue(common.MetricP2PConnectionPolicy, initString)
+	appStatusHandler.SetStringValue(common.MetricP2PCompressionBytesSavedPerTopic, initString)
+	appStatusHandler.SetStringValue(common.MetricP2PBandwidthPerTopic, initString)
+	appStatusHandler.SetStringValue(common.MetricP2PBandwidthPerPeer, initString)
 
 	appStatusHandler.SetStringValue(common.MetricInflation, initZeroString)
 	appStatusHandler.SetStringValue(common.MetricDevRewardsInEpoch, initZeroString)
@@ -36,6 +36,11 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricRoundsPassedInCurrentEpoch,
 		common.MetricNoncesPassedInCurrentEpoch,
 		common.MetricNumConnectedPeers,
+		common.MetricP2PNumDeniedConnections,
+		common.MetricP2PCompressionBytesSaved,
+		common.MetricP2PInboundBytesPerSec,
+		common.MetricP2POutboundBytesPerSec,
+		common.MetricP2POutboundDroppedBytes,
 		common.MetricEpochForEconomicsData,
 		common.MetricConsensusState,
 		common.MetricConsensusRoundState,
@@ -51,6 +56,10 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricP2PCrossShardObservers,
 		common.MetricP2PFullHistoryObservers,
 		common.MetricP2PUnknownPeers,
+		common.MetricP2PConnectionPolicy,
+		common.MetricP2PCompressionBytesSavedPerTopic,
+		common.MetricP2PBandwidthPerTopic,
+		common.MetricP2PBandwidthPerPeer,
 		common.MetricInflation,
 		common.MetricDevRewardsInEpoch,
 		common.MetricTotalFees,
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ConsensusGroupsHandlerStub -
+type ConsensusGroupsHandlerStub struct {
+	GetConsensusGroupsCalled func(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error)
+}
+
+// GetConsensusGroups -
+func (cghs *ConsensusGroupsHandlerStub) GetConsensusGroups(request *common.ConsensusGroupsRequest) (*common.ConsensusGroupsAPIResponse, error) {
+	if cghs.GetConsensusGroupsCalled != nil {
+		return cghs.GetConsensusGroupsCalled(request)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (cghs *ConsensusGroupsHandlerStub) IsInterfaceNil() bool {
+	return cghs == nil
+}
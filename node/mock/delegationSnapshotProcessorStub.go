@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// DelegationSnapshotProcessorStub -
+type DelegationSnapshotProcessorStub struct {
+	GetDelegationSnapshotCalled func(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error)
+}
+
+// GetDelegationSnapshot -
+func (dsps *DelegationSnapshotProcessorStub) GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+	if dsps.GetDelegationSnapshotCalled != nil {
+		return dsps.GetDelegationSnapshotCalled(contractAddress, ctx)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dsps *DelegationSnapshotProcessorStub) IsInterfaceNil() bool {
+	return dsps == nil
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetGovernanceProposalsCalled func(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled  func(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error)
+}
+
+// GetGovernanceProposals -
+func (gps *GovernanceProcessorStub) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+	if gps.GetGovernanceProposalsCalled != nil {
+		return gps.GetGovernanceProposalsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (gps *GovernanceProcessorStub) GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error) {
+	if gps.GetGovernanceProposalCalled != nil {
+		return gps.GetGovernanceProposalCalled(reference, ctx)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+)
+
+// HistoricalNodesCoordinatorStub -
+type HistoricalNodesCoordinatorStub struct {
+	GetEpochValidatorsPublicKeysCalled func(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error)
+	ComputeConsensusGroupCalled        func(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error)
+}
+
+// GetEpochValidatorsPublicKeys -
+func (hncs *HistoricalNodesCoordinatorStub) GetEpochValidatorsPublicKeys(epoch uint32) (*nodesCoordinator.EpochValidatorsPublicKeys, error) {
+	if hncs.GetEpochValidatorsPublicKeysCalled != nil {
+		return hncs.GetEpochValidatorsPublicKeysCalled(epoch)
+	}
+
+	return &nodesCoordinator.EpochValidatorsPublicKeys{}, nil
+}
+
+// ComputeConsensusGroup -
+func (hncs *HistoricalNodesCoordinatorStub) ComputeConsensusGroup(
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]nodesCoordinator.Validator, error) {
+	if hncs.ComputeConsensusGroupCalled != nil {
+		return hncs.ComputeConsensusGroupCalled(randomness, round, shardID, epoch)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (hncs *HistoricalNodesCoordinatorStub) IsInterfaceNil() bool {
+	return hncs == nil
+}
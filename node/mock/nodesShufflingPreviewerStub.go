@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// NodesShufflingPreviewerStub -
+type NodesShufflingPreviewerStub struct {
+	PreviewShufflingCalled func(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error)
+}
+
+// PreviewShuffling -
+func (nsps *NodesShufflingPreviewerStub) PreviewShuffling(request *common.NodesShufflingPreviewRequest) (*common.NodesShufflingPreviewAPIResponse, error) {
+	if nsps.PreviewShufflingCalled != nil {
+		return nsps.PreviewShufflingCalled(request)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (nsps *NodesShufflingPreviewerStub) IsInterfaceNil() bool {
+	return nsps == nil
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// RewardsBreakdownHandlerStub -
+type RewardsBreakdownHandlerStub struct {
+	GetRewardsBreakdownCalled     func(epoch uint32) (*common.RewardsBreakdownAPIResponse, error)
+	GetNodeRewardsBreakdownCalled func(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error)
+}
+
+// GetRewardsBreakdown -
+func (rbhs *RewardsBreakdownHandlerStub) GetRewardsBreakdown(epoch uint32) (*common.RewardsBreakdownAPIResponse, error) {
+	if rbhs.GetRewardsBreakdownCalled != nil {
+		return rbhs.GetRewardsBreakdownCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetNodeRewardsBreakdown -
+func (rbhs *RewardsBreakdownHandlerStub) GetNodeRewardsBreakdown(epoch uint32, blsKey string) (*common.NodeRewardsBreakdownAPIResponse, error) {
+	if rbhs.GetNodeRewardsBreakdownCalled != nil {
+		return rbhs.GetNodeRewardsBreakdownCalled(epoch, blsKey)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (rbhs *RewardsBreakdownHandlerStub) IsInterfaceNil() bool {
+	return rbhs == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// TokenHoldersProcessorStub -
+type TokenHoldersProcessorStub struct {
+	GetTokenHoldersCalled func(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error)
+}
+
+// GetTokenHolders -
+func (thps *TokenHoldersProcessorStub) GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error) {
+	if thps.GetTokenHoldersCalled != nil {
+		return thps.GetTokenHoldersCalled(tokenIdentifier, nonce, ctx)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (thps *TokenHoldersProcessorStub) IsInterfaceNil() bool {
+	return thps == nil
+}
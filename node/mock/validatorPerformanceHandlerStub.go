@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ValidatorPerformanceHandlerStub -
+type ValidatorPerformanceHandlerStub struct {
+	GetValidatorPerformanceCalled func(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error)
+}
+
+// GetValidatorPerformance -
+func (vphs *ValidatorPerformanceHandlerStub) GetValidatorPerformance(request *common.ValidatorPerformanceRequest) (*common.ValidatorPerformanceAPIResponse, error) {
+	if vphs.GetValidatorPerformanceCalled != nil {
+		return vphs.GetValidatorPerformanceCalled(request)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (vphs *ValidatorPerformanceHandlerStub) IsInterfaceNil() bool {
+	return vphs == nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -22,6 +23,8 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -443,17 +446,89 @@ func (n *Node) GetESDTsRoles(address string, ctx context.Context) (map[string][]
 }
 
 // GetTokenSupply returns the provided token supply from current shard
-func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
-	esdtSupply, err := n.processComponents.HistoryRepository().GetESDTSupply(token)
+func (n *Node) GetTokenSupply(token string) (*common.ESDTSupplyAPIResponse, error) {
+	supply, err := n.processComponents.HistoryRepository().GetESDTSupply(token)
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.ESDTSupply{
-		Supply: bigToString(esdtSupply.Supply),
-		Burned: bigToString(esdtSupply.Burned),
-		Minted: bigToString(esdtSupply.Minted),
-	}, nil
+	return supplyToAPIResponse(supply), nil
+}
+
+// GetTokenNoncesSupplies returns the supplies of the provided collection's nonces from current shard
+func (n *Node) GetTokenNoncesSupplies(collection string, page uint32, size uint32) (*common.ESDTNoncesSuppliesAPIResponse, error) {
+	noncesSupplies, numNonces, err := n.processComponents.HistoryRepository().GetESDTNoncesSupplies(collection, page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.ESDTNoncesSuppliesAPIResponse{
+		Nonces:    make([]*common.ESDTNonceSupplyAPIResponse, 0, len(noncesSupplies)),
+		NumNonces: numNonces,
+	}
+	for _, nonceSupply := range noncesSupplies {
+		response.Nonces = append(response.Nonces, &common.ESDTNonceSupplyAPIResponse{
+			Nonce:                 nonceSupply.Nonce,
+			ESDTSupplyAPIResponse: *supplyToAPIResponse(nonceSupply.Supply),
+		})
+	}
+
+	return response, nil
+}
+
+func supplyToAPIResponse(supply *esdtSupply.SupplyESDT) *common.ESDTSupplyAPIResponse {
+	return &common.ESDTSupplyAPIResponse{
+		Supply:               bigToString(supply.Supply),
+		Minted:               bigToString(supply.Minted),
+		Burned:               bigToString(supply.Burned),
+		Wiped:                bigToString(supply.Wiped),
+		NumHolders:           supply.NumHolders,
+		LastUpdateBlockNonce: supply.LastUpdateBlockNonce,
+	}
+}
+
+// GetAccountTransactions returns the indexed transactions the given address was involved in, most recent first
+func (n *Node) GetAccountTransactions(address string, options common.AccountTransactionsQueryOptions) (*common.AccountTransactionsAPIResponse, error) {
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, numTxs, err := n.processComponents.HistoryRepository().GetAccountTransactions(addressBytes, options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AccountTransactionsAPIResponse{
+		Transactions:    make([]*common.AccountTransactionAPIResponse, 0, len(txs)),
+		NumTransactions: numTxs,
+	}
+	for _, tx := range txs {
+		response.Transactions = append(response.Transactions, &common.AccountTransactionAPIResponse{
+			Hash:       hex.EncodeToString(tx.Hash),
+			Type:       string(miniblockTypeToTxType(block.Type(tx.MiniblockType))),
+			Roles:      accountTransactions.RoleNames(tx.Roles),
+			BlockNonce: tx.BlockNonce,
+			Round:      tx.Round,
+			Epoch:      tx.Epoch,
+			Timestamp:  tx.Timestamp,
+		})
+	}
+
+	return response, nil
+}
+
+func miniblockTypeToTxType(miniblockType block.Type) transaction.TxType {
+	switch miniblockType {
+	case block.SmartContractResultBlock:
+		return transaction.TxTypeUnsigned
+	case block.RewardsBlock:
+		return transaction.TxTypeReward
+	case block.InvalidBlock:
+		return transaction.TxTypeInvalid
+	default:
+		return transaction.TxTypeNormal
+	}
 }
 
 func bigToString(bigValue *big.Int) string {
@@ -954,6 +1029,27 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return peerInfoSlice, nil
 }
 
+// GetPeersRatings returns the ratings of the known peers, keyed by their pretty printed peer IDs
+func (n *Node) GetPeersRatings() (map[string]int32, error) {
+	peersRatings := n.networkComponents.PeersRatingHandler().GetPeersRatings()
+	ratings := make(map[string]int32, len(peersRatings))
+	for pid, rating := range peersRatings {
+		ratings[pid.Pretty()] = rating
+	}
+
+	return ratings, nil
+}
+
+// GetManagedKeysStatus returns the activity status of every BLS key managed by the current node
+func (n *Node) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	managedPeersHolder := n.cryptoComponents.ManagedPeersHolder()
+	if check.IfNil(managedPeersHolder) {
+		return make([]*common.ManagedKeyStatus, 0)
+	}
+
+	return managedPeersHolder.GetManagedKeysStatus()
+}
+
 // GetHardforkTrigger returns the hardfork trigger
 func (n *Node) GetHardforkTrigger() HardforkTrigger {
 	return n.hardforkTrigger
@@ -19,6 +19,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/gin"
+	"github.com/ElrondNetwork/elrond-go/api/grpc"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -28,6 +30,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	dbLookupFactory "github.com/ElrondNetwork/elrond-go/dblookupext/factory"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
@@ -35,6 +38,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -271,7 +275,17 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	}
 
 	log.Debug("creating disabled API services")
-	webServerHandler, err := nr.createHttpServer()
+	requestsThrottlers, err := middleware.NewRequestsThrottlers(nr.configs.GeneralConfig.Antiflood.WebServer)
+	if err != nil {
+		return true, err
+	}
+
+	webServerHandler, err := nr.createHttpServer(requestsThrottlers)
+	if err != nil {
+		return true, err
+	}
+
+	grpcServerHandler, err := nr.createGrpcServer(requestsThrottlers)
 	if err != nil {
 		return true, err
 	}
@@ -290,6 +304,11 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	err = nr.reindexESDTSuppliesIfNeeded(managedCoreComponents, managedBootstrapComponents, managedDataComponents)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating state components")
 	managedStateComponents, err := nr.CreateManagedStateComponents(
 		managedCoreComponents,
@@ -455,7 +474,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	allowExternalVMQueriesChan := make(chan struct{})
 
 	log.Debug("updating the API service after creating the node facade")
-	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
+	ef, err := nr.createApiFacade(currentNode, webServerHandler, grpcServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
 	if err != nil {
 		return true, err
 	}
@@ -478,6 +497,8 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		healthService,
 		ef,
 		webServerHandler,
+		grpcServerHandler,
+		requestsThrottlers,
 		currentNode,
 		goRoutinesNumberStart,
 	)
@@ -491,6 +512,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
+	upgradableGrpcServer shared.UpgradeableGrpcServerHandler,
 	gasScheduleNotifier core.GasScheduleNotifier,
 	allowVMQueriesChan chan struct{},
 ) (closing.Closer, error) {
@@ -548,6 +570,11 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	err = upgradableGrpcServer.UpdateFacade(ef)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("updated node facade")
 
 	log.Trace("starting background services")
@@ -555,11 +582,12 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
-func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, error) {
+func (nr *nodeRunner) createHttpServer(requestsThrottlers shared.RequestsThrottlersHandler) (shared.UpgradeableHttpServerHandler, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
-		Facade:          initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
-		ApiConfig:       *nr.configs.ApiRoutesConfig,
-		AntiFloodConfig: nr.configs.GeneralConfig.Antiflood.WebServer,
+		Facade:             initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
+		ApiConfig:          *nr.configs.ApiRoutesConfig,
+		AntiFloodConfig:    nr.configs.GeneralConfig.Antiflood.WebServer,
+		RequestsThrottlers: requestsThrottlers,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -575,6 +603,26 @@ func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, e
 	return httpServerWrapper, nil
 }
 
+func (nr *nodeRunner) createGrpcServer(requestsThrottlers shared.RequestsThrottlersHandler) (shared.UpgradeableGrpcServerHandler, error) {
+	grpcServerArgs := grpc.ArgsNewGrpcServer{
+		Facade:             initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
+		ApiConfig:          *nr.configs.ApiRoutesConfig,
+		RequestsThrottlers: requestsThrottlers,
+	}
+
+	grpcServer, err := grpc.NewGrpcServer(grpcServerArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpcServer.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpcServer, nil
+}
+
 func (nr *nodeRunner) createMetrics(
 	coreComponents mainFactory.CoreComponentsHolder,
 	cryptoComponents mainFactory.CryptoComponentsHolder,
@@ -750,6 +798,8 @@ func waitForSignal(
 	healthService closing.Closer,
 	ef closing.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer shared.UpgradeableGrpcServerHandler,
+	requestsThrottlers shared.RequestsThrottlersHandler,
 	currentNode *Node,
 	goRoutinesNumberStart int,
 ) error {
@@ -774,7 +824,7 @@ func waitForSignal(
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(healthService, ef, httpServer, currentNode, chanCloseComponents)
+		closeAllComponents(healthService, ef, httpServer, grpcServer, requestsThrottlers, currentNode, chanCloseComponents)
 	}()
 
 	select {
@@ -980,6 +1030,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		Marshalizer:              coreComponents.InternalMarshalizer(),
 		Store:                    dataComponents.StorageService(),
 		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1100,6 +1151,41 @@ func (nr *nodeRunner) CreateManagedDataComponents(
 	return managedDataComponents, nil
 }
 
+func (nr *nodeRunner) reindexESDTSuppliesIfNeeded(
+	coreComponents mainFactory.CoreComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+	dataComponents mainFactory.DataComponentsHandler,
+) error {
+	if !nr.configs.FlagsConfig.ReindexESDTSupplies {
+		return nil
+	}
+	if !nr.configs.GeneralConfig.DbLookupExtensions.Enabled {
+		log.Warn("the ESDT supplies will not be reindexed because DbLookupExtensions is disabled")
+		return nil
+	}
+
+	log.Info("reindexing the ESDT supplies from storage, this might take a while...")
+	reindexer, err := esdtSupply.NewSuppliesReindexer(esdtSupply.ArgsSuppliesReindexer{
+		Marshalizer:              coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		Store:                    dataComponents.StorageService(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
+		AllowPartialHistory:      nr.configs.FlagsConfig.ReindexESDTSuppliesPartially,
+	})
+	if err != nil {
+		return err
+	}
+
+	numBlocks, err := reindexer.Reindex()
+	if err != nil {
+		return fmt.Errorf("%w while reindexing the ESDT supplies", err)
+	}
+
+	log.Info("reindexed the ESDT supplies", "replayed blocks", numBlocks)
+
+	return nil
+}
+
 // CreateManagedStateComponents is the managed state components factory
 func (nr *nodeRunner) CreateManagedStateComponents(
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -1194,6 +1280,9 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		PreferredPublicKeys: decodedPreferredPubKeys,
 		BootstrapWaitTime:   common.TimeToWaitForP2PBootstrap,
 		NodeOperationMode:   p2p.NormalOperation,
+		WorkingDir:          nr.configs.FlagsConfig.WorkingDir,
+		EpochNotifier:       coreComponents.EpochNotifier(),
+		EnableEpochs:        nr.configs.EpochConfig.EnableEpochs,
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
@@ -1264,13 +1353,27 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 ) (mainFactory.CryptoComponentsHandler, error) {
 	configs := nr.configs
 	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
+	passwordProvider := keystore.NewPasswordProvider(keystore.ArgsPasswordProvider{
+		FilePath:      configs.ConfigurationPathsHolder.KeystorePasswordFile,
+		EnvVariable:   common.KeystorePasswordEnvVariable,
+		PromptMessage: fmt.Sprintf("password for %s: ", validatorKeyPemFileName),
+	})
+	keyLoader, err := keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+		PemKeyLoader:     &core.KeyLoader{},
+		PasswordProvider: passwordProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	cryptoComponentsHandlerArgs := mainFactory.CryptoComponentsFactoryArgs{
 		ValidatorKeyPemFileName:              validatorKeyPemFileName,
+		AllValidatorKeysPemFileName:          configs.ConfigurationPathsHolder.AllValidatorKeys,
 		SkIndex:                              configs.FlagsConfig.ValidatorKeyIndex,
 		Config:                               *configs.GeneralConfig,
 		CoreComponentsHolder:                 coreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            &core.KeyLoader{},
+		KeyLoader:                            keyLoader,
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 	}
@@ -1297,6 +1400,8 @@ func closeAllComponents(
 	healthService io.Closer,
 	facade mainFactory.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer shared.UpgradeableGrpcServerHandler,
+	requestsThrottlers shared.RequestsThrottlersHandler,
 	node *Node,
 	chanCloseComponents chan struct{},
 ) {
@@ -1307,6 +1412,12 @@ func closeAllComponents(
 	log.Debug("closing http server")
 	log.LogIfError(httpServer.Close())
 
+	log.Debug("closing gRPC server")
+	log.LogIfError(grpcServer.Close())
+
+	log.Debug("closing API requests throttlers")
+	log.LogIfError(requestsThrottlers.Close())
+
 	log.Debug("closing facade")
 	log.LogIfError(facade.Close())
 
@@ -400,6 +400,8 @@ func getDefaultCryptoComponents() *factoryMock.CryptoComponentsMock {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		ManagedPeers:    &cryptoMocks.ManagedPeersHolderStub{},
+		Signing:         &cryptoMocks.SigningHandlerStub{},
 	}
 }
 
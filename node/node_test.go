@@ -20,7 +20,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
 	"github.com/ElrondNetwork/elrond-go-core/data"
-	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -30,6 +29,7 @@ import (
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	factoryMock "github.com/ElrondNetwork/elrond-go/factory/mock"
@@ -40,6 +40,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/bootstrapMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
@@ -3083,6 +3084,71 @@ func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, node.ErrUnknownPeerID))
 }
 
+func TestNode_GetPeersRatings(t *testing.T) {
+	t.Parallel()
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	networkComponents := getDefaultNetworkComponents()
+	networkComponents.PeersRatingHandlerField = &p2pmocks.PeersRatingHandlerStub{
+		GetPeersRatingsCalled: func() map[core.PeerID]int32 {
+			return map[core.PeerID]int32{
+				pid1: 20,
+				pid2: -5,
+			}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithNetworkComponents(networkComponents),
+	)
+
+	ratings, err := n.GetPeersRatings()
+	assert.Nil(t, err)
+	expectedRatings := map[string]int32{
+		pid1.Pretty(): 20,
+		pid2.Pretty(): -5,
+	}
+	assert.Equal(t, expectedRatings, ratings)
+}
+
+func TestNode_GetManagedKeysStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should return empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		cryptoComponents := getDefaultCryptoComponents()
+		cryptoComponents.ManagedPeers = nil
+		n, _ := node.NewNode(
+			node.WithCryptoComponents(cryptoComponents),
+		)
+
+		assert.Equal(t, make([]*common.ManagedKeyStatus, 0), n.GetManagedKeysStatus())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatus := []*common.ManagedKeyStatus{
+			{
+				PublicKey:            "pk",
+				NumRoundsInConsensus: 5,
+			},
+		}
+		cryptoComponents := getDefaultCryptoComponents()
+		cryptoComponents.ManagedPeers = &cryptoMocks.ManagedPeersHolderStub{
+			GetManagedKeysStatusCalled: func() []*common.ManagedKeyStatus {
+				return expectedStatus
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCryptoComponents(cryptoComponents),
+		)
+
+		assert.Equal(t, expectedStatus, n.GetManagedKeysStatus())
+	})
+}
+
 func TestNode_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -3579,8 +3645,11 @@ func TestGetESDTSupply(t *testing.T) {
 	historyProc := &dblookupext.HistoryRepositoryStub{
 		GetESDTSupplyCalled: func(token string) (*esdtSupply.SupplyESDT, error) {
 			return &esdtSupply.SupplyESDT{
-				Supply: big.NewInt(100),
-				Minted: big.NewInt(15),
+				Supply:               big.NewInt(100),
+				Minted:               big.NewInt(15),
+				Wiped:                big.NewInt(5),
+				NumHolders:           3,
+				LastUpdateBlockNonce: 37,
 			}, nil
 		},
 	}
@@ -3594,13 +3663,129 @@ func TestGetESDTSupply(t *testing.T) {
 	supply, err := n.GetTokenSupply("my-token")
 	require.Nil(t, err)
 
-	require.Equal(t, &api.ESDTSupply{
-		Supply: "100",
-		Burned: "0",
-		Minted: "15",
+	require.Equal(t, &common.ESDTSupplyAPIResponse{
+		Supply:               "100",
+		Burned:               "0",
+		Minted:               "15",
+		Wiped:                "5",
+		NumHolders:           3,
+		LastUpdateBlockNonce: 37,
 	}, supply)
 }
 
+func TestNode_GetTokenNoncesSupplies(t *testing.T) {
+	t.Parallel()
+
+	historyProc := &dblookupext.HistoryRepositoryStub{
+		GetESDTNoncesSuppliesCalled: func(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error) {
+			require.Equal(t, "NFT-abcdef", collection)
+			require.Equal(t, uint32(1), page)
+			require.Equal(t, uint32(2), size)
+
+			return []*esdtSupply.NonceSupplyESDT{
+				{
+					Nonce: 3,
+					Supply: &esdtSupply.SupplyESDT{
+						Supply:     big.NewInt(1),
+						Minted:     big.NewInt(1),
+						NumHolders: 1,
+					},
+				},
+			}, 3, nil
+		},
+	}
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = historyProc
+
+	n, _ := node.NewNode(
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	response, err := n.GetTokenNoncesSupplies("NFT-abcdef", 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, &common.ESDTNoncesSuppliesAPIResponse{
+		Nonces: []*common.ESDTNonceSupplyAPIResponse{
+			{
+				Nonce: 3,
+				ESDTSupplyAPIResponse: common.ESDTSupplyAPIResponse{
+					Supply:     "1",
+					Minted:     "1",
+					Burned:     "0",
+					Wiped:      "0",
+					NumHolders: 1,
+				},
+			},
+		},
+		NumNonces: 3,
+	}, response)
+}
+
+func TestNode_GetAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	expectedOptions := common.AccountTransactionsQueryOptions{Page: 1, Size: 2}
+	historyProc := &dblookupext.HistoryRepositoryStub{
+		GetAccountTransactionsCalled: func(addressBytes []byte, options common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error) {
+			require.Equal(t, address, hex.EncodeToString(addressBytes))
+			require.Equal(t, expectedOptions, options)
+
+			return []*accountTransactions.AccountTransaction{
+				{
+					Hash:          []byte("scr"),
+					MiniblockType: int32(block.SmartContractResultBlock),
+					Roles:         accountTransactions.RoleReceiver,
+					BlockNonce:    4,
+					Round:         5,
+					Epoch:         1,
+					Timestamp:     100,
+				},
+				{
+					Hash:          []byte("tx"),
+					MiniblockType: int32(block.TxBlock),
+					Roles:         accountTransactions.RoleSender | accountTransactions.RoleESDTSender,
+					BlockNonce:    3,
+				},
+			}, 7, nil
+		},
+	}
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = historyProc
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	response, err := n.GetAccountTransactions(address, expectedOptions)
+	require.Nil(t, err)
+	require.Equal(t, &common.AccountTransactionsAPIResponse{
+		Transactions: []*common.AccountTransactionAPIResponse{
+			{
+				Hash:       hex.EncodeToString([]byte("scr")),
+				Type:       string(transaction.TxTypeUnsigned),
+				Roles:      []string{"receiver"},
+				BlockNonce: 4,
+				Round:      5,
+				Epoch:      1,
+				Timestamp:  100,
+			},
+			{
+				Hash:       hex.EncodeToString([]byte("tx")),
+				Type:       string(transaction.TxTypeNormal),
+				Roles:      []string{"sender", "esdtSender"},
+				BlockNonce: 3,
+			},
+		},
+		NumTransactions: 7,
+	}, response)
+
+	_, err = n.GetAccountTransactions("not a hex address", expectedOptions)
+	require.NotNil(t, err)
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,520 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const (
+	delegationSnapshotsCacheSize = 100
+
+	delegationOwnerKey      = "owner"
+	delegationConfigKey     = "delegationConfig"
+	delegationStatusKey     = "delegationStatus"
+	delegationMetaDataKey   = "delegationMetaData"
+	delegationGlobalFundKey = "globalFund"
+	delegationServiceFeeKey = "serviceFee"
+	delegationRewardPrefix  = "reward"
+	delegationFundPrefix    = "fund"
+
+	nodeStatusStaked    = "staked"
+	nodeStatusNotStaked = "notStaked"
+	nodeStatusUnStaked  = "unStaked"
+)
+
+// ArgDelegationSnapshotProcessor represents the arguments DTO used in the delegation snapshot processor constructor
+type ArgDelegationSnapshotProcessor struct {
+	ArgTrieIteratorProcessor
+	Marshalizer   marshal.Marshalizer
+	BlockChain    data.ChainHandler
+	MaxServiceFee uint64
+}
+
+type delegationSnapshotProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+	blockChain         data.ChainHandler
+	maxServiceFee      uint64
+	cache              storage.Cacher
+}
+
+// delegationRecords holds the decoded records of a delegation contract storage
+type delegationRecords struct {
+	owner      []byte
+	serviceFee uint64
+	config     *systemSmartContracts.DelegationConfig
+	status     *systemSmartContracts.DelegationContractStatus
+	metaData   *systemSmartContracts.DelegationMetaData
+	globalFund *systemSmartContracts.GlobalFundData
+	delegators map[string]*systemSmartContracts.DelegatorData
+	funds      map[string]*systemSmartContracts.Fund
+	rewards    map[uint32]*systemSmartContracts.RewardComputationData
+}
+
+// NewDelegationSnapshotProcessor will create a new instance of delegationSnapshotProcessor
+func NewDelegationSnapshotProcessor(arg ArgDelegationSnapshotProcessor) (*delegationSnapshotProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	if arg.MaxServiceFee == 0 {
+		return nil, ErrInvalidMaxServiceFee
+	}
+
+	cache, err := lrucache.NewCache(delegationSnapshotsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delegationSnapshotProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+		blockChain:         arg.BlockChain,
+		maxServiceFee:      arg.MaxServiceFee,
+		cache:              cache,
+	}, nil
+}
+
+// GetDelegationSnapshot will return the decoded snapshot of the provided delegation contract: its configuration, nodes,
+// totals, delegators and rewards history. The snapshot is computed in a single pass over the contract's data trie and
+// it is cached per state root hash
+func (dsp *delegationSnapshotProcessor) GetDelegationSnapshot(contractAddress string, ctx context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+	contract, err := dsp.publicKeyConverter.Decode(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	dsp.accounts.Lock()
+	defer dsp.accounts.Unlock()
+
+	rootHash, err := dsp.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := append(append([]byte{}, rootHash...), contract...)
+	cachedResponse, found := dsp.cache.Get(cacheKey)
+	if found {
+		response, ok := cachedResponse.(*common.DelegationSnapshotAPIResponse)
+		if ok {
+			return response, nil
+		}
+	}
+
+	delegationAccount, err := dsp.getAccount(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := dsp.getDelegationRecords(delegationAccount, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if records.config == nil {
+		return nil, ErrNotADelegationContract
+	}
+
+	response := dsp.buildSnapshot(contractAddress, rootHash, records)
+	dsp.cache.Put(cacheKey, response, 0)
+
+	return response, nil
+}
+
+func (dsp *delegationSnapshotProcessor) getDelegationRecords(delegationAccount state.UserAccountHandler, ctx context.Context) (*delegationRecords, error) {
+	records := &delegationRecords{
+		delegators: make(map[string]*systemSmartContracts.DelegatorData),
+		funds:      make(map[string]*systemSmartContracts.Fund),
+		rewards:    make(map[uint32]*systemSmartContracts.RewardComputationData),
+	}
+	if check.IfNil(delegationAccount.DataTrie()) {
+		return records, nil
+	}
+
+	rootHash, err := delegationAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = delegationAccount.DataTrie().GetAllLeavesOnChannel(chLeaves, ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for leaf := range chLeaves {
+		suffix := append(leaf.Key(), delegationAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			continue
+		}
+
+		// records that cannot be decoded are skipped, the snapshot reflecting only the well formed ones
+		_ = dsp.addRecord(records, leaf.Key(), value)
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return records, nil
+}
+
+func (dsp *delegationSnapshotProcessor) addRecord(records *delegationRecords, key []byte, value []byte) error {
+	if len(key) == dsp.publicKeyConverter.Len() {
+		delegator := &systemSmartContracts.DelegatorData{}
+		err := dsp.marshalizer.Unmarshal(delegator, value)
+		if err != nil {
+			return err
+		}
+
+		records.delegators[string(key)] = delegator
+		return nil
+	}
+
+	switch string(key) {
+	case delegationOwnerKey:
+		records.owner = value
+		return nil
+	case delegationServiceFeeKey:
+		records.serviceFee = big.NewInt(0).SetBytes(value).Uint64()
+		return nil
+	case delegationConfigKey:
+		config := &systemSmartContracts.DelegationConfig{}
+		err := dsp.marshalizer.Unmarshal(config, value)
+		if err != nil {
+			return err
+		}
+
+		records.config = config
+		return nil
+	case delegationStatusKey:
+		status := &systemSmartContracts.DelegationContractStatus{}
+		err := dsp.marshalizer.Unmarshal(status, value)
+		if err != nil {
+			return err
+		}
+
+		records.status = status
+		return nil
+	case delegationMetaDataKey:
+		metaData := &systemSmartContracts.DelegationMetaData{}
+		err := dsp.marshalizer.Unmarshal(metaData, value)
+		if err != nil {
+			return err
+		}
+
+		records.metaData = metaData
+		return nil
+	case delegationGlobalFundKey:
+		globalFund := &systemSmartContracts.GlobalFundData{}
+		err := dsp.marshalizer.Unmarshal(globalFund, value)
+		if err != nil {
+			return err
+		}
+
+		records.globalFund = globalFund
+		return nil
+	}
+
+	if bytes.HasPrefix(key, []byte(delegationFundPrefix)) {
+		fund := &systemSmartContracts.Fund{}
+		err := dsp.marshalizer.Unmarshal(fund, value)
+		if err != nil {
+			return err
+		}
+
+		records.funds[string(key)] = fund
+		return nil
+	}
+
+	if bytes.HasPrefix(key, []byte(delegationRewardPrefix)) {
+		reward := &systemSmartContracts.RewardComputationData{}
+		err := dsp.marshalizer.Unmarshal(reward, value)
+		if err != nil {
+			return err
+		}
+
+		epoch := big.NewInt(0).SetBytes(key[len(delegationRewardPrefix):]).Uint64()
+		records.rewards[uint32(epoch)] = reward
+	}
+
+	return nil
+}
+
+func (dsp *delegationSnapshotProcessor) buildSnapshot(contractAddress string, rootHash []byte, records *delegationRecords) *common.DelegationSnapshotAPIResponse {
+	currentEpoch := dsp.getCurrentEpoch()
+	rewardEpochs := sortedRewardEpochs(records.rewards)
+
+	response := &common.DelegationSnapshotAPIResponse{
+		Contract:          contractAddress,
+		RootHash:          hex.EncodeToString(rootHash),
+		Epoch:             currentEpoch,
+		Config:            configToAPIResponse(records.config),
+		ServiceFee:        records.serviceFee,
+		ServiceFeeHistory: computeServiceFeeHistory(rewardEpochs, records.rewards),
+		Nodes:             nodesToAPIResponse(records.status),
+		TotalActiveStake:  "0",
+		TotalUnStaked:     "0",
+		RewardsHistory:    make([]*common.DelegationRewardAPIResponse, 0, len(rewardEpochs)),
+	}
+	if len(records.owner) > 0 {
+		response.Owner = dsp.publicKeyConverter.Encode(records.owner)
+	}
+	if records.metaData != nil {
+		response.Name = string(records.metaData.Name)
+		response.Website = string(records.metaData.Website)
+		response.Identifier = string(records.metaData.Identifier)
+	}
+	if records.status != nil {
+		response.NumUsers = records.status.NumUsers
+	}
+	if records.globalFund != nil {
+		response.TotalActiveStake = valueOrZero(records.globalFund.TotalActive).String()
+		response.TotalUnStaked = valueOrZero(records.globalFund.TotalUnStaked).String()
+	}
+
+	for _, epoch := range rewardEpochs {
+		reward := records.rewards[epoch]
+		response.RewardsHistory = append(response.RewardsHistory, &common.DelegationRewardAPIResponse{
+			Epoch:               epoch,
+			RewardsToDistribute: valueOrZero(reward.RewardsToDistribute).String(),
+			TotalActive:         valueOrZero(reward.TotalActive).String(),
+			ServiceFee:          reward.ServiceFee,
+		})
+	}
+
+	response.Delegators, response.TotalUnBondable = dsp.buildDelegators(records, currentEpoch)
+
+	return response
+}
+
+func (dsp *delegationSnapshotProcessor) buildDelegators(records *delegationRecords, currentEpoch uint32) ([]*common.DelegationDelegatorAPIResponse, string) {
+	addresses := make([]string, 0, len(records.delegators))
+	activeStakes := make(map[string]*big.Int, len(records.delegators))
+	for address, delegator := range records.delegators {
+		addresses = append(addresses, address)
+		activeStakes[address] = records.getFundValue(delegator.ActiveFund)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		comparison := activeStakes[addresses[i]].Cmp(activeStakes[addresses[j]])
+		if comparison != 0 {
+			return comparison > 0
+		}
+
+		return addresses[i] < addresses[j]
+	})
+
+	totalUnBondable := big.NewInt(0)
+	delegators := make([]*common.DelegationDelegatorAPIResponse, 0, len(addresses))
+	for _, address := range addresses {
+		delegator := records.delegators[address]
+		unStaked, unBondable := records.computeUnStakedFunds(delegator, currentEpoch)
+		totalUnBondable.Add(totalUnBondable, unBondable)
+
+		isOwner := bytes.Equal([]byte(address), records.owner)
+		claimableRewards := dsp.computeClaimableRewards(records, delegator, activeStakes[address], isOwner, currentEpoch)
+
+		delegators = append(delegators, &common.DelegationDelegatorAPIResponse{
+			Address:               dsp.publicKeyConverter.Encode([]byte(address)),
+			ActiveStake:           activeStakes[address].String(),
+			UnStaked:              unStaked.String(),
+			UnBondable:            unBondable.String(),
+			ClaimableRewards:      claimableRewards.String(),
+			TotalCumulatedRewards: valueOrZero(delegator.TotalCumulatedRewards).String(),
+		})
+	}
+
+	return delegators, totalUnBondable.String()
+}
+
+// computeClaimableRewards mirrors the delegation contract's rewards computation: the rewards already credited to the
+// delegator plus its share of the rewards distributed since its last checkpoint
+func (dsp *delegationSnapshotProcessor) computeClaimableRewards(
+	records *delegationRecords,
+	delegator *systemSmartContracts.DelegatorData,
+	activeStake *big.Int,
+	isOwner bool,
+	currentEpoch uint32,
+) *big.Int {
+	claimableRewards := big.NewInt(0).Set(valueOrZero(delegator.UnClaimedRewards))
+	if len(delegator.ActiveFund) == 0 {
+		return claimableRewards
+	}
+
+	for epoch := delegator.RewardsCheckpoint; epoch <= currentEpoch; epoch++ {
+		reward, found := records.rewards[epoch]
+		if !found {
+			continue
+		}
+
+		rewardsToDistribute := valueOrZero(reward.RewardsToDistribute)
+		totalActive := valueOrZero(reward.TotalActive)
+		if totalActive.Sign() == 0 {
+			if isOwner {
+				claimableRewards.Add(claimableRewards, rewardsToDistribute)
+			}
+			continue
+		}
+
+		percentage := float64(reward.ServiceFee) / float64(dsp.maxServiceFee)
+		rewardsForOwner := core.GetIntTrimmedPercentageOfValue(rewardsToDistribute, percentage)
+		rewardForDelegator := big.NewInt(0).Sub(rewardsToDistribute, rewardsForOwner)
+		rewardForDelegator.Mul(rewardForDelegator, activeStake)
+		rewardForDelegator.Div(rewardForDelegator, totalActive)
+
+		if isOwner {
+			claimableRewards.Add(claimableRewards, rewardsForOwner)
+		}
+		claimableRewards.Add(claimableRewards, rewardForDelegator)
+	}
+
+	return claimableRewards
+}
+
+func (dsp *delegationSnapshotProcessor) getCurrentEpoch() uint32 {
+	currentHeader := dsp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetEpoch()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsp *delegationSnapshotProcessor) IsInterfaceNil() bool {
+	return dsp == nil
+}
+
+func (dr *delegationRecords) getFundValue(fundKey []byte) *big.Int {
+	fund, found := dr.funds[string(fundKey)]
+	if !found || len(fundKey) == 0 {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(valueOrZero(fund.Value))
+}
+
+func (dr *delegationRecords) computeUnStakedFunds(delegator *systemSmartContracts.DelegatorData, currentEpoch uint32) (*big.Int, *big.Int) {
+	unBondPeriod := uint32(0)
+	if dr.config != nil {
+		unBondPeriod = dr.config.UnBondPeriodInEpochs
+	}
+
+	unStaked := big.NewInt(0)
+	unBondable := big.NewInt(0)
+	for _, fundKey := range delegator.UnStakedFunds {
+		fund, found := dr.funds[string(fundKey)]
+		if !found {
+			continue
+		}
+
+		value := valueOrZero(fund.Value)
+		unStaked.Add(unStaked, value)
+		if currentEpoch >= fund.Epoch && currentEpoch-fund.Epoch >= unBondPeriod {
+			unBondable.Add(unBondable, value)
+		}
+	}
+
+	return unStaked, unBondable
+}
+
+func sortedRewardEpochs(rewards map[uint32]*systemSmartContracts.RewardComputationData) []uint32 {
+	epochs := make([]uint32, 0, len(rewards))
+	for epoch := range rewards {
+		epochs = append(epochs, epoch)
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs
+}
+
+// computeServiceFeeHistory returns the epochs in which the service fee applied on the distributed rewards changed, as
+// the contract only keeps the current service fee
+func computeServiceFeeHistory(
+	sortedEpochs []uint32,
+	rewards map[uint32]*systemSmartContracts.RewardComputationData,
+) []*common.DelegationServiceFeeAPIResponse {
+	history := make([]*common.DelegationServiceFeeAPIResponse, 0)
+	for _, epoch := range sortedEpochs {
+		serviceFee := rewards[epoch].ServiceFee
+		if len(history) > 0 && history[len(history)-1].ServiceFee == serviceFee {
+			continue
+		}
+
+		history = append(history, &common.DelegationServiceFeeAPIResponse{
+			Epoch:      epoch,
+			ServiceFee: serviceFee,
+		})
+	}
+
+	return history
+}
+
+func configToAPIResponse(config *systemSmartContracts.DelegationConfig) *common.DelegationConfigAPIResponse {
+	if config == nil {
+		return nil
+	}
+
+	return &common.DelegationConfigAPIResponse{
+		MaxDelegationCap:            valueOrZero(config.MaxDelegationCap).String(),
+		InitialOwnerFunds:           valueOrZero(config.InitialOwnerFunds).String(),
+		AutomaticActivation:         config.AutomaticActivation,
+		ChangeableServiceFee:        config.ChangeableServiceFee,
+		CheckCapOnReDelegateRewards: config.CheckCapOnReDelegateRewards,
+		CreatedNonce:                config.CreatedNonce,
+		UnBondPeriodInEpochs:        config.UnBondPeriodInEpochs,
+	}
+}
+
+func nodesToAPIResponse(status *systemSmartContracts.DelegationContractStatus) []*common.DelegationNodeAPIResponse {
+	nodes := make([]*common.DelegationNodeAPIResponse, 0)
+	if status == nil {
+		return nodes
+	}
+
+	appendNodes := func(nodesData []*systemSmartContracts.NodesData, nodeStatus string) {
+		for _, nodeData := range nodesData {
+			if nodeData == nil {
+				continue
+			}
+
+			nodes = append(nodes, &common.DelegationNodeAPIResponse{
+				BLSKey: hex.EncodeToString(nodeData.BLSKey),
+				Status: nodeStatus,
+			})
+		}
+	}
+	appendNodes(status.StakedKeys, nodeStatusStaked)
+	appendNodes(status.NotStakedKeys, nodeStatusNotStaked)
+	appendNodes(status.UnStakedKeys, nodeStatusUnStaked)
+
+	return nodes
+}
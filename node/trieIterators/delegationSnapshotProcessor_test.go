@@ -0,0 +1,289 @@
+package trieIterators
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsDelegationSnapshot() ArgDelegationSnapshotProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+
+	return ArgDelegationSnapshotProcessor{
+		ArgTrieIteratorProcessor: arg,
+		Marshalizer:              &testscommon.MarshalizerMock{},
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Epoch: 10}
+			},
+		},
+		MaxServiceFee: 10000,
+	}
+}
+
+func createTestAddress(firstByte byte) []byte {
+	address := make([]byte, 32)
+	address[0] = firstByte
+
+	return address
+}
+
+func createDelegationRecords(t *testing.T, owner []byte, delegator []byte) map[string][]byte {
+	marshalizer := &testscommon.MarshalizerMock{}
+	records := make(map[string][]byte)
+	addRecord := func(key []byte, record interface{}) {
+		recordBytes, err := marshalizer.Marshal(record)
+		require.Nil(t, err)
+		records[string(key)] = recordBytes
+	}
+	fundKey := func(index byte) []byte {
+		return append([]byte(delegationFundPrefix), index)
+	}
+	rewardKey := func(epoch int64) []byte {
+		return append([]byte(delegationRewardPrefix), big.NewInt(epoch).Bytes()...)
+	}
+
+	records[delegationOwnerKey] = owner
+	records[delegationServiceFeeKey] = big.NewInt(2000).Bytes()
+	addRecord([]byte(delegationConfigKey), &systemSmartContracts.DelegationConfig{
+		MaxDelegationCap:     big.NewInt(0),
+		InitialOwnerFunds:    big.NewInt(1000),
+		ChangeableServiceFee: true,
+		CreatedNonce:         7,
+		UnBondPeriodInEpochs: 5,
+	})
+	addRecord([]byte(delegationStatusKey), &systemSmartContracts.DelegationContractStatus{
+		StakedKeys:   []*systemSmartContracts.NodesData{{BLSKey: []byte("bls1")}},
+		UnStakedKeys: []*systemSmartContracts.NodesData{{BLSKey: []byte("bls2")}},
+		NumUsers:     2,
+	})
+	addRecord([]byte(delegationMetaDataKey), &systemSmartContracts.DelegationMetaData{
+		Name:       []byte("provider"),
+		Website:    []byte("provider.com"),
+		Identifier: []byte("prv"),
+	})
+	addRecord([]byte(delegationGlobalFundKey), &systemSmartContracts.GlobalFundData{
+		TotalActive:   big.NewInt(4000),
+		TotalUnStaked: big.NewInt(700),
+	})
+	addRecord(fundKey(1), &systemSmartContracts.Fund{Value: big.NewInt(1000), Address: owner, Epoch: 1})
+	addRecord(fundKey(2), &systemSmartContracts.Fund{Value: big.NewInt(3000), Address: delegator, Epoch: 1})
+	addRecord(fundKey(3), &systemSmartContracts.Fund{Value: big.NewInt(500), Address: delegator, Epoch: 8})
+	addRecord(fundKey(4), &systemSmartContracts.Fund{Value: big.NewInt(200), Address: delegator, Epoch: 2})
+	addRecord(owner, &systemSmartContracts.DelegatorData{
+		ActiveFund:            fundKey(1),
+		RewardsCheckpoint:     10,
+		UnClaimedRewards:      big.NewInt(0),
+		TotalCumulatedRewards: big.NewInt(50),
+	})
+	addRecord(delegator, &systemSmartContracts.DelegatorData{
+		ActiveFund:            fundKey(2),
+		UnStakedFunds:         [][]byte{fundKey(3), fundKey(4)},
+		RewardsCheckpoint:     9,
+		UnClaimedRewards:      big.NewInt(7),
+		TotalCumulatedRewards: big.NewInt(70),
+	})
+	addRecord(rewardKey(5), &systemSmartContracts.RewardComputationData{
+		RewardsToDistribute: big.NewInt(400),
+		TotalActive:         big.NewInt(4000),
+		ServiceFee:          1000,
+	})
+	addRecord(rewardKey(9), &systemSmartContracts.RewardComputationData{
+		RewardsToDistribute: big.NewInt(1000),
+		TotalActive:         big.NewInt(4000),
+		ServiceFee:          1000,
+	})
+	addRecord(rewardKey(10), &systemSmartContracts.RewardComputationData{
+		RewardsToDistribute: big.NewInt(2000),
+		TotalActive:         big.NewInt(4000),
+		ServiceFee:          2000,
+	})
+	records["lastFund"] = fundKey(4)
+
+	return records
+}
+
+func createDelegationAccountsStub(contract []byte, records map[string][]byte, numIterations *int, timeSleep time.Duration) *stateMock.AccountsStub {
+	acc, _ := state.NewUserAccount(contract)
+	acc.SetRootHash([]byte("root hash"))
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+			*numIterations++
+			go func() {
+				time.Sleep(timeSleep)
+				for key, value := range records {
+					value = append(append([]byte{}, value...), key...)
+					value = append(value, contract...)
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), value)
+				}
+
+				close(ch)
+			}()
+
+			return nil
+		},
+	})
+
+	return &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("main root hash"), nil
+		},
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+}
+
+func TestNewDelegationSnapshotProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsDelegationSnapshot()
+	arg.Accounts = nil
+	dsp, err := NewDelegationSnapshotProcessor(arg)
+	assert.True(t, check.IfNil(dsp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	arg = createMockArgsDelegationSnapshot()
+	arg.Marshalizer = nil
+	dsp, err = NewDelegationSnapshotProcessor(arg)
+	assert.True(t, check.IfNil(dsp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	arg = createMockArgsDelegationSnapshot()
+	arg.BlockChain = nil
+	dsp, err = NewDelegationSnapshotProcessor(arg)
+	assert.True(t, check.IfNil(dsp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	arg = createMockArgsDelegationSnapshot()
+	arg.MaxServiceFee = 0
+	dsp, err = NewDelegationSnapshotProcessor(arg)
+	assert.True(t, check.IfNil(dsp))
+	assert.Equal(t, ErrInvalidMaxServiceFee, err)
+
+	dsp, err = NewDelegationSnapshotProcessor(createMockArgsDelegationSnapshot())
+	assert.False(t, check.IfNil(dsp))
+	assert.Nil(t, err)
+}
+
+func TestDelegationSnapshotProcessor_GetDelegationSnapshotShouldWork(t *testing.T) {
+	t.Parallel()
+
+	contract := createTestAddress(9)
+	owner := createTestAddress(1)
+	delegator := createTestAddress(2)
+	numIterations := 0
+
+	arg := createMockArgsDelegationSnapshot()
+	records := createDelegationRecords(t, owner, delegator)
+	arg.Accounts.AccountsAdapter = createDelegationAccountsStub(contract, records, &numIterations, 0)
+	dsp, _ := NewDelegationSnapshotProcessor(arg)
+
+	contractAddress := arg.PublicKeyConverter.Encode(contract)
+	snapshot, err := dsp.GetDelegationSnapshot(contractAddress, context.Background())
+	require.Nil(t, err)
+
+	assert.Equal(t, contractAddress, snapshot.Contract)
+	assert.Equal(t, uint32(10), snapshot.Epoch)
+	assert.Equal(t, arg.PublicKeyConverter.Encode(owner), snapshot.Owner)
+	assert.Equal(t, "provider", snapshot.Name)
+	assert.Equal(t, uint64(2000), snapshot.ServiceFee)
+	assert.Equal(t, uint32(5), snapshot.Config.UnBondPeriodInEpochs)
+	assert.Equal(t, uint64(2), snapshot.NumUsers)
+	assert.Equal(t, "4000", snapshot.TotalActiveStake)
+	assert.Equal(t, "700", snapshot.TotalUnStaked)
+	assert.Equal(t, "200", snapshot.TotalUnBondable)
+	assert.Equal(t, []*common.DelegationNodeAPIResponse{
+		{BLSKey: "626c7331", Status: nodeStatusStaked},
+		{BLSKey: "626c7332", Status: nodeStatusUnStaked},
+	}, snapshot.Nodes)
+	assert.Equal(t, []*common.DelegationServiceFeeAPIResponse{
+		{Epoch: 5, ServiceFee: 1000},
+		{Epoch: 10, ServiceFee: 2000},
+	}, snapshot.ServiceFeeHistory)
+	require.Equal(t, 3, len(snapshot.RewardsHistory))
+	assert.Equal(t, uint32(9), snapshot.RewardsHistory[1].Epoch)
+	assert.Equal(t, "1000", snapshot.RewardsHistory[1].RewardsToDistribute)
+
+	// epoch 9: (1000 - 10%) * 3000 / 4000 = 675, epoch 10: (2000 - 20%) * 3000 / 4000 = 1200
+	// the owner gets its service fee and its stake share for epoch 10: 400 + 1600 * 1000 / 4000 = 800
+	assert.Equal(t, []*common.DelegationDelegatorAPIResponse{
+		{
+			Address:               arg.PublicKeyConverter.Encode(delegator),
+			ActiveStake:           "3000",
+			UnStaked:              "700",
+			UnBondable:            "200",
+			ClaimableRewards:      "1882",
+			TotalCumulatedRewards: "70",
+		},
+		{
+			Address:               arg.PublicKeyConverter.Encode(owner),
+			ActiveStake:           "1000",
+			UnStaked:              "0",
+			UnBondable:            "0",
+			ClaimableRewards:      "800",
+			TotalCumulatedRewards: "50",
+		},
+	}, snapshot.Delegators)
+
+	// same root hash, the snapshot should be returned from the cache
+	_, err = dsp.GetDelegationSnapshot(contractAddress, context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, 1, numIterations)
+}
+
+func TestDelegationSnapshotProcessor_GetDelegationSnapshotNotADelegationContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	contract := createTestAddress(9)
+	numIterations := 0
+
+	arg := createMockArgsDelegationSnapshot()
+	records := map[string][]byte{"key": []byte("value")}
+	arg.Accounts.AccountsAdapter = createDelegationAccountsStub(contract, records, &numIterations, 0)
+	dsp, _ := NewDelegationSnapshotProcessor(arg)
+
+	snapshot, err := dsp.GetDelegationSnapshot(arg.PublicKeyConverter.Encode(contract), context.Background())
+	assert.Nil(t, snapshot)
+	assert.Equal(t, ErrNotADelegationContract, err)
+}
+
+func TestDelegationSnapshotProcessor_GetDelegationSnapshotContextShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	contract := createTestAddress(9)
+	numIterations := 0
+
+	arg := createMockArgsDelegationSnapshot()
+	records := createDelegationRecords(t, createTestAddress(1), createTestAddress(2))
+	arg.Accounts.AccountsAdapter = createDelegationAccountsStub(contract, records, &numIterations, time.Second)
+	dsp, _ := NewDelegationSnapshotProcessor(arg)
+
+	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	snapshot, err := dsp.GetDelegationSnapshot(arg.PublicKeyConverter.Encode(contract), ctxWithTimeout)
+	assert.Nil(t, snapshot)
+	assert.Equal(t, ErrTrieOperationsTimeout, err)
+}
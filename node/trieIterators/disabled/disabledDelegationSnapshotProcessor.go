@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnDelegationSnapshotFromShardNode = errors.New("delegation snapshots cannot be returned by a shard node")
+
+type delegationSnapshotProcessor struct{}
+
+// NewDisabledDelegationSnapshotProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledDelegationSnapshotProcessor() *delegationSnapshotProcessor {
+	return &delegationSnapshotProcessor{}
+}
+
+// GetDelegationSnapshot returns the errCannotReturnDelegationSnapshotFromShardNode error
+func (dsp *delegationSnapshotProcessor) GetDelegationSnapshot(_ string, _ context.Context) (*common.DelegationSnapshotAPIResponse, error) {
+	return nil, errCannotReturnDelegationSnapshotFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsp *delegationSnapshotProcessor) IsInterfaceNil() bool {
+	return dsp == nil
+}
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnGovernanceProposalsFromShardNode = errors.New("governance proposals cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetGovernanceProposals returns the errCannotReturnGovernanceProposalsFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposals(_ context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, errCannotReturnGovernanceProposalsFromShardNode
+}
+
+// GetGovernanceProposal returns the errCannotReturnGovernanceProposalsFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposal(_ string, _ context.Context) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errCannotReturnGovernanceProposalsFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
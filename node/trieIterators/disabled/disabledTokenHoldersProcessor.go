@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnTokenHoldersFromMetaNode = errors.New("token holders cannot be returned by a metachain node")
+
+type tokenHoldersProcessor struct{}
+
+// NewDisabledTokenHoldersProcessor returns a disabled implementation to be used on metachain nodes
+func NewDisabledTokenHoldersProcessor() *tokenHoldersProcessor {
+	return &tokenHoldersProcessor{}
+}
+
+// GetTokenHolders returns the errCannotReturnTokenHoldersFromMetaNode error
+func (thp *tokenHoldersProcessor) GetTokenHolders(_ string, _ uint64, _ context.Context) (*common.TokenHoldersAPIResponse, error) {
+	return nil, errCannotReturnTokenHoldersFromMetaNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (thp *tokenHoldersProcessor) IsInterfaceNil() bool {
+	return thp == nil
+}
@@ -19,3 +19,22 @@ var ErrNilMutex = errors.New("nil mutex")
 
 // ErrTrieOperationsTimeout signals a timeout during trie operations
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilBlockChain signals that a nil blockchain has been provided
+var ErrNilBlockChain = errors.New("nil blockchain")
+
+// ErrGovernanceProposalNotFound signals that the requested governance proposal was not found
+var ErrGovernanceProposalNotFound = errors.New("governance proposal not found")
+
+// ErrInvalidGovernanceProposalReference signals that the provided governance proposal reference is neither a commit
+// hash nor an address
+var ErrInvalidGovernanceProposalReference = errors.New("invalid governance proposal reference")
+
+// ErrInvalidMaxServiceFee signals that an invalid maximum service fee has been provided
+var ErrInvalidMaxServiceFee = errors.New("invalid maximum service fee")
+
+// ErrNotADelegationContract signals that the provided address does not belong to a delegation contract
+var ErrNotADelegationContract = errors.New("not a delegation contract")
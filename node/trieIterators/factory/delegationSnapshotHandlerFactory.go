@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateDelegationSnapshotHandler will create a new instance of DelegationSnapshotHandler
+func CreateDelegationSnapshotHandler(args trieIterators.ArgDelegationSnapshotProcessor) (external.DelegationSnapshotHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledDelegationSnapshotProcessor(), nil
+	}
+
+	return trieIterators.NewDelegationSnapshotProcessor(args)
+}
@@ -0,0 +1,52 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationSnapshotHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationSnapshotProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	delegationSnapshotHandler, err := CreateDelegationSnapshotHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.delegationSnapshotProcessor", fmt.Sprintf("%T", delegationSnapshotHandler))
+}
+
+func TestCreateDelegationSnapshotHandler_DelegationSnapshotProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationSnapshotProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &mock.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		Marshalizer:   &testscommon.MarshalizerMock{},
+		BlockChain:    &testscommon.ChainHandlerStub{},
+		MaxServiceFee: 10000,
+	}
+
+	delegationSnapshotHandler, err := CreateDelegationSnapshotHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.delegationSnapshotProcessor", fmt.Sprintf("%T", delegationSnapshotHandler))
+}
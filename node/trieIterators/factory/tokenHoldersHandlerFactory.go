@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateTokenHoldersHandler will create a new instance of TokenHoldersHandler
+func CreateTokenHoldersHandler(args trieIterators.ArgTokenHoldersProcessor) (external.TokenHoldersHandler, error) {
+	if args.ShardID == core.MetachainShardId {
+		return disabled.NewDisabledTokenHoldersProcessor(), nil
+	}
+
+	return trieIterators.NewTokenHoldersProcessor(args)
+}
@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTokenHoldersHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTokenHoldersProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+		},
+	}
+
+	tokenHoldersHandler, err := CreateTokenHoldersHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.tokenHoldersProcessor", fmt.Sprintf("%T", tokenHoldersHandler))
+}
+
+func TestCreateTokenHoldersHandler_TokenHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTokenHoldersProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &mock.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		Marshalizer: &testscommon.MarshalizerMock{},
+	}
+
+	tokenHoldersHandler, err := CreateTokenHoldersHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.tokenHoldersProcessor", fmt.Sprintf("%T", tokenHoldersHandler))
+}
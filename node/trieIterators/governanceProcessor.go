@@ -0,0 +1,355 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const (
+	governanceProposalPrefix   = "proposal_"
+	governanceConfigKey        = "governanceConfig"
+	governanceCommitHashLength = 40
+)
+
+// ArgGovernanceProcessor represents the arguments DTO used in the governance processor constructor
+type ArgGovernanceProcessor struct {
+	ArgTrieIteratorProcessor
+	Marshalizer marshal.Marshalizer
+	BlockChain  data.ChainHandler
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+	blockChain         data.ChainHandler
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(arg ArgGovernanceProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+		blockChain:         arg.BlockChain,
+	}, nil
+}
+
+// GetGovernanceProposals will return all the proposals found in the governance contract storage, along with their
+// tallies and voters, ordered by their start vote nonce
+func (gp *governanceProcessor) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposalAPIResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := gp.getGovernanceRecords(governanceAccount, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getRecord := func(key []byte) ([]byte, error) {
+		return records[string(key)], nil
+	}
+	config := gp.getConfig(getRecord)
+	currentNonce := gp.getCurrentNonce()
+
+	proposals := make([]*common.GovernanceProposalAPIResponse, 0)
+	for key, record := range records {
+		if !strings.HasPrefix(key, governanceProposalPrefix) {
+			continue
+		}
+
+		reference := []byte(key[len(governanceProposalPrefix):])
+		if !gp.isProposalReference(reference) {
+			continue
+		}
+
+		proposal, errBuild := gp.buildProposal(reference, record, getRecord, config, currentNonce)
+		if errBuild != nil {
+			// the record might not hold a proposal
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		if proposals[i].StartVoteNonce != proposals[j].StartVoteNonce {
+			return proposals[i].StartVoteNonce < proposals[j].StartVoteNonce
+		}
+
+		return proposals[i].Reference < proposals[j].Reference
+	})
+
+	return proposals, nil
+}
+
+// GetGovernanceProposal will return the proposal identified by the provided reference, which is either the commit
+// hash of the proposal or the address of the account that proposed its whitelisting
+func (gp *governanceProcessor) GetGovernanceProposal(reference string, ctx context.Context) (*common.GovernanceProposalAPIResponse, error) {
+	referenceBytes, err := gp.decodeReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(governanceAccount.DataTrieTracker()) {
+		return nil, ErrGovernanceProposalNotFound
+	}
+
+	getRecord := governanceAccount.DataTrieTracker().RetrieveValue
+	proposalRecord, err := getRecord(append([]byte(governanceProposalPrefix), referenceBytes...))
+	if err != nil {
+		return nil, err
+	}
+	if len(proposalRecord) == 0 {
+		return nil, ErrGovernanceProposalNotFound
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return gp.buildProposal(referenceBytes, proposalRecord, getRecord, gp.getConfig(getRecord), gp.getCurrentNonce())
+}
+
+// getGovernanceRecords returns the proposals, the vote sets and the configuration found in the governance contract
+// storage
+func (gp *governanceProcessor) getGovernanceRecords(governanceAccount state.UserAccountHandler, ctx context.Context) (map[string][]byte, error) {
+	records := make(map[string][]byte)
+	if check.IfNil(governanceAccount.DataTrie()) {
+		return records, nil
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = governanceAccount.DataTrie().GetAllLeavesOnChannel(chLeaves, ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for leaf := range chLeaves {
+		leafKey := leaf.Key()
+		isRecordOfInterest := bytes.HasPrefix(leafKey, []byte(governanceProposalPrefix)) ||
+			bytes.Equal(leafKey, []byte(governanceConfigKey))
+		if !isRecordOfInterest {
+			continue
+		}
+
+		suffix := append(leafKey, governanceAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			continue
+		}
+
+		records[string(leafKey)] = value
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return records, nil
+}
+
+func (gp *governanceProcessor) buildProposal(
+	reference []byte,
+	proposalRecord []byte,
+	getRecord func(key []byte) ([]byte, error),
+	config *systemSmartContracts.GovernanceConfigV2,
+	currentNonce uint64,
+) (*common.GovernanceProposalAPIResponse, error) {
+	proposal := &systemSmartContracts.GeneralProposal{}
+	err := gp.marshalizer.Unmarshal(proposal, proposalRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	yes, no, veto := valueOrZero(proposal.Yes), valueOrZero(proposal.No), valueOrZero(proposal.Veto)
+	totalVotes := big.NewInt(0).Add(yes, no)
+	totalVotes.Add(totalVotes, veto)
+
+	response := &common.GovernanceProposalAPIResponse{
+		Reference:       gp.encodeReference(reference),
+		CommitHash:      string(proposal.CommitHash),
+		Issuer:          gp.publicKeyConverter.Encode(proposal.IssuerAddress),
+		StartVoteNonce:  proposal.StartVoteNonce,
+		EndVoteNonce:    proposal.EndVoteNonce,
+		RemainingRounds: computeRemainingRounds(proposal, currentNonce),
+		Yes:             yes.String(),
+		No:              no.String(),
+		Veto:            veto.String(),
+		TotalVotes:      totalVotes.String(),
+		QuorumReached:   config != nil && totalVotes.Cmp(valueOrZero(config.MinQuorum)) >= 0,
+		Passed:          proposal.Passed,
+		Closed:          proposal.Closed,
+		Voters:          make([]*common.GovernanceVoterAPIResponse, 0, len(proposal.Votes)),
+	}
+
+	for _, voter := range proposal.Votes {
+		voteKey := append([]byte(governanceProposalPrefix), proposal.CommitHash...)
+		voteKey = append(voteKey, voter...)
+
+		voteRecord, errGet := getRecord(voteKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		response.Voters = append(response.Voters, gp.buildVoter(voter, voteRecord))
+	}
+
+	return response, nil
+}
+
+func (gp *governanceProcessor) buildVoter(voter []byte, voteRecord []byte) *common.GovernanceVoterAPIResponse {
+	voteSet := &systemSmartContracts.VoteSet{}
+	if len(voteRecord) > 0 {
+		err := gp.marshalizer.Unmarshal(voteSet, voteRecord)
+		if err != nil {
+			// the voter is still listed even if its votes could not be decoded
+			voteSet = &systemSmartContracts.VoteSet{}
+		}
+	}
+
+	response := &common.GovernanceVoterAPIResponse{
+		Address:   gp.publicKeyConverter.Encode(voter),
+		UsedPower: valueOrZero(voteSet.UsedPower).String(),
+		TotalYes:  valueOrZero(voteSet.TotalYes).String(),
+		TotalNo:   valueOrZero(voteSet.TotalNo).String(),
+		TotalVeto: valueOrZero(voteSet.TotalVeto).String(),
+		Votes:     make([]*common.GovernanceVoteAPIResponse, 0, len(voteSet.VoteItems)),
+	}
+
+	for _, voteItem := range voteSet.VoteItems {
+		if voteItem == nil {
+			continue
+		}
+
+		vote := &common.GovernanceVoteAPIResponse{
+			Value:   strings.ToLower(voteItem.Value.String()),
+			Power:   valueOrZero(voteItem.Power).String(),
+			Balance: valueOrZero(voteItem.Balance).String(),
+		}
+		if len(voteItem.DelegatedTo) > 0 {
+			vote.DelegatedTo = gp.publicKeyConverter.Encode(voteItem.DelegatedTo)
+		}
+
+		response.Votes = append(response.Votes, vote)
+	}
+
+	return response
+}
+
+// getConfig returns the governance configuration or nil if it cannot be found or it is not the one used since the
+// governance contract was upgraded
+func (gp *governanceProcessor) getConfig(getRecord func(key []byte) ([]byte, error)) *systemSmartContracts.GovernanceConfigV2 {
+	configRecord, err := getRecord([]byte(governanceConfigKey))
+	if err != nil || len(configRecord) == 0 {
+		return nil
+	}
+
+	config := &systemSmartContracts.GovernanceConfigV2{}
+	err = gp.marshalizer.Unmarshal(config, configRecord)
+	if err != nil {
+		return nil
+	}
+
+	return config
+}
+
+func (gp *governanceProcessor) getCurrentNonce() uint64 {
+	currentHeader := gp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+// isProposalReference returns true if the reference is a commit hash or an address, as the vote sets are saved
+// under the same prefix, suffixed with the address of the voter
+func (gp *governanceProcessor) isProposalReference(reference []byte) bool {
+	return len(reference) == governanceCommitHashLength || len(reference) == gp.publicKeyConverter.Len()
+}
+
+func (gp *governanceProcessor) encodeReference(reference []byte) string {
+	if len(reference) == governanceCommitHashLength {
+		return string(reference)
+	}
+
+	return gp.publicKeyConverter.Encode(reference)
+}
+
+func (gp *governanceProcessor) decodeReference(reference string) ([]byte, error) {
+	if len(reference) == governanceCommitHashLength {
+		return []byte(reference), nil
+	}
+
+	referenceBytes, err := gp.publicKeyConverter.Decode(reference)
+	if err != nil || len(referenceBytes) != gp.publicKeyConverter.Len() {
+		return nil, ErrInvalidGovernanceProposalReference
+	}
+
+	return referenceBytes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
+
+func computeRemainingRounds(proposal *systemSmartContracts.GeneralProposal, currentNonce uint64) uint64 {
+	if proposal.Closed || currentNonce >= proposal.EndVoteNonce {
+		return 0
+	}
+
+	return proposal.EndVoteNonce - currentNonce
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
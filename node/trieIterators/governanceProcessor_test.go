@@ -0,0 +1,249 @@
+package trieIterators
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCommitHash = "3b5d1fe4fbbd4ab3d4bbd0d8a1a70bb3ab0c9a5e"
+
+func createMockArgsGovernance() ArgGovernanceProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+
+	return ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: arg,
+		Marshalizer:              &testscommon.MarshalizerMock{},
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Nonce: 60}
+			},
+		},
+	}
+}
+
+func createGovernanceRecords(t *testing.T) map[string][]byte {
+	marshalizer := &testscommon.MarshalizerMock{}
+	voter := make([]byte, 32)
+	voter[0] = 1
+	whitelisted := whitelistAddress()
+
+	records := make(map[string][]byte)
+	addRecord := func(key []byte, record interface{}) {
+		recordBytes, err := marshalizer.Marshal(record)
+		require.Nil(t, err)
+		records[string(key)] = recordBytes
+	}
+
+	addRecord([]byte(governanceConfigKey), &systemSmartContracts.GovernanceConfigV2{
+		MinQuorum:        big.NewInt(100),
+		MinPassThreshold: big.NewInt(60),
+		MinVetoThreshold: big.NewInt(30),
+		ProposalFee:      big.NewInt(10),
+	})
+	addRecord(append([]byte(governanceProposalPrefix), testCommitHash...), &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  whitelisted,
+		CommitHash:     []byte(testCommitHash),
+		StartVoteNonce: 50,
+		EndVoteNonce:   100,
+		Yes:            big.NewInt(80),
+		No:             big.NewInt(20),
+		Veto:           big.NewInt(0),
+		Votes:          [][]byte{voter},
+	})
+	voteKey := append([]byte(governanceProposalPrefix), testCommitHash...)
+	addRecord(append(voteKey, voter...), &systemSmartContracts.VoteSet{
+		UsedPower: big.NewInt(100),
+		TotalYes:  big.NewInt(80),
+		TotalNo:   big.NewInt(20),
+		TotalVeto: big.NewInt(0),
+		VoteItems: []*systemSmartContracts.VoteDetails{
+			{Value: systemSmartContracts.Yes, Power: big.NewInt(80), Balance: big.NewInt(6400), DelegatedTo: whitelisted},
+			{Value: systemSmartContracts.No, Power: big.NewInt(20), Balance: big.NewInt(400)},
+		},
+	})
+	addRecord(append([]byte(governanceProposalPrefix), whitelisted...), &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  whitelisted,
+		CommitHash:     []byte("genesis"),
+		StartVoteNonce: 0,
+		EndVoteNonce:   0,
+		Passed:         true,
+		Closed:         true,
+	})
+	records["whiteList_"+string(whitelisted)] = []byte("whitelist record")
+
+	return records
+}
+
+func createGovernanceAccountsStub(records map[string][]byte, timeSleep time.Duration) *stateMock.AccountsStub {
+	acc, _ := state.NewUserAccount(vm.GovernanceSCAddress)
+	acc.SetRootHash([]byte("root hash"))
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			value, found := records[string(key)]
+			if !found {
+				return nil, nil
+			}
+
+			value = append(append([]byte{}, value...), key...)
+			return append(value, vm.GovernanceSCAddress...), nil
+		},
+		GetAllLeavesOnChannelCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+			go func() {
+				time.Sleep(timeSleep)
+				for key, value := range records {
+					value = append(append([]byte{}, value...), key...)
+					value = append(value, vm.GovernanceSCAddress...)
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), value)
+				}
+
+				close(ch)
+			}()
+
+			return nil
+		},
+	})
+
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsGovernance()
+	arg.Accounts = nil
+	gp, err := NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	arg = createMockArgsGovernance()
+	arg.Marshalizer = nil
+	gp, err = NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	arg = createMockArgsGovernance()
+	arg.BlockChain = nil
+	gp, err = NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	gp, err = NewGovernanceProcessor(createMockArgsGovernance())
+	assert.False(t, check.IfNil(gp))
+	assert.Nil(t, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsGovernance()
+	arg.Accounts.AccountsAdapter = createGovernanceAccountsStub(createGovernanceRecords(t), 0)
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(proposals))
+
+	whitelistProposal := proposals[0]
+	assert.Equal(t, arg.PublicKeyConverter.Encode(whitelistAddress()), whitelistProposal.Reference)
+	assert.Equal(t, "genesis", whitelistProposal.CommitHash)
+	assert.True(t, whitelistProposal.Passed)
+	assert.True(t, whitelistProposal.Closed)
+	assert.False(t, whitelistProposal.QuorumReached)
+	assert.Equal(t, uint64(0), whitelistProposal.RemainingRounds)
+	assert.Equal(t, 0, len(whitelistProposal.Voters))
+
+	proposal := proposals[1]
+	assert.Equal(t, testCommitHash, proposal.Reference)
+	assert.Equal(t, "100", proposal.TotalVotes)
+	assert.True(t, proposal.QuorumReached)
+	assert.False(t, proposal.Closed)
+	assert.Equal(t, uint64(40), proposal.RemainingRounds)
+	require.Equal(t, 1, len(proposal.Voters))
+	assert.Equal(t, "100", proposal.Voters[0].UsedPower)
+	assert.Equal(t, []*common.GovernanceVoteAPIResponse{
+		{Value: "yes", Power: "80", Balance: "6400", DelegatedTo: arg.PublicKeyConverter.Encode(whitelistAddress())},
+		{Value: "no", Power: "20", Balance: "400"},
+	}, proposal.Voters[0].Votes)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsContextShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsGovernance()
+	arg.Accounts.AccountsAdapter = createGovernanceAccountsStub(createGovernanceRecords(t), time.Second)
+	gp, _ := NewGovernanceProcessor(arg)
+
+	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	proposals, err := gp.GetGovernanceProposals(ctxWithTimeout)
+	require.Nil(t, proposals)
+	require.Equal(t, ErrTrieOperationsTimeout, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsGovernance()
+	arg.Accounts.AccountsAdapter = createGovernanceAccountsStub(createGovernanceRecords(t), 0)
+	gp, _ := NewGovernanceProcessor(arg)
+
+	t.Run("by commit hash", func(t *testing.T) {
+		proposal, err := gp.GetGovernanceProposal(testCommitHash, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, testCommitHash, proposal.Reference)
+		assert.Equal(t, "80", proposal.Yes)
+		assert.Equal(t, 1, len(proposal.Voters))
+	})
+	t.Run("by whitelisted address", func(t *testing.T) {
+		reference := arg.PublicKeyConverter.Encode(whitelistAddress())
+		proposal, err := gp.GetGovernanceProposal(reference, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, reference, proposal.Reference)
+		assert.True(t, proposal.Passed)
+	})
+	t.Run("missing proposal", func(t *testing.T) {
+		proposal, err := gp.GetGovernanceProposal("0000000000000000000000000000000000000000", context.Background())
+		assert.Nil(t, proposal)
+		assert.Equal(t, ErrGovernanceProposalNotFound, err)
+	})
+	t.Run("invalid reference", func(t *testing.T) {
+		proposal, err := gp.GetGovernanceProposal("invalid", context.Background())
+		assert.Nil(t, proposal)
+		assert.Equal(t, ErrInvalidGovernanceProposalReference, err)
+	})
+}
+
+func whitelistAddress() []byte {
+	whitelisted := make([]byte, 32)
+	whitelisted[0] = 2
+
+	return whitelisted
+}
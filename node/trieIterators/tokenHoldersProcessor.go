@@ -0,0 +1,200 @@
+package trieIterators
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const tokenHoldersCacheSize = 100
+
+// ArgTokenHoldersProcessor represents the arguments DTO used in the token holders processor constructor
+type ArgTokenHoldersProcessor struct {
+	ArgTrieIteratorProcessor
+	Marshalizer marshal.Marshalizer
+}
+
+type tokenHoldersProcessor struct {
+	accounts           *AccountsWrapper
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+	cache              storage.Cacher
+}
+
+// NewTokenHoldersProcessor will create a new instance of tokenHoldersProcessor
+func NewTokenHoldersProcessor(arg ArgTokenHoldersProcessor) (*tokenHoldersProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	cache, err := lrucache.NewCache(tokenHoldersCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenHoldersProcessor{
+		accounts:           arg.Accounts,
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+		cache:              cache,
+	}, nil
+}
+
+// GetTokenHolders will return the snapshot of the holders of the provided token (or token nonce, for NFTs and SFTs)
+// and their balances, as found in the accounts state. The snapshots are cached per state root hash
+func (thp *tokenHoldersProcessor) GetTokenHolders(tokenIdentifier string, nonce uint64, ctx context.Context) (*common.TokenHoldersAPIResponse, error) {
+	thp.accounts.Lock()
+	defer thp.accounts.Unlock()
+
+	rootHash, err := thp.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := computeTokenHoldersCacheKey(rootHash, tokenIdentifier, nonce)
+	cachedResponse, found := thp.cache.Get(cacheKey)
+	if found {
+		response, ok := cachedResponse.(*common.TokenHoldersAPIResponse)
+		if ok {
+			return response, nil
+		}
+	}
+
+	holders, err := thp.getHolders(rootHash, computeESDTTokenKey(tokenIdentifier, nonce), ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.TokenHoldersAPIResponse{
+		Token:    tokenIdentifier,
+		Nonce:    nonce,
+		RootHash: hex.EncodeToString(rootHash),
+		Holders:  holders,
+	}
+	thp.cache.Put(cacheKey, response, 0)
+
+	return response, nil
+}
+
+func (thp *tokenHoldersProcessor) getHolders(rootHash []byte, esdtTokenKey []byte, ctx context.Context) ([]*common.TokenHolderAPIResponse, error) {
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err := thp.accounts.GetAllLeaves(chLeaves, ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]*big.Int)
+	for leaf := range chLeaves {
+		if len(leaf.Key()) != thp.publicKeyConverter.Len() {
+			continue
+		}
+
+		balance, errGet := thp.getBalance(leaf, esdtTokenKey)
+		if errGet != nil || balance.Sign() <= 0 {
+			// the leaf might not hold a user account or the account might not hold the token
+			continue
+		}
+
+		balances[string(leaf.Key())] = balance
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return thp.sortHolders(balances), nil
+}
+
+func (thp *tokenHoldersProcessor) getBalance(leaf core.KeyValueHolder, esdtTokenKey []byte) (*big.Int, error) {
+	accountHandler, err := thp.accounts.GetAccountFromBytes(leaf.Key(), leaf.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccount
+	}
+	if len(account.GetRootHash()) == 0 || check.IfNil(account.DataTrieTracker()) {
+		return big.NewInt(0), nil
+	}
+
+	esdtTokenBytes, err := account.DataTrieTracker().RetrieveValue(esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(esdtTokenBytes) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = thp.marshalizer.Unmarshal(esdtToken, esdtTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return esdtToken.Value, nil
+}
+
+// sortHolders returns the holders ordered by their balances, descending, and then by their addresses
+func (thp *tokenHoldersProcessor) sortHolders(balances map[string]*big.Int) []*common.TokenHolderAPIResponse {
+	addresses := make([]string, 0, len(balances))
+	for address := range balances {
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		comparison := balances[addresses[i]].Cmp(balances[addresses[j]])
+		if comparison != 0 {
+			return comparison > 0
+		}
+
+		return addresses[i] < addresses[j]
+	})
+
+	holders := make([]*common.TokenHolderAPIResponse, 0, len(addresses))
+	for _, address := range addresses {
+		holders = append(holders, &common.TokenHolderAPIResponse{
+			Address: thp.publicKeyConverter.Encode([]byte(address)),
+			Balance: balances[address].String(),
+		})
+	}
+
+	return holders
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (thp *tokenHoldersProcessor) IsInterfaceNil() bool {
+	return thp == nil
+}
+
+func computeESDTTokenKey(tokenIdentifier string, nonce uint64) []byte {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+	if nonce == 0 {
+		return esdtTokenKey
+	}
+
+	return append(esdtTokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func computeTokenHoldersCacheKey(rootHash []byte, tokenIdentifier string, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s-%s-%d", hex.EncodeToString(rootHash), tokenIdentifier, nonce))
+}
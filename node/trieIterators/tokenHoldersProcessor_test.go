@@ -0,0 +1,192 @@
+package trieIterators
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressLen = 10
+
+func createMockArgsTokenHolders() ArgTokenHoldersProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(testAddressLen)
+
+	return ArgTokenHoldersProcessor{
+		ArgTrieIteratorProcessor: arg,
+		Marshalizer:              &testscommon.MarshalizerMock{},
+	}
+}
+
+func createHolderAccount(address []byte, balances map[string]int64) state.UserAccountHandler {
+	marshalizer := &testscommon.MarshalizerMock{}
+	acc, _ := state.NewUserAccount(address)
+	acc.SetRootHash([]byte("root hash"))
+	acc.SetDataTrie(&trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			balance, found := balances[string(key)]
+			if !found {
+				return nil, nil
+			}
+
+			esdtTokenBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(balance)})
+			esdtTokenBytes = append(esdtTokenBytes, key...)
+
+			return append(esdtTokenBytes, address...), nil
+		},
+	})
+
+	return acc
+}
+
+func createAccountsStubWithHolders(accounts map[string]state.UserAccountHandler, leaves [][]byte, timeSleep time.Duration) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("main root hash"), nil
+		},
+		GetAllLeavesCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+			go func() {
+				time.Sleep(timeSleep)
+				for _, leafKey := range leaves {
+					ch <- keyValStorage.NewKeyValStorage(leafKey, []byte("account bytes"))
+				}
+
+				close(ch)
+			}()
+
+			return nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			account, found := accounts[string(address)]
+			if !found {
+				return nil, errors.New("account not found")
+			}
+
+			return account, nil
+		},
+	}
+}
+
+func TestNewTokenHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsTokenHolders()
+	arg.Accounts = nil
+	thp, err := NewTokenHoldersProcessor(arg)
+	assert.True(t, check.IfNil(thp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	arg = createMockArgsTokenHolders()
+	arg.Marshalizer = nil
+	thp, err = NewTokenHoldersProcessor(arg)
+	assert.True(t, check.IfNil(thp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	thp, err = NewTokenHoldersProcessor(createMockArgsTokenHolders())
+	assert.False(t, check.IfNil(thp))
+	assert.Nil(t, err)
+}
+
+func TestTokenHoldersProcessor_GetTokenHoldersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fungibleKey := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef"
+	nftKey := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "NFT-abcdef" + string([]byte{2})
+	alice := []byte("alice-addr")
+	bob := []byte("bob-addres")
+	carol := []byte("carol-addr")
+	accounts := map[string]state.UserAccountHandler{
+		string(alice): createHolderAccount(alice, map[string]int64{fungibleKey: 5, nftKey: 1}),
+		string(bob):   createHolderAccount(bob, map[string]int64{fungibleKey: 20}),
+		string(carol): createHolderAccount(carol, map[string]int64{fungibleKey: 0}),
+	}
+	leaves := [][]byte{alice, bob, carol, []byte("not an address")}
+
+	numIterations := 0
+	arg := createMockArgsTokenHolders()
+	accountsStub := createAccountsStubWithHolders(accounts, leaves, 0)
+	getAllLeaves := accountsStub.GetAllLeavesCalled
+	accountsStub.GetAllLeavesCalled = func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+		numIterations++
+		return getAllLeaves(ch, ctx, rootHash)
+	}
+	arg.Accounts.AccountsAdapter = accountsStub
+	thp, _ := NewTokenHoldersProcessor(arg)
+
+	response, err := thp.GetTokenHolders("TKN-abcdef", 0, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, &common.TokenHoldersAPIResponse{
+		Token:    "TKN-abcdef",
+		Nonce:    0,
+		RootHash: "6d61696e20726f6f742068617368",
+		Holders: []*common.TokenHolderAPIResponse{
+			{Address: arg.PublicKeyConverter.Encode(bob), Balance: "20"},
+			{Address: arg.PublicKeyConverter.Encode(alice), Balance: "5"},
+		},
+	}, response)
+
+	response, err = thp.GetTokenHolders("NFT-abcdef", 2, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []*common.TokenHolderAPIResponse{
+		{Address: arg.PublicKeyConverter.Encode(alice), Balance: "1"},
+	}, response.Holders)
+	require.Equal(t, 2, numIterations)
+
+	// same root hash, the snapshot should be returned from the cache
+	_, err = thp.GetTokenHolders("TKN-abcdef", 0, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, numIterations)
+}
+
+func TestTokenHoldersProcessor_GetTokenHoldersContextShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	alice := []byte("alice-addr")
+	accounts := map[string]state.UserAccountHandler{
+		string(alice): createHolderAccount(alice, nil),
+	}
+
+	arg := createMockArgsTokenHolders()
+	arg.Accounts.AccountsAdapter = createAccountsStubWithHolders(accounts, [][]byte{alice}, time.Second)
+	thp, _ := NewTokenHoldersProcessor(arg)
+
+	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	response, err := thp.GetTokenHolders("TKN-abcdef", 0, ctxWithTimeout)
+	require.Nil(t, response)
+	require.Equal(t, ErrTrieOperationsTimeout, err)
+}
+
+func TestTokenHoldersProcessor_GetTokenHoldersRootHashErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgsTokenHolders()
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	thp, _ := NewTokenHoldersProcessor(arg)
+
+	response, err := thp.GetTokenHolders("TKN-abcdef", 0, context.Background())
+	require.Nil(t, response)
+	require.Equal(t, expectedErr, err)
+}
@@ -158,3 +158,36 @@ var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
 
 // ErrNilCacher signals that a nil cacher has been provided
 var ErrNilCacher = errors.New("nil cacher")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilConnectionPolicy signals that a nil connection policy has been provided
+var ErrNilConnectionPolicy = errors.New("nil connection policy")
+
+// ErrPeerDeniedByConnectionPolicy signals that the connection policy does not allow the connection to the peer
+var ErrPeerDeniedByConnectionPolicy = errors.New("peer denied by the connection policy")
+
+// ErrInvalidConnectionPolicyRules signals that invalid connection policy rules have been provided
+var ErrInvalidConnectionPolicyRules = errors.New("invalid connection policy rules")
+
+// ErrUnknownCompressionAlgorithm signals that an unknown compression algorithm has been provided
+var ErrUnknownCompressionAlgorithm = errors.New("unknown compression algorithm")
+
+// ErrPayloadDecompression signals that a message payload could not be decompressed
+var ErrPayloadDecompression = errors.New("payload decompression error")
+
+// ErrNilPayloadCompressor signals that a nil payload compressor has been provided
+var ErrNilPayloadCompressor = errors.New("nil payload compressor")
+
+// ErrOutboundBandwidthExceeded signals that the message was not sent as its topic class exceeded the outbound cap
+var ErrOutboundBandwidthExceeded = errors.New("outbound bandwidth exceeded")
+
+// ErrNilBandwidthMonitor signals that a nil bandwidth monitor has been provided
+var ErrNilBandwidthMonitor = errors.New("nil bandwidth monitor")
+
+// ErrTopologyCrawlerDisabled signals that the topology crawler is not enabled
+var ErrTopologyCrawlerDisabled = errors.New("topology crawler disabled")
+
+// ErrTopologySnapshotNotAvailable signals that the topology crawler did not finish its first crawl yet
+var ErrTopologySnapshotNotAvailable = errors.New("topology snapshot not available")
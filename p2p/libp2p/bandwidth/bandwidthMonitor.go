@@ -0,0 +1,265 @@
+package bandwidth
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const (
+	defaultClassName             = "default"
+	defaultAccountingWindowInSec = 10
+)
+
+// ArgsBandwidthMonitor is the DTO used to create a new bandwidth monitor
+type ArgsBandwidthMonitor struct {
+	Config config.P2PBandwidthConfig
+}
+
+type trafficCounters struct {
+	inbound              *slidingWindow
+	outbound             *slidingWindow
+	droppedOutboundBytes uint64
+}
+
+type topicClass struct {
+	name          string
+	topicPrefixes []string
+	prioritized   bool
+	bucket        *tokenBucket
+}
+
+// bandwidthMonitor accounts the inbound and the outbound traffic on each topic and each peer and shapes the outbound
+// traffic using a token bucket for each configured topic class and a global one. The prioritized classes are never
+// delayed but their traffic still consumes the global tokens, so the lower priority classes are the ones dropped
+// when the global cap is reached
+type bandwidthMonitor struct {
+	mut            sync.Mutex
+	window         time.Duration
+	shapingEnabled bool
+	classes        []*topicClass
+	defaultClass   *topicClass
+	classOfTopic   map[string]*topicClass
+	globalBucket   *tokenBucket
+	total          *trafficCounters
+	topics         map[string]*trafficCounters
+	peers          map[core.PeerID]*trafficCounters
+	lastPeersSweep time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewBandwidthMonitor creates a new bandwidth monitor. An unset accounting window defaults to 10 seconds
+func NewBandwidthMonitor(args ArgsBandwidthMonitor) (*bandwidthMonitor, error) {
+	accountingWindowInSec := args.Config.AccountingWindowInSec
+	if accountingWindowInSec == 0 {
+		accountingWindowInSec = defaultAccountingWindowInSec
+	}
+
+	bm := &bandwidthMonitor{
+		window:         time.Duration(accountingWindowInSec) * time.Second,
+		shapingEnabled: args.Config.ShapingEnabled,
+		classOfTopic:   make(map[string]*topicClass),
+		topics:         make(map[string]*trafficCounters),
+		peers:          make(map[core.PeerID]*trafficCounters),
+		getTimeHandler: time.Now,
+	}
+	now := bm.getTimeHandler()
+	bm.total = bm.newTrafficCounters()
+	bm.lastPeersSweep = now
+	bm.globalBucket = createTokenBucket(args.Config.MaxOutboundBytesPerSec, now)
+	bm.defaultClass = &topicClass{name: defaultClassName}
+
+	for idx, classConfig := range args.Config.TopicClasses {
+		if len(classConfig.Name) == 0 {
+			return nil, fmt.Errorf("%w for the name of the topic class at index %d", p2p.ErrInvalidValue, idx)
+		}
+		if len(classConfig.TopicPrefixes) == 0 {
+			return nil, fmt.Errorf("%w, no topic prefixes for the topic class %s", p2p.ErrInvalidValue, classConfig.Name)
+		}
+
+		bm.classes = append(bm.classes, &topicClass{
+			name:          classConfig.Name,
+			topicPrefixes: classConfig.TopicPrefixes,
+			prioritized:   classConfig.Prioritized,
+			bucket:        createTokenBucket(classConfig.MaxOutboundBytesPerSec, now),
+		})
+	}
+
+	return bm, nil
+}
+
+func createTokenBucket(bytesPerSec uint64, now time.Time) *tokenBucket {
+	if bytesPerSec == 0 {
+		return nil
+	}
+
+	return newTokenBucket(bytesPerSec, now)
+}
+
+func (bm *bandwidthMonitor) newTrafficCounters() *trafficCounters {
+	return &trafficCounters{
+		inbound:  newSlidingWindow(bm.window),
+		outbound: newSlidingWindow(bm.window),
+	}
+}
+
+// AddInbound accounts the bytes received on the provided topic from the provided peer
+func (bm *bandwidthMonitor) AddInbound(topic string, pid core.PeerID, numBytes uint64) {
+	bm.mut.Lock()
+	defer bm.mut.Unlock()
+
+	now := bm.getTimeHandler()
+	bm.total.inbound.add(numBytes, now)
+	bm.getTopicCounters(topic).inbound.add(numBytes, now)
+	if len(pid) > 0 {
+		bm.getPeerCounters(pid).inbound.add(numBytes, now)
+	}
+	bm.sweepIdlePeersIfNeeded(now)
+}
+
+// AddOutbound accounts the bytes sent on the provided topic. The peer is empty for the broadcast messages
+func (bm *bandwidthMonitor) AddOutbound(topic string, pid core.PeerID, numBytes uint64) {
+	bm.mut.Lock()
+	defer bm.mut.Unlock()
+
+	now := bm.getTimeHandler()
+	bm.total.outbound.add(numBytes, now)
+	bm.getTopicCounters(topic).outbound.add(numBytes, now)
+	if len(pid) > 0 {
+		bm.getPeerCounters(pid).outbound.add(numBytes, now)
+	}
+	bm.sweepIdlePeersIfNeeded(now)
+}
+
+// IsOutboundAllowed returns true if a message of the provided size can be sent on the provided topic. The messages of
+// the prioritized classes are always allowed. The denied bytes are accounted as dropped
+func (bm *bandwidthMonitor) IsOutboundAllowed(topic string, numBytes uint64) bool {
+	if !bm.shapingEnabled {
+		return true
+	}
+
+	bm.mut.Lock()
+	defer bm.mut.Unlock()
+
+	now := bm.getTimeHandler()
+	class := bm.getTopicClass(topic)
+	if !class.prioritized {
+		isAllowed := class.bucket.canConsume(now) && bm.globalBucket.canConsume(now)
+		if !isAllowed {
+			bm.total.droppedOutboundBytes += numBytes
+			bm.getTopicCounters(topic).droppedOutboundBytes += numBytes
+			return false
+		}
+	}
+
+	class.bucket.consume(numBytes, now)
+	bm.globalBucket.consume(numBytes, now)
+
+	return true
+}
+
+func (bm *bandwidthMonitor) getTopicClass(topic string) *topicClass {
+	class, found := bm.classOfTopic[topic]
+	if found {
+		return class
+	}
+
+	class = bm.defaultClass
+	for _, c := range bm.classes {
+		if hasAnyPrefix(topic, c.topicPrefixes) {
+			class = c
+			break
+		}
+	}
+	bm.classOfTopic[topic] = class
+
+	return class
+}
+
+func hasAnyPrefix(topic string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(topic, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bm *bandwidthMonitor) getTopicCounters(topic string) *trafficCounters {
+	counters, found := bm.topics[topic]
+	if !found {
+		counters = bm.newTrafficCounters()
+		bm.topics[topic] = counters
+	}
+
+	return counters
+}
+
+func (bm *bandwidthMonitor) getPeerCounters(pid core.PeerID) *trafficCounters {
+	counters, found := bm.peers[pid]
+	if !found {
+		counters = bm.newTrafficCounters()
+		bm.peers[pid] = counters
+	}
+
+	return counters
+}
+
+// sweepIdlePeersIfNeeded removes, at most once per window, the peers without any traffic in the last window so the
+// disconnected peers do not accumulate
+func (bm *bandwidthMonitor) sweepIdlePeersIfNeeded(now time.Time) {
+	if now.Sub(bm.lastPeersSweep) < bm.window {
+		return
+	}
+	bm.lastPeersSweep = now
+
+	for pid, counters := range bm.peers {
+		if counters.inbound.sum(now) == 0 && counters.outbound.sum(now) == 0 {
+			delete(bm.peers, pid)
+		}
+	}
+}
+
+func (tc *trafficCounters) statistics(now time.Time) p2p.BandwidthStatistics {
+	return p2p.BandwidthStatistics{
+		InboundBytesPerSec:   tc.inbound.bytesPerSecond(now),
+		OutboundBytesPerSec:  tc.outbound.bytesPerSecond(now),
+		TotalInboundBytes:    tc.inbound.total,
+		TotalOutboundBytes:   tc.outbound.total,
+		DroppedOutboundBytes: tc.droppedOutboundBytes,
+	}
+}
+
+// Report returns the traffic counters of the node, of each topic and of each peer with traffic in the last window
+func (bm *bandwidthMonitor) Report() *p2p.BandwidthReport {
+	bm.mut.Lock()
+	defer bm.mut.Unlock()
+
+	now := bm.getTimeHandler()
+	bm.sweepIdlePeersIfNeeded(now)
+
+	report := &p2p.BandwidthReport{
+		Total:  bm.total.statistics(now),
+		Topics: make(map[string]p2p.BandwidthStatistics, len(bm.topics)),
+		Peers:  make(map[core.PeerID]p2p.BandwidthStatistics, len(bm.peers)),
+	}
+	for topic, counters := range bm.topics {
+		report.Topics[topic] = counters.statistics(now)
+	}
+	for pid, counters := range bm.peers {
+		report.Peers[pid] = counters.statistics(now)
+	}
+
+	return report
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bm *bandwidthMonitor) IsInterfaceNil() bool {
+	return bm == nil
+}
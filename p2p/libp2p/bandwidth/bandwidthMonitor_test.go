@@ -0,0 +1,210 @@
+package bandwidth_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/bandwidth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mut sync.Mutex
+	now time.Time
+}
+
+func (fc *fakeClock) Now() time.Time {
+	fc.mut.Lock()
+	defer fc.mut.Unlock()
+
+	return fc.now
+}
+
+func (fc *fakeClock) Advance(duration time.Duration) {
+	fc.mut.Lock()
+	fc.now = fc.now.Add(duration)
+	fc.mut.Unlock()
+}
+
+func createShapingConfig() config.P2PBandwidthConfig {
+	return config.P2PBandwidthConfig{
+		AccountingWindowInSec:  10,
+		ShapingEnabled:         true,
+		MaxOutboundBytesPerSec: 1000,
+		TopicClasses: []config.TopicClassConfig{
+			{
+				Name:          "consensus",
+				TopicPrefixes: []string{"consensus"},
+				Prioritized:   true,
+			},
+			{
+				Name:                   "trieSync",
+				TopicPrefixes:          []string{"accountTrieNodes"},
+				MaxOutboundBytesPerSec: 500,
+			},
+		},
+	}
+}
+
+func createMonitorWithClock(t *testing.T, cfg config.P2PBandwidthConfig) (libp2p.BandwidthMonitor, *fakeClock) {
+	bm, err := bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{Config: cfg})
+	require.Nil(t, err)
+
+	clock := &fakeClock{now: time.Now()}
+	bm.SetTimeHandler(clock.Now)
+
+	return bm, clock
+}
+
+func TestNewBandwidthMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("topic class without name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createShapingConfig()
+		cfg.TopicClasses[1].Name = ""
+		bm, err := bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{Config: cfg})
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(bm))
+	})
+	t.Run("topic class without prefixes should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createShapingConfig()
+		cfg.TopicClasses[0].TopicPrefixes = nil
+		bm, err := bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{Config: cfg})
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(bm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bm, err := bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{Config: createShapingConfig()})
+		assert.Nil(t, err)
+		assert.False(t, bm.IsInterfaceNil())
+	})
+}
+
+func TestBandwidthMonitor_AccountingOnSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	bm, clock := createMonitorWithClock(t, config.P2PBandwidthConfig{})
+	bm.AddInbound("transactions", "pid1", 1000)
+	bm.AddInbound("transactions", "pid2", 1000)
+	bm.AddOutbound("transactions", "", 500)
+	bm.AddOutbound("consensus", "pid1", 300)
+
+	report := bm.Report()
+	assert.Equal(t, p2p.BandwidthStatistics{
+		InboundBytesPerSec:  200,
+		OutboundBytesPerSec: 80,
+		TotalInboundBytes:   2000,
+		TotalOutboundBytes:  800,
+	}, report.Total)
+	assert.Equal(t, uint64(2000), report.Topics["transactions"].TotalInboundBytes)
+	assert.Equal(t, uint64(500), report.Topics["transactions"].TotalOutboundBytes)
+	assert.Equal(t, uint64(30), report.Topics["consensus"].OutboundBytesPerSec)
+	assert.Equal(t, 2, len(report.Peers))
+	assert.Equal(t, uint64(300), report.Peers["pid1"].TotalOutboundBytes)
+
+	clock.Advance(5 * time.Second)
+	bm.AddInbound("transactions", "pid1", 1000)
+	report = bm.Report()
+	assert.Equal(t, uint64(300), report.Total.InboundBytesPerSec)
+
+	clock.Advance(6 * time.Second)
+	report = bm.Report()
+	assert.Equal(t, uint64(100), report.Total.InboundBytesPerSec)
+	assert.Equal(t, uint64(0), report.Total.OutboundBytesPerSec)
+	assert.Equal(t, uint64(3000), report.Total.TotalInboundBytes)
+	assert.Equal(t, 1, len(report.Peers), "the idle peer should have been removed")
+	_, found := report.Peers["pid1"]
+	assert.True(t, found)
+}
+
+func TestBandwidthMonitor_IsOutboundAllowed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled shaping should allow all", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createShapingConfig()
+		cfg.ShapingEnabled = false
+		bm, _ := createMonitorWithClock(t, cfg)
+		for i := 0; i < 100; i++ {
+			assert.True(t, bm.IsOutboundAllowed("accountTrieNodes_0", 1000))
+		}
+		assert.Equal(t, uint64(0), bm.Report().Total.DroppedOutboundBytes)
+	})
+	t.Run("class cap should drop until refilled", func(t *testing.T) {
+		t.Parallel()
+
+		bm, clock := createMonitorWithClock(t, createShapingConfig())
+		assert.True(t, bm.IsOutboundAllowed("accountTrieNodes_0", 400))
+		assert.True(t, bm.IsOutboundAllowed("accountTrieNodes_0", 400))
+		assert.False(t, bm.IsOutboundAllowed("accountTrieNodes_0", 100))
+
+		report := bm.Report()
+		assert.Equal(t, uint64(100), report.Total.DroppedOutboundBytes)
+		assert.Equal(t, uint64(100), report.Topics["accountTrieNodes_0"].DroppedOutboundBytes)
+
+		clock.Advance(time.Second)
+		assert.True(t, bm.IsOutboundAllowed("accountTrieNodes_0", 100))
+	})
+	t.Run("prioritized class should always be allowed and starve the others", func(t *testing.T) {
+		t.Parallel()
+
+		bm, clock := createMonitorWithClock(t, createShapingConfig())
+		for i := 0; i < 10; i++ {
+			assert.True(t, bm.IsOutboundAllowed("consensus_0", 1000))
+		}
+		assert.False(t, bm.IsOutboundAllowed("accountTrieNodes_0", 10))
+		assert.False(t, bm.IsOutboundAllowed("transactions_0", 10))
+
+		clock.Advance(time.Second)
+		assert.False(t, bm.IsOutboundAllowed("transactions_0", 10), "the global debt is capped to a second of traffic")
+		clock.Advance(time.Second / 2)
+		assert.True(t, bm.IsOutboundAllowed("transactions_0", 10))
+		assert.True(t, bm.IsOutboundAllowed("accountTrieNodes_0", 10))
+		assert.Equal(t, uint64(0), bm.Report().Topics["consensus_0"].DroppedOutboundBytes)
+	})
+}
+
+func TestBandwidthMonitor_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	bm, err := bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{Config: createShapingConfig()})
+	require.Nil(t, err)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			topic := []string{"consensus", "accountTrieNodes", "transactions"}[idx%3]
+			switch idx % 4 {
+			case 0:
+				bm.AddInbound(topic, "pid", 10)
+			case 1:
+				bm.AddOutbound(topic, "pid", 10)
+			case 2:
+				_ = bm.IsOutboundAllowed(topic, 10)
+			case 3:
+				_ = bm.Report()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(250), bm.Report().Total.TotalInboundBytes)
+}
@@ -0,0 +1,12 @@
+package bandwidth
+
+import (
+	"time"
+)
+
+// SetTimeHandler -
+func (bm *bandwidthMonitor) SetTimeHandler(handler func() time.Time) {
+	bm.mut.Lock()
+	bm.getTimeHandler = handler
+	bm.mut.Unlock()
+}
@@ -0,0 +1,62 @@
+package bandwidth
+
+import (
+	"time"
+)
+
+const numBucketsPerWindow = 10
+
+// slidingWindow sums the bytes added during the last window using a ring of fixed duration buckets. It is not
+// concurrent safe
+type slidingWindow struct {
+	buckets        []uint64
+	window         time.Duration
+	bucketDuration int64
+	lastIndex      int64
+	total          uint64
+}
+
+func newSlidingWindow(window time.Duration) *slidingWindow {
+	return &slidingWindow{
+		buckets:        make([]uint64, numBucketsPerWindow),
+		window:         window,
+		bucketDuration: int64(window) / numBucketsPerWindow,
+	}
+}
+
+func (sw *slidingWindow) advance(now time.Time) {
+	index := now.UnixNano() / sw.bucketDuration
+	if index <= sw.lastIndex {
+		return
+	}
+
+	numBucketsToClear := index - sw.lastIndex
+	if numBucketsToClear > numBucketsPerWindow {
+		numBucketsToClear = numBucketsPerWindow
+	}
+	for i := int64(1); i <= numBucketsToClear; i++ {
+		sw.buckets[(sw.lastIndex+i)%numBucketsPerWindow] = 0
+	}
+	sw.lastIndex = index
+}
+
+func (sw *slidingWindow) add(numBytes uint64, now time.Time) {
+	sw.advance(now)
+	sw.buckets[sw.lastIndex%numBucketsPerWindow] += numBytes
+	sw.total += numBytes
+}
+
+func (sw *slidingWindow) sum(now time.Time) uint64 {
+	sw.advance(now)
+
+	sum := uint64(0)
+	for _, numBytes := range sw.buckets {
+		sum += numBytes
+	}
+
+	return sum
+}
+
+func (sw *slidingWindow) bytesPerSecond(now time.Time) uint64 {
+	return uint64(float64(sw.sum(now)) / sw.window.Seconds())
+}
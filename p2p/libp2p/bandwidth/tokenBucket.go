@@ -0,0 +1,61 @@
+package bandwidth
+
+import (
+	"time"
+)
+
+// tokenBucket limits the outbound rate of a topic class. The messages are allowed to overdraw the bucket, so the
+// messages larger than its capacity can still be sent, while the following messages are dropped until the debt is
+// paid. It is not concurrent safe
+type tokenBucket struct {
+	bytesPerSec float64
+	capacity    float64
+	tokens      float64
+	lastRefill  time.Time
+}
+
+func newTokenBucket(bytesPerSec uint64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		bytesPerSec: float64(bytesPerSec),
+		capacity:    float64(bytesPerSec),
+		tokens:      float64(bytesPerSec),
+		lastRefill:  now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens += elapsed.Seconds() * tb.bytesPerSec
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+	tb.lastRefill = now
+}
+
+func (tb *tokenBucket) canConsume(now time.Time) bool {
+	if tb == nil {
+		return true
+	}
+
+	tb.refill(now)
+
+	return tb.tokens > 0
+}
+
+// consume takes the tokens even if the bucket is already in debt. The debt is limited to the bucket capacity so the
+// lower priority traffic can not be starved for longer than a second after a burst of prioritized traffic
+func (tb *tokenBucket) consume(numBytes uint64, now time.Time) {
+	if tb == nil {
+		return
+	}
+
+	tb.refill(now)
+	tb.tokens -= float64(numBytes)
+	if tb.tokens < -tb.capacity {
+		tb.tokens = -tb.capacity
+	}
+}
@@ -0,0 +1,76 @@
+package compression
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+type compressor interface {
+	compress(payload []byte) []byte
+	decompress(payload []byte) ([]byte, error)
+	close()
+}
+
+type snappyCompressor struct {
+	maxDecompressedSize int
+}
+
+func (sc *snappyCompressor) compress(payload []byte) []byte {
+	return snappy.Encode(nil, payload)
+}
+
+func (sc *snappyCompressor) decompress(payload []byte) ([]byte, error) {
+	decompressedSize, err := snappy.DecodedLen(payload)
+	if err != nil {
+		return nil, err
+	}
+	if decompressedSize > sc.maxDecompressedSize {
+		return nil, fmt.Errorf("%w, decompressed size: %d, maximum: %d",
+			p2p.ErrMessageTooLarge, decompressedSize, sc.maxDecompressedSize)
+	}
+
+	return snappy.Decode(nil, payload)
+}
+
+func (sc *snappyCompressor) close() {
+}
+
+// zstdCompressor uses a single encoder and a single decoder as both EncodeAll and DecodeAll are safe for concurrent use
+type zstdCompressor struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCompressor(maxDecompressedSize int) (*zstdCompressor, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(maxDecompressedSize)))
+	if err != nil {
+		_ = encoder.Close()
+		return nil, err
+	}
+
+	return &zstdCompressor{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (zc *zstdCompressor) compress(payload []byte) []byte {
+	return zc.encoder.EncodeAll(payload, nil)
+}
+
+func (zc *zstdCompressor) decompress(payload []byte) ([]byte, error) {
+	return zc.decoder.DecodeAll(payload, nil)
+}
+
+func (zc *zstdCompressor) close() {
+	_ = zc.encoder.Close()
+	zc.decoder.Close()
+}
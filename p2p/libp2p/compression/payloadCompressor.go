@@ -0,0 +1,214 @@
+package compression
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	coreAtomic "github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const (
+	// NoCompression marks the payloads sent uncompressed
+	NoCompression = uint32(0)
+	// SnappyCompression marks the payloads compressed with snappy
+	SnappyCompression = uint32(1)
+	// ZstdCompression marks the payloads compressed with zstd
+	ZstdCompression = uint32(2)
+)
+
+var log = logger.GetOrCreate("p2p/libp2p/compression")
+
+var algorithms = map[string]uint32{
+	"snappy": SnappyCompression,
+	"zstd":   ZstdCompression,
+}
+
+// ArgsPayloadCompressor is the DTO used to create a new payload compressor
+type ArgsPayloadCompressor struct {
+	Config          config.P2PCompressionConfig
+	MaxPayloadSize  int
+	ActivationEpoch uint32
+}
+
+type topicStatistics struct {
+	numCompressedSent       uint64
+	numDecompressedReceived uint64
+	bytesSavedOnSend        uint64
+	bytesSavedOnReceive     uint64
+}
+
+// payloadCompressor compresses the outgoing payloads of the configured topics and decompresses the incoming payloads
+// compressed with any of the known algorithms. The decompression does not depend on the configuration so the nodes
+// can decompress the messages of the peers that already enabled the compression. As the nodes unaware of the
+// compression blacklist both the originator and the relayer of a compressed message, the payloads are compressed only
+// after the activation epoch, when the whole network is able to decompress them
+type payloadCompressor struct {
+	isEnabled         bool
+	activationEpoch   uint32
+	flagActivated     coreAtomic.Flag
+	algorithm         uint32
+	minPayloadSize    int
+	topicPrefixes     []string
+	compressors       map[uint32]compressor
+	mutStatistics     sync.RWMutex
+	statisticsOnTopic map[string]*topicStatistics
+}
+
+// NewPayloadCompressor creates a new payload compressor
+func NewPayloadCompressor(args ArgsPayloadCompressor) (*payloadCompressor, error) {
+	if args.MaxPayloadSize <= 0 {
+		return nil, fmt.Errorf("%w for the maximum payload size", p2p.ErrInvalidValue)
+	}
+
+	pc := &payloadCompressor{
+		isEnabled:         args.Config.Enabled,
+		activationEpoch:   args.ActivationEpoch,
+		minPayloadSize:    int(args.Config.MinPayloadSizeInBytes),
+		topicPrefixes:     args.Config.TopicPrefixes,
+		statisticsOnTopic: make(map[string]*topicStatistics),
+	}
+
+	if pc.isEnabled {
+		algorithm, found := algorithms[strings.ToLower(args.Config.Algorithm)]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", p2p.ErrUnknownCompressionAlgorithm, args.Config.Algorithm)
+		}
+		pc.algorithm = algorithm
+	}
+
+	zstdComp, err := newZstdCompressor(args.MaxPayloadSize)
+	if err != nil {
+		return nil, err
+	}
+	pc.compressors = map[uint32]compressor{
+		SnappyCompression: &snappyCompressor{maxDecompressedSize: args.MaxPayloadSize},
+		ZstdCompression:   zstdComp,
+	}
+
+	return pc, nil
+}
+
+func (pc *payloadCompressor) shouldCompress(topic string, payload []byte) bool {
+	if !pc.isEnabled || !pc.flagActivated.IsSet() || len(payload) < pc.minPayloadSize {
+		return false
+	}
+	if len(pc.topicPrefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range pc.topicPrefixes {
+		if strings.HasPrefix(topic, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CompressPayload compresses the payload if the compression is enabled for the provided topic. It returns the
+// algorithm used or NoCompression if the payload was left uncompressed
+func (pc *payloadCompressor) CompressPayload(topic string, payload []byte) ([]byte, uint32) {
+	if !pc.shouldCompress(topic, payload) {
+		return payload, NoCompression
+	}
+
+	compressed := pc.compressors[pc.algorithm].compress(payload)
+	if len(compressed) >= len(payload) {
+		return payload, NoCompression
+	}
+
+	statistics := pc.getTopicStatistics(topic)
+	atomic.AddUint64(&statistics.numCompressedSent, 1)
+	atomic.AddUint64(&statistics.bytesSavedOnSend, uint64(len(payload)-len(compressed)))
+
+	return compressed, pc.algorithm
+}
+
+// DecompressPayload decompresses the payload compressed with the provided algorithm
+func (pc *payloadCompressor) DecompressPayload(topic string, algorithm uint32, payload []byte) ([]byte, error) {
+	if algorithm == NoCompression {
+		return payload, nil
+	}
+
+	comp, found := pc.compressors[algorithm]
+	if !found {
+		return nil, fmt.Errorf("%w: %d", p2p.ErrUnknownCompressionAlgorithm, algorithm)
+	}
+
+	decompressed, err := comp.decompress(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", p2p.ErrPayloadDecompression, err.Error())
+	}
+
+	statistics := pc.getTopicStatistics(topic)
+	atomic.AddUint64(&statistics.numDecompressedReceived, 1)
+	if len(decompressed) > len(payload) {
+		atomic.AddUint64(&statistics.bytesSavedOnReceive, uint64(len(decompressed)-len(payload)))
+	}
+
+	return decompressed, nil
+}
+
+func (pc *payloadCompressor) getTopicStatistics(topic string) *topicStatistics {
+	pc.mutStatistics.RLock()
+	statistics, found := pc.statisticsOnTopic[topic]
+	pc.mutStatistics.RUnlock()
+	if found {
+		return statistics
+	}
+
+	pc.mutStatistics.Lock()
+	defer pc.mutStatistics.Unlock()
+
+	statistics, found = pc.statisticsOnTopic[topic]
+	if !found {
+		statistics = &topicStatistics{}
+		pc.statisticsOnTopic[topic] = statistics
+	}
+
+	return statistics
+}
+
+// Statistics returns the compression counters of each topic that had compressed messages
+func (pc *payloadCompressor) Statistics() map[string]p2p.CompressionStatistics {
+	pc.mutStatistics.RLock()
+	defer pc.mutStatistics.RUnlock()
+
+	result := make(map[string]p2p.CompressionStatistics, len(pc.statisticsOnTopic))
+	for topic, statistics := range pc.statisticsOnTopic {
+		result[topic] = p2p.CompressionStatistics{
+			NumCompressedSent:       atomic.LoadUint64(&statistics.numCompressedSent),
+			NumDecompressedReceived: atomic.LoadUint64(&statistics.numDecompressedReceived),
+			BytesSavedOnSend:        atomic.LoadUint64(&statistics.bytesSavedOnSend),
+			BytesSavedOnReceive:     atomic.LoadUint64(&statistics.bytesSavedOnReceive),
+		}
+	}
+
+	return result
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (pc *payloadCompressor) EpochConfirmed(epoch uint32, _ uint64) {
+	pc.flagActivated.SetValue(epoch >= pc.activationEpoch)
+	log.Debug("payload compressor: compression of the sent messages",
+		"enabled", pc.isEnabled && pc.flagActivated.IsSet())
+}
+
+// Close releases the resources used by the compression algorithms
+func (pc *payloadCompressor) Close() error {
+	for _, comp := range pc.compressors {
+		comp.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *payloadCompressor) IsInterfaceNil() bool {
+	return pc == nil
+}
@@ -0,0 +1,252 @@
+package compression_test
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxPayloadSize = 1 << 16
+
+func createPayloadCompressor(t *testing.T, cfg config.P2PCompressionConfig) libp2p.PayloadCompressor {
+	pc, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+		Config:         cfg,
+		MaxPayloadSize: maxPayloadSize,
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = pc.Close()
+	})
+	pc.EpochConfirmed(0, 0)
+
+	return pc
+}
+
+func TestNewPayloadCompressor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid maximum payload size should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{})
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.Nil(t, pc)
+	})
+	t.Run("unknown algorithm should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+			Config: config.P2PCompressionConfig{
+				Enabled:   true,
+				Algorithm: "gzip",
+			},
+			MaxPayloadSize: maxPayloadSize,
+		})
+		assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionAlgorithm))
+		assert.Nil(t, pc)
+	})
+	t.Run("unknown algorithm on disabled compression should work", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{Algorithm: "gzip"})
+		assert.False(t, pc.IsInterfaceNil())
+	})
+}
+
+func TestPayloadCompressor_CompressPayload(t *testing.T) {
+	t.Parallel()
+
+	largePayload := bytes.Repeat([]byte("compressible payload "), 100)
+	t.Run("disabled compression should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{Algorithm: "snappy"})
+		payload, algorithm := pc.CompressPayload("topic", largePayload)
+		assert.Equal(t, largePayload, payload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+	})
+	t.Run("should not compress before the activation epoch", func(t *testing.T) {
+		t.Parallel()
+
+		pc, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+			Config: config.P2PCompressionConfig{
+				Enabled:   true,
+				Algorithm: "snappy",
+			},
+			MaxPayloadSize:  maxPayloadSize,
+			ActivationEpoch: 5,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = pc.Close()
+		}()
+
+		payload, algorithm := pc.CompressPayload("topic", largePayload)
+		assert.Equal(t, largePayload, payload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+
+		pc.EpochConfirmed(4, 0)
+		_, algorithm = pc.CompressPayload("topic", largePayload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+
+		pc.EpochConfirmed(5, 0)
+		_, algorithm = pc.CompressPayload("topic", largePayload)
+		assert.Equal(t, compression.SnappyCompression, algorithm)
+	})
+	t.Run("small payload or other topic should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{
+			Enabled:               true,
+			Algorithm:             "snappy",
+			MinPayloadSizeInBytes: 100,
+			TopicPrefixes:         []string{"transactions"},
+		})
+		payload, algorithm := pc.CompressPayload("transactions_0", largePayload[:99])
+		assert.Equal(t, largePayload[:99], payload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+
+		payload, algorithm = pc.CompressPayload("consensus_0", largePayload)
+		assert.Equal(t, largePayload, payload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+		assert.Equal(t, 0, len(pc.Statistics()))
+	})
+	t.Run("incompressible payload should be sent uncompressed", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{
+			Enabled:   true,
+			Algorithm: "zstd",
+		})
+		incompressible := []byte("a")
+		payload, algorithm := pc.CompressPayload("topic", incompressible)
+		assert.Equal(t, incompressible, payload)
+		assert.Equal(t, compression.NoCompression, algorithm)
+	})
+	t.Run("should compress", func(t *testing.T) {
+		t.Parallel()
+
+		for name, expectedAlgorithm := range map[string]uint32{
+			"snappy": compression.SnappyCompression,
+			"ZSTD":   compression.ZstdCompression,
+		} {
+			pc := createPayloadCompressor(t, config.P2PCompressionConfig{
+				Enabled:       true,
+				Algorithm:     name,
+				TopicPrefixes: []string{"transactions"},
+			})
+			payload, algorithm := pc.CompressPayload("transactions_0_1", largePayload)
+			assert.Equal(t, expectedAlgorithm, algorithm)
+			assert.True(t, len(payload) < len(largePayload))
+
+			decompressed, err := pc.DecompressPayload("transactions_0_1", algorithm, payload)
+			assert.Nil(t, err)
+			assert.Equal(t, largePayload, decompressed)
+
+			bytesSaved := uint64(len(largePayload) - len(payload))
+			expectedStatistics := map[string]p2p.CompressionStatistics{
+				"transactions_0_1": {
+					NumCompressedSent:       1,
+					NumDecompressedReceived: 1,
+					BytesSavedOnSend:        bytesSaved,
+					BytesSavedOnReceive:     bytesSaved,
+				},
+			}
+			assert.Equal(t, expectedStatistics, pc.Statistics())
+		}
+	})
+}
+
+func TestPayloadCompressor_DecompressPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uncompressed payload should be returned as it is", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{})
+		payload, err := pc.DecompressPayload("topic", compression.NoCompression, []byte("payload"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("payload"), payload)
+	})
+	t.Run("unknown algorithm should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{})
+		payload, err := pc.DecompressPayload("topic", 37, []byte("payload"))
+		assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionAlgorithm))
+		assert.Nil(t, payload)
+	})
+	t.Run("corrupted payload should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc := createPayloadCompressor(t, config.P2PCompressionConfig{})
+		for _, algorithm := range []uint32{compression.SnappyCompression, compression.ZstdCompression} {
+			payload, err := pc.DecompressPayload("topic", algorithm, []byte("not a compressed payload"))
+			assert.True(t, errors.Is(err, p2p.ErrPayloadDecompression))
+			assert.Nil(t, payload)
+		}
+	})
+	t.Run("payload decompressing above the maximum size should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{"snappy", "zstd"} {
+			sender, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+				Config: config.P2PCompressionConfig{
+					Enabled:   true,
+					Algorithm: name,
+				},
+				MaxPayloadSize: maxPayloadSize * 4,
+			})
+			require.Nil(t, err)
+			sender.EpochConfirmed(0, 0)
+
+			compressed, algorithm := sender.CompressPayload("topic", make([]byte, maxPayloadSize*2))
+			_ = sender.Close()
+			require.NotEqual(t, compression.NoCompression, algorithm)
+
+			pc := createPayloadCompressor(t, config.P2PCompressionConfig{})
+			payload, err := pc.DecompressPayload("topic", algorithm, compressed)
+			assert.True(t, errors.Is(err, p2p.ErrPayloadDecompression), name)
+			assert.Nil(t, payload)
+		}
+	})
+}
+
+func TestPayloadCompressor_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	pc := createPayloadCompressor(t, config.P2PCompressionConfig{
+		Enabled:   true,
+		Algorithm: "zstd",
+	})
+	largePayload := bytes.Repeat([]byte("compressible payload "), 100)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			topic := []string{"topic1", "topic2", "topic3"}[idx%3]
+			payload, algorithm := pc.CompressPayload(topic, largePayload)
+			decompressed, err := pc.DecompressPayload(topic, algorithm, payload)
+			assert.Nil(t, err)
+			assert.Equal(t, largePayload, decompressed)
+			_ = pc.Statistics()
+		}(i)
+	}
+	wg.Wait()
+
+	statistics := pc.Statistics()
+	assert.Equal(t, 3, len(statistics))
+	assert.Equal(t, uint64(34), statistics["topic1"].NumCompressedSent)
+}
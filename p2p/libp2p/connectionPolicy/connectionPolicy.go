@@ -0,0 +1,250 @@
+package connectionPolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/control"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+var log = logger.GetOrCreate("p2p/libp2p/connectionpolicy")
+
+// ArgsConnectionPolicy is the DTO used to create a new connection policy
+type ArgsConnectionPolicy struct {
+	RulesFilePath  string
+	ReloadInterval time.Duration
+}
+
+// connectionPolicy enforces the static allow/deny rules loaded from a file. It is used as the libp2p connection gater
+// so the denied peers are rejected before their connections are accepted or dialed
+type connectionPolicy struct {
+	rulesFilePath     string
+	mutRules          sync.RWMutex
+	rules             *rules
+	lastModTime       time.Time
+	mutResolver       sync.RWMutex
+	peerShardResolver p2p.PeerShardResolver
+	numDenied         uint64
+	cancel            func()
+}
+
+// NewConnectionPolicy creates a new connection policy, loading the rules from the provided file. A non-zero reload
+// interval makes the policy reload the rules each time the file changes
+func NewConnectionPolicy(args ArgsConnectionPolicy) (*connectionPolicy, error) {
+	if len(args.RulesFilePath) == 0 {
+		return nil, fmt.Errorf("%w: empty rules file path", p2p.ErrInvalidConnectionPolicyRules)
+	}
+
+	cp := &connectionPolicy{
+		rulesFilePath: args.RulesFilePath,
+	}
+	_, err := cp.reloadRulesIfChanged()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cp.cancel = cancel
+	if args.ReloadInterval > 0 {
+		go cp.reloadLoop(ctx, args.ReloadInterval)
+	}
+
+	return cp, nil
+}
+
+func (cp *connectionPolicy) reloadLoop(ctx context.Context, reloadInterval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing connectionPolicy.reloadLoop go routine")
+			return
+		case <-time.After(reloadInterval):
+		}
+
+		reloaded, err := cp.reloadRulesIfChanged()
+		if err != nil {
+			log.Warn("connection policy rules file could not be reloaded, keeping the previous rules",
+				"file", cp.rulesFilePath, "error", err)
+			continue
+		}
+		if reloaded {
+			log.Info("connection policy rules reloaded", "file", cp.rulesFilePath, "rules", cp.String())
+		}
+	}
+}
+
+func (cp *connectionPolicy) reloadRulesIfChanged() (bool, error) {
+	fileInfo, err := os.Stat(cp.rulesFilePath)
+	if err != nil {
+		return false, err
+	}
+
+	cp.mutRules.RLock()
+	isUnchanged := cp.rules != nil && fileInfo.ModTime().Equal(cp.lastModTime)
+	cp.mutRules.RUnlock()
+	if isUnchanged {
+		return false, nil
+	}
+
+	rulesConfig := config.ConnectionPolicyRulesConfig{}
+	err = core.LoadTomlFile(&rulesConfig, cp.rulesFilePath)
+	if err != nil {
+		return false, err
+	}
+
+	newPolicyRules, err := newRules(rulesConfig)
+	if err != nil {
+		return false, err
+	}
+
+	cp.mutRules.Lock()
+	cp.rules = newPolicyRules
+	cp.lastModTime = fileInfo.ModTime()
+	cp.mutRules.Unlock()
+
+	return true, nil
+}
+
+// SetPeerShardResolver sets the component able to map the peer IDs to validators public keys, needed by the public
+// key rules
+func (cp *connectionPolicy) SetPeerShardResolver(peerShardResolver p2p.PeerShardResolver) error {
+	if check.IfNil(peerShardResolver) {
+		return p2p.ErrNilPeerShardResolver
+	}
+
+	cp.mutResolver.Lock()
+	cp.peerShardResolver = peerShardResolver
+	cp.mutResolver.Unlock()
+
+	return nil
+}
+
+func (cp *connectionPolicy) getPublicKey(pid core.PeerID) []byte {
+	cp.mutResolver.RLock()
+	defer cp.mutResolver.RUnlock()
+
+	if check.IfNil(cp.peerShardResolver) {
+		return nil
+	}
+
+	return cp.peerShardResolver.GetPeerInfo(pid).PkBytes
+}
+
+func (cp *connectionPolicy) getRules() *rules {
+	cp.mutRules.RLock()
+	defer cp.mutRules.RUnlock()
+
+	return cp.rules
+}
+
+// IsConnectionAllowed returns true if the rules allow the connection with the provided peer on at least one of the
+// provided addresses
+func (cp *connectionPolicy) IsConnectionAllowed(pid core.PeerID, addresses []multiaddr.Multiaddr) bool {
+	currentRules := cp.getRules()
+	publicKey := cp.getPublicKey(pid)
+	if len(addresses) == 0 {
+		return cp.checkAllowed(currentRules.isAllowed(pid, publicKey, nil))
+	}
+
+	for _, address := range addresses {
+		if currentRules.isAllowed(pid, publicKey, toIP(address)) {
+			return true
+		}
+	}
+
+	return cp.checkAllowed(false)
+}
+
+func (cp *connectionPolicy) checkAllowed(isAllowed bool) bool {
+	if !isAllowed {
+		atomic.AddUint64(&cp.numDenied, 1)
+	}
+
+	return isAllowed
+}
+
+func toIP(address multiaddr.Multiaddr) net.IP {
+	if address == nil {
+		return nil
+	}
+
+	ip, err := manet.ToIP(address)
+	if err != nil {
+		return nil
+	}
+
+	return ip
+}
+
+// InterceptPeerDial rejects the dials to the peers denied by their peer ID or public key. The address rules are
+// checked later, in InterceptAddrDial
+func (cp *connectionPolicy) InterceptPeerDial(p peer.ID) bool {
+	pid := core.PeerID(p)
+	publicKey := cp.getPublicKey(pid)
+
+	return cp.checkAllowed(!cp.getRules().deny.matches(pid, publicKey, nil))
+}
+
+// InterceptAddrDial tests whether the peer can be dialed on the provided address
+func (cp *connectionPolicy) InterceptAddrDial(p peer.ID, address multiaddr.Multiaddr) bool {
+	pid := core.PeerID(p)
+
+	return cp.checkAllowed(cp.getRules().isAllowed(pid, cp.getPublicKey(pid), toIP(address)))
+}
+
+// InterceptAccept rejects the incoming connections from denied networks. The peer ID and public key rules are checked
+// in InterceptSecured, once the remote peer is authenticated
+func (cp *connectionPolicy) InterceptAccept(addresses network.ConnMultiaddrs) bool {
+	ip := toIP(addresses.RemoteMultiaddr())
+
+	return cp.checkAllowed(!cp.getRules().deny.matches("", nil, ip))
+}
+
+// InterceptSecured tests whether the authenticated connection with the provided peer is allowed
+func (cp *connectionPolicy) InterceptSecured(_ network.Direction, p peer.ID, addresses network.ConnMultiaddrs) bool {
+	pid := core.PeerID(p)
+	ip := toIP(addresses.RemoteMultiaddr())
+
+	return cp.checkAllowed(cp.getRules().isAllowed(pid, cp.getPublicKey(pid), ip))
+}
+
+// InterceptUpgraded allows all the upgraded connections as they were already checked
+func (cp *connectionPolicy) InterceptUpgraded(_ network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+// NumDeniedConnections returns the number of connections denied by the policy since the node started
+func (cp *connectionPolicy) NumDeniedConnections() uint64 {
+	return atomic.LoadUint64(&cp.numDenied)
+}
+
+// String returns a summary of the current rules
+func (cp *connectionPolicy) String() string {
+	return cp.getRules().String()
+}
+
+// Close stops the rules reloading
+func (cp *connectionPolicy) Close() error {
+	cp.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *connectionPolicy) IsInterfaceNil() bool {
+	return cp == nil
+}
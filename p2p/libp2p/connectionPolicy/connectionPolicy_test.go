@@ -0,0 +1,250 @@
+package connectionPolicy_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionPolicy"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPeerID(t *testing.T) peer.ID {
+	_, pk, err := crypto.GenerateSecp256k1Key(nil)
+	require.Nil(t, err)
+
+	pid, err := peer.IDFromPublicKey(pk)
+	require.Nil(t, err)
+
+	return pid
+}
+
+func writeRulesFile(t *testing.T, path string, content string, modTime time.Time) {
+	err := os.WriteFile(path, []byte(content), 0644)
+	require.Nil(t, err)
+
+	err = os.Chtimes(path, modTime, modTime)
+	require.Nil(t, err)
+}
+
+func createPolicy(t *testing.T, content string) libp2p.ConnectionPolicy {
+	path := filepath.Join(t.TempDir(), "connectionPolicy.toml")
+	writeRulesFile(t, path, content, time.Now())
+
+	cp, err := connectionPolicy.NewConnectionPolicy(connectionPolicy.ArgsConnectionPolicy{
+		RulesFilePath: path,
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = cp.Close()
+	})
+
+	return cp
+}
+
+func createConnMultiaddrs(address string) network.ConnMultiaddrs {
+	return &mock.ConnStub{
+		RemoteMultiaddrCalled: func() multiaddr.Multiaddr {
+			return multiaddr.StringCast(address)
+		},
+	}
+}
+
+func TestNewConnectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty rules file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := connectionPolicy.NewConnectionPolicy(connectionPolicy.ArgsConnectionPolicy{})
+		assert.True(t, errors.Is(err, p2p.ErrInvalidConnectionPolicyRules))
+		assert.Nil(t, cp)
+	})
+	t.Run("missing rules file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := connectionPolicy.NewConnectionPolicy(connectionPolicy.ArgsConnectionPolicy{
+			RulesFilePath: filepath.Join(t.TempDir(), "missing.toml"),
+		})
+		assert.NotNil(t, err)
+		assert.Nil(t, cp)
+	})
+	t.Run("invalid rules should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRules := []string{
+			`DefaultAction = "reject"`,
+			"DefaultAction = \"allow\"\n[Allow]\nCIDRs = [\"10.0.0.0/33\"]",
+			"DefaultAction = \"allow\"\n[Deny]\nPeerIDs = [\"not a peer ID\"]",
+			"DefaultAction = \"allow\"\n[Deny]\nPublicKeys = [\"not hex\"]",
+		}
+		for _, content := range invalidRules {
+			path := filepath.Join(t.TempDir(), "connectionPolicy.toml")
+			writeRulesFile(t, path, content, time.Now())
+
+			cp, err := connectionPolicy.NewConnectionPolicy(connectionPolicy.ArgsConnectionPolicy{
+				RulesFilePath: path,
+			})
+			assert.True(t, errors.Is(err, p2p.ErrInvalidConnectionPolicyRules), content)
+			assert.Nil(t, cp)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cp := createPolicy(t, `DefaultAction = "Deny"`)
+		assert.False(t, cp.IsInterfaceNil())
+		assert.Equal(t, "default: deny, allow: 0 networks 0 peers 0 keys, deny: 0 networks 0 peers 0 keys", cp.String())
+	})
+}
+
+func TestConnectionPolicy_IsConnectionAllowed(t *testing.T) {
+	t.Parallel()
+
+	deniedPid := createPeerID(t)
+	allowedPid := createPeerID(t)
+	otherPid := createPeerID(t)
+	content := `DefaultAction = "deny"
+[Allow]
+    CIDRs = ["10.0.0.0/8"]
+    PeerIDs = ["` + allowedPid.Pretty() + `"]
+[Deny]
+    CIDRs = ["10.1.0.0/16"]
+    PeerIDs = ["` + deniedPid.Pretty() + `"]
+`
+	cp := createPolicy(t, content)
+
+	addressInAllowedNetwork := multiaddr.StringCast("/ip4/10.2.0.1/tcp/37373")
+	addressInDeniedNetwork := multiaddr.StringCast("/ip4/10.1.0.1/tcp/37373")
+	otherAddress := multiaddr.StringCast("/ip4/192.168.0.1/tcp/37373")
+
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(otherPid), []multiaddr.Multiaddr{addressInAllowedNetwork}))
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(allowedPid), []multiaddr.Multiaddr{otherAddress}))
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(allowedPid), nil))
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(otherPid), []multiaddr.Multiaddr{addressInDeniedNetwork, addressInAllowedNetwork}))
+	assert.Equal(t, uint64(0), cp.NumDeniedConnections())
+
+	// the deny rules take precedence over the allow rules
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(otherPid), []multiaddr.Multiaddr{addressInDeniedNetwork}))
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(deniedPid), []multiaddr.Multiaddr{addressInAllowedNetwork}))
+	// the default action
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(otherPid), []multiaddr.Multiaddr{otherAddress}))
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(otherPid), nil))
+	assert.Equal(t, uint64(4), cp.NumDeniedConnections())
+}
+
+func TestConnectionPolicy_PublicKeyRules(t *testing.T) {
+	t.Parallel()
+
+	deniedPk := []byte("denied public key")
+	deniedPid := createPeerID(t)
+	content := `DefaultAction = "allow"
+[Deny]
+    PublicKeys = ["` + hex.EncodeToString(deniedPk) + `"]
+`
+	cp := createPolicy(t, content)
+
+	// not yet mapped to a public key
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(deniedPid), nil))
+
+	err := cp.SetPeerShardResolver(nil)
+	assert.Equal(t, p2p.ErrNilPeerShardResolver, err)
+
+	err = cp.SetPeerShardResolver(&mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			if pid == core.PeerID(deniedPid) {
+				return core.P2PPeerInfo{PkBytes: deniedPk}
+			}
+
+			return core.P2PPeerInfo{}
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(deniedPid), nil))
+	assert.False(t, cp.InterceptPeerDial(deniedPid))
+	assert.True(t, cp.InterceptPeerDial(createPeerID(t)))
+}
+
+func TestConnectionPolicy_Gater(t *testing.T) {
+	t.Parallel()
+
+	deniedPid := createPeerID(t)
+	otherPid := createPeerID(t)
+	content := `DefaultAction = "deny"
+[Allow]
+    CIDRs = ["10.0.0.0/8"]
+[Deny]
+    CIDRs = ["10.1.0.0/16"]
+    PeerIDs = ["` + deniedPid.Pretty() + `"]
+`
+	cp := createPolicy(t, content)
+
+	t.Run("InterceptPeerDial", func(t *testing.T) {
+		assert.False(t, cp.InterceptPeerDial(deniedPid))
+		assert.True(t, cp.InterceptPeerDial(otherPid), "the default action should be applied on the address dial")
+	})
+	t.Run("InterceptAddrDial", func(t *testing.T) {
+		assert.True(t, cp.InterceptAddrDial(otherPid, multiaddr.StringCast("/ip4/10.2.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptAddrDial(otherPid, multiaddr.StringCast("/ip4/10.1.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptAddrDial(otherPid, multiaddr.StringCast("/ip4/192.168.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptAddrDial(deniedPid, multiaddr.StringCast("/ip4/10.2.0.1/tcp/37373")))
+	})
+	t.Run("InterceptAccept", func(t *testing.T) {
+		assert.True(t, cp.InterceptAccept(createConnMultiaddrs("/ip4/192.168.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptAccept(createConnMultiaddrs("/ip4/10.1.0.1/tcp/37373")))
+	})
+	t.Run("InterceptSecured", func(t *testing.T) {
+		assert.True(t, cp.InterceptSecured(network.DirInbound, otherPid, createConnMultiaddrs("/ip4/10.2.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptSecured(network.DirInbound, otherPid, createConnMultiaddrs("/ip4/192.168.0.1/tcp/37373")))
+		assert.False(t, cp.InterceptSecured(network.DirInbound, deniedPid, createConnMultiaddrs("/ip4/10.2.0.1/tcp/37373")))
+	})
+	t.Run("InterceptUpgraded", func(t *testing.T) {
+		isAllowed, _ := cp.InterceptUpgraded(&mock.ConnStub{})
+		assert.True(t, isAllowed)
+	})
+}
+
+func TestConnectionPolicy_ShouldReloadChangedRules(t *testing.T) {
+	t.Parallel()
+
+	pid := createPeerID(t)
+	path := filepath.Join(t.TempDir(), "connectionPolicy.toml")
+	modTime := time.Now().Add(-time.Hour)
+	writeRulesFile(t, path, `DefaultAction = "allow"`, modTime)
+
+	cp, err := connectionPolicy.NewConnectionPolicy(connectionPolicy.ArgsConnectionPolicy{
+		RulesFilePath:  path,
+		ReloadInterval: time.Millisecond * 10,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = cp.Close()
+	}()
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(pid), nil))
+
+	writeRulesFile(t, path, "DefaultAction = \"allow\"\n[Deny]\nPeerIDs = [\""+pid.Pretty()+"\"]", modTime.Add(time.Minute))
+	time.Sleep(time.Millisecond * 200)
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(pid), nil))
+
+	// an invalid file should keep the previous rules
+	writeRulesFile(t, path, `DefaultAction = "reject"`, modTime.Add(time.Minute*2))
+	time.Sleep(time.Millisecond * 200)
+	assert.False(t, cp.IsConnectionAllowed(core.PeerID(pid), nil))
+
+	writeRulesFile(t, path, `DefaultAction = "allow"`, modTime.Add(time.Minute*3))
+	time.Sleep(time.Millisecond * 200)
+	assert.True(t, cp.IsConnectionAllowed(core.PeerID(pid), nil))
+}
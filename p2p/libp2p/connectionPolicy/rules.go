@@ -0,0 +1,143 @@
+package connectionPolicy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	actionAllow = "allow"
+	actionDeny  = "deny"
+)
+
+type ruleSet struct {
+	networks   []*net.IPNet
+	peerIDs    map[core.PeerID]struct{}
+	publicKeys map[string]struct{}
+}
+
+type rules struct {
+	defaultAllow bool
+	allow        *ruleSet
+	deny         *ruleSet
+}
+
+func newRules(rulesConfig config.ConnectionPolicyRulesConfig) (*rules, error) {
+	r := &rules{}
+	switch strings.ToLower(rulesConfig.DefaultAction) {
+	case actionAllow:
+		r.defaultAllow = true
+	case actionDeny:
+		r.defaultAllow = false
+	default:
+		return nil, fmt.Errorf("%w: unknown default action %s", p2p.ErrInvalidConnectionPolicyRules, rulesConfig.DefaultAction)
+	}
+
+	var err error
+	r.allow, err = newRuleSet(rulesConfig.Allow)
+	if err != nil {
+		return nil, fmt.Errorf("%w in the allow rules", err)
+	}
+
+	r.deny, err = newRuleSet(rulesConfig.Deny)
+	if err != nil {
+		return nil, fmt.Errorf("%w in the deny rules", err)
+	}
+
+	return r, nil
+}
+
+func newRuleSet(rulesConfig config.ConnectionRulesConfig) (*ruleSet, error) {
+	rs := &ruleSet{
+		networks:   make([]*net.IPNet, 0, len(rulesConfig.CIDRs)),
+		peerIDs:    make(map[core.PeerID]struct{}),
+		publicKeys: make(map[string]struct{}),
+	}
+
+	for _, cidr := range rulesConfig.CIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", p2p.ErrInvalidConnectionPolicyRules, err.Error())
+		}
+		rs.networks = append(rs.networks, network)
+	}
+
+	for _, pidString := range rulesConfig.PeerIDs {
+		pid, err := peer.Decode(pidString)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid peer ID %s", p2p.ErrInvalidConnectionPolicyRules, pidString)
+		}
+		rs.peerIDs[core.PeerID(pid)] = struct{}{}
+	}
+
+	for _, publicKey := range rulesConfig.PublicKeys {
+		pkBytes, err := hex.DecodeString(publicKey)
+		if err != nil || len(pkBytes) == 0 {
+			return nil, fmt.Errorf("%w: invalid public key %s", p2p.ErrInvalidConnectionPolicyRules, publicKey)
+		}
+		rs.publicKeys[string(pkBytes)] = struct{}{}
+	}
+
+	return rs, nil
+}
+
+// matches returns true if any of the provided, known, attributes of a peer matches a rule. An empty peer ID, an empty
+// public key or a nil IP do not match anything
+func (rs *ruleSet) matches(pid core.PeerID, publicKey []byte, ip net.IP) bool {
+	if len(pid) > 0 {
+		_, found := rs.peerIDs[pid]
+		if found {
+			return true
+		}
+	}
+
+	if len(publicKey) > 0 {
+		_, found := rs.publicKeys[string(publicKey)]
+		if found {
+			return true
+		}
+	}
+
+	if ip == nil {
+		return false
+	}
+	for _, network := range rs.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAllowed applies, in order, the deny rules, the allow rules and the default action
+func (r *rules) isAllowed(pid core.PeerID, publicKey []byte, ip net.IP) bool {
+	if r.deny.matches(pid, publicKey, ip) {
+		return false
+	}
+	if r.allow.matches(pid, publicKey, ip) {
+		return true
+	}
+
+	return r.defaultAllow
+}
+
+func (r *rules) String() string {
+	defaultAction := actionDeny
+	if r.defaultAllow {
+		defaultAction = actionAllow
+	}
+
+	return fmt.Sprintf("default: %s, allow: %s, deny: %s", defaultAction, r.allow.String(), r.deny.String())
+}
+
+func (rs *ruleSet) String() string {
+	return fmt.Sprintf("%d networks %d peers %d keys", len(rs.networks), len(rs.peerIDs), len(rs.publicKeys))
+}
@@ -0,0 +1,72 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/control"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const disabledConnectionPolicy = "disabled"
+
+// ConnectionPolicy is the connection policy used when the static connection rules are not enabled (all peers are
+// allowed)
+type ConnectionPolicy struct {
+}
+
+// IsConnectionAllowed returns true
+func (cp *ConnectionPolicy) IsConnectionAllowed(_ core.PeerID, _ []multiaddr.Multiaddr) bool {
+	return true
+}
+
+// SetPeerShardResolver does nothing and returns nil
+func (cp *ConnectionPolicy) SetPeerShardResolver(_ p2p.PeerShardResolver) error {
+	return nil
+}
+
+// InterceptPeerDial returns true
+func (cp *ConnectionPolicy) InterceptPeerDial(_ peer.ID) bool {
+	return true
+}
+
+// InterceptAddrDial returns true
+func (cp *ConnectionPolicy) InterceptAddrDial(_ peer.ID, _ multiaddr.Multiaddr) bool {
+	return true
+}
+
+// InterceptAccept returns true
+func (cp *ConnectionPolicy) InterceptAccept(_ network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured returns true
+func (cp *ConnectionPolicy) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptUpgraded returns true
+func (cp *ConnectionPolicy) InterceptUpgraded(_ network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+// NumDeniedConnections returns 0
+func (cp *ConnectionPolicy) NumDeniedConnections() uint64 {
+	return 0
+}
+
+// String returns the disabled policy description
+func (cp *ConnectionPolicy) String() string {
+	return disabledConnectionPolicy
+}
+
+// Close returns nil
+func (cp *ConnectionPolicy) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *ConnectionPolicy) IsInterfaceNil() bool {
+	return cp == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// TopologyCrawler is the topology crawler used when the network crawling is not enabled
+type TopologyCrawler struct {
+}
+
+// Snapshot returns ErrTopologyCrawlerDisabled
+func (tc *TopologyCrawler) Snapshot() (*p2p.TopologySnapshot, error) {
+	return nil, p2p.ErrTopologyCrawlerDisabled
+}
+
+// SetPeerShardResolver does nothing and returns nil
+func (tc *TopologyCrawler) SetPeerShardResolver(_ p2p.PeerShardResolver) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *TopologyCrawler) IsInterfaceNil() bool {
+	return tc == nil
+}
@@ -34,6 +34,7 @@ type ArgKadDht struct {
 	RoutingTableRefresh         time.Duration
 	KddSharder                  p2p.Sharder
 	ConnectionWatcher           p2p.ConnectionsWatcher
+	ConnectionPolicy            ConnectionPolicy
 }
 
 // ContinuousKadDhtDiscoverer is the kad-dht discovery type implementation
@@ -53,6 +54,7 @@ type ContinuousKadDhtDiscoverer struct {
 	hostConnManagement   *hostWithConnectionManagement
 	sharder              Sharder
 	connectionWatcher    p2p.ConnectionsWatcher
+	connectionPolicy     ConnectionPolicy
 }
 
 // NewContinuousKadDhtDiscoverer creates a new kad-dht discovery type implementation
@@ -75,6 +77,7 @@ func NewContinuousKadDhtDiscoverer(arg ArgKadDht) (*ContinuousKadDhtDiscoverer,
 		bucketSize:           arg.BucketSize,
 		routingTableRefresh:  arg.RoutingTableRefresh,
 		connectionWatcher:    arg.ConnectionWatcher,
+		connectionPolicy:     arg.ConnectionPolicy,
 	}, nil
 }
 
@@ -91,6 +94,9 @@ func prepareArguments(arg ArgKadDht) (Sharder, error) {
 	if check.IfNil(arg.ConnectionWatcher) {
 		return nil, p2p.ErrNilConnectionsWatcher
 	}
+	if check.IfNil(arg.ConnectionPolicy) {
+		return nil, p2p.ErrNilConnectionPolicy
+	}
 	sharder, ok := arg.KddSharder.(Sharder)
 	if !ok {
 		return nil, fmt.Errorf("%w for sharder: expected discovery.Sharder type of interface", p2p.ErrWrongTypeAssertion)
@@ -129,6 +135,7 @@ func (ckdd *ContinuousKadDhtDiscoverer) startDHT() error {
 		ConnectableHost:    ckdd.host,
 		Sharder:            ckdd.sharder,
 		ConnectionsWatcher: ckdd.connectionWatcher,
+		ConnectionPolicy:   ckdd.connectionPolicy,
 	}
 	ckdd.hostConnManagement, err = NewHostWithConnectionManagement(args)
 	if err != nil {
@@ -29,6 +29,7 @@ func createTestArgument() discovery.ArgKadDht {
 		RoutingTableRefresh:         5 * time.Second,
 		SeedersReconnectionInterval: time.Second * 5,
 		ConnectionWatcher:           &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:            &mock.ConnectionPolicyStub{},
 	}
 }
 
@@ -112,6 +113,17 @@ func TestNewContinuousKadDhtDiscoverer(t *testing.T) {
 		assert.Nil(t, kdd)
 		assert.True(t, errors.Is(err, p2p.ErrNilConnectionsWatcher))
 	})
+	t.Run("nil connection policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createTestArgument()
+		arg.ConnectionPolicy = nil
+
+		kdd, err := discovery.NewContinuousKadDhtDiscoverer(arg)
+
+		assert.Nil(t, kdd)
+		assert.True(t, errors.Is(err, p2p.ErrNilConnectionPolicy))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -61,6 +61,7 @@ func NewOptimizedKadDhtDiscovererWithInitFunc(
 		ConnectableHost:    arg.Host,
 		Sharder:            okdd.sharder,
 		ConnectionsWatcher: arg.ConnectionWatcher,
+		ConnectionPolicy:   arg.ConnectionPolicy,
 	}
 	okdd.hostConnManagement, err = NewHostWithConnectionManagement(argConnectionManagement)
 	if err != nil {
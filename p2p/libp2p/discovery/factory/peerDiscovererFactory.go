@@ -24,6 +24,7 @@ type ArgsPeerDiscoverer struct {
 	Sharder            p2p.Sharder
 	P2pConfig          config.P2PConfig
 	ConnectionsWatcher p2p.ConnectionsWatcher
+	ConnectionPolicy   discovery.ConnectionPolicy
 }
 
 // NewPeerDiscoverer generates an implementation of PeerDiscoverer by parsing the p2pConfig struct
@@ -49,6 +50,7 @@ func createKadDhtPeerDiscoverer(args ArgsPeerDiscoverer) (p2p.PeerDiscoverer, er
 		BucketSize:                  args.P2pConfig.KadDhtPeerDiscovery.BucketSize,
 		RoutingTableRefresh:         time.Second * time.Duration(args.P2pConfig.KadDhtPeerDiscovery.RoutingTableRefreshIntervalInSec),
 		ConnectionWatcher:           args.ConnectionsWatcher,
+		ConnectionPolicy:            args.ConnectionPolicy,
 	}
 
 	switch args.P2pConfig.Sharding.Type {
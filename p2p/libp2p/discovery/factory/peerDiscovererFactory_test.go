@@ -27,6 +27,7 @@ func TestNewPeerDiscoverer_NoDiscoveryEnabledShouldRetNullDiscoverer(t *testing.
 			},
 		},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 	pDiscoverer, err := factory.NewPeerDiscoverer(args)
 	_, ok := pDiscoverer.(*discovery.NilDiscoverer)
@@ -54,6 +55,7 @@ func TestNewPeerDiscoverer_ListsSharderShouldWork(t *testing.T) {
 			},
 		},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 
 	pDiscoverer, err := factory.NewPeerDiscoverer(args)
@@ -83,6 +85,7 @@ func TestNewPeerDiscoverer_OptimizedKadDhtShouldWork(t *testing.T) {
 			},
 		},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 	pDiscoverer, err := factory.NewPeerDiscoverer(args)
 
@@ -108,6 +111,7 @@ func TestNewPeerDiscoverer_UnknownSharderShouldErr(t *testing.T) {
 			},
 		},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 
 	pDiscoverer, err := factory.NewPeerDiscoverer(args)
@@ -135,6 +139,7 @@ func TestNewPeerDiscoverer_UnknownKadDhtShouldErr(t *testing.T) {
 			},
 		},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 
 	pDiscoverer, err := factory.NewPeerDiscoverer(args)
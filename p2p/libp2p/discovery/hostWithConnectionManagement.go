@@ -18,12 +18,14 @@ type ArgsHostWithConnectionManagement struct {
 	ConnectableHost    ConnectableHost
 	Sharder            Sharder
 	ConnectionsWatcher p2p.ConnectionsWatcher
+	ConnectionPolicy   ConnectionPolicy
 }
 
 type hostWithConnectionManagement struct {
 	ConnectableHost
 	sharder            Sharder
 	connectionsWatcher p2p.ConnectionsWatcher
+	connectionPolicy   ConnectionPolicy
 }
 
 // NewHostWithConnectionManagement returns a host wrapper able to decide if connection initiated to a peer
@@ -38,18 +40,26 @@ func NewHostWithConnectionManagement(args ArgsHostWithConnectionManagement) (*ho
 	if check.IfNil(args.ConnectionsWatcher) {
 		return nil, p2p.ErrNilConnectionsWatcher
 	}
+	if check.IfNil(args.ConnectionPolicy) {
+		return nil, p2p.ErrNilConnectionPolicy
+	}
 
 	return &hostWithConnectionManagement{
 		ConnectableHost:    args.ConnectableHost,
 		sharder:            args.Sharder,
 		connectionsWatcher: args.ConnectionsWatcher,
+		connectionPolicy:   args.ConnectionPolicy,
 	}, nil
 }
 
-// Connect tries to connect to the provided address info if the sharder allows it
+// Connect tries to connect to the provided address info if the connection policy and the sharder allow it
 func (hwcm *hostWithConnectionManagement) Connect(ctx context.Context, pi peer.AddrInfo) error {
 	addresses := concatenateAddresses(pi.Addrs)
 	hwcm.connectionsWatcher.NewKnownConnection(core.PeerID(pi.ID), addresses)
+	if !hwcm.connectionPolicy.IsConnectionAllowed(core.PeerID(pi.ID), pi.Addrs) {
+		return fmt.Errorf("%w, pid: %s", p2p.ErrPeerDeniedByConnectionPolicy, pi.ID.Pretty())
+	}
+
 	err := hwcm.canConnectToPeer(pi.ID)
 	if err != nil {
 		return err
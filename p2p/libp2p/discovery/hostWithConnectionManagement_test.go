@@ -2,6 +2,7 @@ package discovery_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,6 +29,7 @@ func createMockArgsHostWithConnectionManagement() discovery.ArgsHostWithConnecti
 		ConnectableHost:    &mock.ConnectableHostStub{},
 		Sharder:            &mock.KadSharderStub{},
 		ConnectionsWatcher: &mock.ConnectionsWatcherStub{},
+		ConnectionPolicy:   &mock.ConnectionPolicyStub{},
 	}
 }
 
@@ -63,6 +66,16 @@ func TestNewHostWithConnectionManagement(t *testing.T) {
 		assert.True(t, check.IfNil(hwcm))
 		assert.Equal(t, p2p.ErrNilConnectionsWatcher, err)
 	})
+	t.Run("nil connection policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHostWithConnectionManagement()
+		args.ConnectionPolicy = nil
+		hwcm, err := discovery.NewHostWithConnectionManagement(args)
+
+		assert.True(t, check.IfNil(hwcm))
+		assert.Equal(t, p2p.ErrNilConnectionPolicy, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -147,3 +160,30 @@ func TestHostWithConnectionManagement_ConnectWithSharderEvictedShouldNotCallConn
 	assert.False(t, connectCalled)
 	assert.True(t, newKnownConnectionCalled)
 }
+
+func TestHostWithConnectionManagement_ConnectWithPeerDeniedByPolicyShouldNotCallConnect(t *testing.T) {
+	t.Parallel()
+
+	connectCalled := false
+	args := createMockArgsHostWithConnectionManagement()
+	args.ConnectableHost = &mock.ConnectableHostStub{
+		ConnectCalled: func(_ context.Context, _ peer.AddrInfo) error {
+			connectCalled = true
+			return nil
+		},
+		NetworkCalled: func() network.Network {
+			return createStubNetwork()
+		},
+	}
+	args.ConnectionPolicy = &mock.ConnectionPolicyStub{
+		IsConnectionAllowedCalled: func(pid core.PeerID, addresses []multiaddr.Multiaddr) bool {
+			return false
+		},
+	}
+	hwcm, _ := discovery.NewHostWithConnectionManagement(args)
+
+	err := hwcm.Connect(context.Background(), peer.AddrInfo{})
+
+	assert.True(t, errors.Is(err, p2p.ErrPeerDeniedByConnectionPolicy))
+	assert.False(t, connectCalled)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // ConnectableHost is an enhanced Host interface that has the ability to connect to a string address
@@ -25,6 +26,12 @@ type Sharder interface {
 	IsInterfaceNil() bool
 }
 
+// ConnectionPolicy defines the static connection rules checked before connecting to a peer
+type ConnectionPolicy interface {
+	IsConnectionAllowed(pid core.PeerID, addresses []multiaddr.Multiaddr) bool
+	IsInterfaceNil() bool
+}
+
 // KadDhtHandler defines the behavior of a component that can find new peers in a p2p network through kad dht mechanism
 type KadDhtHandler interface {
 	Bootstrap(ctx context.Context) error
@@ -69,6 +69,7 @@ func NewOptimizedKadDhtDiscoverer(arg ArgKadDht) (*optimizedKadDhtDiscoverer, er
 		ConnectableHost:    arg.Host,
 		Sharder:            okdd.sharder,
 		ConnectionsWatcher: okdd.connectionWatcher,
+		ConnectionPolicy:   arg.ConnectionPolicy,
 	}
 	okdd.hostConnManagement, err = NewHostWithConnectionManagement(args)
 	if err != nil {
@@ -17,6 +17,7 @@ var PubsubTimeCacheDuration = pubsubTimeCacheDuration
 var AcceptMessagesInAdvanceDuration = acceptMessagesInAdvanceDuration
 
 const CurrentTopicMessageVersion = currentTopicMessageVersion
+const CompressedTopicMessageVersion = compressedTopicMessageVersion
 const PollWaitForConnectionsInterval = pollWaitForConnectionsInterval
 
 // SetHost -
@@ -1,8 +1,11 @@
 package libp2p
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // ConnectionMonitor defines the behavior of a connection monitor
@@ -15,6 +18,46 @@ type ConnectionMonitor interface {
 	IsInterfaceNil() bool
 }
 
+// ConnectionPolicy defines the static rules deciding which peers can be connected to the node
+type ConnectionPolicy interface {
+	connmgr.ConnectionGater
+	IsConnectionAllowed(pid core.PeerID, addresses []multiaddr.Multiaddr) bool
+	SetPeerShardResolver(peerShardResolver p2p.PeerShardResolver) error
+	NumDeniedConnections() uint64
+	String() string
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// PayloadCompressor defines the component able to compress the outgoing messages payloads and to decompress the
+// incoming ones
+type PayloadCompressor interface {
+	CompressPayload(topic string, payload []byte) ([]byte, uint32)
+	DecompressPayload(topic string, algorithm uint32, payload []byte) ([]byte, error)
+	Statistics() map[string]p2p.CompressionStatistics
+	EpochConfirmed(epoch uint32, timestamp uint64)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// BandwidthMonitor defines the component able to account the traffic on each topic and each peer and to shape the
+// outbound traffic
+type BandwidthMonitor interface {
+	AddInbound(topic string, pid core.PeerID, numBytes uint64)
+	AddOutbound(topic string, pid core.PeerID, numBytes uint64)
+	IsOutboundAllowed(topic string, numBytes uint64) bool
+	Report() *p2p.BandwidthReport
+	IsInterfaceNil() bool
+}
+
+// TopologyCrawler defines the component able to periodically crawl the kad-dht network and to provide the last
+// topology snapshot
+type TopologyCrawler interface {
+	Snapshot() (*p2p.TopologySnapshot, error)
+	SetPeerShardResolver(peerShardResolver p2p.PeerShardResolver) error
+	IsInterfaceNil() bool
+}
+
 // PeerDiscovererWithSharder extends the PeerDiscoverer with the possibility to set the sharder
 type PeerDiscovererWithSharder interface {
 	p2p.PeerDiscoverer
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	pubsub "github.com/ElrondNetwork/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -14,11 +15,19 @@ import (
 
 const currentTopicMessageVersion = uint32(1)
 
+// compressedTopicMessageVersion is used for the messages with compressed payloads so they are never processed as
+// uncompressed ones. The nodes unaware of this version blacklist the originator and the relayer, so the compressed
+// messages are sent only after the compression activation epoch
+const compressedTopicMessageVersion = uint32(2)
+
 // NewMessage returns a new instance of a Message object
-func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, error) {
+func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer, payloadCompressor PayloadCompressor) (*message.Message, error) {
 	if check.IfNil(marshalizer) {
 		return nil, p2p.ErrNilMarshalizer
 	}
+	if check.IfNil(payloadCompressor) {
+		return nil, p2p.ErrNilPayloadCompressor
+	}
 	if msg == nil {
 		return nil, p2p.ErrNilMessage
 	}
@@ -41,10 +50,9 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
 	}
 
-	// TODO change this area when new versions of the message will need to be implemented
-	if topicMessage.Version != currentTopicMessageVersion {
-		return nil, fmt.Errorf("%w, supported %d, got %d",
-			p2p.ErrUnsupportedMessageVersion, currentTopicMessageVersion, topicMessage.Version)
+	payload, err := getPayload(topicMessage, newMsg.TopicField, payloadCompressor)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(topicMessage.SignatureOnPid)+len(topicMessage.Pk) > 0 {
@@ -52,7 +60,7 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 			p2p.ErrUnsupportedFields)
 	}
 
-	newMsg.DataField = topicMessage.Payload
+	newMsg.DataField = payload
 	newMsg.TimestampField = topicMessage.Timestamp
 
 	id, err := peer.IDFromBytes(newMsg.From())
@@ -63,3 +71,23 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 	newMsg.PeerField = core.PeerID(id)
 	return newMsg, nil
 }
+
+func getPayload(topicMessage *data.TopicMessage, topic string, payloadCompressor PayloadCompressor) ([]byte, error) {
+	switch topicMessage.Version {
+	case currentTopicMessageVersion:
+		if topicMessage.Compression != compression.NoCompression {
+			return nil, fmt.Errorf("%w for topicMessage.Compression", p2p.ErrUnsupportedFields)
+		}
+
+		return topicMessage.Payload, nil
+	case compressedTopicMessageVersion:
+		if topicMessage.Compression == compression.NoCompression {
+			return nil, fmt.Errorf("%w, missing topicMessage.Compression", p2p.ErrUnsupportedFields)
+		}
+
+		return payloadCompressor.DecompressPayload(topic, topicMessage.Compression, topicMessage.Payload)
+	default:
+		return nil, fmt.Errorf("%w, supported %d and %d, got %d", p2p.ErrUnsupportedMessageVersion,
+			currentTopicMessageVersion, compressedTopicMessageVersion, topicMessage.Version)
+	}
+}
@@ -1,6 +1,7 @@
 package libp2p_test
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"errors"
@@ -12,10 +13,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/go-libp2p-pubsub"
 	pb "github.com/ElrondNetwork/go-libp2p-pubsub/pb"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/klauspost/compress/snappy"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
@@ -34,7 +38,7 @@ func TestMessage_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pMes := &pubsub.Message{}
-	m, err := libp2p.NewMessage(pMes, nil)
+	m, err := libp2p.NewMessage(pMes, nil, &mock.PayloadCompressorStub{})
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrNilMarshalizer))
@@ -59,7 +63,7 @@ func TestMessage_ShouldErrBecauseOfFromField(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.True(t, check.IfNil(m))
 	assert.NotNil(t, err)
@@ -83,7 +87,7 @@ func TestMessage_ShouldWork(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	require.Nil(t, err)
 	assert.False(t, check.IfNil(m))
@@ -107,7 +111,7 @@ func TestMessage_From(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	require.Nil(t, err)
 	assert.Equal(t, m.From(), from)
@@ -132,7 +136,7 @@ func TestMessage_Peer(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	require.Nil(t, err)
 	assert.Equal(t, core.PeerID(id), m.Peer())
@@ -144,7 +148,7 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
 	topicMessage := &data.TopicMessage{
-		Version:   libp2p.CurrentTopicMessageVersion + 1,
+		Version:   libp2p.CompressedTopicMessageVersion + 1,
 		Timestamp: time.Now().Unix(),
 		Payload:   []byte("data"),
 	}
@@ -157,7 +161,7 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedMessageVersion))
@@ -183,7 +187,7 @@ func TestMessage_PopulatedPkFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -209,7 +213,7 @@ func TestMessage_PopulatedSigFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -233,7 +237,7 @@ func TestMessage_NilTopic(t *testing.T) {
 		Topic: nil,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.Equal(t, p2p.ErrNilTopic, err)
 	assert.True(t, check.IfNil(m))
@@ -244,8 +248,106 @@ func TestMessage_NilMessage(t *testing.T) {
 
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
-	m, err := libp2p.NewMessage(nil, marshalizer)
+	m, err := libp2p.NewMessage(nil, marshalizer, &mock.PayloadCompressorStub{})
 
 	assert.Equal(t, p2p.ErrNilMessage, err)
 	assert.True(t, check.IfNil(m))
 }
+
+func createPubsubMessage(marshalizer p2p.Marshalizer, topicMessage *data.TopicMessage) *pubsub.Message {
+	buff, _ := marshalizer.Marshal(topicMessage)
+	topic := "topic"
+	mes := &pb.Message{
+		From:  getRandomID(),
+		Data:  buff,
+		Topic: &topic,
+	}
+
+	return &pubsub.Message{Message: mes}
+}
+
+func TestMessage_NilPayloadCompressorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, err := libp2p.NewMessage(&pubsub.Message{}, &testscommon.ProtoMarshalizerMock{}, nil)
+
+	assert.True(t, check.IfNil(m))
+	assert.Equal(t, p2p.ErrNilPayloadCompressor, err)
+}
+
+func TestMessage_CompressedPayload(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	t.Run("compression on the uncompressed version should error", func(t *testing.T) {
+		t.Parallel()
+
+		pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+			Version:     libp2p.CurrentTopicMessageVersion,
+			Timestamp:   time.Now().Unix(),
+			Payload:     []byte("data"),
+			Compression: compression.SnappyCompression,
+		})
+		m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
+
+		assert.True(t, check.IfNil(m))
+		assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
+	})
+	t.Run("missing compression on the compressed version should error", func(t *testing.T) {
+		t.Parallel()
+
+		pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+			Version:   libp2p.CompressedTopicMessageVersion,
+			Timestamp: time.Now().Unix(),
+			Payload:   []byte("data"),
+		})
+		m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{})
+
+		assert.True(t, check.IfNil(m))
+		assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
+	})
+	t.Run("decompression error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+			Version:     libp2p.CompressedTopicMessageVersion,
+			Timestamp:   time.Now().Unix(),
+			Payload:     []byte("data"),
+			Compression: compression.SnappyCompression,
+		})
+		m, err := libp2p.NewMessage(pMes, marshalizer, &mock.PayloadCompressorStub{
+			DecompressPayloadCalled: func(topic string, algorithm uint32, payload []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		})
+
+		assert.True(t, check.IfNil(m))
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should decompress", func(t *testing.T) {
+		t.Parallel()
+
+		payloadCompressor, err := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+			MaxPayloadSize: libp2p.MaxSendBuffSize,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = payloadCompressor.Close()
+		}()
+
+		payload := bytes.Repeat([]byte("data"), 100)
+		compressedPayload := snappy.Encode(nil, payload)
+		pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+			Version:     libp2p.CompressedTopicMessageVersion,
+			Timestamp:   time.Now().Unix(),
+			Payload:     compressedPayload,
+			Compression: compression.SnappyCompression,
+		})
+		m, err := libp2p.NewMessage(pMes, marshalizer, payloadCompressor)
+
+		require.Nil(t, err)
+		assert.Equal(t, payload, m.Data())
+		assert.Equal(t, uint64(len(payload)-len(compressedPayload)), payloadCompressor.Statistics()["topic"].BytesSavedOnReceive)
+	})
+}
@@ -24,11 +24,17 @@ func NewMockMessenger(
 		return nil, err
 	}
 
+	policy, err := createConnectionPolicy(args.P2pConfig.ConnectionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	p2pNode := &networkMessenger{
-		p2pHost:    NewConnectableHost(h),
-		ctx:        ctx,
-		cancelFunc: cancelFunc,
+		p2pHost:          NewConnectableHost(h),
+		ctx:              ctx,
+		cancelFunc:       cancelFunc,
+		connectionPolicy: policy,
 	}
 	p2pNode.connectionsWatcher, err = factory.NewConnectionsWatcher(args.P2pConfig.Node.ConnectionWatcherType, ttlConnectionsWatcher)
 	if err != nil {
@@ -19,13 +19,17 @@ import (
 	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/bandwidth"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionMonitor"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionPolicy"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/disabled"
 	discoveryFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	metricsFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/networksharding/factory"
 	randFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/rand/factory"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/topology"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	pubsub "github.com/ElrondNetwork/go-libp2p-pubsub"
 	pubsubPb "github.com/ElrondNetwork/go-libp2p-pubsub/pb"
@@ -36,6 +40,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/multiformats/go-multiaddr"
 )
 
 const (
@@ -118,6 +123,10 @@ type networkMessenger struct {
 	preferredPeersHolder p2p.PreferredPeersHolderHandler
 	connectionsWatcher   p2p.ConnectionsWatcher
 	peersRatingHandler   p2p.PeersRatingHandler
+	connectionPolicy     ConnectionPolicy
+	payloadCompressor    PayloadCompressor
+	bandwidthMonitor     BandwidthMonitor
+	topologyCrawler      TopologyCrawler
 }
 
 // ArgsNetworkMessenger defines the options used to create a p2p wrapper
@@ -129,6 +138,9 @@ type ArgsNetworkMessenger struct {
 	PreferredPeersHolder p2p.PreferredPeersHolderHandler
 	NodeOperationMode    p2p.NodeOperation
 	PeersRatingHandler   p2p.PeersRatingHandler
+	// CompressionEnableEpoch is the epoch from which the payloads are compressed, if the compression is enabled. The
+	// messenger starts compressing only after being notified about an epoch at least equal to this one
+	CompressionEnableEpoch uint32
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -157,23 +169,53 @@ func newNetworkMessenger(args ArgsNetworkMessenger, messageSigning messageSignin
 
 	setupExternalP2PLoggers()
 
-	p2pNode, err := constructNodeWithPortRetry(args, p2pPrivKey)
+	policy, err := createConnectionPolicy(args.P2pConfig.ConnectionPolicy)
 	if err != nil {
 		return nil, err
 	}
 
+	p2pNode, err := constructNodeWithPortRetry(args, p2pPrivKey, policy)
+	if err != nil {
+		log.LogIfError(policy.Close())
+		return nil, err
+	}
+
 	err = addComponentsToNode(args, p2pNode, messageSigning)
 	if err != nil {
 		log.LogIfError(p2pNode.p2pHost.Close())
+		log.LogIfError(policy.Close())
+		if !check.IfNil(p2pNode.payloadCompressor) {
+			log.LogIfError(p2pNode.payloadCompressor.Close())
+		}
 		return nil, err
 	}
 
 	return p2pNode, nil
 }
 
+func createConnectionPolicy(policyConfig config.ConnectionPolicyConfig) (ConnectionPolicy, error) {
+	if !policyConfig.Enabled {
+		return &disabled.ConnectionPolicy{}, nil
+	}
+
+	args := connectionPolicy.ArgsConnectionPolicy{
+		RulesFilePath:  policyConfig.RulesFilePath,
+		ReloadInterval: time.Duration(policyConfig.ReloadIntervalInSec) * time.Second,
+	}
+	policy, err := connectionPolicy.NewConnectionPolicy(args)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("connection policy enabled", "rules", policy.String())
+
+	return policy, nil
+}
+
 func constructNode(
 	args ArgsNetworkMessenger,
 	p2pPrivKey *libp2pCrypto.Secp256k1PrivateKey,
+	policy ConnectionPolicy,
 ) (*networkMessenger, error) {
 
 	port, err := getPort(args.P2pConfig.Node.Port, checkFreePort)
@@ -197,6 +239,9 @@ func constructNode(
 		libp2p.DisableRelay(),
 		libp2p.NATPortMap(),
 	}
+	if args.P2pConfig.ConnectionPolicy.Enabled {
+		opts = append(opts, libp2p.ConnectionGater(policy))
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	h, err := libp2p.New(opts...)
@@ -212,6 +257,7 @@ func constructNode(
 		port:               port,
 		connectionsWatcher: connWatcher,
 		peersRatingHandler: args.PeersRatingHandler,
+		connectionPolicy:   policy,
 	}
 
 	return p2pNode, nil
@@ -220,11 +266,12 @@ func constructNode(
 func constructNodeWithPortRetry(
 	args ArgsNetworkMessenger,
 	p2pPrivKey *libp2pCrypto.Secp256k1PrivateKey,
+	policy ConnectionPolicy,
 ) (*networkMessenger, error) {
 
 	var lastErr error
 	for i := 0; i < maxRetriesIfBindError; i++ {
-		p2pNode, err := constructNode(args, p2pPrivKey)
+		p2pNode, err := constructNode(args, p2pPrivKey, policy)
 		if err == nil {
 			return p2pNode, nil
 		}
@@ -281,6 +328,22 @@ func addComponentsToNode(
 	p2pNode.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pNode.p2pHost.ID()))
 	p2pNode.peersRatingHandler = args.PeersRatingHandler
 
+	p2pNode.payloadCompressor, err = compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+		Config:          args.P2pConfig.Compression,
+		MaxPayloadSize:  maxSendBuffSize,
+		ActivationEpoch: args.CompressionEnableEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	p2pNode.bandwidthMonitor, err = bandwidth.NewBandwidthMonitor(bandwidth.ArgsBandwidthMonitor{
+		Config: args.P2pConfig.Bandwidth,
+	})
+	if err != nil {
+		return err
+	}
+
 	err = p2pNode.createPubSub(messageSigning)
 	if err != nil {
 		return err
@@ -301,6 +364,11 @@ func addComponentsToNode(
 		return err
 	}
 
+	err = p2pNode.createTopologyCrawler(args.P2pConfig)
+	if err != nil {
+		return err
+	}
+
 	p2pNode.createConnectionsMetric()
 
 	p2pNode.ds, err = NewDirectSender(p2pNode.ctx, p2pNode.p2pHost, p2pNode.directMessageHandler)
@@ -366,26 +434,45 @@ func (netMes *networkMessenger) createPubSub(messageSigning messageSigningConfig
 				continue
 			}
 
-			buffToSend := netMes.createMessageBytes(sendableData.Buff)
+			buffToSend := netMes.createMessageBytes(sendableData.Topic, sendableData.Buff)
 			if len(buffToSend) == 0 {
 				continue
 			}
 
+			numBytes := uint64(len(buffToSend))
+			if !netMes.bandwidthMonitor.IsOutboundAllowed(sendableData.Topic, numBytes) {
+				log.Trace("outbound bandwidth exceeded - message dropped",
+					"topic", sendableData.Topic,
+					"size", numBytes,
+				)
+
+				continue
+			}
+
 			errPublish := topic.Publish(netMes.ctx, buffToSend)
 			if errPublish != nil {
 				log.Trace("error sending data", "error", errPublish)
+				continue
 			}
+			netMes.bandwidthMonitor.AddOutbound(sendableData.Topic, "", numBytes)
 		}
 	}(netMes.outgoingPLB)
 
 	return nil
 }
 
-func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
+func (netMes *networkMessenger) createMessageBytes(topic string, buff []byte) []byte {
+	payload, algorithm := netMes.payloadCompressor.CompressPayload(topic, buff)
+	version := currentTopicMessageVersion
+	if algorithm != compression.NoCompression {
+		version = compressedTopicMessageVersion
+	}
+
 	message := &data.TopicMessage{
-		Version:   currentTopicMessageVersion,
-		Payload:   buff,
-		Timestamp: netMes.syncTimer.CurrentTime().Unix(),
+		Version:     version,
+		Payload:     payload,
+		Timestamp:   netMes.syncTimer.CurrentTime().Unix(),
+		Compression: algorithm,
 	}
 
 	buffToSend, errMarshal := netMes.marshalizer.Marshal(message)
@@ -421,6 +508,7 @@ func (netMes *networkMessenger) createDiscoverer(p2pConfig config.P2PConfig) err
 		Sharder:            netMes.sharder,
 		P2pConfig:          p2pConfig,
 		ConnectionsWatcher: netMes.connectionsWatcher,
+		ConnectionPolicy:   netMes.connectionPolicy,
 	}
 
 	netMes.peerDiscoverer, err = discoveryFactory.NewPeerDiscoverer(args)
@@ -428,6 +516,28 @@ func (netMes *networkMessenger) createDiscoverer(p2pConfig config.P2PConfig) err
 	return err
 }
 
+func (netMes *networkMessenger) createTopologyCrawler(p2pConfig config.P2PConfig) error {
+	if !p2pConfig.TopologyCrawler.Enabled {
+		netMes.topologyCrawler = &disabled.TopologyCrawler{}
+		return nil
+	}
+
+	args := topology.ArgsCrawler{
+		Context:           netMes.ctx,
+		Host:              netMes.p2pHost,
+		ProtocolID:        p2pConfig.KadDhtPeerDiscovery.ProtocolID,
+		CrawlInterval:     time.Duration(p2pConfig.TopologyCrawler.CrawlIntervalInSec) * time.Second,
+		Parallelism:       int(p2pConfig.TopologyCrawler.Parallelism),
+		ConnectionTimeout: time.Duration(p2pConfig.TopologyCrawler.ConnectionTimeoutInSec) * time.Second,
+		PeerShardResolver: netMes.peerShardResolver,
+	}
+
+	var err error
+	netMes.topologyCrawler, err = topology.NewCrawler(args)
+
+	return err
+}
+
 func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConfig) error {
 	reconnecter, ok := netMes.peerDiscoverer.(p2p.Reconnecter)
 	if !ok {
@@ -463,6 +573,7 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 	go func() {
 		for {
 			cmw.CheckConnectionsBlocking()
+			netMes.closeConnectionsDeniedByPolicy()
 			select {
 			case <-time.After(durationCheckConnections):
 			case <-netMes.ctx.Done():
@@ -475,6 +586,23 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 	return nil
 }
 
+// closeConnectionsDeniedByPolicy closes the connections that are no longer allowed by the connection policy, either
+// because its rules changed or because a peer was mapped to a denied public key
+func (netMes *networkMessenger) closeConnectionsDeniedByPolicy() {
+	for _, conn := range netMes.p2pHost.Network().Conns() {
+		pid := conn.RemotePeer()
+		if netMes.connectionPolicy.IsConnectionAllowed(core.PeerID(pid), []multiaddr.Multiaddr{conn.RemoteMultiaddr()}) {
+			continue
+		}
+
+		log.Debug("dropping connection to peer denied by the connection policy",
+			"pid", pid.Pretty(),
+			"address", conn.RemoteMultiaddr().String(),
+		)
+		_ = netMes.p2pHost.Network().ClosePeer(pid)
+	}
+}
+
 func (netMes *networkMessenger) createConnectionsMetric() {
 	netMes.connectionsMetric = metrics.NewConnections()
 	netMes.p2pHost.Network().Notify(netMes.connectionsMetric)
@@ -618,9 +746,25 @@ func (netMes *networkMessenger) Close() error {
 			"error", errConnMonitor)
 	}
 
+	log.Debug("closing network messenger's connection policy...")
+	errConnectionPolicy := netMes.connectionPolicy.Close()
+	if errConnectionPolicy != nil {
+		log.Warn("networkMessenger.Close",
+			"component", "connectionPolicy",
+			"error", errConnectionPolicy)
+	}
+
 	log.Debug("closing network messenger's components through the context...")
 	netMes.cancelFunc()
 
+	log.Debug("closing network messenger's payload compressor...")
+	errPayloadCompressor := netMes.payloadCompressor.Close()
+	if errPayloadCompressor != nil {
+		log.Warn("networkMessenger.Close",
+			"component", "payloadCompressor",
+			"error", errPayloadCompressor)
+	}
+
 	log.Debug("closing network messenger's debugger...")
 	errDebugger := netMes.debugger.Close()
 	if errDebugger != nil {
@@ -982,7 +1126,11 @@ func (netMes *networkMessenger) pubsubCallback(topicProcs *topicProcessors, topi
 }
 
 func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message, pid core.PeerID, topic string) (p2p.MessageP2P, error) {
-	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer)
+	if pid != netMes.ID() {
+		netMes.bandwidthMonitor.AddInbound(topic, pid, uint64(len(pbMsg.Data)))
+	}
+
+	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer, netMes.payloadCompressor)
 	if errUnmarshal != nil {
 		// this error is so severe that will need to blacklist both the originator and the connected peer as there is
 		// no way this node can communicate with them
@@ -1135,7 +1283,7 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
+	buffToSend := netMes.createMessageBytes(topic, buff)
 	if len(buffToSend) == 0 {
 		return nil
 	}
@@ -1144,8 +1292,17 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return netMes.sendDirectToSelf(topic, buffToSend)
 	}
 
+	numBytes := uint64(len(buffToSend))
+	if !netMes.bandwidthMonitor.IsOutboundAllowed(topic, numBytes) {
+		netMes.debugger.AddOutgoingMessage(topic, numBytes, true)
+		return p2p.ErrOutboundBandwidthExceeded
+	}
+
 	err = netMes.ds.Send(topic, buffToSend, peerID)
-	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
+	netMes.debugger.AddOutgoingMessage(topic, numBytes, err != nil)
+	if err == nil {
+		netMes.bandwidthMonitor.AddOutbound(topic, peerID, numBytes)
+	}
 
 	return err
 }
@@ -1248,6 +1405,15 @@ func (netMes *networkMessenger) SetPeerShardResolver(peerShardResolver p2p.PeerS
 		return err
 	}
 
+	err = netMes.connectionPolicy.SetPeerShardResolver(peerShardResolver)
+	if err != nil {
+		return err
+	}
+	err = netMes.topologyCrawler.SetPeerShardResolver(peerShardResolver)
+	if err != nil {
+		return err
+	}
+
 	netMes.mutPeerResolver.Lock()
 	netMes.peerShardResolver = peerShardResolver
 	netMes.mutPeerResolver.Unlock()
@@ -1261,6 +1427,27 @@ func (netMes *networkMessenger) SetPeerDenialEvaluator(handler p2p.PeerDenialEva
 	return netMes.connMonitorWrapper.SetPeerDenialEvaluator(handler)
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed. The payloads compression starts on the configured epoch
+func (netMes *networkMessenger) EpochConfirmed(epoch uint32, timestamp uint64) {
+	netMes.payloadCompressor.EpochConfirmed(epoch, timestamp)
+}
+
+// GetCompressionStatistics returns the payload compression counters of each topic
+func (netMes *networkMessenger) GetCompressionStatistics() map[string]p2p.CompressionStatistics {
+	return netMes.payloadCompressor.Statistics()
+}
+
+// GetBandwidthReport returns the traffic counters of the node, split by topic and by peer
+func (netMes *networkMessenger) GetBandwidthReport() *p2p.BandwidthReport {
+	return netMes.bandwidthMonitor.Report()
+}
+
+// GetTopologySnapshot returns the network topology built by the last crawl. Errors if the topology crawler is not
+// enabled or if no crawl finished yet
+func (netMes *networkMessenger) GetTopologySnapshot() (*p2p.TopologySnapshot, error) {
+	return netMes.topologyCrawler.Snapshot()
+}
+
 // GetConnectedPeersInfo gets the current connected peers information
 func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	peers := netMes.p2pHost.Network().Peers()
@@ -1275,6 +1462,8 @@ func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo
 		NumObserversOnShard:      make(map[uint32]int),
 		NumValidatorsOnShard:     make(map[uint32]int),
 		NumPreferredPeersOnShard: make(map[uint32]int),
+		ConnectionPolicy:         netMes.connectionPolicy.String(),
+		NumDeniedConnections:     netMes.connectionPolicy.NumDeniedConnections(),
 	}
 
 	netMes.mutPeerResolver.RLock()
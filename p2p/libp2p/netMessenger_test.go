@@ -620,6 +620,155 @@ func TestLibp2pMessenger_BroadcastDataBetween2PeersWithLargeMsgShouldWork(t *tes
 	_ = mes2.Close()
 }
 
+func TestLibp2pMessenger_CompressedMessagesShouldBeDecompressedByPeersWithDisabledCompression(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible message "), 1000)
+
+	netw := mocknet.New()
+	args := createMockNetworkArgs()
+	args.P2pConfig.Compression = config.P2PCompressionConfig{
+		Enabled:       true,
+		Algorithm:     "zstd",
+		TopicPrefixes: []string{"test"},
+	}
+	args.CompressionEnableEpoch = 2
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes1.EpochConfirmed(2, 0)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(3)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", msg)
+	err := mes1.SendToConnectedPeer("test", msg, mes2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	sentStatistics := mes1.GetCompressionStatistics()["test"]
+	assert.Equal(t, uint64(2), sentStatistics.NumCompressedSent)
+	assert.True(t, sentStatistics.BytesSavedOnSend > 0)
+	receivedStatistics := mes2.GetCompressionStatistics()["test"]
+	assert.Equal(t, uint64(2), receivedStatistics.NumDecompressedReceived)
+	assert.Equal(t, sentStatistics.BytesSavedOnSend, receivedStatistics.BytesSavedOnReceive)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_MessagesShouldNotBeCompressedBeforeTheActivationEpoch(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible message "), 1000)
+
+	netw := mocknet.New()
+	args := createMockNetworkArgs()
+	args.P2pConfig.Compression = config.P2PCompressionConfig{
+		Enabled:       true,
+		Algorithm:     "zstd",
+		TopicPrefixes: []string{"test"},
+	}
+	args.CompressionEnableEpoch = 2
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes1.EpochConfirmed(1, 0)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	_ = mes1.CreateTopic("test", false)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	err := mes1.SendToConnectedPeer("test", msg, mes2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	assert.Equal(t, 0, len(mes1.GetCompressionStatistics()))
+	assert.Equal(t, 0, len(mes2.GetCompressionStatistics()))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_SendToConnectedPeerShouldAccountAndShapeTheOutboundTraffic(t *testing.T) {
+	msg := []byte("trie node")
+
+	netw := mocknet.New()
+	args := createMockNetworkArgs()
+	args.P2pConfig.Bandwidth = config.P2PBandwidthConfig{
+		ShapingEnabled: true,
+		TopicClasses: []config.TopicClassConfig{
+			{
+				Name:                   "trieSync",
+				TopicPrefixes:          []string{"trie"},
+				MaxOutboundBytesPerSec: 1,
+			},
+		},
+	}
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	_ = mes1.CreateTopic("trie", true)
+	_ = mes2.CreateTopic("trie", true)
+	_ = mes2.RegisterMessageProcessor("trie", "identifier", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			wg.Done()
+			return nil
+		},
+	})
+
+	err := mes1.SendToConnectedPeer("trie", msg, mes2.ID())
+	assert.Nil(t, err)
+	err = mes1.SendToConnectedPeer("trie", msg, mes2.ID())
+	assert.Equal(t, p2p.ErrOutboundBandwidthExceeded, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	sentReport := mes1.GetBandwidthReport()
+	assert.True(t, sentReport.Topics["trie"].TotalOutboundBytes > uint64(len(msg)))
+	assert.Equal(t, sentReport.Topics["trie"].TotalOutboundBytes, sentReport.Peers[mes2.ID()].TotalOutboundBytes)
+	assert.Equal(t, sentReport.Topics["trie"].TotalOutboundBytes, sentReport.Topics["trie"].DroppedOutboundBytes)
+	receivedReport := mes2.GetBandwidthReport()
+	assert.Equal(t, sentReport.Topics["trie"].TotalOutboundBytes, receivedReport.Peers[mes1.ID()].TotalInboundBytes)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
 func TestLibp2pMessenger_Peers(t *testing.T) {
 	_, mes1, mes2 := createMockNetworkOf2()
 
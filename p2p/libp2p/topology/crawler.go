@@ -0,0 +1,270 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	dhtCrawler "github.com/libp2p/go-libp2p-kad-dht/crawler"
+)
+
+var log = logger.GetOrCreate("p2p/libp2p/topology")
+
+// kadProtocolSuffix is appended by the kad-dht implementation to the configured protocol prefix
+const kadProtocolSuffix = "/kad/1.0.0"
+
+const (
+	agentVersionKey    = "AgentVersion"
+	protocolVersionKey = "ProtocolVersion"
+)
+
+// ArgsCrawler is the DTO used to create a new topology crawler
+type ArgsCrawler struct {
+	Context           context.Context
+	Host              host.Host
+	ProtocolID        string
+	CrawlInterval     time.Duration
+	Parallelism       int
+	ConnectionTimeout time.Duration
+	PeerShardResolver p2p.PeerShardResolver
+}
+
+// crawler periodically walks the kad-dht network starting from the host's connected peers, querying each peer for
+// its routing table, and keeps the snapshot of the last crawl
+type crawler struct {
+	host              host.Host
+	dhtCrawler        *dhtCrawler.Crawler
+	crawlInterval     time.Duration
+	mutPeerResolver   sync.RWMutex
+	peerShardResolver p2p.PeerShardResolver
+	mutSnapshot       sync.RWMutex
+	snapshot          *p2p.TopologySnapshot
+}
+
+// NewCrawler creates a new topology crawler and starts the periodic crawls. The crawls stop when the provided
+// context is done
+func NewCrawler(args ArgsCrawler) (*crawler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	kadCrawler, err := dhtCrawler.New(
+		args.Host,
+		dhtCrawler.WithProtocols([]protocol.ID{protocol.ID(args.ProtocolID + kadProtocolSuffix)}),
+		dhtCrawler.WithParallelism(args.Parallelism),
+		dhtCrawler.WithConnectTimeout(args.ConnectionTimeout),
+		dhtCrawler.WithMsgTimeout(args.ConnectionTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &crawler{
+		host:              args.Host,
+		dhtCrawler:        kadCrawler,
+		crawlInterval:     args.CrawlInterval,
+		peerShardResolver: args.PeerShardResolver,
+	}
+
+	go c.crawlContinuously(args.Context)
+
+	return c, nil
+}
+
+func checkArgs(args ArgsCrawler) error {
+	if args.Context == nil {
+		return p2p.ErrNilContext
+	}
+	if check.IfNilReflect(args.Host) {
+		return p2p.ErrNilHost
+	}
+	if len(args.ProtocolID) == 0 {
+		return fmt.Errorf("%w for the protocol ID", p2p.ErrInvalidValue)
+	}
+	if args.CrawlInterval < time.Second {
+		return fmt.Errorf("%w for the crawl interval, minimum %v", p2p.ErrInvalidDurationProvided, time.Second)
+	}
+	if args.Parallelism < 1 {
+		return fmt.Errorf("%w for the parallelism", p2p.ErrInvalidValue)
+	}
+	if args.ConnectionTimeout <= 0 {
+		return fmt.Errorf("%w for the connection timeout", p2p.ErrInvalidDurationProvided)
+	}
+	if check.IfNil(args.PeerShardResolver) {
+		return p2p.ErrNilPeerShardResolver
+	}
+
+	return nil
+}
+
+func (c *crawler) crawlContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing the topology crawler go routine")
+			return
+		case <-time.After(c.crawlInterval):
+		}
+
+		snapshot := c.crawl(ctx)
+		log.Debug("topology crawl finished",
+			"reachable peers", snapshot.NumReachable,
+			"unreachable peers", snapshot.NumUnreachable,
+		)
+	}
+}
+
+// crawl queries all the peers reachable from the currently connected peers and builds the topology snapshot
+func (c *crawler) crawl(ctx context.Context) *p2p.TopologySnapshot {
+	startingPeers := make([]*peer.AddrInfo, 0)
+	for _, pid := range c.host.Network().Peers() {
+		addrInfo := c.host.Peerstore().PeerInfo(pid)
+		startingPeers = append(startingPeers, &addrInfo)
+	}
+
+	reachable := make(map[peer.ID][]peer.ID)
+	unreachable := make(map[peer.ID]struct{})
+	// both handlers are called from the crawler's main go routine
+	handleSuccess := func(pid peer.ID, rtPeers []*peer.AddrInfo) {
+		if pid == c.host.ID() {
+			return
+		}
+
+		knownPeers := make([]peer.ID, 0, len(rtPeers))
+		for _, addrInfo := range rtPeers {
+			knownPeers = append(knownPeers, addrInfo.ID)
+		}
+		reachable[pid] = knownPeers
+	}
+	handleFail := func(pid peer.ID, err error) {
+		if pid == c.host.ID() {
+			return
+		}
+
+		log.Trace("topology crawler could not query peer", "pid", pid.Pretty(), "error", err)
+		unreachable[pid] = struct{}{}
+	}
+
+	c.dhtCrawler.Run(ctx, startingPeers, handleSuccess, handleFail)
+
+	snapshot := c.createSnapshot(reachable, unreachable)
+
+	c.mutSnapshot.Lock()
+	c.snapshot = snapshot
+	c.mutSnapshot.Unlock()
+
+	return snapshot
+}
+
+func (c *crawler) createSnapshot(reachable map[peer.ID][]peer.ID, unreachable map[peer.ID]struct{}) *p2p.TopologySnapshot {
+	snapshot := &p2p.TopologySnapshot{
+		Crawler:        c.host.ID().Pretty(),
+		Timestamp:      time.Now().Unix(),
+		NumReachable:   len(reachable),
+		NumUnreachable: len(unreachable),
+		Peers:          make([]p2p.TopologyPeer, 0, len(reachable)+len(unreachable)),
+	}
+
+	for pid, knownPeers := range reachable {
+		topologyPeer := c.createTopologyPeer(pid)
+		topologyPeer.Reachable = true
+		for _, knownPeer := range knownPeers {
+			topologyPeer.KnownPeers = append(topologyPeer.KnownPeers, knownPeer.Pretty())
+		}
+		sort.Strings(topologyPeer.KnownPeers)
+
+		snapshot.Peers = append(snapshot.Peers, topologyPeer)
+	}
+	for pid := range unreachable {
+		snapshot.Peers = append(snapshot.Peers, c.createTopologyPeer(pid))
+	}
+
+	sort.Slice(snapshot.Peers, func(i, j int) bool {
+		return snapshot.Peers[i].Pid < snapshot.Peers[j].Pid
+	})
+
+	return snapshot
+}
+
+func (c *crawler) createTopologyPeer(pid peer.ID) p2p.TopologyPeer {
+	c.mutPeerResolver.RLock()
+	peerInfo := c.peerShardResolver.GetPeerInfo(core.PeerID(pid))
+	c.mutPeerResolver.RUnlock()
+
+	topologyPeer := p2p.TopologyPeer{
+		Pid:                     pid.Pretty(),
+		Addresses:               make([]string, 0),
+		ShardID:                 peerInfo.ShardID,
+		PeerType:                peerInfo.PeerType.String(),
+		AgentVersion:            c.getPeerstoreString(pid, agentVersionKey),
+		ProtocolVersion:         c.getPeerstoreString(pid, protocolVersionKey),
+		Protocols:               make([]string, 0),
+		NumConnectionsToCrawler: len(c.host.Network().ConnsToPeer(pid)),
+		KnownPeers:              make([]string, 0),
+	}
+	for _, address := range c.host.Peerstore().Addrs(pid) {
+		topologyPeer.Addresses = append(topologyPeer.Addresses, address.String())
+	}
+
+	protocols, err := c.host.Peerstore().GetProtocols(pid)
+	if err == nil {
+		topologyPeer.Protocols = append(topologyPeer.Protocols, protocols...)
+		sort.Strings(topologyPeer.Protocols)
+	}
+
+	return topologyPeer
+}
+
+func (c *crawler) getPeerstoreString(pid peer.ID, key string) string {
+	value, err := c.host.Peerstore().Get(pid, key)
+	if err != nil {
+		return ""
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	return str
+}
+
+// Snapshot returns the snapshot of the last finished crawl
+func (c *crawler) Snapshot() (*p2p.TopologySnapshot, error) {
+	c.mutSnapshot.RLock()
+	defer c.mutSnapshot.RUnlock()
+
+	if c.snapshot == nil {
+		return nil, p2p.ErrTopologySnapshotNotAvailable
+	}
+
+	return c.snapshot, nil
+}
+
+// SetPeerShardResolver sets the peer shard resolver used to fill in the shard of the crawled peers
+func (c *crawler) SetPeerShardResolver(peerShardResolver p2p.PeerShardResolver) error {
+	if check.IfNil(peerShardResolver) {
+		return p2p.ErrNilPeerShardResolver
+	}
+
+	c.mutPeerResolver.Lock()
+	c.peerShardResolver = peerShardResolver
+	c.mutPeerResolver.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *crawler) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,238 @@
+package topology_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/topology"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const protocolID = "/erd/kad/1.0.0"
+
+func createHost(t *testing.T) host.Host {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = h.Close()
+	})
+
+	return h
+}
+
+func createDhtHost(ctx context.Context, t *testing.T) (host.Host, *dht.IpfsDHT) {
+	h := createHost(t)
+	kadDht, err := dht.New(ctx, h, dht.ProtocolPrefix(protocolID), dht.Mode(dht.ModeServer))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = kadDht.Close()
+	})
+
+	return h, kadDht
+}
+
+func connectHosts(ctx context.Context, t *testing.T, h1 host.Host, h2 host.Host) {
+	err := h1.Connect(ctx, peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()})
+	require.Nil(t, err)
+}
+
+func createMockArgs(h host.Host) topology.ArgsCrawler {
+	return topology.ArgsCrawler{
+		Context:           context.Background(),
+		Host:              h,
+		ProtocolID:        protocolID,
+		CrawlInterval:     time.Hour,
+		Parallelism:       4,
+		ConnectionTimeout: time.Second * 5,
+		PeerShardResolver: &mock.PeerShardResolverStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return core.P2PPeerInfo{PeerType: core.UnknownPeer}
+			},
+		},
+	}
+}
+
+func TestNewCrawler(t *testing.T) {
+	t.Parallel()
+
+	h := createHost(t)
+	t.Run("nil host should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		c, err := topology.NewCrawler(args)
+		assert.Equal(t, p2p.ErrNilHost, err)
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("invalid crawl interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(h)
+		args.CrawlInterval = time.Millisecond
+		c, err := topology.NewCrawler(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidDurationProvided))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("invalid parallelism should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(h)
+		args.Parallelism = 0
+		c, err := topology.NewCrawler(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("nil peer shard resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(h)
+		args.PeerShardResolver = nil
+		c, err := topology.NewCrawler(args)
+		assert.Equal(t, p2p.ErrNilPeerShardResolver, err)
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := topology.NewCrawler(createMockArgs(h))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(c))
+
+		snapshot, err := c.Snapshot()
+		assert.Equal(t, p2p.ErrTopologySnapshotNotAvailable, err)
+		assert.Nil(t, snapshot)
+	})
+}
+
+func TestCrawler_CrawlShouldDiscoverTheWholeNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the peers are connected as a chain: 0 - 1 - 2 - 3, the crawler being connected only with the first one
+	numPeers := 4
+	hosts := make([]host.Host, numPeers)
+	dhts := make([]*dht.IpfsDHT, numPeers)
+	for i := 0; i < numPeers; i++ {
+		hosts[i], dhts[i] = createDhtHost(ctx, t)
+		if i > 0 {
+			connectHosts(ctx, t, hosts[i-1], hosts[i])
+		}
+	}
+	require.Eventually(t, func() bool {
+		for i := 0; i < numPeers; i++ {
+			expectedSize := 2
+			if i == 0 || i == numPeers-1 {
+				expectedSize = 1
+			}
+			if dhts[i].RoutingTable().Size() < expectedSize {
+				return false
+			}
+		}
+
+		return true
+	}, time.Second*10, time.Millisecond*100)
+
+	crawlerHost := createHost(t)
+	connectHosts(ctx, t, crawlerHost, hosts[0])
+
+	args := createMockArgs(crawlerHost)
+	args.Context = ctx
+	metaPid := core.PeerID(hosts[2].ID())
+	args.PeerShardResolver = &mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			if pid == metaPid {
+				return core.P2PPeerInfo{PeerType: core.ValidatorPeer, ShardID: core.MetachainShardId}
+			}
+
+			return core.P2PPeerInfo{PeerType: core.ObserverPeer, ShardID: 1}
+		},
+	}
+	c, err := topology.NewCrawler(args)
+	require.Nil(t, err)
+
+	snapshot := c.Crawl(ctx)
+	assert.Equal(t, crawlerHost.ID().Pretty(), snapshot.Crawler)
+	assert.Equal(t, numPeers, snapshot.NumReachable)
+	assert.Equal(t, 0, snapshot.NumUnreachable)
+	require.Equal(t, numPeers, len(snapshot.Peers))
+
+	peersByPid := make(map[string]p2p.TopologyPeer)
+	for _, topologyPeer := range snapshot.Peers {
+		peersByPid[topologyPeer.Pid] = topologyPeer
+	}
+	kadProtocol := string(protocol.ID(protocolID + "/kad/1.0.0"))
+	for i, h := range hosts {
+		topologyPeer, found := peersByPid[h.ID().Pretty()]
+		require.True(t, found)
+		assert.True(t, topologyPeer.Reachable)
+		assert.True(t, topologyPeer.NumConnectionsToCrawler > 0)
+		assert.True(t, len(topologyPeer.Addresses) > 0)
+		assert.Contains(t, topologyPeer.Protocols, kadProtocol)
+
+		if i > 0 {
+			assert.Contains(t, topologyPeer.KnownPeers, hosts[i-1].ID().Pretty())
+		}
+		if i < numPeers-1 {
+			assert.Contains(t, topologyPeer.KnownPeers, hosts[i+1].ID().Pretty())
+		}
+	}
+	assert.Equal(t, core.MetachainShardId, peersByPid[hosts[2].ID().Pretty()].ShardID)
+	assert.Equal(t, core.ValidatorPeer.String(), peersByPid[hosts[2].ID().Pretty()].PeerType)
+	assert.Equal(t, uint32(1), peersByPid[hosts[0].ID().Pretty()].ShardID)
+
+	lastSnapshot, err := c.Snapshot()
+	assert.Nil(t, err)
+	assert.Equal(t, snapshot, lastSnapshot)
+
+	dot := topology.SnapshotToDOT(snapshot)
+	assert.True(t, strings.HasPrefix(dot, "digraph topology {"))
+	assert.Contains(t, dot, "\""+hosts[1].ID().Pretty()+"\" -> \""+hosts[2].ID().Pretty()+"\";")
+	assert.Contains(t, dot, "shard meta, validator")
+}
+
+func TestSnapshotToDOT(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &p2p.TopologySnapshot{
+		Peers: []p2p.TopologyPeer{
+			{
+				Pid:          "pid1",
+				ShardID:      0,
+				PeerType:     "observer",
+				AgentVersion: "agent \"1\"",
+				Reachable:    true,
+				KnownPeers:   []string{"pid2"},
+			},
+			{
+				Pid:      "pid2",
+				ShardID:  core.MetachainShardId,
+				PeerType: "validator",
+			},
+		},
+	}
+
+	expectedDOT := "digraph topology {\n" +
+		"\tnode [shape=box];\n" +
+		"\t\"pid1\" [label=\"pid1\\nshard 0, observer\\nagent \\\"1\\\"\", style=solid];\n" +
+		"\t\"pid2\" [label=\"pid2\\nshard meta, validator\\n\", style=dashed];\n" +
+		"\t\"pid1\" -> \"pid2\";\n" +
+		"}\n"
+	assert.Equal(t, expectedDOT, topology.SnapshotToDOT(snapshot))
+}
@@ -0,0 +1,65 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// SnapshotToDOT renders the topology snapshot as a Graphviz DOT directed graph. Each reachable peer has an edge
+// towards every peer found in its routing table, the unreachable peers being drawn with dashed borders
+func SnapshotToDOT(snapshot *p2p.TopologySnapshot) string {
+	builder := &strings.Builder{}
+	builder.WriteString("digraph topology {\n")
+	builder.WriteString("\tnode [shape=box];\n")
+
+	for _, topologyPeer := range snapshot.Peers {
+		style := "solid"
+		if !topologyPeer.Reachable {
+			style = "dashed"
+		}
+
+		// the label lines are joined with the DOT line break escape sequence so they can not be quoted with %q
+		label := strings.Join([]string{
+			shortPid(topologyPeer.Pid),
+			fmt.Sprintf("shard %s, %s", shardToString(topologyPeer.ShardID), topologyPeer.PeerType),
+			escapeDOTString(topologyPeer.AgentVersion),
+		}, "\\n")
+		_, _ = fmt.Fprintf(builder, "\t%q [label=\"%s\", style=%s];\n", topologyPeer.Pid, label, style)
+	}
+
+	for _, topologyPeer := range snapshot.Peers {
+		for _, knownPeer := range topologyPeer.KnownPeers {
+			_, _ = fmt.Fprintf(builder, "\t%q -> %q;\n", topologyPeer.Pid, knownPeer)
+		}
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+func shortPid(prettyPid string) string {
+	pid, err := peer.Decode(prettyPid)
+	if err != nil {
+		return prettyPid
+	}
+
+	return p2p.PeerIdToShortString(core.PeerID(pid))
+}
+
+func escapeDOTString(str string) string {
+	str = strings.ReplaceAll(str, "\\", "\\\\")
+	return strings.ReplaceAll(str, "\"", "\\\"")
+}
+
+func shardToString(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
@@ -0,0 +1,12 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// Crawl -
+func (c *crawler) Crawl(ctx context.Context) *p2p.TopologySnapshot {
+	return c.crawl(ctx)
+}
@@ -10,3 +10,9 @@ var ErrNotConnectedToNetwork = errors.New("not connected to network")
 
 // ErrReceivingPeerNotConnected signals that the receiving peer of a sending operation is not connected to the network
 var ErrReceivingPeerNotConnected = errors.New("receiving peer not connected to network")
+
+// ErrNilNetworkSimulator signals that a nil network simulator has been provided
+var ErrNilNetworkSimulator = errors.New("nil network simulator")
+
+// ErrInvalidLinkConditions signals that invalid link conditions have been provided
+var ErrInvalidLinkConditions = errors.New("invalid link conditions")
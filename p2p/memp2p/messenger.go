@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -167,13 +168,19 @@ func (messenger *Messenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 	return filteredPeers
 }
 
+// ConnectedFullHistoryPeersOnTopic returns the same peers as ConnectedPeersOnTopic, as the in-memory network does not
+// differentiate between the peer types
+func (messenger *Messenger) ConnectedFullHistoryPeersOnTopic(topic string) []core.PeerID {
+	return messenger.ConnectedPeersOnTopic(topic)
+}
+
 // TrimConnections does nothing, as it is not applicable to the in-memory
 // messenger.
 func (messenger *Messenger) TrimConnections() {
 }
 
 // Bootstrap does nothing, as it is not applicable to the in-memory messenger.
-func (messenger *Messenger) Bootstrap(_ uint32) error {
+func (messenger *Messenger) Bootstrap() error {
 	return nil
 }
 
@@ -247,6 +254,25 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string, _ string) e
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors of all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics of interest of this Messenger
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -291,7 +317,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), peer, messageObject)
 	}
 
 	return nil
@@ -340,7 +366,7 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), receivingPeer, messageObject)
 
 		return nil
 	}
@@ -392,6 +418,33 @@ func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// GetCompressionStatistics returns an empty map as the in-memory messenger does not compress the messages
+func (messenger *Messenger) GetCompressionStatistics() map[string]p2p.CompressionStatistics {
+	return make(map[string]p2p.CompressionStatistics)
+}
+
+// GetBandwidthReport returns an empty report as the in-memory messenger does not account the traffic
+func (messenger *Messenger) GetBandwidthReport() *p2p.BandwidthReport {
+	return &p2p.BandwidthReport{
+		Topics: make(map[string]p2p.BandwidthStatistics),
+		Peers:  make(map[core.PeerID]p2p.BandwidthStatistics),
+	}
+}
+
+// GetTopologySnapshot returns ErrTopologyCrawlerDisabled as the in-memory messenger does not crawl the network
+func (messenger *Messenger) GetTopologySnapshot() (*p2p.TopologySnapshot, error) {
+	return nil, p2p.ErrTopologyCrawlerDisabled
+}
+
+// Port returns 0 as the in-memory messenger does not bind to any port
+func (messenger *Messenger) Port() int {
+	return 0
+}
+
+// WaitForConnections does nothing as all the peers registered on the in-memory network are already connected
+func (messenger *Messenger) WaitForConnections(_ time.Duration, _ uint32) {
+}
+
 // Close disconnects this Messenger from the network it was connected to.
 func (messenger *Messenger) Close() error {
 	messenger.network.UnregisterPeer(messenger.ID())
@@ -6,10 +6,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/rating"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitializingNetworkAndPeer(t *testing.T) {
@@ -184,3 +189,77 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func createPeersRatingHandler(t *testing.T, storer storage.Storer) p2p.PeersRatingHandler {
+	prh, err := rating.NewPeersRatingHandler(rating.ArgPeersRatingHandler{
+		TopRatedCache: testscommon.NewCacherMock(),
+		BadRatedCache: testscommon.NewCacherMock(),
+		Storer:        storer,
+	})
+	require.Nil(t, err)
+
+	return prh
+}
+
+func TestPeersRatingShouldPreferResponsivePeersAfterRestart(t *testing.T) {
+	network := memp2p.NewNetwork()
+	requester, _ := memp2p.NewMessenger(network)
+	responsivePeer, _ := memp2p.NewMessenger(network)
+	silentPeer, _ := memp2p.NewMessenger(network)
+
+	_ = requester.CreateTopic("response", false)
+	_ = responsivePeer.CreateTopic("request", false)
+	_ = silentPeer.CreateTopic("request", false)
+
+	_ = responsivePeer.RegisterMessageProcessor("request", "", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			return responsivePeer.SendToConnectedPeer("response", message.Data(), message.Peer())
+		},
+	})
+	_ = silentPeer.RegisterMessageProcessor("request", "", &mock.MessageProcessorStub{})
+
+	storer := testscommon.CreateMemUnit()
+	peersRatingHandler := createPeersRatingHandler(t, storer)
+	chResponses := make(chan struct{}, 10)
+	_ = requester.RegisterMessageProcessor("response", "", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			peersRatingHandler.IncreaseRating(message.Peer())
+			chResponses <- struct{}{}
+			return nil
+		},
+	})
+
+	// each request decreases the rating of the requested peer, each response increases it
+	numRequests := 5
+	for i := 0; i < numRequests; i++ {
+		for _, pid := range requester.ConnectedPeersOnTopic("request") {
+			peersRatingHandler.AddPeer(pid)
+			peersRatingHandler.DecreaseRating(pid)
+			_ = requester.SendToConnectedPeer("request", []byte(fmt.Sprintf("request %d", i)), pid)
+		}
+
+		select {
+		case <-chResponses:
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout waiting for the response")
+		}
+	}
+
+	// restart the rating component: the ratings are persisted on close and loaded on creation
+	require.Nil(t, peersRatingHandler.Close())
+	restartedRatingHandler := createPeersRatingHandler(t, storer)
+	defer func() {
+		_ = restartedRatingHandler.Close()
+	}()
+
+	ratings := restartedRatingHandler.GetPeersRatings()
+	assert.True(t, ratings[responsivePeer.ID()] > 0)
+	assert.True(t, ratings[silentPeer.ID()] < 0)
+
+	peersToRequestFrom := restartedRatingHandler.GetTopRatedPeersFromList(requester.ConnectedPeersOnTopic("request"), 1)
+	assert.Equal(t, []core.PeerID{responsivePeer.ID()}, peersToRequestFrom)
+
+	_ = requester.Close()
+	_ = responsivePeer.Close()
+	_ = silentPeer.Close()
+}
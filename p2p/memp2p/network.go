@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
 // Network provides in-memory connectivity for the Messenger
@@ -12,8 +13,9 @@ import (
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
 type Network struct {
-	mutex sync.RWMutex
-	peers map[core.PeerID]*Messenger
+	mutex     sync.RWMutex
+	peers     map[core.PeerID]*Messenger
+	simulator *NetworkSimulator
 }
 
 // NewNetwork constructs a new Network instance with an empty
@@ -27,6 +29,19 @@ func NewNetwork() *Network {
 	return &network
 }
 
+// NewNetworkWithSimulator constructs a new Network instance whose messages are
+// delivered according to the fault model of the provided NetworkSimulator.
+func NewNetworkWithSimulator(simulator *NetworkSimulator) (*Network, error) {
+	if simulator == nil {
+		return nil, ErrNilNetworkSimulator
+	}
+
+	network := NewNetwork()
+	network.simulator = simulator
+
+	return network, nil
+}
+
 // ListAddressesExceptOne provides the addresses of the known peers, except a specified one.
 func (network *Network) ListAddressesExceptOne(peerIDToExclude core.PeerID) []string {
 	network.mutex.RLock()
@@ -110,6 +125,10 @@ func (network *Network) RegisterPeer(messenger *Messenger) {
 	network.mutex.Lock()
 	network.peers[messenger.ID()] = messenger
 	network.mutex.Unlock()
+
+	if network.simulator != nil {
+		network.simulator.registerPeer(messenger.ID())
+	}
 }
 
 // UnregisterPeer removes a messenger from the Peers map and its PeerID from
@@ -129,3 +148,20 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// deliver passes the message sent by a peer to the receiving messenger. When a
+// simulator is set, the message is delayed or dropped according to its fault
+// model and it is lost if the receiver disconnects in the meantime. The
+// messages sent by a peer to itself are always delivered immediately.
+func (network *Network) deliver(from core.PeerID, receiver *Messenger, message p2p.MessageP2P) {
+	if network.simulator == nil || from == receiver.ID() {
+		receiver.receiveMessage(message)
+		return
+	}
+
+	network.simulator.schedule(from, receiver.ID(), len(message.Data()), func() {
+		if network.IsPeerConnected(receiver.ID()) {
+			receiver.receiveMessage(message)
+		}
+	})
+}
@@ -0,0 +1,460 @@
+package memp2p
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const (
+	idleWaitTime       = time.Hour
+	maxNormalJitterStd = 3
+)
+
+// unlistedPeersGroup is the group of the peers that are not mentioned in any of the groups of a partition
+const unlistedPeersGroup = -1
+
+// JitterDistribution defines how the random jitter of a link is distributed around the latency of the link
+type JitterDistribution uint8
+
+const (
+	// UniformJitter draws the jitter uniformly from the [-Jitter, Jitter] interval
+	UniformJitter JitterDistribution = iota
+	// NormalJitter draws the jitter from a normal distribution with the standard deviation equal to Jitter, clamped
+	// to 3 standard deviations
+	NormalJitter
+)
+
+// LinkConditions holds the faults applied on the messages sent on a directional link between two peers
+type LinkConditions struct {
+	Latency                 time.Duration
+	Jitter                  time.Duration
+	JitterDistribution      JitterDistribution
+	DropProbability         float64
+	ReorderProbability      float64
+	ReorderDelay            time.Duration
+	BandwidthBytesPerSecond uint64
+}
+
+// PartitionEvent is a scripted change of the network partitions. At is the offset from the moment the schedule is
+// started, while an empty Groups slice heals the network
+type PartitionEvent struct {
+	At     time.Duration
+	Groups [][]core.PeerID
+}
+
+// ArgsNetworkSimulator holds the arguments needed to create a new instance of NetworkSimulator
+type ArgsNetworkSimulator struct {
+	Seed              int64
+	DefaultConditions LinkConditions
+}
+
+type linkKey struct {
+	from core.PeerID
+	to   core.PeerID
+}
+
+type linkState struct {
+	randomizer       *rand.Rand
+	lastDeliveryTime time.Time
+	busyUntil        time.Time
+}
+
+type scheduledDelivery struct {
+	deliveryTime time.Time
+	index        uint64
+	handler      func()
+}
+
+type deliveriesHeap []*scheduledDelivery
+
+// Len returns the number of scheduled deliveries
+func (dh deliveriesHeap) Len() int { return len(dh) }
+
+// Less orders the deliveries by their time and then by the order in which they were scheduled
+func (dh deliveriesHeap) Less(i, j int) bool {
+	if dh[i].deliveryTime.Equal(dh[j].deliveryTime) {
+		return dh[i].index < dh[j].index
+	}
+
+	return dh[i].deliveryTime.Before(dh[j].deliveryTime)
+}
+
+// Swap swaps two deliveries
+func (dh deliveriesHeap) Swap(i, j int) { dh[i], dh[j] = dh[j], dh[i] }
+
+// Push adds a delivery
+func (dh *deliveriesHeap) Push(x interface{}) { *dh = append(*dh, x.(*scheduledDelivery)) }
+
+// Pop removes the last delivery
+func (dh *deliveriesHeap) Pop() interface{} {
+	old := *dh
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*dh = old[:n-1]
+
+	return item
+}
+
+// NetworkSimulator applies a configurable fault model on the messages exchanged over a memp2p Network: per link
+// latency and jitter, drop probability, bandwidth caps, message reordering and partitions. All the random decisions
+// taken on a link are drawn from a source seeded from the simulator seed and the registration order of the two peers,
+// so the n-th message sent on a link gets the same treatment on every run
+type NetworkSimulator struct {
+	seed              int64
+	mutLinks          sync.Mutex
+	defaultConditions LinkConditions
+	linksConditions   map[linkKey]LinkConditions
+	links             map[linkKey]*linkState
+	peersIndexes      map[core.PeerID]uint32
+	partitionGroups   map[core.PeerID]int
+	numDropped        uint64
+
+	mutDeliveries   sync.Mutex
+	deliveries      deliveriesHeap
+	deliveriesIndex uint64
+	chWakeUp        chan struct{}
+
+	ctx    context.Context
+	cancel func()
+}
+
+// NewNetworkSimulator creates a new NetworkSimulator instance
+func NewNetworkSimulator(args ArgsNetworkSimulator) (*NetworkSimulator, error) {
+	err := checkLinkConditions(args.DefaultConditions)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ns := &NetworkSimulator{
+		seed:              args.Seed,
+		defaultConditions: args.DefaultConditions,
+		linksConditions:   make(map[linkKey]LinkConditions),
+		links:             make(map[linkKey]*linkState),
+		peersIndexes:      make(map[core.PeerID]uint32),
+		deliveries:        make(deliveriesHeap, 0),
+		chWakeUp:          make(chan struct{}, 1),
+		ctx:               ctx,
+		cancel:            cancel,
+	}
+
+	go ns.processDeliveries()
+
+	return ns, nil
+}
+
+func checkLinkConditions(conditions LinkConditions) error {
+	if conditions.Latency < 0 || conditions.Jitter < 0 || conditions.ReorderDelay < 0 {
+		return fmt.Errorf("%w: negative duration", ErrInvalidLinkConditions)
+	}
+	if conditions.DropProbability < 0 || conditions.DropProbability > 1 {
+		return fmt.Errorf("%w: drop probability %f", ErrInvalidLinkConditions, conditions.DropProbability)
+	}
+	if conditions.ReorderProbability < 0 || conditions.ReorderProbability > 1 {
+		return fmt.Errorf("%w: reorder probability %f", ErrInvalidLinkConditions, conditions.ReorderProbability)
+	}
+	if conditions.JitterDistribution > NormalJitter {
+		return fmt.Errorf("%w: unknown jitter distribution %d", ErrInvalidLinkConditions, conditions.JitterDistribution)
+	}
+
+	return nil
+}
+
+// SetLinkConditions overrides the default conditions for the messages sent from a peer to another
+func (ns *NetworkSimulator) SetLinkConditions(from core.PeerID, to core.PeerID, conditions LinkConditions) error {
+	err := checkLinkConditions(conditions)
+	if err != nil {
+		return err
+	}
+
+	ns.mutLinks.Lock()
+	ns.linksConditions[linkKey{from: from, to: to}] = conditions
+	ns.mutLinks.Unlock()
+
+	return nil
+}
+
+// Partition splits the network in the provided groups of peers. The messages between peers from different groups are
+// dropped. The peers not found in any group are grouped together
+func (ns *NetworkSimulator) Partition(groups ...[]core.PeerID) {
+	partitionGroups := make(map[core.PeerID]int)
+	for idx, group := range groups {
+		for _, pid := range group {
+			partitionGroups[pid] = idx
+		}
+	}
+
+	ns.mutLinks.Lock()
+	ns.partitionGroups = partitionGroups
+	ns.mutLinks.Unlock()
+}
+
+// Heal removes the current partition, if any
+func (ns *NetworkSimulator) Heal() {
+	ns.mutLinks.Lock()
+	ns.partitionGroups = nil
+	ns.mutLinks.Unlock()
+}
+
+// ArePeersLinked returns false if the provided peers are separated by the current partition
+func (ns *NetworkSimulator) ArePeersLinked(first core.PeerID, second core.PeerID) bool {
+	ns.mutLinks.Lock()
+	defer ns.mutLinks.Unlock()
+
+	return ns.arePeersLinked(first, second)
+}
+
+func (ns *NetworkSimulator) arePeersLinked(first core.PeerID, second core.PeerID) bool {
+	if ns.partitionGroups == nil {
+		return true
+	}
+
+	return ns.getPartitionGroup(first) == ns.getPartitionGroup(second)
+}
+
+func (ns *NetworkSimulator) getPartitionGroup(pid core.PeerID) int {
+	group, found := ns.partitionGroups[pid]
+	if !found {
+		return unlistedPeersGroup
+	}
+
+	return group
+}
+
+// RunSchedule applies the provided partition events, in the order of their offsets, relative to the moment of the call
+func (ns *NetworkSimulator) RunSchedule(events []PartitionEvent) {
+	sortedEvents := make([]PartitionEvent, len(events))
+	copy(sortedEvents, events)
+	sort.SliceStable(sortedEvents, func(i, j int) bool {
+		return sortedEvents[i].At < sortedEvents[j].At
+	})
+
+	go ns.runSchedule(time.Now(), sortedEvents)
+}
+
+func (ns *NetworkSimulator) runSchedule(startTime time.Time, events []PartitionEvent) {
+	for _, event := range events {
+		timer := time.NewTimer(time.Until(startTime.Add(event.At)))
+		select {
+		case <-ns.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if len(event.Groups) == 0 {
+			log.Debug("network simulator: healing the network", "at", event.At)
+			ns.Heal()
+			continue
+		}
+
+		log.Debug("network simulator: partitioning the network", "at", event.At, "num groups", len(event.Groups))
+		ns.Partition(event.Groups...)
+	}
+}
+
+// NumDroppedMessages returns the number of messages dropped either by partitions or by the drop probability
+func (ns *NetworkSimulator) NumDroppedMessages() uint64 {
+	return atomic.LoadUint64(&ns.numDropped)
+}
+
+func (ns *NetworkSimulator) registerPeer(pid core.PeerID) {
+	ns.mutLinks.Lock()
+	_ = ns.getPeerIndex(pid)
+	ns.mutLinks.Unlock()
+}
+
+// schedule computes the delivery time of a message of the provided size sent from a peer to another one and schedules
+// the handler to be called at that time. Dropped messages are never handled
+func (ns *NetworkSimulator) schedule(from core.PeerID, to core.PeerID, size int, handler func()) {
+	deliveryTime, shouldDeliver := ns.computeDeliveryTime(from, to, size, time.Now())
+	if !shouldDeliver {
+		atomic.AddUint64(&ns.numDropped, 1)
+		return
+	}
+
+	ns.mutDeliveries.Lock()
+	ns.deliveriesIndex++
+	heap.Push(&ns.deliveries, &scheduledDelivery{
+		deliveryTime: deliveryTime,
+		index:        ns.deliveriesIndex,
+		handler:      handler,
+	})
+	isFirst := ns.deliveries[0].index == ns.deliveriesIndex
+	ns.mutDeliveries.Unlock()
+
+	if isFirst {
+		select {
+		case ns.chWakeUp <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (ns *NetworkSimulator) computeDeliveryTime(from core.PeerID, to core.PeerID, size int, now time.Time) (time.Time, bool) {
+	ns.mutLinks.Lock()
+	defer ns.mutLinks.Unlock()
+
+	if !ns.arePeersLinked(from, to) {
+		return time.Time{}, false
+	}
+
+	key := linkKey{from: from, to: to}
+	conditions, found := ns.linksConditions[key]
+	if !found {
+		conditions = ns.defaultConditions
+	}
+	link := ns.getOrCreateLink(key)
+
+	// all the random values are drawn for each message so the values used by a message do not depend on the
+	// decisions taken for the previous ones
+	dropValue := link.randomizer.Float64()
+	reorderValue := link.randomizer.Float64()
+	jitter := drawJitter(link.randomizer, conditions)
+
+	if dropValue < conditions.DropProbability {
+		return time.Time{}, false
+	}
+
+	sendTime := now
+	if conditions.BandwidthBytesPerSecond > 0 {
+		if link.busyUntil.After(sendTime) {
+			sendTime = link.busyUntil
+		}
+		transmissionTime := time.Duration(uint64(size) * uint64(time.Second) / conditions.BandwidthBytesPerSecond)
+		sendTime = sendTime.Add(transmissionTime)
+		link.busyUntil = sendTime
+	}
+
+	delay := conditions.Latency + jitter
+	if delay < 0 {
+		delay = 0
+	}
+	deliveryTime := sendTime.Add(delay)
+
+	if reorderValue < conditions.ReorderProbability {
+		// a reordered message is held back without moving the link ordering barrier, so the next messages can
+		// overtake it
+		return deliveryTime.Add(conditions.ReorderDelay), true
+	}
+
+	if deliveryTime.Before(link.lastDeliveryTime) {
+		deliveryTime = link.lastDeliveryTime
+	}
+	link.lastDeliveryTime = deliveryTime
+
+	return deliveryTime, true
+}
+
+func (ns *NetworkSimulator) getOrCreateLink(key linkKey) *linkState {
+	link, found := ns.links[key]
+	if found {
+		return link
+	}
+
+	link = &linkState{
+		randomizer: rand.New(rand.NewSource(ns.computeLinkSeed(key))),
+	}
+	ns.links[key] = link
+
+	return link
+}
+
+func (ns *NetworkSimulator) computeLinkSeed(key linkKey) int64 {
+	buff := make([]byte, 16)
+	binary.BigEndian.PutUint64(buff, uint64(ns.seed))
+	binary.BigEndian.PutUint32(buff[8:], ns.getPeerIndex(key.from))
+	binary.BigEndian.PutUint32(buff[12:], ns.getPeerIndex(key.to))
+
+	hasher := fnv.New64a()
+	_, _ = hasher.Write(buff)
+
+	return int64(hasher.Sum64())
+}
+
+func (ns *NetworkSimulator) getPeerIndex(pid core.PeerID) uint32 {
+	index, found := ns.peersIndexes[pid]
+	if !found {
+		index = uint32(len(ns.peersIndexes))
+		ns.peersIndexes[pid] = index
+	}
+
+	return index
+}
+
+func drawJitter(randomizer *rand.Rand, conditions LinkConditions) time.Duration {
+	if conditions.JitterDistribution == NormalJitter {
+		value := randomizer.NormFloat64()
+		if value > maxNormalJitterStd {
+			value = maxNormalJitterStd
+		}
+		if value < -maxNormalJitterStd {
+			value = -maxNormalJitterStd
+		}
+
+		return time.Duration(value * float64(conditions.Jitter))
+	}
+
+	return time.Duration((2*randomizer.Float64() - 1) * float64(conditions.Jitter))
+}
+
+func (ns *NetworkSimulator) processDeliveries() {
+	for {
+		dueDeliveries, waitTime := ns.popDueDeliveries(time.Now())
+		for _, delivery := range dueDeliveries {
+			delivery.handler()
+		}
+		if len(dueDeliveries) > 0 {
+			continue
+		}
+
+		timer := time.NewTimer(waitTime)
+		select {
+		case <-ns.ctx.Done():
+			timer.Stop()
+			return
+		case <-ns.chWakeUp:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (ns *NetworkSimulator) popDueDeliveries(now time.Time) ([]*scheduledDelivery, time.Duration) {
+	ns.mutDeliveries.Lock()
+	defer ns.mutDeliveries.Unlock()
+
+	dueDeliveries := make([]*scheduledDelivery, 0)
+	for ns.deliveries.Len() > 0 && !ns.deliveries[0].deliveryTime.After(now) {
+		dueDeliveries = append(dueDeliveries, heap.Pop(&ns.deliveries).(*scheduledDelivery))
+	}
+
+	if ns.deliveries.Len() == 0 {
+		return dueDeliveries, idleWaitTime
+	}
+
+	return dueDeliveries, ns.deliveries[0].deliveryTime.Sub(now)
+}
+
+// Close stops the delivery of the scheduled messages and the partitions schedule
+func (ns *NetworkSimulator) Close() error {
+	ns.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ns *NetworkSimulator) IsInterfaceNil() bool {
+	return ns == nil
+}
@@ -0,0 +1,304 @@
+package memp2p_test
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const simulatorTopic = "topic"
+
+type receivedMessages struct {
+	mut      sync.Mutex
+	messages []string
+}
+
+func (rm *receivedMessages) get() []string {
+	rm.mut.Lock()
+	defer rm.mut.Unlock()
+
+	return append(make([]string, 0, len(rm.messages)), rm.messages...)
+}
+
+func createSimulatedNetwork(t *testing.T, args memp2p.ArgsNetworkSimulator, numPeers int) (*memp2p.NetworkSimulator, []*memp2p.Messenger, []*receivedMessages) {
+	simulator, err := memp2p.NewNetworkSimulator(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = simulator.Close()
+	})
+
+	network, err := memp2p.NewNetworkWithSimulator(simulator)
+	require.Nil(t, err)
+
+	peers := make([]*memp2p.Messenger, numPeers)
+	received := make([]*receivedMessages, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peers[i], err = memp2p.NewMessenger(network)
+		require.Nil(t, err)
+		_ = peers[i].CreateTopic(simulatorTopic, false)
+
+		rm := &receivedMessages{}
+		received[i] = rm
+		_ = peers[i].RegisterMessageProcessor(simulatorTopic, "", &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+				rm.mut.Lock()
+				rm.messages = append(rm.messages, string(message.Data()))
+				rm.mut.Unlock()
+
+				return nil
+			},
+		})
+	}
+
+	return simulator, peers, received
+}
+
+func sendNumberedMessages(t *testing.T, sender *memp2p.Messenger, receiver *memp2p.Messenger, numMessages int) []string {
+	sent := make([]string, numMessages)
+	for i := 0; i < numMessages; i++ {
+		sent[i] = strconv.Itoa(i)
+		err := sender.SendToConnectedPeer(simulatorTopic, []byte(sent[i]), receiver.ID())
+		require.Nil(t, err)
+	}
+
+	return sent
+}
+
+func TestNewNetworkSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		invalidConditions := []memp2p.LinkConditions{
+			{Latency: -time.Second},
+			{Jitter: -time.Second},
+			{ReorderDelay: -time.Second},
+			{DropProbability: 1.1},
+			{DropProbability: -0.1},
+			{ReorderProbability: 2},
+			{JitterDistribution: 2},
+		}
+		for _, conditions := range invalidConditions {
+			ns, err := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{DefaultConditions: conditions})
+			assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+			assert.Nil(t, ns)
+		}
+	})
+	t.Run("nil simulator should error", func(t *testing.T) {
+		t.Parallel()
+
+		network, err := memp2p.NewNetworkWithSimulator(nil)
+		assert.Equal(t, memp2p.ErrNilNetworkSimulator, err)
+		assert.Nil(t, network)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ns, err := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{})
+		assert.Nil(t, err)
+		assert.False(t, ns.IsInterfaceNil())
+		assert.Nil(t, ns.Close())
+	})
+}
+
+func TestNetworkSimulator_SetLinkConditions(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers, received := createSimulatedNetwork(t, memp2p.ArgsNetworkSimulator{}, 3)
+	err := simulator.SetLinkConditions(peers[0].ID(), peers[1].ID(), memp2p.LinkConditions{DropProbability: 2})
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+
+	err = simulator.SetLinkConditions(peers[0].ID(), peers[1].ID(), memp2p.LinkConditions{DropProbability: 1})
+	assert.Nil(t, err)
+
+	peers[0].Broadcast(simulatorTopic, []byte("message"))
+	time.Sleep(time.Millisecond * 200)
+
+	assert.Equal(t, 1, len(received[0].get()))
+	assert.Equal(t, 0, len(received[1].get()))
+	assert.Equal(t, 1, len(received[2].get()))
+	assert.Equal(t, uint64(1), simulator.NumDroppedMessages())
+
+	// the link conditions are directional
+	peers[1].Broadcast(simulatorTopic, []byte("message"))
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, 2, len(received[0].get()))
+}
+
+func TestNetworkSimulator_LatencyShouldDelayMessages(t *testing.T) {
+	t.Parallel()
+
+	args := memp2p.ArgsNetworkSimulator{
+		DefaultConditions: memp2p.LinkConditions{
+			Latency: time.Millisecond * 300,
+		},
+	}
+	_, peers, received := createSimulatedNetwork(t, args, 2)
+
+	peers[0].Broadcast(simulatorTopic, []byte("message"))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, len(received[0].get()), "self delivery should not be delayed")
+	assert.Equal(t, 0, len(received[1].get()))
+
+	time.Sleep(time.Millisecond * 500)
+	assert.Equal(t, 1, len(received[1].get()))
+}
+
+func TestNetworkSimulator_BandwidthShouldDelayMessages(t *testing.T) {
+	t.Parallel()
+
+	args := memp2p.ArgsNetworkSimulator{
+		DefaultConditions: memp2p.LinkConditions{
+			BandwidthBytesPerSecond: 10,
+		},
+	}
+	_, peers, received := createSimulatedNetwork(t, args, 2)
+
+	// each message takes 300ms to be transmitted
+	_ = peers[0].SendToConnectedPeer(simulatorTopic, []byte("abc"), peers[1].ID())
+	_ = peers[0].SendToConnectedPeer(simulatorTopic, []byte("def"), peers[1].ID())
+
+	time.Sleep(time.Millisecond * 150)
+	assert.Equal(t, 0, len(received[1].get()))
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, []string{"abc"}, received[1].get())
+	time.Sleep(time.Millisecond * 400)
+	assert.Equal(t, []string{"abc", "def"}, received[1].get())
+}
+
+func TestNetworkSimulator_JitterShouldKeepTheOrderOfTheLink(t *testing.T) {
+	t.Parallel()
+
+	for _, distribution := range []memp2p.JitterDistribution{memp2p.UniformJitter, memp2p.NormalJitter} {
+		args := memp2p.ArgsNetworkSimulator{
+			Seed: 37,
+			DefaultConditions: memp2p.LinkConditions{
+				Latency:            time.Millisecond * 50,
+				Jitter:             time.Millisecond * 40,
+				JitterDistribution: distribution,
+			},
+		}
+		_, peers, received := createSimulatedNetwork(t, args, 2)
+
+		sent := sendNumberedMessages(t, peers[0], peers[1], 50)
+		time.Sleep(time.Millisecond * 500)
+		assert.Equal(t, sent, received[1].get())
+	}
+}
+
+func TestNetworkSimulator_ReorderShouldChangeTheOrderOfTheLink(t *testing.T) {
+	t.Parallel()
+
+	args := memp2p.ArgsNetworkSimulator{
+		Seed: 37,
+		DefaultConditions: memp2p.LinkConditions{
+			ReorderProbability: 0.3,
+			ReorderDelay:       time.Millisecond * 100,
+		},
+	}
+	_, peers, received := createSimulatedNetwork(t, args, 2)
+
+	sent := sendNumberedMessages(t, peers[0], peers[1], 50)
+	time.Sleep(time.Millisecond * 500)
+	assert.ElementsMatch(t, sent, received[1].get())
+	assert.NotEqual(t, sent, received[1].get())
+}
+
+func TestNetworkSimulator_SameSeedShouldTakeTheSameDecisions(t *testing.T) {
+	t.Parallel()
+
+	runScenario := func(seed int64) []string {
+		args := memp2p.ArgsNetworkSimulator{
+			Seed: seed,
+			DefaultConditions: memp2p.LinkConditions{
+				DropProbability: 0.5,
+			},
+		}
+		_, peers, received := createSimulatedNetwork(t, args, 2)
+		_ = sendNumberedMessages(t, peers[0], peers[1], 100)
+		time.Sleep(time.Millisecond * 200)
+
+		return received[1].get()
+	}
+
+	firstRun := runScenario(1)
+	assert.True(t, len(firstRun) > 0 && len(firstRun) < 100)
+	assert.Equal(t, firstRun, runScenario(1))
+	assert.NotEqual(t, firstRun, runScenario(2))
+}
+
+func TestNetworkSimulator_PartitionAndHeal(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers, received := createSimulatedNetwork(t, memp2p.ArgsNetworkSimulator{}, 4)
+	simulator.Partition([]core.PeerID{peers[0].ID()}, []core.PeerID{peers[1].ID(), peers[2].ID()})
+	assert.False(t, simulator.ArePeersLinked(peers[0].ID(), peers[1].ID()))
+	assert.True(t, simulator.ArePeersLinked(peers[1].ID(), peers[2].ID()))
+	assert.False(t, simulator.ArePeersLinked(peers[2].ID(), peers[3].ID()))
+
+	peers[1].Broadcast(simulatorTopic, []byte("partitioned"))
+	peers[3].Broadcast(simulatorTopic, []byte("unlisted"))
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, 0, len(received[0].get()))
+	assert.Equal(t, []string{"partitioned"}, received[2].get())
+	assert.Equal(t, []string{"unlisted"}, received[3].get())
+	assert.Equal(t, uint64(5), simulator.NumDroppedMessages())
+
+	simulator.Heal()
+	peers[0].Broadcast(simulatorTopic, []byte("healed"))
+	time.Sleep(time.Millisecond * 200)
+	for _, rm := range received {
+		assert.Contains(t, rm.get(), "healed")
+	}
+}
+
+func TestNetworkSimulator_RunSchedule(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers, received := createSimulatedNetwork(t, memp2p.ArgsNetworkSimulator{}, 2)
+	simulator.RunSchedule([]memp2p.PartitionEvent{
+		{
+			At: time.Millisecond * 400,
+		},
+		{
+			At:     0,
+			Groups: [][]core.PeerID{{peers[0].ID()}, {peers[1].ID()}},
+		},
+	})
+	time.Sleep(time.Millisecond * 100)
+
+	peers[0].Broadcast(simulatorTopic, []byte("partitioned"))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 0, len(received[1].get()))
+
+	time.Sleep(time.Millisecond * 400)
+	peers[0].Broadcast(simulatorTopic, []byte("healed"))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, []string{"healed"}, received[1].get())
+}
+
+func TestNetworkSimulator_DisconnectedReceiverShouldNotGetInFlightMessages(t *testing.T) {
+	t.Parallel()
+
+	args := memp2p.ArgsNetworkSimulator{
+		DefaultConditions: memp2p.LinkConditions{
+			Latency: time.Millisecond * 200,
+		},
+	}
+	_, peers, received := createSimulatedNetwork(t, args, 2)
+
+	peers[0].Broadcast(simulatorTopic, []byte("message"))
+	_ = peers[1].Close()
+	time.Sleep(time.Millisecond * 400)
+	assert.Equal(t, 0, len(received[1].get()))
+}
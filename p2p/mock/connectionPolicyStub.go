@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// ConnectionPolicyStub -
+type ConnectionPolicyStub struct {
+	IsConnectionAllowedCalled func(pid core.PeerID, addresses []multiaddr.Multiaddr) bool
+}
+
+// IsConnectionAllowed -
+func (stub *ConnectionPolicyStub) IsConnectionAllowed(pid core.PeerID, addresses []multiaddr.Multiaddr) bool {
+	if stub.IsConnectionAllowedCalled != nil {
+		return stub.IsConnectionAllowedCalled(pid, addresses)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (stub *ConnectionPolicyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
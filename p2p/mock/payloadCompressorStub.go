@@ -0,0 +1,51 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/p2p"
+
+// PayloadCompressorStub -
+type PayloadCompressorStub struct {
+	CompressPayloadCalled   func(topic string, payload []byte) ([]byte, uint32)
+	DecompressPayloadCalled func(topic string, algorithm uint32, payload []byte) ([]byte, error)
+	StatisticsCalled        func() map[string]p2p.CompressionStatistics
+}
+
+// CompressPayload -
+func (pcs *PayloadCompressorStub) CompressPayload(topic string, payload []byte) ([]byte, uint32) {
+	if pcs.CompressPayloadCalled != nil {
+		return pcs.CompressPayloadCalled(topic, payload)
+	}
+
+	return payload, 0
+}
+
+// DecompressPayload -
+func (pcs *PayloadCompressorStub) DecompressPayload(topic string, algorithm uint32, payload []byte) ([]byte, error) {
+	if pcs.DecompressPayloadCalled != nil {
+		return pcs.DecompressPayloadCalled(topic, algorithm, payload)
+	}
+
+	return payload, nil
+}
+
+// Statistics -
+func (pcs *PayloadCompressorStub) Statistics() map[string]p2p.CompressionStatistics {
+	if pcs.StatisticsCalled != nil {
+		return pcs.StatisticsCalled()
+	}
+
+	return make(map[string]p2p.CompressionStatistics)
+}
+
+// EpochConfirmed -
+func (pcs *PayloadCompressorStub) EpochConfirmed(_ uint32, _ uint64) {
+}
+
+// Close -
+func (pcs *PayloadCompressorStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (pcs *PayloadCompressorStub) IsInterfaceNil() bool {
+	return pcs == nil
+}
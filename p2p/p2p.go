@@ -151,6 +151,9 @@ type Messenger interface {
 	SetPeerShardResolver(peerShardResolver PeerShardResolver) error
 	SetPeerDenialEvaluator(handler PeerDenialEvaluator) error
 	GetConnectedPeersInfo() *ConnectedPeersInfo
+	GetCompressionStatistics() map[string]CompressionStatistics
+	GetBandwidthReport() *BandwidthReport
+	GetTopologySnapshot() (*TopologySnapshot, error)
 	UnjoinAllTopics() error
 	Port() int
 	WaitForConnections(maxWaitingTime time.Duration, minNumOfPeers uint32)
@@ -244,6 +247,59 @@ type ConnectedPeersInfo struct {
 	NumCrossShardValidators  int
 	NumCrossShardObservers   int
 	NumFullHistoryObservers  int
+	ConnectionPolicy         string
+	NumDeniedConnections     uint64
+}
+
+// CompressionStatistics holds the messages payload compression counters of a topic
+type CompressionStatistics struct {
+	NumCompressedSent       uint64
+	NumDecompressedReceived uint64
+	BytesSavedOnSend        uint64
+	BytesSavedOnReceive     uint64
+}
+
+// BandwidthStatistics holds the traffic counters of a topic, of a peer or of the whole node. The per second values are
+// computed on the accounting sliding window
+type BandwidthStatistics struct {
+	InboundBytesPerSec   uint64
+	OutboundBytesPerSec  uint64
+	TotalInboundBytes    uint64
+	TotalOutboundBytes   uint64
+	DroppedOutboundBytes uint64
+}
+
+// BandwidthReport holds the traffic counters of the node, split by topic and by connected peer
+type BandwidthReport struct {
+	Total  BandwidthStatistics
+	Topics map[string]BandwidthStatistics
+	Peers  map[core.PeerID]BandwidthStatistics
+}
+
+// TopologyPeer holds the information collected by the topology crawler about a peer. The reachable peers are the ones
+// that answered the crawler's queries, the known peers being the peers found in their routing tables. The shard is the
+// one advertised by the peer in its heartbeat messages, and NumConnectionsToCrawler only counts the connections
+// between the crawler's host and the peer
+type TopologyPeer struct {
+	Pid                     string   `json:"pid"`
+	Addresses               []string `json:"addresses"`
+	ShardID                 uint32   `json:"shardID"`
+	PeerType                string   `json:"peerType"`
+	AgentVersion            string   `json:"agentVersion"`
+	ProtocolVersion         string   `json:"protocolVersion"`
+	Protocols               []string `json:"protocols"`
+	Reachable               bool     `json:"reachable"`
+	NumConnectionsToCrawler int      `json:"numConnectionsToCrawler"`
+	KnownPeers              []string `json:"knownPeers"`
+}
+
+// TopologySnapshot holds the result of a network topology crawl
+type TopologySnapshot struct {
+	Crawler        string         `json:"crawler"`
+	Timestamp      int64          `json:"timestamp"`
+	NumReachable   int            `json:"numReachable"`
+	NumUnreachable int            `json:"numUnreachable"`
+	Peers          []TopologyPeer `json:"peers"`
 }
 
 // NetworkShardingCollector defines the updating methods used by the network sharding component
@@ -338,5 +394,7 @@ type PeersRatingHandler interface {
 	IncreaseRating(pid core.PeerID)
 	DecreaseRating(pid core.PeerID)
 	GetTopRatedPeersFromList(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID
+	GetPeersRatings() map[core.PeerID]int32
+	Close() error
 	IsInterfaceNil() bool
 }
@@ -1,8 +1,11 @@
 package rating
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -21,23 +24,36 @@ const (
 	decreaseFactor = -1
 	minNumOfPeers  = 1
 	int32Size      = 4
+	int64Size      = 8
+	maxPercent     = 100
 )
 
 var log = logger.GetOrCreate("p2p/peersRatingHandler")
 
 // ArgPeersRatingHandler is the DTO used to create a new peers rating handler
 type ArgPeersRatingHandler struct {
-	TopRatedCache storage.Cacher
-	BadRatedCache storage.Cacher
+	TopRatedCache        storage.Cacher
+	BadRatedCache        storage.Cacher
+	Storer               storage.Storer
+	PersistIntervalInSec uint32
+	DecayIntervalInSec   uint32
+	DecayPercent         uint32
 }
 
 type peersRatingHandler struct {
-	topRatedCache storage.Cacher
-	badRatedCache storage.Cacher
-	mut           sync.Mutex
+	topRatedCache   storage.Cacher
+	badRatedCache   storage.Cacher
+	storer          storage.Storer
+	persistInterval time.Duration
+	decayInterval   time.Duration
+	decayPercent    int32
+	getTimeHandler  func() time.Time
+	cancelFunc      context.CancelFunc
+	mut             sync.Mutex
 }
 
-// NewPeersRatingHandler returns a new peers rating handler
+// NewPeersRatingHandler returns a new peers rating handler. The ratings saved in the storer are loaded, after being
+// decayed for the time passed since they were saved
 func NewPeersRatingHandler(args ArgPeersRatingHandler) (*peersRatingHandler, error) {
 	err := checkArgs(args)
 	if err != nil {
@@ -45,10 +61,21 @@ func NewPeersRatingHandler(args ArgPeersRatingHandler) (*peersRatingHandler, err
 	}
 
 	prh := &peersRatingHandler{
-		topRatedCache: args.TopRatedCache,
-		badRatedCache: args.BadRatedCache,
+		topRatedCache:   args.TopRatedCache,
+		badRatedCache:   args.BadRatedCache,
+		storer:          args.Storer,
+		persistInterval: time.Second * time.Duration(args.PersistIntervalInSec),
+		decayInterval:   time.Second * time.Duration(args.DecayIntervalInSec),
+		decayPercent:    int32(args.DecayPercent),
+		getTimeHandler:  time.Now,
 	}
 
+	prh.loadRatings()
+
+	var ctx context.Context
+	ctx, prh.cancelFunc = context.WithCancel(context.Background())
+	go prh.processLoop(ctx)
+
 	return prh, nil
 }
 
@@ -59,6 +86,12 @@ func checkArgs(args ArgPeersRatingHandler) error {
 	if check.IfNil(args.BadRatedCache) {
 		return fmt.Errorf("%w for BadRatedCache", p2p.ErrNilCacher)
 	}
+	if check.IfNil(args.Storer) {
+		return p2p.ErrNilStorer
+	}
+	if args.DecayPercent > maxPercent {
+		return fmt.Errorf("%w for DecayPercent, maximum %d, provided %d", p2p.ErrInvalidValue, maxPercent, args.DecayPercent)
+	}
 
 	return nil
 }
@@ -232,6 +265,174 @@ func (prh *peersRatingHandler) splitPeersByTiers(peers []core.PeerID) ([]core.Pe
 	return topRated, badRated
 }
 
+// GetPeersRatings returns the ratings of all the known peers
+func (prh *peersRatingHandler) GetPeersRatings() map[core.PeerID]int32 {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	return prh.getAllRatings()
+}
+
+func (prh *peersRatingHandler) getAllRatings() map[core.PeerID]int32 {
+	ratings := make(map[core.PeerID]int32)
+	for _, cache := range []storage.Cacher{prh.topRatedCache, prh.badRatedCache} {
+		for _, key := range cache.Keys() {
+			rating, found := cache.Get(key)
+			if !found {
+				continue
+			}
+
+			ratingInt, ok := rating.(int32)
+			if ok {
+				ratings[core.PeerID(key)] = ratingInt
+			}
+		}
+	}
+
+	return ratings
+}
+
+func (prh *peersRatingHandler) processLoop(ctx context.Context) {
+	persistChan := createTickerChan(ctx, prh.persistInterval)
+	decayChan := createTickerChan(ctx, prh.decayInterval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("peersRatingHandler's go routine is stopping...")
+			return
+		case <-persistChan:
+			prh.saveRatings()
+		case <-decayChan:
+			prh.decayRatings()
+		}
+	}
+}
+
+// createTickerChan returns a channel written at each interval. A zero interval returns a channel that is never written
+func createTickerChan(ctx context.Context, interval time.Duration) <-chan time.Time {
+	if interval == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		<-ctx.Done()
+		ticker.Stop()
+	}()
+
+	return ticker.C
+}
+
+func (prh *peersRatingHandler) decayRatings() {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	for pid, rating := range prh.getAllRatings() {
+		newRating := prh.computeDecayedRating(rating, 1)
+		if newRating != rating {
+			prh.updateRating(pid, rating, newRating)
+		}
+	}
+}
+
+// computeDecayedRating moves the rating towards the default rating, by the decay percent, for each decay interval
+func (prh *peersRatingHandler) computeDecayedRating(rating int32, numIntervals int64) int32 {
+	if prh.decayPercent == 0 {
+		return rating
+	}
+
+	for i := int64(0); i < numIntervals && rating != defaultRating; i++ {
+		rating = rating * (maxPercent - prh.decayPercent) / maxPercent
+	}
+
+	return rating
+}
+
+func (prh *peersRatingHandler) saveRatings() {
+	prh.mut.Lock()
+	ratings := prh.getAllRatings()
+	prh.mut.Unlock()
+
+	timestamp := prh.getTimeHandler().Unix()
+	for pid, rating := range ratings {
+		err := prh.storer.Put(pid.Bytes(), encodeRating(rating, timestamp))
+		if err != nil {
+			log.Debug("could not save peer rating", "pid", pid.Pretty(), "error", err.Error())
+		}
+	}
+
+	log.Debug("saved peers ratings", "num peers", len(ratings))
+}
+
+func (prh *peersRatingHandler) loadRatings() {
+	now := prh.getTimeHandler().Unix()
+	fullyDecayed := make([][]byte, 0)
+	numLoaded := 0
+	prh.storer.RangeKeys(func(key []byte, value []byte) bool {
+		rating, timestamp, err := decodeRating(value)
+		if err != nil {
+			log.Debug("could not load peer rating", "pid", core.PeerID(key).Pretty(), "error", err.Error())
+			return true
+		}
+
+		numIntervals := int64(0)
+		if prh.decayInterval > 0 && now > timestamp {
+			numIntervals = (now - timestamp) / int64(prh.decayInterval.Seconds())
+		}
+
+		rating = prh.computeDecayedRating(rating, numIntervals)
+		if rating == defaultRating {
+			fullyDecayed = append(fullyDecayed, key)
+			return true
+		}
+
+		pid := core.PeerID(key)
+		if computeRatingTier(rating) == topRatedTier {
+			prh.topRatedCache.Put(pid.Bytes(), rating, int32Size)
+		} else {
+			prh.badRatedCache.Put(pid.Bytes(), rating, int32Size)
+		}
+		numLoaded++
+
+		return true
+	})
+
+	// the peers with the default rating are not kept in the storer, they will be added again once connected
+	for _, key := range fullyDecayed {
+		log.LogIfError(prh.storer.Remove(key))
+	}
+
+	log.Debug("loaded peers ratings", "num peers", numLoaded, "num fully decayed", len(fullyDecayed))
+}
+
+func encodeRating(rating int32, timestamp int64) []byte {
+	buff := make([]byte, int32Size+int64Size)
+	binary.BigEndian.PutUint32(buff[:int32Size], uint32(rating))
+	binary.BigEndian.PutUint64(buff[int32Size:], uint64(timestamp))
+
+	return buff
+}
+
+func decodeRating(buff []byte) (int32, int64, error) {
+	if len(buff) != int32Size+int64Size {
+		return 0, 0, fmt.Errorf("%w for the saved rating, length %d", p2p.ErrInvalidValue, len(buff))
+	}
+
+	rating := int32(binary.BigEndian.Uint32(buff[:int32Size]))
+	timestamp := int64(binary.BigEndian.Uint64(buff[int32Size:]))
+
+	return rating, timestamp, nil
+}
+
+// Close stops the decay and the persist loop and saves the current ratings. The storer is not closed
+func (prh *peersRatingHandler) Close() error {
+	prh.cancelFunc()
+	prh.saveRatings()
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (prh *peersRatingHandler) IsInterfaceNil() bool {
 	return prh == nil
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +20,7 @@ func createMockArgs() ArgPeersRatingHandler {
 	return ArgPeersRatingHandler{
 		TopRatedCache: &testscommon.CacherStub{},
 		BadRatedCache: &testscommon.CacherStub{},
+		Storer:        &storageStubs.StorerStub{},
 	}
 }
 
@@ -45,6 +49,26 @@ func TestNewPeersRatingHandler(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), "BadRatedCache"))
 		assert.True(t, check.IfNil(prh))
 	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Storer = nil
+
+		prh, err := NewPeersRatingHandler(args)
+		assert.Equal(t, p2p.ErrNilStorer, err)
+		assert.True(t, check.IfNil(prh))
+	})
+	t.Run("invalid decay percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DecayPercent = maxPercent + 1
+
+		prh, err := NewPeersRatingHandler(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(prh))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -424,3 +448,110 @@ func TestPeersRatingHandler_GetTopRatedPeersFromList(t *testing.T) {
 		assert.Equal(t, expectedListOfPeers, res)
 	})
 }
+
+func createArgsWithStorer(storer storage.Storer) ArgPeersRatingHandler {
+	return ArgPeersRatingHandler{
+		TopRatedCache:      testscommon.NewCacherMock(),
+		BadRatedCache:      testscommon.NewCacherMock(),
+		Storer:             storer,
+		DecayIntervalInSec: 10,
+		DecayPercent:       50,
+	}
+}
+
+func TestPeersRatingHandler_CloseShouldPersistAndNewHandlerShouldLoad(t *testing.T) {
+	t.Parallel()
+
+	storer := testscommon.CreateMemUnit()
+	goodPid := core.PeerID("good pid")
+	badPid := core.PeerID("bad pid")
+
+	prh, _ := NewPeersRatingHandler(createArgsWithStorer(storer))
+	prh.AddPeer(goodPid)
+	prh.AddPeer(badPid)
+	for i := 0; i < 10; i++ {
+		prh.IncreaseRating(goodPid)
+		prh.DecreaseRating(badPid)
+	}
+	assert.Nil(t, prh.Close())
+
+	reloaded, err := NewPeersRatingHandler(createArgsWithStorer(storer))
+	assert.Nil(t, err)
+	defer func() {
+		_ = reloaded.Close()
+	}()
+
+	expectedRatings := map[core.PeerID]int32{
+		goodPid: 20,
+		badPid:  -10,
+	}
+	assert.Equal(t, expectedRatings, reloaded.GetPeersRatings())
+
+	topRatedPeers := reloaded.GetTopRatedPeersFromList([]core.PeerID{badPid, goodPid}, 1)
+	assert.Equal(t, []core.PeerID{goodPid}, topRatedPeers)
+}
+
+func TestPeersRatingHandler_LoadShouldDecayRatings(t *testing.T) {
+	t.Parallel()
+
+	storer := testscommon.CreateMemUnit()
+	timestamp := int64(1000)
+	_ = storer.Put([]byte("pid 1"), encodeRating(80, timestamp))
+	_ = storer.Put([]byte("pid 2"), encodeRating(-40, timestamp))
+	_ = storer.Put([]byte("pid 3"), encodeRating(1, timestamp))
+	_ = storer.Put([]byte("invalid"), []byte("invalid"))
+
+	prh := &peersRatingHandler{
+		topRatedCache: testscommon.NewCacherMock(),
+		badRatedCache: testscommon.NewCacherMock(),
+		storer:        storer,
+		decayInterval: time.Second * 10,
+		decayPercent:  50,
+		getTimeHandler: func() time.Time {
+			// 2 decay intervals have passed since the ratings were saved
+			return time.Unix(timestamp+25, 0)
+		},
+	}
+	prh.loadRatings()
+
+	expectedRatings := map[core.PeerID]int32{
+		"pid 1": 20,
+		"pid 2": -10,
+	}
+	assert.Equal(t, expectedRatings, prh.GetPeersRatings())
+
+	// the fully decayed rating should have been removed from the storer
+	_, err := storer.Get([]byte("pid 3"))
+	assert.NotNil(t, err)
+}
+
+func TestPeersRatingHandler_DecayRatingsShouldMoveBetweenTiers(t *testing.T) {
+	t.Parallel()
+
+	prh, _ := NewPeersRatingHandler(createArgsWithStorer(testscommon.CreateMemUnit()))
+	defer func() {
+		_ = prh.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	prh.AddPeer(pid)
+	prh.DecreaseRating(pid)
+	assert.True(t, prh.badRatedCache.Has(pid.Bytes()))
+
+	prh.decayRatings()
+	assert.Equal(t, map[core.PeerID]int32{pid: 0}, prh.GetPeersRatings())
+	assert.True(t, prh.topRatedCache.Has(pid.Bytes()))
+	assert.False(t, prh.badRatedCache.Has(pid.Bytes()))
+}
+
+func TestEncodeDecodeRating(t *testing.T) {
+	t.Parallel()
+
+	rating, timestamp, err := decodeRating(encodeRating(-37, 123456))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(-37), rating)
+	assert.Equal(t, int64(123456), timestamp)
+
+	_, _, err = decodeRating([]byte("short"))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
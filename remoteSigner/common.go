@@ -0,0 +1,30 @@
+package remoteSigner
+
+import logger "github.com/ElrondNetwork/elrond-go-logger"
+
+var log = logger.GetOrCreate("remoteSigner")
+
+const (
+	// UnixNetwork is the network type used when the signer listens on a Unix domain socket
+	UnixNetwork = "unix"
+	// TCPNetwork is the network type used when the signer listens on a TCP address
+	TCPNetwork = "tcp"
+
+	serviceName       = "Signer"
+	signMethod        = serviceName + ".Sign"
+	publicKeysMethod  = serviceName + ".PublicKeys"
+	socketPermissions = 0600
+
+	recordsFilePermissions = 0600
+)
+
+func checkNetworkAndAddress(network string, address string) error {
+	if network != UnixNetwork && network != TCPNetwork {
+		return ErrInvalidNetwork
+	}
+	if len(address) == 0 {
+		return ErrEmptyAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package remoteSigner
+
+// SignReply is the reply of a signing request
+type SignReply struct {
+	Signature []byte `json:"signature"`
+}
+
+// PublicKeysArgs is the (empty) argument of a public keys request
+type PublicKeysArgs struct {
+}
+
+// PublicKeysReply is the reply holding all the public keys hosted by the signer
+type PublicKeysReply struct {
+	PublicKeys [][]byte `json:"publicKeys"`
+}
@@ -0,0 +1,66 @@
+package remoteSigner
+
+import "errors"
+
+// ErrInvalidNetwork signals that an invalid network type has been provided
+var ErrInvalidNetwork = errors.New("invalid network, should be unix or tcp")
+
+// ErrEmptyAddress signals that an empty address has been provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrNilTLSConfig signals that a nil TLS configuration has been provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrInvalidTimeout signals that an invalid timeout has been provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
+// ErrNilSigningRequest signals that a nil signing request has been provided
+var ErrNilSigningRequest = errors.New("nil signing request")
+
+// ErrSigningTimeout signals that the remote signer did not respond in time
+var ErrSigningTimeout = errors.New("remote signing timeout")
+
+// ErrRemoteSigning signals that the remote signer refused or failed to sign
+var ErrRemoteSigning = errors.New("remote signing error")
+
+// ErrInvalidCACertificate signals that the CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilSignerService signals that a nil signer service has been provided
+var ErrNilSignerService = errors.New("nil signer service")
+
+// ErrNoKeys signals that no private keys have been provided
+var ErrNoKeys = errors.New("no keys provided")
+
+// ErrDuplicatedKey signals that the same key has been provided more than once
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrUnknownPublicKey signals that the requested public key is not hosted by the signer
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrDoubleSigningAttempt signals an attempt to sign two different messages of the same kind in the same round
+var ErrDoubleSigningAttempt = errors.New("double signing attempt")
+
+// ErrOlderRoundSigningAttempt signals an attempt to sign for a round older than the last signed one
+var ErrOlderRoundSigningAttempt = errors.New("older round signing attempt")
+
+// ErrServerAlreadyStarted signals that the signer server was already started
+var ErrServerAlreadyStarted = errors.New("server already started")
+
+// ErrNilPublicKey signals that a nil public key has been provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrSecretKeyNotAvailable signals an attempt to read the secret of a key hosted by the remote signer
+var ErrSecretKeyNotAvailable = errors.New("secret key not available, the key is hosted by the remote signer")
+
+// ErrUnknownSigningKind signals that the requested signing kind is not known by the signer
+var ErrUnknownSigningKind = errors.New("unknown signing kind")
+
+// ErrInvalidMessageStructure signals that the message to be signed does not match the requested signing kind
+var ErrInvalidMessageStructure = errors.New("invalid message structure")
@@ -0,0 +1,48 @@
+package remoteSigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// hostedKey stands in the node for a BLS private key hosted by the remote signer. Only the public part is known, so
+// the key can be managed like any other key while its secret never reaches the node's memory
+type hostedKey struct {
+	publicKey crypto.PublicKey
+}
+
+// NewHostedKey creates the private key placeholder of the provided public key
+func NewHostedKey(publicKey crypto.PublicKey) (*hostedKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+
+	return &hostedKey{
+		publicKey: publicKey,
+	}, nil
+}
+
+// ToByteArray errors as the secret is only known by the remote signer
+func (key *hostedKey) ToByteArray() ([]byte, error) {
+	return nil, ErrSecretKeyNotAvailable
+}
+
+// Suite returns the suite of the public key
+func (key *hostedKey) Suite() crypto.Suite {
+	return key.publicKey.Suite()
+}
+
+// GeneratePublic returns the public key hosted by the remote signer
+func (key *hostedKey) GeneratePublic() crypto.PublicKey {
+	return key.publicKey
+}
+
+// Scalar returns nil as the secret is only known by the remote signer. Any local signing attempt will then fail
+func (key *hostedKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (key *hostedKey) IsInterfaceNil() bool {
+	return key == nil
+}
@@ -0,0 +1,52 @@
+package remoteSigner
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHostedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := NewHostedKey(nil)
+		assert.Equal(t, ErrNilPublicKey, err)
+		assert.True(t, check.IfNil(key))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, publicKey := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+		key, err := NewHostedKey(publicKey)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(key))
+		assert.True(t, publicKey == key.GeneratePublic())
+		assert.Equal(t, publicKey.Suite(), key.Suite())
+	})
+}
+
+func TestHostedKey_SecretShouldNotBeAvailable(t *testing.T) {
+	t.Parallel()
+
+	_, publicKey := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+	key, err := NewHostedKey(publicKey)
+	require.Nil(t, err)
+
+	secret, err := key.ToByteArray()
+	assert.Equal(t, ErrSecretKeyNotAvailable, err)
+	assert.Nil(t, secret)
+	assert.Nil(t, key.Scalar())
+
+	signer := &singlesig.BlsSingleSigner{}
+	signature, err := signer.Sign(key, []byte("message"))
+	assert.NotNil(t, err)
+	assert.Nil(t, signature)
+}
@@ -0,0 +1,10 @@
+package remoteSigner
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// SignerService defines the operations exposed by the signer process to its clients
+type SignerService interface {
+	Sign(request *common.SigningRequest, reply *SignReply) error
+	PublicKeys(args *PublicKeysArgs, reply *PublicKeysReply) error
+	IsInterfaceNil() bool
+}
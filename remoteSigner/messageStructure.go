@@ -0,0 +1,74 @@
+package remoteSigner
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	headerHashSize   = 32
+	blsSignatureSize = 48
+)
+
+// checkMessageStructure checks that the message matches the requested signing kind. The checks keep the kinds
+// disjoint, so a message accepted for a kind is refused for any other one. Otherwise, as the kind is chosen by the
+// node, a compromised node could get consensus data signed under another kind and bypass the slashing protection.
+// A random seed is the previous block's one, so a signature, except for the first block which uses the genesis random
+// seed. As this seed has the size of a header hash, only the provided genesis random seeds are accepted
+func checkMessageStructure(kind common.SigningKind, message []byte, genesisRandSeeds map[string]struct{}) error {
+	switch kind {
+	case common.SignatureShareSigning:
+		return checkMessageSize(kind, message, headerHashSize)
+	case common.RandSeedSigning:
+		_, isGenesisRandSeed := genesisRandSeeds[string(message)]
+		if isGenesisRandSeed {
+			return nil
+		}
+		return checkMessageSize(kind, message, blsSignatureSize)
+	case common.PeerSignatureSigning:
+		if !isPeerID(message) {
+			return fmt.Errorf("%w, %s message should be a valid peer ID", ErrInvalidMessageStructure, kind)
+		}
+		return checkNotFixedSizeMessage(kind, message)
+	case common.BlockHeaderSigning:
+		if isPeerID(message) {
+			return fmt.Errorf("%w, %s message is a peer ID", ErrInvalidMessageStructure, kind)
+		}
+		return checkNotFixedSizeMessage(kind, message)
+	default:
+		return fmt.Errorf("%w %s", ErrUnknownSigningKind, kind)
+	}
+}
+
+func checkMessageSize(kind common.SigningKind, message []byte, expectedSize int) error {
+	if len(message) != expectedSize {
+		return fmt.Errorf("%w, %s message should have %d bytes, got %d",
+			ErrInvalidMessageStructure, kind, expectedSize, len(message))
+	}
+
+	return nil
+}
+
+// checkNotFixedSizeMessage refuses the messages having the size of a header hash or of a signature, as those could be
+// signed as signature shares or random seeds
+func checkNotFixedSizeMessage(kind common.SigningKind, message []byte) error {
+	if len(message) == headerHashSize || len(message) == blsSignatureSize {
+		return fmt.Errorf("%w, %s message can not have %d bytes", ErrInvalidMessageStructure, kind, len(message))
+	}
+
+	return nil
+}
+
+// isPeerID returns true if the message is a peer ID embedding its public key, as the ones used by the nodes
+func isPeerID(message []byte) bool {
+	pid, err := peer.IDFromBytes(message)
+	if err != nil {
+		return false
+	}
+
+	_, err = pid.ExtractPublicKey()
+
+	return err == nil
+}
@@ -0,0 +1,92 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPeerID(t *testing.T) []byte {
+	privateKey, _, err := libp2pCrypto.GenerateSecp256k1Key(rand.Reader)
+	require.Nil(t, err)
+	pid, err := peer.IDFromPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	return []byte(pid)
+}
+
+func createTestHeaderHash(text string) []byte {
+	hash := sha256.Sum256([]byte(text))
+	return hash[:]
+}
+
+func TestCheckMessageStructure(t *testing.T) {
+	t.Parallel()
+
+	headerHash := createTestHeaderHash("header")
+	randSeed := bytes.Repeat([]byte{1}, blsSignatureSize)
+	pid := createTestPeerID(t)
+	header := []byte("a marshalized block header, longer than a hash or a signature")
+
+	t.Run("unknown kind should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := checkMessageStructure("unknown", headerHash, nil)
+		assert.True(t, errors.Is(err, ErrUnknownSigningKind))
+	})
+	t.Run("signature share should be a header hash", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkMessageStructure(common.SignatureShareSigning, headerHash, nil))
+		for _, message := range [][]byte{randSeed, pid, header} {
+			err := checkMessageStructure(common.SignatureShareSigning, message, nil)
+			assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+		}
+	})
+	t.Run("rand seed should be a signature", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkMessageStructure(common.RandSeedSigning, randSeed, nil))
+		for _, message := range [][]byte{headerHash, pid, header} {
+			err := checkMessageStructure(common.RandSeedSigning, message, nil)
+			assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+		}
+	})
+	t.Run("rand seed can be a genesis rand seed", func(t *testing.T) {
+		t.Parallel()
+
+		genesisRandSeed := createTestHeaderHash("genesis root hash")
+		genesisRandSeeds := map[string]struct{}{string(genesisRandSeed): {}}
+		assert.Nil(t, checkMessageStructure(common.RandSeedSigning, genesisRandSeed, genesisRandSeeds))
+		assert.Nil(t, checkMessageStructure(common.RandSeedSigning, randSeed, genesisRandSeeds))
+
+		err := checkMessageStructure(common.RandSeedSigning, headerHash, genesisRandSeeds)
+		assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+	})
+	t.Run("peer signature should be a peer ID", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkMessageStructure(common.PeerSignatureSigning, pid, nil))
+		for _, message := range [][]byte{headerHash, randSeed, header} {
+			err := checkMessageStructure(common.PeerSignatureSigning, message, nil)
+			assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+		}
+	})
+	t.Run("block header should not be a hash, a signature or a peer ID", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkMessageStructure(common.BlockHeaderSigning, header, nil))
+		for _, message := range [][]byte{headerHash, randSeed, pid} {
+			err := checkMessageStructure(common.BlockHeaderSigning, message, nil)
+			assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+		}
+	})
+}
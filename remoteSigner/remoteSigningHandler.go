@@ -0,0 +1,170 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsRemoteSigningHandler represents the arguments for the remote signing handler
+type ArgsRemoteSigningHandler struct {
+	Network        string
+	Address        string
+	TLSConfig      *tls.Config
+	RequestTimeout time.Duration
+}
+
+type remoteSigningHandler struct {
+	network        string
+	address        string
+	tlsConfig      *tls.Config
+	requestTimeout time.Duration
+	mut            sync.Mutex
+	client         *rpc.Client
+}
+
+// NewRemoteSigningHandler creates a signing handler that delegates all the signing operations to an external signer
+// process. The connection is established at construction time and re-established on demand if it breaks
+func NewRemoteSigningHandler(args ArgsRemoteSigningHandler) (*remoteSigningHandler, error) {
+	err := checkNetworkAndAddress(args.Network, args.Address)
+	if err != nil {
+		return nil, err
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidTimeout
+	}
+
+	handler := &remoteSigningHandler{
+		network:        args.Network,
+		address:        args.Address,
+		tlsConfig:      args.TLSConfig,
+		requestTimeout: args.RequestTimeout,
+	}
+
+	_, err = handler.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+// Sign requests the signature of the message from the remote signer
+func (handler *remoteSigningHandler) Sign(request *common.SigningRequest) ([]byte, error) {
+	if request == nil {
+		return nil, ErrNilSigningRequest
+	}
+
+	reply := &SignReply{}
+	err := handler.call(signMethod, request, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Signature, nil
+}
+
+// GetPublicKeys returns the public keys hosted by the remote signer
+func (handler *remoteSigningHandler) GetPublicKeys() ([][]byte, error) {
+	reply := &PublicKeysReply{}
+	err := handler.call(publicKeysMethod, &PublicKeysArgs{}, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.PublicKeys, nil
+}
+
+func (handler *remoteSigningHandler) call(method string, args interface{}, reply interface{}) error {
+	client, err := handler.getClient()
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(handler.requestTimeout)
+	defer timer.Stop()
+
+	call := client.Go(method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-timer.C:
+		handler.resetClient(client)
+		return fmt.Errorf("%w after %v", ErrSigningTimeout, handler.requestTimeout)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	var serverErr rpc.ServerError
+	if errors.As(err, &serverErr) {
+		return fmt.Errorf("%w: %s", ErrRemoteSigning, serverErr.Error())
+	}
+
+	handler.resetClient(client)
+
+	return err
+}
+
+func (handler *remoteSigningHandler) getClient() (*rpc.Client, error) {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if handler.client != nil {
+		return handler.client, nil
+	}
+
+	dialer := &net.Dialer{Timeout: handler.requestTimeout}
+	conn, err := tls.DialWithDialer(dialer, handler.network, handler.address, handler.tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w while connecting to the remote signer at %s", err, handler.address)
+	}
+
+	handler.client = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+	log.Debug("connected to the remote signer", "network", handler.network, "address", handler.address)
+
+	return handler.client, nil
+}
+
+func (handler *remoteSigningHandler) resetClient(client *rpc.Client) {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if handler.client != client {
+		return
+	}
+
+	_ = handler.client.Close()
+	handler.client = nil
+}
+
+// Close closes the connection with the remote signer
+func (handler *remoteSigningHandler) Close() error {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if handler.client == nil {
+		return nil
+	}
+
+	err := handler.client.Close()
+	handler.client = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *remoteSigningHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
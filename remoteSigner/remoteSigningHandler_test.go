@@ -0,0 +1,276 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRequestTimeout = 5 * time.Second
+
+type testSigner struct {
+	server      *signerServer
+	serviceArgs ArgsSignerService
+	publicKeys  [][]byte
+}
+
+func startTestSigner(t *testing.T, certs testCertificates, network string, address string) *testSigner {
+	serviceArgs, publicKeys := createMockArgsSignerService(t, 2)
+	service, err := NewSignerService(serviceArgs)
+	require.Nil(t, err)
+
+	tlsConfig, err := NewServerTLSConfig(ArgsTLSConfig{
+		CertificateFile:   certs.serverCertFile,
+		PrivateKeyFile:    certs.serverKeyFile,
+		CACertificateFile: certs.caFile,
+	})
+	require.Nil(t, err)
+
+	server, err := NewSignerServer(ArgsSignerServer{
+		Network:   network,
+		Address:   address,
+		TLSConfig: tlsConfig,
+		Service:   service,
+	})
+	require.Nil(t, err)
+	require.Nil(t, server.Start())
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return &testSigner{
+		server:      server,
+		serviceArgs: serviceArgs,
+		publicKeys:  publicKeys,
+	}
+}
+
+func createClientTLSConfig(t *testing.T, certs testCertificates) *tls.Config {
+	tlsConfig, err := NewClientTLSConfig(ArgsTLSConfig{
+		CertificateFile:   certs.clientCertFile,
+		PrivateKeyFile:    certs.clientKeyFile,
+		CACertificateFile: certs.caFile,
+		ServerName:        testServerName,
+	})
+	require.Nil(t, err)
+
+	return tlsConfig
+}
+
+func TestNewRemoteSigningHandler(t *testing.T) {
+	t.Parallel()
+
+	certs := createTestCertificates(t)
+
+	t.Run("invalid network should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        "udp",
+			Address:        "address",
+			TLSConfig:      createClientTLSConfig(t, certs),
+			RequestTimeout: testRequestTimeout,
+		})
+		assert.Equal(t, ErrInvalidNetwork, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("nil TLS config should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        "address",
+			RequestTimeout: testRequestTimeout,
+		})
+		assert.Equal(t, ErrNilTLSConfig, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:   UnixNetwork,
+			Address:   "address",
+			TLSConfig: createClientTLSConfig(t, certs),
+		})
+		assert.Equal(t, ErrInvalidTimeout, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("unreachable signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        filepath.Join(t.TempDir(), "missing.sock"),
+			TLSConfig:      createClientTLSConfig(t, certs),
+			RequestTimeout: testRequestTimeout,
+		})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("client certificate issued by another CA should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		signer := startTestSigner(t, certs, UnixNetwork, filepath.Join(t.TempDir(), "signer.sock"))
+		tlsConfig, err := NewClientTLSConfig(ArgsTLSConfig{
+			CertificateFile:   certs.rogueCertFile,
+			PrivateKeyFile:    certs.rogueKeyFile,
+			CACertificateFile: certs.caFile,
+			ServerName:        testServerName,
+		})
+		require.Nil(t, err)
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        signer.server.Address(),
+			TLSConfig:      tlsConfig,
+			RequestTimeout: testRequestTimeout,
+		})
+		if err == nil {
+			// with TLS 1.3 the client certificate is verified after the client handshake completes
+			_, err = handler.GetPublicKeys()
+		}
+		assert.NotNil(t, err)
+	})
+	t.Run("server certificate issued by another CA should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		signer := startTestSigner(t, certs, UnixNetwork, filepath.Join(t.TempDir(), "signer.sock"))
+		tlsConfig, err := NewClientTLSConfig(ArgsTLSConfig{
+			CertificateFile:   certs.clientCertFile,
+			PrivateKeyFile:    certs.clientKeyFile,
+			CACertificateFile: certs.rogueCAFile,
+			ServerName:        testServerName,
+		})
+		require.Nil(t, err)
+
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        signer.server.Address(),
+			TLSConfig:      tlsConfig,
+			RequestTimeout: testRequestTimeout,
+		})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(handler))
+	})
+}
+
+func TestRemoteSigningHandler_Sign(t *testing.T) {
+	t.Parallel()
+
+	certs := createTestCertificates(t)
+
+	t.Run("nil request should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer := startTestSigner(t, certs, UnixNetwork, filepath.Join(t.TempDir(), "signer.sock"))
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        signer.server.Address(),
+			TLSConfig:      createClientTLSConfig(t, certs),
+			RequestTimeout: testRequestTimeout,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = handler.Close()
+		}()
+
+		sig, err := handler.Sign(nil)
+		assert.Equal(t, ErrNilSigningRequest, err)
+		assert.Nil(t, sig)
+	})
+	t.Run("should work over a unix socket", func(t *testing.T) {
+		t.Parallel()
+
+		signer := startTestSigner(t, certs, UnixNetwork, filepath.Join(t.TempDir(), "signer.sock"))
+		testSignRoundTrip(t, certs, signer, UnixNetwork)
+	})
+	t.Run("should work over tcp", func(t *testing.T) {
+		t.Parallel()
+
+		signer := startTestSigner(t, certs, TCPNetwork, "127.0.0.1:0")
+		testSignRoundTrip(t, certs, signer, TCPNetwork)
+	})
+	t.Run("should reconnect after the signer restarted", func(t *testing.T) {
+		t.Parallel()
+
+		address := filepath.Join(t.TempDir(), "signer.sock")
+		signer := startTestSigner(t, certs, UnixNetwork, address)
+		handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+			Network:        UnixNetwork,
+			Address:        address,
+			TLSConfig:      createClientTLSConfig(t, certs),
+			RequestTimeout: testRequestTimeout,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = handler.Close()
+		}()
+
+		request := &common.SigningRequest{
+			PublicKey: signer.publicKeys[0],
+			Message:   createTestPeerID(t),
+			Kind:      common.PeerSignatureSigning,
+		}
+		_, err = handler.Sign(request)
+		require.Nil(t, err)
+
+		require.Nil(t, signer.server.Close())
+		_, err = handler.Sign(request)
+		assert.NotNil(t, err)
+
+		require.Nil(t, signer.server.Start())
+		sig, err := handler.Sign(request)
+		assert.Nil(t, err)
+		verifySignature(t, signer.serviceArgs.KeyGenerator, signer.publicKeys[0], request.Message, sig)
+	})
+}
+
+func testSignRoundTrip(t *testing.T, certs testCertificates, signer *testSigner, network string) {
+	handler, err := NewRemoteSigningHandler(ArgsRemoteSigningHandler{
+		Network:        network,
+		Address:        signer.server.Address(),
+		TLSConfig:      createClientTLSConfig(t, certs),
+		RequestTimeout: testRequestTimeout,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = handler.Close()
+	}()
+
+	publicKeys, err := handler.GetPublicKeys()
+	require.Nil(t, err)
+	assert.Equal(t, len(signer.publicKeys), len(publicKeys))
+
+	request := &common.SigningRequest{
+		PublicKey: signer.publicKeys[1],
+		Message:   createTestHeaderHash("header"),
+		Kind:      common.SignatureShareSigning,
+		Round:     7,
+	}
+	sig, err := handler.Sign(request)
+	require.Nil(t, err)
+	verifySignature(t, signer.serviceArgs.KeyGenerator, request.PublicKey, request.Message, sig)
+
+	request.Message = createTestHeaderHash("other header")
+	sig, err = handler.Sign(request)
+	assert.True(t, errors.Is(err, ErrRemoteSigning))
+	assert.Contains(t, err.Error(), ErrDoubleSigningAttempt.Error())
+	assert.Nil(t, sig)
+
+	request.PublicKey = []byte("unknown")
+	_, err = handler.Sign(request)
+	assert.True(t, errors.Is(err, ErrRemoteSigning))
+
+	// the connection should still be usable after the refused requests
+	request.PublicKey = signer.publicKeys[0]
+	_, err = handler.Sign(request)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,196 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const handshakeTimeout = 10 * time.Second
+
+// ArgsSignerServer represents the arguments for the signer server
+type ArgsSignerServer struct {
+	Network   string
+	Address   string
+	TLSConfig *tls.Config
+	Service   SignerService
+}
+
+type signerServer struct {
+	network     string
+	address     string
+	tlsConfig   *tls.Config
+	rpcServer   *rpc.Server
+	mut         sync.Mutex
+	listener    net.Listener
+	connections map[net.Conn]struct{}
+}
+
+// NewSignerServer creates the server exposing the signer service over a mutually authenticated TLS connection,
+// either on a Unix domain socket or on a TCP address
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	err := checkNetworkAndAddress(args.Network, args.Address)
+	if err != nil {
+		return nil, err
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+	if check.IfNil(args.Service) {
+		return nil, ErrNilSignerService
+	}
+
+	rpcServer := rpc.NewServer()
+	err = rpcServer.RegisterName(serviceName, args.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerServer{
+		network:     args.Network,
+		address:     args.Address,
+		tlsConfig:   args.TLSConfig,
+		rpcServer:   rpcServer,
+		connections: make(map[net.Conn]struct{}),
+	}, nil
+}
+
+// Start starts listening for the clients connections
+func (server *signerServer) Start() error {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.listener != nil {
+		return ErrServerAlreadyStarted
+	}
+
+	if server.network == UnixNetwork {
+		err := os.Remove(server.address)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	listener, err := net.Listen(server.network, server.address)
+	if err != nil {
+		return err
+	}
+
+	if server.network == UnixNetwork {
+		err = os.Chmod(server.address, socketPermissions)
+		if err != nil {
+			_ = listener.Close()
+			return err
+		}
+	}
+
+	server.listener = tls.NewListener(listener, server.tlsConfig)
+	go server.acceptConnections(server.listener)
+
+	log.Info("remote signer started", "network", server.network, "address", server.listener.Addr().String())
+
+	return nil
+}
+
+// Address returns the address the server listens on, useful when started on a random TCP port
+func (server *signerServer) Address() string {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.listener == nil {
+		return server.address
+	}
+
+	return server.listener.Addr().String()
+}
+
+func (server *signerServer) acceptConnections(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Debug("remote signer stopped accepting connections", "error", err)
+			return
+		}
+
+		go server.serveConnection(conn)
+	}
+}
+
+func (server *signerServer) serveConnection(conn net.Conn) {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		_ = conn.Close()
+		return
+	}
+
+	_ = tlsConn.SetDeadline(time.Now().Add(handshakeTimeout))
+	err := tlsConn.Handshake()
+	if err != nil {
+		log.Warn("remote signer: rejected connection", "remote", conn.RemoteAddr().String(), "error", err)
+		_ = conn.Close()
+		return
+	}
+	_ = tlsConn.SetDeadline(time.Time{})
+
+	peerCertificates := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCertificates) > 0 {
+		log.Info("remote signer: client connected", "subject", peerCertificates[0].Subject.CommonName)
+	}
+
+	if !server.addConnection(conn) {
+		_ = conn.Close()
+		return
+	}
+
+	server.rpcServer.ServeCodec(jsonrpc.NewServerCodec(conn))
+	server.removeConnection(conn)
+}
+
+func (server *signerServer) addConnection(conn net.Conn) bool {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.listener == nil {
+		return false
+	}
+
+	server.connections[conn] = struct{}{}
+
+	return true
+}
+
+func (server *signerServer) removeConnection(conn net.Conn) {
+	server.mut.Lock()
+	delete(server.connections, conn)
+	server.mut.Unlock()
+}
+
+// Close stops the server and closes all the clients connections
+func (server *signerServer) Close() error {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.listener == nil {
+		return nil
+	}
+
+	err := server.listener.Close()
+	server.listener = nil
+	for conn := range server.connections {
+		_ = conn.Close()
+	}
+	server.connections = make(map[net.Conn]struct{})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *signerServer) IsInterfaceNil() bool {
+	return server == nil
+}
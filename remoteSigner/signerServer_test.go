@@ -0,0 +1,136 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSignerServer(t *testing.T, certs testCertificates) ArgsSignerServer {
+	serviceArgs, _ := createMockArgsSignerService(t, 1)
+	service, err := NewSignerService(serviceArgs)
+	require.Nil(t, err)
+
+	tlsConfig, err := NewServerTLSConfig(ArgsTLSConfig{
+		CertificateFile:   certs.serverCertFile,
+		PrivateKeyFile:    certs.serverKeyFile,
+		CACertificateFile: certs.caFile,
+	})
+	require.Nil(t, err)
+
+	return ArgsSignerServer{
+		Network:   UnixNetwork,
+		Address:   filepath.Join(t.TempDir(), "signer.sock"),
+		TLSConfig: tlsConfig,
+		Service:   service,
+	}
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	certs := createTestCertificates(t)
+
+	t.Run("invalid network should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, certs)
+		args.Network = "udp"
+		server, err := NewSignerServer(args)
+		assert.Equal(t, ErrInvalidNetwork, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, certs)
+		args.Address = ""
+		server, err := NewSignerServer(args)
+		assert.Equal(t, ErrEmptyAddress, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil TLS config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, certs)
+		args.TLSConfig = nil
+		server, err := NewSignerServer(args)
+		assert.Equal(t, ErrNilTLSConfig, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer(t, certs)
+		args.Service = nil
+		server, err := NewSignerServer(args)
+		assert.Equal(t, ErrNilSignerService, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := NewSignerServer(createMockArgsSignerServer(t, certs))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(server))
+	})
+}
+
+func TestSignerServer_StartTwiceShouldError(t *testing.T) {
+	t.Parallel()
+
+	certs := createTestCertificates(t)
+	server, _ := NewSignerServer(createMockArgsSignerServer(t, certs))
+	err := server.Start()
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	err = server.Start()
+	assert.Equal(t, ErrServerAlreadyStarted, err)
+}
+
+func TestNewServerTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certs := createTestCertificates(t)
+
+	t.Run("missing certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := NewServerTLSConfig(ArgsTLSConfig{
+			CertificateFile:   "missing.pem",
+			PrivateKeyFile:    certs.serverKeyFile,
+			CACertificateFile: certs.caFile,
+		})
+		assert.NotNil(t, err)
+		assert.Nil(t, tlsConfig)
+	})
+	t.Run("invalid CA certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := NewServerTLSConfig(ArgsTLSConfig{
+			CertificateFile:   certs.serverCertFile,
+			PrivateKeyFile:    certs.serverKeyFile,
+			CACertificateFile: certs.serverKeyFile,
+		})
+		assert.Equal(t, ErrInvalidCACertificate, err)
+		assert.Nil(t, tlsConfig)
+	})
+	t.Run("should require client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := NewServerTLSConfig(ArgsTLSConfig{
+			CertificateFile:   certs.serverCertFile,
+			PrivateKeyFile:    certs.serverKeyFile,
+			CACertificateFile: certs.caFile,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	})
+}
@@ -0,0 +1,129 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsSignerService represents the arguments for the signer service
+type ArgsSignerService struct {
+	KeyGenerator               crypto.KeyGenerator
+	SingleSigner               crypto.SingleSigner
+	PrivateKeys                [][]byte
+	GenesisRandSeeds           [][]byte
+	SlashingProtectionFilePath string
+}
+
+type signerService struct {
+	singleSigner       crypto.SingleSigner
+	keys               map[string]crypto.PrivateKey
+	genesisRandSeeds   map[string]struct{}
+	slashingProtection *slashingProtector
+}
+
+// NewSignerService creates the service that holds the BLS private keys of the signer process and signs on their
+// behalf, after applying the slashing protection checks
+func NewSignerService(args ArgsSignerService) (*signerService, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if len(args.PrivateKeys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	keys := make(map[string]crypto.PrivateKey, len(args.PrivateKeys))
+	for _, skBytes := range args.PrivateKeys {
+		privateKey, err := args.KeyGenerator.PrivateKeyFromByteArray(skBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding a private key", err)
+		}
+
+		pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+
+		_, found := keys[string(pkBytes)]
+		if found {
+			return nil, fmt.Errorf("%w, public key %s", ErrDuplicatedKey, hex.EncodeToString(pkBytes))
+		}
+
+		keys[string(pkBytes)] = privateKey
+	}
+
+	genesisRandSeeds := make(map[string]struct{}, len(args.GenesisRandSeeds))
+	for _, randSeed := range args.GenesisRandSeeds {
+		genesisRandSeeds[string(randSeed)] = struct{}{}
+	}
+
+	slashingProtection, err := newSlashingProtector(args.SlashingProtectionFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerService{
+		singleSigner:       args.SingleSigner,
+		keys:               keys,
+		genesisRandSeeds:   genesisRandSeeds,
+		slashingProtection: slashingProtection,
+	}, nil
+}
+
+// Sign signs the message of the request on behalf of the requested public key, after checking that the message matches
+// the requested kind and that signing it can not get the key slashed
+func (service *signerService) Sign(request *common.SigningRequest, reply *SignReply) error {
+	if request == nil {
+		return ErrNilSigningRequest
+	}
+
+	privateKey, found := service.keys[string(request.PublicKey)]
+	if !found {
+		return fmt.Errorf("%w %s", ErrUnknownPublicKey, hex.EncodeToString(request.PublicKey))
+	}
+
+	err := checkMessageStructure(request.Kind, request.Message, service.genesisRandSeeds)
+	if err == nil {
+		err = service.slashingProtection.checkAndRecord(request)
+	}
+	if err != nil {
+		log.Warn("signing request refused",
+			"pk", hex.EncodeToString(request.PublicKey),
+			"kind", request.Kind,
+			"round", request.Round,
+			"error", err)
+		return err
+	}
+
+	reply.Signature, err = service.singleSigner.Sign(privateKey, request.Message)
+
+	return err
+}
+
+// PublicKeys returns all the public keys hosted by the signer, sorted
+func (service *signerService) PublicKeys(_ *PublicKeysArgs, reply *PublicKeysReply) error {
+	publicKeys := make([][]byte, 0, len(service.keys))
+	for pk := range service.keys {
+		publicKeys = append(publicKeys, []byte(pk))
+	}
+
+	sort.Slice(publicKeys, func(i, j int) bool {
+		return bytes.Compare(publicKeys[i], publicKeys[j]) < 0
+	})
+	reply.PublicKeys = publicKeys
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *signerService) IsInterfaceNil() bool {
+	return service == nil
+}
@@ -0,0 +1,193 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSignerService(t *testing.T, numKeys int) (ArgsSignerService, [][]byte) {
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys := make([][]byte, 0, numKeys)
+	publicKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGenerator.GeneratePair()
+		skBytes, err := sk.ToByteArray()
+		require.Nil(t, err)
+		pkBytes, err := pk.ToByteArray()
+		require.Nil(t, err)
+
+		privateKeys = append(privateKeys, skBytes)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	return ArgsSignerService{
+		KeyGenerator: keyGenerator,
+		SingleSigner: &singlesig.BlsSingleSigner{},
+		PrivateKeys:  privateKeys,
+	}, publicKeys
+}
+
+func TestNewSignerService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		args.KeyGenerator = nil
+		service, err := NewSignerService(args)
+		assert.Equal(t, ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(service))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		args.SingleSigner = nil
+		service, err := NewSignerService(args)
+		assert.Equal(t, ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(service))
+	})
+	t.Run("no keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 0)
+		service, err := NewSignerService(args)
+		assert.Equal(t, ErrNoKeys, err)
+		assert.True(t, check.IfNil(service))
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		args.PrivateKeys = append(args.PrivateKeys, []byte("invalid"))
+		service, err := NewSignerService(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(service))
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		args.PrivateKeys = append(args.PrivateKeys, args.PrivateKeys[0])
+		service, err := NewSignerService(args)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+		assert.True(t, check.IfNil(service))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 2)
+		service, err := NewSignerService(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(service))
+	})
+}
+
+func TestSignerService_Sign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		service, _ := NewSignerService(args)
+		err := service.Sign(nil, &SignReply{})
+		assert.Equal(t, ErrNilSigningRequest, err)
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsSignerService(t, 1)
+		service, _ := NewSignerService(args)
+		err := service.Sign(&common.SigningRequest{PublicKey: []byte("unknown")}, &SignReply{})
+		assert.True(t, errors.Is(err, ErrUnknownPublicKey))
+	})
+	t.Run("message not matching the kind should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, publicKeys := createMockArgsSignerService(t, 1)
+		service, _ := NewSignerService(args)
+		reply := &SignReply{}
+		err := service.Sign(&common.SigningRequest{
+			PublicKey: publicKeys[0],
+			Message:   createTestHeaderHash("header"),
+			Kind:      common.PeerSignatureSigning,
+			Round:     4,
+		}, reply)
+		assert.True(t, errors.Is(err, ErrInvalidMessageStructure))
+		assert.Nil(t, reply.Signature)
+	})
+	t.Run("double signing should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, publicKeys := createMockArgsSignerService(t, 1)
+		service, _ := NewSignerService(args)
+		request := &common.SigningRequest{
+			PublicKey: publicKeys[0],
+			Message:   createTestHeaderHash("header"),
+			Kind:      common.SignatureShareSigning,
+			Round:     4,
+		}
+		err := service.Sign(request, &SignReply{})
+		require.Nil(t, err)
+
+		request.Message = createTestHeaderHash("other header")
+		reply := &SignReply{}
+		err = service.Sign(request, reply)
+		assert.True(t, errors.Is(err, ErrDoubleSigningAttempt))
+		assert.Nil(t, reply.Signature)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, publicKeys := createMockArgsSignerService(t, 2)
+		service, _ := NewSignerService(args)
+		message := createTestHeaderHash("header")
+		reply := &SignReply{}
+		err := service.Sign(&common.SigningRequest{
+			PublicKey: publicKeys[1],
+			Message:   message,
+			Kind:      common.SignatureShareSigning,
+			Round:     4,
+		}, reply)
+		require.Nil(t, err)
+
+		verifySignature(t, args.KeyGenerator, publicKeys[1], message, reply.Signature)
+	})
+}
+
+func TestSignerService_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsSignerService(t, 5)
+	service, _ := NewSignerService(args)
+	reply := &PublicKeysReply{}
+	err := service.PublicKeys(&PublicKeysArgs{}, reply)
+	require.Nil(t, err)
+	require.Equal(t, len(publicKeys), len(reply.PublicKeys))
+	for i := 1; i < len(reply.PublicKeys); i++ {
+		assert.True(t, bytes.Compare(reply.PublicKeys[i-1], reply.PublicKeys[i]) < 0)
+	}
+	for _, pk := range publicKeys {
+		assert.Contains(t, reply.PublicKeys, pk)
+	}
+}
+
+func verifySignature(t *testing.T, keyGenerator crypto.KeyGenerator, pkBytes []byte, message []byte, signature []byte) {
+	pk, err := keyGenerator.PublicKeyFromByteArray(pkBytes)
+	require.Nil(t, err)
+
+	err = (&singlesig.BlsSingleSigner{}).Verify(pk, message, signature)
+	assert.Nil(t, err)
+}
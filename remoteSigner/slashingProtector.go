@@ -0,0 +1,129 @@
+package remoteSigner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+type signedRecord struct {
+	Round       int64  `json:"round"`
+	MessageHash string `json:"messageHash"`
+}
+
+// slashingProtector refuses to sign two different block headers, signature shares or random seeds for the same key in
+// the same round, as well as signing them for rounds older than the last signed one. The records are persisted, if a
+// file path is provided, so the protection survives restarts
+type slashingProtector struct {
+	mut      sync.Mutex
+	filePath string
+	records  map[string]*signedRecord
+}
+
+func newSlashingProtector(filePath string) (*slashingProtector, error) {
+	sp := &slashingProtector{
+		filePath: filePath,
+		records:  make(map[string]*signedRecord),
+	}
+
+	if len(filePath) == 0 {
+		log.Warn("slashing protection records are not persisted, the protection will be lost on restart")
+		return sp, nil
+	}
+
+	buff, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return sp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(buff, &sp.records)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the slashing protection records", err)
+	}
+
+	return sp, nil
+}
+
+// isProtectedKind returns true for the kinds signing round bound consensus data
+func isProtectedKind(kind common.SigningKind) bool {
+	switch kind {
+	case common.BlockHeaderSigning, common.SignatureShareSigning, common.RandSeedSigning:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkAndRecord returns an error if signing the request might get the key slashed, otherwise it records the request
+func (sp *slashingProtector) checkAndRecord(request *common.SigningRequest) error {
+	if !isProtectedKind(request.Kind) {
+		return nil
+	}
+
+	recordKey := fmt.Sprintf("%s_%s", hex.EncodeToString(request.PublicKey), request.Kind)
+	messageHash := sha256.Sum256(request.Message)
+	newRecord := &signedRecord{
+		Round:       request.Round,
+		MessageHash: hex.EncodeToString(messageHash[:]),
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	lastRecord, found := sp.records[recordKey]
+	if found {
+		if request.Round < lastRecord.Round {
+			return fmt.Errorf("%w for %s, last signed round %d, requested round %d",
+				ErrOlderRoundSigningAttempt, request.Kind, lastRecord.Round, request.Round)
+		}
+		if request.Round == lastRecord.Round {
+			if lastRecord.MessageHash != newRecord.MessageHash {
+				return fmt.Errorf("%w for %s in round %d", ErrDoubleSigningAttempt, request.Kind, request.Round)
+			}
+
+			return nil
+		}
+	}
+
+	sp.records[recordKey] = newRecord
+	err := sp.persist()
+	if err != nil {
+		if found {
+			sp.records[recordKey] = lastRecord
+		} else {
+			delete(sp.records, recordKey)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (sp *slashingProtector) persist() error {
+	if len(sp.filePath) == 0 {
+		return nil
+	}
+
+	buff, err := json.Marshal(sp.records)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := sp.filePath + ".tmp"
+	err = ioutil.WriteFile(tmpFilePath, buff, recordsFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, sp.filePath)
+}
@@ -0,0 +1,96 @@
+package remoteSigner
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSigningRequest(kind common.SigningKind, round int64, message string) *common.SigningRequest {
+	return &common.SigningRequest{
+		PublicKey: []byte("pk"),
+		Message:   []byte(message),
+		Kind:      kind,
+		Round:     round,
+	}
+}
+
+func TestSlashingProtector_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unprotected kinds should always pass", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector("")
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.PeerSignatureSigning, 10, "a")))
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.PeerSignatureSigning, 10, "b")))
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.PeerSignatureSigning, 9, "c")))
+		assert.Equal(t, 0, len(sp.records))
+	})
+	t.Run("all round bound consensus kinds should be protected", func(t *testing.T) {
+		t.Parallel()
+
+		kinds := []common.SigningKind{common.BlockHeaderSigning, common.SignatureShareSigning, common.RandSeedSigning}
+		for _, kind := range kinds {
+			sp, _ := newSlashingProtector("")
+			assert.Nil(t, sp.checkAndRecord(createSigningRequest(kind, 10, "a")))
+			err := sp.checkAndRecord(createSigningRequest(kind, 10, "b"))
+			assert.True(t, errors.Is(err, ErrDoubleSigningAttempt), string(kind))
+			err = sp.checkAndRecord(createSigningRequest(kind, 9, "a"))
+			assert.True(t, errors.Is(err, ErrOlderRoundSigningAttempt), string(kind))
+		}
+	})
+	t.Run("same message in the same round should pass", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector("")
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.BlockHeaderSigning, 10, "a")))
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.BlockHeaderSigning, 10, "a")))
+	})
+	t.Run("different message in the same round should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector("")
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "a")))
+		err := sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "b"))
+		assert.True(t, errors.Is(err, ErrDoubleSigningAttempt))
+	})
+	t.Run("older round should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector("")
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "a")))
+		err := sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 9, "b"))
+		assert.True(t, errors.Is(err, ErrOlderRoundSigningAttempt))
+	})
+	t.Run("kinds and keys should be tracked separately", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector("")
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "a")))
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.BlockHeaderSigning, 10, "b")))
+		otherKeyRequest := createSigningRequest(common.SignatureShareSigning, 10, "c")
+		otherKeyRequest.PublicKey = []byte("other pk")
+		assert.Nil(t, sp.checkAndRecord(otherKeyRequest))
+		assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 11, "d")))
+	})
+}
+
+func TestSlashingProtector_RecordsShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "slashing-protection.json")
+	sp, err := newSlashingProtector(filePath)
+	require.Nil(t, err)
+	require.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "a")))
+
+	sp, err = newSlashingProtector(filePath)
+	require.Nil(t, err)
+	err = sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "b"))
+	assert.True(t, errors.Is(err, ErrDoubleSigningAttempt))
+	assert.Nil(t, sp.checkAndRecord(createSigningRequest(common.SignatureShareSigning, 10, "a")))
+}
@@ -0,0 +1,115 @@
+package remoteSigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testServerName = "remote-signer"
+
+type testCertificates struct {
+	caFile         string
+	serverCertFile string
+	serverKeyFile  string
+	clientCertFile string
+	clientKeyFile  string
+	rogueCertFile  string
+	rogueKeyFile   string
+	rogueCAFile    string
+}
+
+type testIdentity struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func createTestCertificates(t *testing.T) testCertificates {
+	dir := t.TempDir()
+
+	ca := createTestCA(t, "test CA")
+	rogueCA := createTestCA(t, "rogue CA")
+	certs := testCertificates{
+		caFile:         writeCertificate(t, dir, "ca.pem", ca.certificate.Raw),
+		rogueCAFile:    writeCertificate(t, dir, "rogue-ca.pem", rogueCA.certificate.Raw),
+		serverCertFile: filepath.Join(dir, "server.pem"),
+		serverKeyFile:  filepath.Join(dir, "server-key.pem"),
+		clientCertFile: filepath.Join(dir, "client.pem"),
+		clientKeyFile:  filepath.Join(dir, "client-key.pem"),
+		rogueCertFile:  filepath.Join(dir, "rogue.pem"),
+		rogueKeyFile:   filepath.Join(dir, "rogue-key.pem"),
+	}
+
+	createTestLeaf(t, ca, testServerName, x509.ExtKeyUsageServerAuth, certs.serverCertFile, certs.serverKeyFile)
+	createTestLeaf(t, ca, "node", x509.ExtKeyUsageClientAuth, certs.clientCertFile, certs.clientKeyFile)
+	createTestLeaf(t, rogueCA, "rogue node", x509.ExtKeyUsageClientAuth, certs.rogueCertFile, certs.rogueKeyFile)
+
+	return certs
+}
+
+func createTestCA(t *testing.T, name string) testIdentity {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+
+	return testIdentity{certificate: certificate, key: key}
+}
+
+func createTestLeaf(t *testing.T, ca testIdentity, name string, usage x509.ExtKeyUsage, certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.Nil(t, err)
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyBytes)
+}
+
+func writeCertificate(t *testing.T, dir string, name string, der []byte) string {
+	path := filepath.Join(dir, name)
+	writePEM(t, path, "CERTIFICATE", der)
+
+	return path
+}
+
+func writePEM(t *testing.T, path string, blockType string, data []byte) {
+	buff := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data})
+	err := ioutil.WriteFile(path, buff, 0600)
+	require.Nil(t, err)
+}
@@ -0,0 +1,66 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// ArgsTLSConfig represents the arguments needed to create the mutually authenticated TLS configurations
+type ArgsTLSConfig struct {
+	CertificateFile   string
+	PrivateKeyFile    string
+	CACertificateFile string
+	ServerName        string
+}
+
+// NewServerTLSConfig creates the TLS configuration of the signer process. Only the clients presenting a certificate
+// issued by the configured CA are accepted
+func NewServerTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// NewClientTLSConfig creates the TLS configuration of the node. The signer must present a certificate issued by the
+// configured CA for the configured server name
+func NewClientTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		ServerName:   args.ServerName,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func loadCertificates(args ArgsTLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(args.CertificateFile, args.PrivateKeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caBytes, err := ioutil.ReadFile(args.CACertificateFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBytes) {
+		return tls.Certificate{}, nil, ErrInvalidCACertificate
+	}
+
+	return certificate, caPool, nil
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ValidatorInfoProviderStub -
+type ValidatorInfoProviderStub struct {
+	LastFinalizedRootHashCalled       func() []byte
+	GetValidatorInfoForRootHashCalled func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
+}
+
+// LastFinalizedRootHash -
+func (vips *ValidatorInfoProviderStub) LastFinalizedRootHash() []byte {
+	if vips.LastFinalizedRootHashCalled != nil {
+		return vips.LastFinalizedRootHashCalled()
+	}
+
+	return nil
+}
+
+// GetValidatorInfoForRootHash -
+func (vips *ValidatorInfoProviderStub) GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+	if vips.GetValidatorInfoForRootHashCalled != nil {
+		return vips.GetValidatorInfoForRootHashCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (vips *ValidatorInfoProviderStub) IsInterfaceNil() bool {
+	return vips == nil
+}
@@ -0,0 +1,26 @@
+package nodesCoordinator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+type disabledNodesShufflingPreviewer struct {
+}
+
+// NewDisabledNodesShufflingPreviewer returns a nodes shuffling previewer used on the shard nodes, which do not have
+// the validators info
+func NewDisabledNodesShufflingPreviewer() *disabledNodesShufflingPreviewer {
+	return &disabledNodesShufflingPreviewer{}
+}
+
+// PreviewShuffling returns the ErrShufflingPreviewNotAvailable error
+func (d *disabledNodesShufflingPreviewer) PreviewShuffling(
+	_ *common.NodesShufflingPreviewRequest,
+) (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nil, ErrShufflingPreviewNotAvailable
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledNodesShufflingPreviewer) IsInterfaceNil() bool {
+	return d == nil
+}
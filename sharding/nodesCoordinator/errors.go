@@ -111,3 +111,24 @@ var ErrValidatorCannotBeFullArchive = errors.New("validator cannot be a full arc
 
 // ErrNilNodeTypeProvider signals that a nil node type provider has been given
 var ErrNilNodeTypeProvider = errors.New("nil node type provider")
+
+// ErrNilValidatorInfoProvider signals that a nil validator info provider has been provided
+var ErrNilValidatorInfoProvider = errors.New("nil validator info provider")
+
+// ErrNilCurrentBlockProvider signals that a nil current block provider has been provided
+var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
+
+// ErrNilBlockHeader signals that a nil block header has been provided
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrMissingValidatorsInfo signals that the validators info is not available yet
+var ErrMissingValidatorsInfo = errors.New("missing validators info")
+
+// ErrInvalidShufflingPreviewRequest signals that the shuffling preview request is invalid
+var ErrInvalidShufflingPreviewRequest = errors.New("invalid shuffling preview request")
+
+// ErrShufflingPreviewNotAvailable signals that the nodes shuffling preview is not available on this node
+var ErrShufflingPreviewNotAvailable = errors.New("nodes shuffling preview is only available on metachain nodes")
+
+// ErrNilMetaBlockStorer signals that a nil meta block storer has been provided
+var ErrNilMetaBlockStorer = errors.New("nil meta block storer")
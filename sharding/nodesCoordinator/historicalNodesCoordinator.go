@@ -0,0 +1,238 @@
+package nodesCoordinator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsHistoricalNodesCoordinator holds the arguments needed to create a historical nodes coordinator
+type ArgsHistoricalNodesCoordinator struct {
+	NodesCoordinator NodesCoordinator
+	BootStorer       storage.Storer
+	MetaBlockStorer  storage.Storer
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+}
+
+// EpochValidatorsPublicKeys holds the public keys of the eligible, waiting and leaving validators of an epoch
+type EpochValidatorsPublicKeys struct {
+	Eligible map[uint32][][]byte
+	Waiting  map[uint32][][]byte
+	Leaving  map[uint32][][]byte
+}
+
+type historicalNodesCoordinator struct {
+	nodesCoordinator NodesCoordinator
+	bootStorer       storage.Storer
+	metaBlockStorer  storage.Storer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+}
+
+// NewHistoricalNodesCoordinator creates a component able to answer nodes coordinator queries for past epochs. The
+// epochs still held by the nodes coordinator are served directly, while the older ones are rebuilt from the
+// registries persisted in the boot storer at each start of epoch
+func NewHistoricalNodesCoordinator(args ArgsHistoricalNodesCoordinator) (*historicalNodesCoordinator, error) {
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.BootStorer) {
+		return nil, ErrNilBootStorer
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, ErrNilMetaBlockStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &historicalNodesCoordinator{
+		nodesCoordinator: args.NodesCoordinator,
+		bootStorer:       args.BootStorer,
+		metaBlockStorer:  args.MetaBlockStorer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+	}, nil
+}
+
+// GetEpochValidatorsPublicKeys returns the public keys of the eligible, waiting and leaving validators of the
+// provided epoch
+func (hnc *historicalNodesCoordinator) GetEpochValidatorsPublicKeys(epoch uint32) (*EpochValidatorsPublicKeys, error) {
+	eligible, err := hnc.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err == nil {
+		return hnc.getEpochValidatorsPublicKeysFromNodesCoordinator(epoch, eligible)
+	}
+	if !errors.Is(err, ErrEpochNodesConfigDoesNotExist) {
+		return nil, err
+	}
+
+	epochValidators, err := hnc.loadEpochValidators(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EpochValidatorsPublicKeys{
+		Eligible: serializableValidatorsToPublicKeys(epochValidators.EligibleValidators),
+		Waiting:  serializableValidatorsToPublicKeys(epochValidators.WaitingValidators),
+		Leaving:  serializableValidatorsToPublicKeys(epochValidators.LeavingValidators),
+	}, nil
+}
+
+func (hnc *historicalNodesCoordinator) getEpochValidatorsPublicKeysFromNodesCoordinator(
+	epoch uint32,
+	eligible map[uint32][][]byte,
+) (*EpochValidatorsPublicKeys, error) {
+	waiting, err := hnc.nodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	leaving, err := hnc.nodesCoordinator.GetAllLeavingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EpochValidatorsPublicKeys{
+		Eligible: eligible,
+		Waiting:  waiting,
+		Leaving:  leaving,
+	}, nil
+}
+
+// ComputeConsensusGroup computes the consensus group of the provided randomness, round, shard and epoch, the
+// first validator of the group being the leader
+func (hnc *historicalNodesCoordinator) ComputeConsensusGroup(
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]Validator, error) {
+	consensusGroup, err := hnc.nodesCoordinator.ComputeConsensusGroup(randomness, round, shardID, epoch)
+	if err == nil {
+		return consensusGroup, nil
+	}
+	if !errors.Is(err, ErrEpochNodesConfigDoesNotExist) {
+		return nil, err
+	}
+	if len(randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	epochValidators, err := hnc.loadEpochValidators(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleMap, err := SerializableValidatorsToValidators(epochValidators.EligibleValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleList, ok := eligibleMap[shardID]
+	if !ok {
+		return nil, ErrInvalidShardId
+	}
+
+	weights, err := hnc.nodesCoordinator.ValidatorsWeights(eligibleList)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := NewSelectorExpandedList(weights, hnc.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusSize := hnc.nodesCoordinator.ConsensusGroupSize(shardID)
+	roundRandomness := []byte(fmt.Sprintf("%d-%s", round, randomness))
+
+	return selectValidators(selector, roundRandomness, uint32(consensusSize), eligibleList)
+}
+
+// loadEpochValidators searches the configuration of the provided epoch in the registries saved at the start of the
+// epoch or at the start of the following ones, as each registry holds the last nodesCoordinatorStoredEpochs epochs
+func (hnc *historicalNodesCoordinator) loadEpochValidators(epoch uint32) (*EpochValidators, error) {
+	epochKey := fmt.Sprint(epoch)
+	for registryEpoch := epoch; registryEpoch < epoch+nodesCoordinatorStoredEpochs; registryEpoch++ {
+		if registryEpoch == 0 {
+			continue
+		}
+
+		registry, err := hnc.loadRegistry(registryEpoch)
+		if err != nil {
+			log.Trace("historicalNodesCoordinator.loadEpochValidators",
+				"epoch", epoch, "registry epoch", registryEpoch, "error", err.Error())
+			continue
+		}
+
+		epochValidators, ok := registry.EpochsConfig[epochKey]
+		if ok && epochValidators != nil {
+			return epochValidators, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w epoch=%v", ErrEpochNodesConfigDoesNotExist, epoch)
+}
+
+func (hnc *historicalNodesCoordinator) loadRegistry(epoch uint32) (*NodesCoordinatorRegistry, error) {
+	metaBlockBytes, err := hnc.metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = hnc.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	registryKey := append([]byte(common.NodesCoordinatorRegistryKeyPrefix), metaBlock.GetPrevRandSeed()...)
+	registryBytes, err := hnc.bootStorer.Get(registryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := &NodesCoordinatorRegistry{}
+	err = json.Unmarshal(registryBytes, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func serializableValidatorsToPublicKeys(validators map[string][]*SerializableValidator) map[uint32][][]byte {
+	validatorsMap, err := SerializableValidatorsToValidators(validators)
+	if err != nil {
+		log.Debug("serializableValidatorsToPublicKeys", "error", err.Error())
+		return make(map[uint32][][]byte)
+	}
+
+	publicKeys := make(map[uint32][][]byte, len(validatorsMap))
+	for shardID, shardValidators := range validatorsMap {
+		shardPublicKeys := make([][]byte, 0, len(shardValidators))
+		for _, v := range shardValidators {
+			shardPublicKeys = append(shardPublicKeys, v.PubKey())
+		}
+		publicKeys[shardID] = shardPublicKeys
+	}
+
+	return publicKeys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hnc *historicalNodesCoordinator) IsInterfaceNil() bool {
+	return hnc == nil
+}
@@ -0,0 +1,218 @@
+package nodesCoordinator
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var epochStartRandomness = []byte("epoch start randomness")
+
+func createHistoricalNodesCoordinatorArguments() ArgNodesCoordinator {
+	arguments := createArguments()
+	arguments.EligibleNodes = createUniqueNodesMap(10, 2, "eligible")
+	arguments.WaitingNodes = createUniqueNodesMap(3, 2, "waiting")
+	arguments.NbShards = 2
+	arguments.ShardConsensusGroupSize = 3
+	arguments.MetaConsensusGroupSize = 3
+
+	return arguments
+}
+
+func createMockArgsHistoricalNodesCoordinator(nodesCoordinator NodesCoordinator) ArgsHistoricalNodesCoordinator {
+	return ArgsHistoricalNodesCoordinator{
+		NodesCoordinator: nodesCoordinator,
+		BootStorer:       mock.NewStorerMock(),
+		MetaBlockStorer:  mock.NewStorerMock(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &hashingMocks.HasherMock{},
+	}
+}
+
+// saveEpochStartRegistry persists the registry of the nodes coordinator the way it is done at the start of epoch 1,
+// then removes the configuration of epoch 0 from memory so that it can only be rebuilt from the registry
+func saveEpochStartRegistry(t *testing.T, ihnc *indexHashedNodesCoordinator, args ArgsHistoricalNodesCoordinator) {
+	registryBytes, err := json.Marshal(ihnc.NodesCoordinatorToRegistry())
+	require.Nil(t, err)
+	registryKey := append([]byte(common.NodesCoordinatorRegistryKeyPrefix), epochStartRandomness...)
+	require.Nil(t, args.BootStorer.Put(registryKey, registryBytes))
+
+	metaBlockBytes, err := args.Marshalizer.Marshal(&block.MetaBlock{Epoch: 1, PrevRandSeed: epochStartRandomness})
+	require.Nil(t, err)
+	require.Nil(t, args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(1)), metaBlockBytes))
+
+	ihnc.mutNodesConfig.Lock()
+	delete(ihnc.nodesConfig, 0)
+	ihnc.mutNodesConfig.Unlock()
+}
+
+func TestNewHistoricalNodesCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		hnc, err := NewHistoricalNodesCoordinator(createMockArgsHistoricalNodesCoordinator(nil))
+		assert.Equal(t, ErrNilNodesCoordinator, err)
+		assert.True(t, check.IfNil(hnc))
+	})
+	t.Run("nil boot storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		args.BootStorer = nil
+		hnc, err := NewHistoricalNodesCoordinator(args)
+		assert.Equal(t, ErrNilBootStorer, err)
+		assert.True(t, check.IfNil(hnc))
+	})
+	t.Run("nil meta block storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		args.MetaBlockStorer = nil
+		hnc, err := NewHistoricalNodesCoordinator(args)
+		assert.Equal(t, ErrNilMetaBlockStorer, err)
+		assert.True(t, check.IfNil(hnc))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		args.Marshalizer = nil
+		hnc, err := NewHistoricalNodesCoordinator(args)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(hnc))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		args.Hasher = nil
+		hnc, err := NewHistoricalNodesCoordinator(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(hnc))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		hnc, err := NewHistoricalNodesCoordinator(createMockArgsHistoricalNodesCoordinator(ihnc))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(hnc))
+	})
+}
+
+func TestHistoricalNodesCoordinator_GetEpochValidatorsPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("epoch held by the nodes coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		hnc, _ := NewHistoricalNodesCoordinator(createMockArgsHistoricalNodesCoordinator(ihnc))
+
+		epochValidators, err := hnc.GetEpochValidatorsPublicKeys(0)
+		require.Nil(t, err)
+
+		expectedEligible, _ := ihnc.GetAllEligibleValidatorsPublicKeys(0)
+		expectedWaiting, _ := ihnc.GetAllWaitingValidatorsPublicKeys(0)
+		assert.Equal(t, expectedEligible, epochValidators.Eligible)
+		assert.Equal(t, expectedWaiting, epochValidators.Waiting)
+	})
+	t.Run("epoch rebuilt from the registry", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createHistoricalNodesCoordinatorArguments()
+		reference, _ := NewIndexHashedNodesCoordinator(arguments)
+		ihnc, _ := NewIndexHashedNodesCoordinator(arguments)
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		saveEpochStartRegistry(t, ihnc, args)
+		hnc, _ := NewHistoricalNodesCoordinator(args)
+
+		_, err := ihnc.GetAllEligibleValidatorsPublicKeys(0)
+		require.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+
+		epochValidators, err := hnc.GetEpochValidatorsPublicKeys(0)
+		require.Nil(t, err)
+
+		expectedEligible, _ := reference.GetAllEligibleValidatorsPublicKeys(0)
+		expectedWaiting, _ := reference.GetAllWaitingValidatorsPublicKeys(0)
+		assert.Equal(t, expectedEligible, epochValidators.Eligible)
+		assert.Equal(t, expectedWaiting, epochValidators.Waiting)
+	})
+	t.Run("epoch not retained should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		hnc, _ := NewHistoricalNodesCoordinator(createMockArgsHistoricalNodesCoordinator(ihnc))
+
+		epochValidators, err := hnc.GetEpochValidatorsPublicKeys(7)
+		assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+		assert.Nil(t, epochValidators)
+	})
+}
+
+func TestHistoricalNodesCoordinator_ComputeConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("consensus group rebuilt from the registry should match the nodes coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createHistoricalNodesCoordinatorArguments()
+		reference, _ := NewIndexHashedNodesCoordinator(arguments)
+		ihnc, _ := NewIndexHashedNodesCoordinator(arguments)
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		saveEpochStartRegistry(t, ihnc, args)
+		hnc, _ := NewHistoricalNodesCoordinator(args)
+
+		randomness := []byte("block randomness")
+		for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+			for round := uint64(1); round < 20; round++ {
+				expectedGroup, err := reference.ComputeConsensusGroup(randomness, round, shardID, 0)
+				require.Nil(t, err)
+
+				consensusGroup, err := hnc.ComputeConsensusGroup(randomness, round, shardID, 0)
+				require.Nil(t, err)
+				require.Equal(t, len(expectedGroup), len(consensusGroup))
+				for i := range expectedGroup {
+					assert.Equal(t, expectedGroup[i].PubKey(), consensusGroup[i].PubKey())
+				}
+			}
+		}
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		args := createMockArgsHistoricalNodesCoordinator(ihnc)
+		saveEpochStartRegistry(t, ihnc, args)
+		hnc, _ := NewHistoricalNodesCoordinator(args)
+
+		consensusGroup, err := hnc.ComputeConsensusGroup([]byte("randomness"), 1, 5, 0)
+		assert.Equal(t, ErrInvalidShardId, err)
+		assert.Nil(t, consensusGroup)
+	})
+	t.Run("nil randomness should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createHistoricalNodesCoordinatorArguments())
+		hnc, _ := NewHistoricalNodesCoordinator(createMockArgsHistoricalNodesCoordinator(ihnc))
+
+		consensusGroup, err := hnc.ComputeConsensusGroup(nil, 1, 0, 3)
+		assert.Equal(t, ErrNilRandomness, err)
+		assert.Nil(t, consensusGroup)
+	})
+}
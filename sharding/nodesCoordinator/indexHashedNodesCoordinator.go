@@ -697,13 +697,29 @@ func (ihnc *indexHashedNodesCoordinator) GetChance(_ uint32) uint32 {
 func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 	previousEpochConfig *epochNodesConfig,
 	validatorInfos []*state.ShardValidatorInfo,
+) (*epochNodesConfig, error) {
+	return computeNodesConfigFromList(
+		previousEpochConfig,
+		validatorInfos,
+		ihnc.nodesCoordinatorHelper,
+		ihnc.flagWaitingListFix.IsSet(),
+	)
+}
+
+// computeNodesConfigFromList splits the validators info in the lists of the nodes config. It is also used by the
+// nodes shuffling previewer, so the preview is computed from the same lists as the start of epoch
+func computeNodesConfigFromList(
+	previousEpochConfig *epochNodesConfig,
+	validatorInfos []*state.ShardValidatorInfo,
+	chanceComputer NodesCoordinatorHelper,
+	waitingListFixEnabled bool,
 ) (*epochNodesConfig, error) {
 	eligibleMap := make(map[uint32][]Validator)
 	waitingMap := make(map[uint32][]Validator)
 	leavingMap := make(map[uint32][]Validator)
 	newNodesList := make([]Validator, 0)
 
-	if ihnc.flagWaitingListFix.IsSet() && previousEpochConfig == nil {
+	if waitingListFixEnabled && previousEpochConfig == nil {
 		return nil, ErrNilPreviousEpochConfig
 	}
 
@@ -712,7 +728,7 @@ func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 	}
 
 	for _, validatorInfo := range validatorInfos {
-		chance := ihnc.nodesCoordinatorHelper.GetChance(validatorInfo.TempRating)
+		chance := chanceComputer.GetChance(validatorInfo.TempRating)
 		currentValidator, err := NewValidator(validatorInfo.PublicKey, chance, validatorInfo.Index)
 		if err != nil {
 			return nil, err
@@ -726,12 +742,13 @@ func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 		case string(common.LeavingList):
 			log.Debug("leaving node validatorInfo", "pk", validatorInfo.PublicKey)
 			leavingMap[validatorInfo.ShardId] = append(leavingMap[validatorInfo.ShardId], currentValidator)
-			ihnc.addValidatorToPreviousMap(
+			addValidatorToPreviousMap(
 				previousEpochConfig,
 				eligibleMap,
 				waitingMap,
 				currentValidator,
-				validatorInfo.ShardId)
+				validatorInfo.ShardId,
+				waitingListFixEnabled)
 		case string(common.NewList):
 			log.Debug("new node registered", "pk", validatorInfo.PublicKey)
 			newNodesList = append(newNodesList, currentValidator)
@@ -770,14 +787,15 @@ func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 	return newNodesConfig, nil
 }
 
-func (ihnc *indexHashedNodesCoordinator) addValidatorToPreviousMap(
+func addValidatorToPreviousMap(
 	previousEpochConfig *epochNodesConfig,
 	eligibleMap map[uint32][]Validator,
 	waitingMap map[uint32][]Validator,
 	currentValidator *validator,
-	currentValidatorShardId uint32) {
+	currentValidatorShardId uint32,
+	waitingListFixEnabled bool) {
 
-	if !ihnc.flagWaitingListFix.IsSet() {
+	if !waitingListFixEnabled {
 		eligibleMap[currentValidatorShardId] = append(eligibleMap[currentValidatorShardId], currentValidator)
 		return
 	}
@@ -130,3 +130,16 @@ type EpochsConfigUpdateHandler interface {
 	SetNodesConfigFromValidatorsInfo(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) error
 	IsEpochInConfig(epoch uint32) bool
 }
+
+// CurrentBlockProvider can provide the current block that the node was able to commit
+type CurrentBlockProvider interface {
+	GetCurrentBlockHeader() data.HeaderHandler
+	IsInterfaceNil() bool
+}
+
+// ValidatorInfoProvider defines a component able to provide the validators info saved in the peer accounts trie
+type ValidatorInfoProvider interface {
+	LastFinalizedRootHash() []byte
+	GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,400 @@
+package nodesCoordinator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ArgsNodesShufflingPreviewer holds the arguments needed to create a nodes shuffling previewer
+type ArgsNodesShufflingPreviewer struct {
+	Shuffler                  NodesShuffler
+	NodesCoordinator          NodesCoordinator
+	ValidatorInfoProvider     ValidatorInfoProvider
+	CurrentBlockProvider      CurrentBlockProvider
+	PubKeyConverter           core.PubkeyConverter
+	WaitingListFixEnableEpoch uint32
+}
+
+type nodePlacement struct {
+	shardID uint32
+	list    string
+}
+
+type nodesShufflingPreviewer struct {
+	mutPreview                sync.Mutex
+	shuffler                  NodesShuffler
+	nodesCoordinator          NodesCoordinator
+	validatorInfoProvider     ValidatorInfoProvider
+	currentBlockProvider      CurrentBlockProvider
+	pubKeyConverter           core.PubkeyConverter
+	waitingListFixEnableEpoch uint32
+}
+
+// NewNodesShufflingPreviewer creates a component able to preview the nodes lists of the next epoch by running the
+// nodes shuffler on the latest validators info. The shuffler should not be the one used by the nodes coordinator as
+// updating the nodes lists also changes the shuffler's configuration
+func NewNodesShufflingPreviewer(args ArgsNodesShufflingPreviewer) (*nodesShufflingPreviewer, error) {
+	if check.IfNil(args.Shuffler) {
+		return nil, ErrNilShuffler
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ValidatorInfoProvider) {
+		return nil, ErrNilValidatorInfoProvider
+	}
+	if check.IfNil(args.CurrentBlockProvider) {
+		return nil, ErrNilCurrentBlockProvider
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &nodesShufflingPreviewer{
+		shuffler:                  args.Shuffler,
+		nodesCoordinator:          args.NodesCoordinator,
+		validatorInfoProvider:     args.ValidatorInfoProvider,
+		currentBlockProvider:      args.CurrentBlockProvider,
+		pubKeyConverter:           args.PubKeyConverter,
+		waitingListFixEnableEpoch: args.WaitingListFixEnableEpoch,
+	}, nil
+}
+
+// PreviewShuffling computes the nodes lists of the next epoch from the validators info of the last finalized block,
+// after applying the hypothetical stake and unstake sets of the request. When no randomness is provided, the
+// randomness of the current block is used: the preview is exact only if the current block is the last one before
+// the start of epoch block, as the shuffling uses the randomness of the block preceding the start of epoch block
+func (nsp *nodesShufflingPreviewer) PreviewShuffling(
+	request *common.NodesShufflingPreviewRequest,
+) (*common.NodesShufflingPreviewAPIResponse, error) {
+	if request == nil {
+		request = &common.NodesShufflingPreviewRequest{}
+	}
+
+	header := nsp.currentBlockProvider.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+	currentEpoch := header.GetEpoch()
+	newEpoch := currentEpoch + 1
+
+	randomness, err := getPreviewRandomness(header, request.Randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo, err := nsp.getValidatorsInfo()
+	if err != nil {
+		return nil, err
+	}
+	previousPlacements := createNodesPlacements(validatorsInfo)
+
+	validatorsInfo, err = nsp.applyStakeAndUnstake(validatorsInfo, request)
+	if err != nil {
+		return nil, err
+	}
+
+	previousConfig, err := nsp.getPreviousEpochConfig(currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	waitingListFixEnabled := newEpoch >= nsp.waitingListFixEnableEpoch
+	nodesConfig, err := computeNodesConfigFromList(previousConfig, validatorsInfo, nsp.nodesCoordinator, waitingListFixEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalLeavingMap, err := nsp.nodesCoordinator.ComputeAdditionalLeaving(validatorsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	shufflerArgs := ArgsUpdateNodes{
+		Eligible:          nodesConfig.eligibleMap,
+		Waiting:           nodesConfig.waitingMap,
+		NewNodes:          nodesConfig.newList,
+		UnStakeLeaving:    createSortedList(nodesConfig.leavingMap),
+		AdditionalLeaving: createSortedList(additionalLeavingMap),
+		Rand:              randomness,
+		NbShards:          nodesConfig.nbShards,
+		Epoch:             newEpoch,
+	}
+
+	nsp.mutPreview.Lock()
+	resUpdateNodes, err := nsp.shuffler.UpdateNodeLists(shufflerArgs)
+	nsp.mutPreview.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	leavingNodesMap, stillRemainingNodesMap := createActuallyLeavingPerShards(
+		nodesConfig.leavingMap,
+		additionalLeavingMap,
+		resUpdateNodes.Leaving,
+	)
+
+	response := &common.NodesShufflingPreviewAPIResponse{
+		CurrentEpoch:   currentEpoch,
+		Epoch:          newEpoch,
+		Randomness:     hex.EncodeToString(randomness),
+		WhatIf:         len(request.Stake) > 0 || len(request.Unstake) > 0,
+		Eligible:       nsp.encodeValidatorsMap(resUpdateNodes.Eligible),
+		Waiting:        nsp.encodeValidatorsMap(resUpdateNodes.Waiting),
+		Leaving:        nsp.encodeValidatorsMap(leavingNodesMap),
+		StillRemaining: nsp.encodeValidatorsMap(stillRemainingNodesMap),
+	}
+	response.Nodes = nsp.createNodesResponse(previousPlacements, resUpdateNodes, leavingNodesMap)
+
+	return response, nil
+}
+
+func getPreviewRandomness(header data.HeaderHandler, requestedRandomness string) ([]byte, error) {
+	if len(requestedRandomness) == 0 {
+		return header.GetRandSeed(), nil
+	}
+
+	randomness, err := hex.DecodeString(requestedRandomness)
+	if err != nil || len(randomness) == 0 {
+		return nil, fmt.Errorf("%w: invalid randomness %s", ErrInvalidShufflingPreviewRequest, requestedRandomness)
+	}
+
+	return randomness, nil
+}
+
+func (nsp *nodesShufflingPreviewer) getValidatorsInfo() ([]*state.ShardValidatorInfo, error) {
+	rootHash := nsp.validatorInfoProvider.LastFinalizedRootHash()
+	if len(rootHash) == 0 {
+		return nil, ErrMissingValidatorsInfo
+	}
+
+	validatorsInfoMap, err := nsp.validatorInfoProvider.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0)
+	for _, shardID := range sortedValidatorInfoShardIDs(validatorsInfoMap) {
+		for _, validatorInfo := range validatorsInfoMap[shardID] {
+			validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+				PublicKey:  validatorInfo.PublicKey,
+				ShardId:    validatorInfo.ShardId,
+				List:       validatorInfo.List,
+				Index:      validatorInfo.Index,
+				TempRating: validatorInfo.TempRating,
+			})
+		}
+	}
+
+	return validatorsInfo, nil
+}
+
+func sortedValidatorInfoShardIDs(validatorsInfoMap map[uint32][]*state.ValidatorInfo) []uint32 {
+	shardIDs := make([]uint32, 0, len(validatorsInfoMap))
+	for shardID := range validatorsInfoMap {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+func createNodesPlacements(validatorsInfo []*state.ShardValidatorInfo) map[string]*nodePlacement {
+	placements := make(map[string]*nodePlacement, len(validatorsInfo))
+	for _, validatorInfo := range validatorsInfo {
+		placements[string(validatorInfo.PublicKey)] = &nodePlacement{
+			shardID: validatorInfo.ShardId,
+			list:    validatorInfo.List,
+		}
+	}
+
+	return placements
+}
+
+// applyStakeAndUnstake returns a copy of the validators info in which the staked nodes are added to the new list
+// and the unstaked nodes are moved to the leaving list, as the staking system smart contract would do at the end
+// of the epoch
+func (nsp *nodesShufflingPreviewer) applyStakeAndUnstake(
+	validatorsInfo []*state.ShardValidatorInfo,
+	request *common.NodesShufflingPreviewRequest,
+) ([]*state.ShardValidatorInfo, error) {
+	validatorsInfoMap := make(map[string]*state.ShardValidatorInfo, len(validatorsInfo))
+	result := make([]*state.ShardValidatorInfo, 0, len(validatorsInfo)+len(request.Stake))
+	for _, validatorInfo := range validatorsInfo {
+		validatorInfoCopy := *validatorInfo
+		validatorsInfoMap[string(validatorInfo.PublicKey)] = &validatorInfoCopy
+		result = append(result, &validatorInfoCopy)
+	}
+
+	for _, encodedKey := range request.Stake {
+		pubKey, err := nsp.pubKeyConverter.Decode(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s for staked key %s", ErrInvalidShufflingPreviewRequest, err.Error(), encodedKey)
+		}
+
+		validatorInfo, found := validatorsInfoMap[string(pubKey)]
+		if !found {
+			validatorInfo = &state.ShardValidatorInfo{
+				PublicKey: pubKey,
+				ShardId:   core.MetachainShardId,
+			}
+			validatorsInfoMap[string(pubKey)] = validatorInfo
+			result = append(result, validatorInfo)
+		}
+		if !isStakeAllowed(validatorInfo.List) {
+			return nil, fmt.Errorf("%w: staked key %s is already in the %s list",
+				ErrInvalidShufflingPreviewRequest, encodedKey, validatorInfo.List)
+		}
+		validatorInfo.List = string(common.NewList)
+	}
+
+	for _, encodedKey := range request.Unstake {
+		pubKey, err := nsp.pubKeyConverter.Decode(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s for unstaked key %s", ErrInvalidShufflingPreviewRequest, err.Error(), encodedKey)
+		}
+
+		validatorInfo, found := validatorsInfoMap[string(pubKey)]
+		if !found || !isUnstakeAllowed(validatorInfo.List) {
+			return nil, fmt.Errorf("%w: unstaked key %s is neither eligible nor waiting",
+				ErrInvalidShufflingPreviewRequest, encodedKey)
+		}
+		validatorInfo.List = string(common.LeavingList)
+	}
+
+	return result, nil
+}
+
+func isStakeAllowed(list string) bool {
+	return len(list) == 0 || list == string(common.InactiveList)
+}
+
+func isUnstakeAllowed(list string) bool {
+	return list == string(common.EligibleList) || list == string(common.WaitingList)
+}
+
+func (nsp *nodesShufflingPreviewer) getPreviousEpochConfig(epoch uint32) (*epochNodesConfig, error) {
+	eligible, err := nsp.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+	waiting, err := nsp.nodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleMap, err := createValidatorsMapFromKeys(eligible)
+	if err != nil {
+		return nil, err
+	}
+	waitingMap, err := createValidatorsMapFromKeys(waiting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &epochNodesConfig{
+		eligibleMap: eligibleMap,
+		waitingMap:  waitingMap,
+	}, nil
+}
+
+func createValidatorsMapFromKeys(keys map[uint32][][]byte) (map[uint32][]Validator, error) {
+	validatorsMap := make(map[uint32][]Validator, len(keys))
+	for shardID, shardKeys := range keys {
+		validators := make([]Validator, 0, len(shardKeys))
+		for _, key := range shardKeys {
+			v, err := NewValidator(key, defaultSelectionChances, 0)
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, v)
+		}
+		validatorsMap[shardID] = validators
+	}
+
+	return validatorsMap, nil
+}
+
+func createSortedList(validatorsMap map[uint32][]Validator) []Validator {
+	sortedList := make([]Validator, 0)
+	for _, validators := range validatorsMap {
+		sortedList = append(sortedList, validators...)
+	}
+	sort.Sort(validatorList(sortedList))
+
+	return sortedList
+}
+
+func (nsp *nodesShufflingPreviewer) encodeValidatorsMap(validatorsMap map[uint32][]Validator) map[uint32][]string {
+	encodedMap := make(map[uint32][]string, len(validatorsMap))
+	for shardID, validators := range validatorsMap {
+		encodedKeys := make([]string, 0, len(validators))
+		for _, v := range validators {
+			encodedKeys = append(encodedKeys, nsp.pubKeyConverter.Encode(v.PubKey()))
+		}
+		encodedMap[shardID] = encodedKeys
+	}
+
+	return encodedMap
+}
+
+func (nsp *nodesShufflingPreviewer) createNodesResponse(
+	previousPlacements map[string]*nodePlacement,
+	resUpdateNodes *ResUpdateNodes,
+	leavingNodesMap map[uint32][]Validator,
+) []*common.NodeShufflingPreviewAPIResponse {
+	nextPlacements := make(map[string]*nodePlacement)
+	addPlacements(nextPlacements, resUpdateNodes.Eligible, string(common.EligibleList))
+	addPlacements(nextPlacements, resUpdateNodes.Waiting, string(common.WaitingList))
+	addPlacements(nextPlacements, leavingNodesMap, string(common.LeavingList))
+
+	nodes := make([]*common.NodeShufflingPreviewAPIResponse, 0, len(nextPlacements))
+	for pubKey, next := range nextPlacements {
+		node := &common.NodeShufflingPreviewAPIResponse{
+			BLSKey:       nsp.pubKeyConverter.Encode([]byte(pubKey)),
+			ShardID:      next.shardID,
+			List:         next.list,
+			PreviousList: string(common.NewList),
+		}
+
+		previous, found := previousPlacements[pubKey]
+		if found && len(previous.list) > 0 {
+			node.PreviousShardID = previous.shardID
+			node.PreviousList = previous.list
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].BLSKey < nodes[j].BLSKey
+	})
+
+	return nodes
+}
+
+func addPlacements(placements map[string]*nodePlacement, validatorsMap map[uint32][]Validator, list string) {
+	for shardID, validators := range validatorsMap {
+		for _, v := range validators {
+			placements[string(v.PubKey())] = &nodePlacement{
+				shardID: shardID,
+				list:    list,
+			}
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *nodesShufflingPreviewer) IsInterfaceNil() bool {
+	return nsp == nil
+}
@@ -0,0 +1,362 @@
+package nodesCoordinator
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var previewRandomness = []byte("preview randomness")
+
+func createUniqueNodesMap(nodesPerShard uint32, nbShards uint32, list string) map[uint32][]Validator {
+	hasher := sha256.NewSha256()
+	nodesMap := make(map[uint32][]Validator)
+	for i := uint32(0); i <= nbShards; i++ {
+		shardID := i
+		if i == nbShards {
+			shardID = core.MetachainShardId
+		}
+
+		validators := make([]Validator, 0, nodesPerShard)
+		for j := uint32(0); j < nodesPerShard; j++ {
+			pk := hasher.Compute(fmt.Sprintf("%s_%d_%d", list, shardID, j))
+			validators = append(validators, newValidatorMock(pk, 1, defaultSelectionChances))
+		}
+		nodesMap[shardID] = validators
+	}
+
+	return nodesMap
+}
+
+func createValidatorsInfoFromNodesMap(
+	validatorsInfo map[uint32][]*state.ValidatorInfo,
+	nodesMap map[uint32][]Validator,
+	list string,
+) {
+	for shardID, validators := range nodesMap {
+		for index, v := range validators {
+			validatorsInfo[shardID] = append(validatorsInfo[shardID], &state.ValidatorInfo{
+				PublicKey:  v.PubKey(),
+				ShardId:    shardID,
+				List:       list,
+				Index:      uint32(index),
+				TempRating: 10,
+			})
+		}
+	}
+}
+
+func createShufflingPreviewSetup(t *testing.T) (*indexHashedNodesCoordinator, map[uint32][]*state.ValidatorInfo) {
+	eligibleMap := createUniqueNodesMap(10, 1, "eligible")
+	waitingMap := createUniqueNodesMap(3, 1, "waiting")
+	arguments := createArguments()
+	arguments.EligibleNodes = eligibleMap
+	arguments.WaitingNodes = waitingMap
+	ihnc, err := NewIndexHashedNodesCoordinator(arguments)
+	require.Nil(t, err)
+
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	createValidatorsInfoFromNodesMap(validatorsInfo, eligibleMap, string(common.EligibleList))
+	createValidatorsInfoFromNodesMap(validatorsInfo, waitingMap, string(common.WaitingList))
+	createValidatorsInfoFromNodesMap(validatorsInfo, createUniqueNodesMap(2, 0, "new"), string(common.NewList))
+	validatorsInfo[0][1].List = string(common.LeavingList)
+
+	return ihnc, validatorsInfo
+}
+
+func createMockArgsNodesShufflingPreviewer(
+	ihnc *indexHashedNodesCoordinator,
+	validatorsInfo map[uint32][]*state.ValidatorInfo,
+) ArgsNodesShufflingPreviewer {
+	shuffler, _ := NewHashValidatorsShuffler(&NodesShufflerArgs{
+		NodesShard:           10,
+		NodesMeta:            10,
+		Hysteresis:           hysteresis,
+		Adaptivity:           adaptivity,
+		ShuffleBetweenShards: shuffleBetweenShards,
+	})
+
+	return ArgsNodesShufflingPreviewer{
+		Shuffler:         shuffler,
+		NodesCoordinator: ihnc,
+		ValidatorInfoProvider: &mock.ValidatorInfoProviderStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+				return validatorsInfo, nil
+			},
+		},
+		CurrentBlockProvider: &mock.CurrentBlockProviderStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{RandSeed: previewRandomness}
+			},
+		},
+		PubKeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+}
+
+func encodeNodesMap(nodesMap map[uint32][]Validator) map[uint32][]string {
+	encodedMap := make(map[uint32][]string)
+	for shardID, validators := range nodesMap {
+		encodedKeys := make([]string, 0, len(validators))
+		for _, v := range validators {
+			encodedKeys = append(encodedKeys, hex.EncodeToString(v.PubKey()))
+		}
+		encodedMap[shardID] = encodedKeys
+	}
+
+	return encodedMap
+}
+
+func findNodeInPreview(preview *common.NodesShufflingPreviewAPIResponse, blsKey string) *common.NodeShufflingPreviewAPIResponse {
+	for _, node := range preview.Nodes {
+		if node.BLSKey == blsKey {
+			return node
+		}
+	}
+
+	return nil
+}
+
+func TestNewNodesShufflingPreviewer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shuffler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.Shuffler = nil
+		previewer, err := NewNodesShufflingPreviewer(args)
+		assert.Equal(t, ErrNilShuffler, err)
+		assert.True(t, check.IfNil(previewer))
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.NodesCoordinator = nil
+		previewer, err := NewNodesShufflingPreviewer(args)
+		assert.Equal(t, ErrNilNodesCoordinator, err)
+		assert.True(t, check.IfNil(previewer))
+	})
+	t.Run("nil validator info provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.ValidatorInfoProvider = nil
+		previewer, err := NewNodesShufflingPreviewer(args)
+		assert.Equal(t, ErrNilValidatorInfoProvider, err)
+		assert.True(t, check.IfNil(previewer))
+	})
+	t.Run("nil current block provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.CurrentBlockProvider = nil
+		previewer, err := NewNodesShufflingPreviewer(args)
+		assert.Equal(t, ErrNilCurrentBlockProvider, err)
+		assert.True(t, check.IfNil(previewer))
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.PubKeyConverter = nil
+		previewer, err := NewNodesShufflingPreviewer(args)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(previewer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		previewer, err := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t)))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(previewer))
+	})
+}
+
+func TestNodesShufflingPreviewer_PreviewShufflingErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.CurrentBlockProvider = &mock.CurrentBlockProviderStub{}
+		previewer, _ := NewNodesShufflingPreviewer(args)
+
+		preview, err := previewer.PreviewShuffling(nil)
+		assert.Equal(t, ErrNilBlockHeader, err)
+		assert.Nil(t, preview)
+	})
+	t.Run("missing validators info should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.ValidatorInfoProvider = &mock.ValidatorInfoProviderStub{}
+		previewer, _ := NewNodesShufflingPreviewer(args)
+
+		preview, err := previewer.PreviewShuffling(nil)
+		assert.Equal(t, ErrMissingValidatorsInfo, err)
+		assert.Nil(t, preview)
+	})
+	t.Run("validators info error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t))
+		args.ValidatorInfoProvider = &mock.ValidatorInfoProviderStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+				return nil, expectedErr
+			},
+		}
+		previewer, _ := NewNodesShufflingPreviewer(args)
+
+		preview, err := previewer.PreviewShuffling(nil)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, preview)
+	})
+	t.Run("invalid randomness should error", func(t *testing.T) {
+		t.Parallel()
+
+		previewer, _ := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t)))
+
+		preview, err := previewer.PreviewShuffling(&common.NodesShufflingPreviewRequest{Randomness: "not hex"})
+		assert.True(t, errors.Is(err, ErrInvalidShufflingPreviewRequest))
+		assert.Nil(t, preview)
+	})
+	t.Run("staking an eligible node should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, validatorsInfo := createShufflingPreviewSetup(t)
+		previewer, _ := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(ihnc, validatorsInfo))
+
+		request := &common.NodesShufflingPreviewRequest{
+			Stake: []string{hex.EncodeToString(validatorsInfo[0][0].PublicKey)},
+		}
+		preview, err := previewer.PreviewShuffling(request)
+		assert.True(t, errors.Is(err, ErrInvalidShufflingPreviewRequest))
+		assert.Nil(t, preview)
+	})
+	t.Run("unstaking an unknown node should error", func(t *testing.T) {
+		t.Parallel()
+
+		previewer, _ := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(createShufflingPreviewSetup(t)))
+
+		request := &common.NodesShufflingPreviewRequest{
+			Unstake: []string{hex.EncodeToString([]byte("unknown key"))},
+		}
+		preview, err := previewer.PreviewShuffling(request)
+		assert.True(t, errors.Is(err, ErrInvalidShufflingPreviewRequest))
+		assert.Nil(t, preview)
+	})
+}
+
+func TestNodesShufflingPreviewer_PreviewShufflingShouldMatchTheEpochStartShuffling(t *testing.T) {
+	t.Parallel()
+
+	ihnc, validatorsInfo := createShufflingPreviewSetup(t)
+	previewer, _ := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(ihnc, validatorsInfo))
+
+	preview, err := previewer.PreviewShuffling(nil)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(0), preview.CurrentEpoch)
+	assert.Equal(t, uint32(1), preview.Epoch)
+	assert.Equal(t, hex.EncodeToString(previewRandomness), preview.Randomness)
+	assert.False(t, preview.WhatIf)
+
+	// the preview should not change the nodes coordinator
+	_, err = ihnc.GetAllEligibleValidatorsPublicKeys(1)
+	assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+
+	body := &block.Body{}
+	for _, shardValidatorsInfo := range validatorsInfo {
+		miniBlock := &block.MiniBlock{Type: block.PeerBlock}
+		for _, validatorInfo := range shardValidatorsInfo {
+			marshaledData, _ := ihnc.marshalizer.Marshal(&state.ShardValidatorInfo{
+				PublicKey:  validatorInfo.PublicKey,
+				ShardId:    validatorInfo.ShardId,
+				List:       validatorInfo.List,
+				Index:      validatorInfo.Index,
+				TempRating: validatorInfo.TempRating,
+			})
+			miniBlock.TxHashes = append(miniBlock.TxHashes, marshaledData)
+		}
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+	header := &block.MetaBlock{
+		PrevRandSeed: previewRandomness,
+		EpochStart:   block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		Epoch:        1,
+	}
+	ihnc.EpochStartPrepare(header, body)
+
+	nodesConfig := ihnc.nodesConfig[1]
+	require.NotNil(t, nodesConfig)
+	assert.Equal(t, encodeNodesMap(nodesConfig.eligibleMap), preview.Eligible)
+	assert.Equal(t, encodeNodesMap(nodesConfig.waitingMap), preview.Waiting)
+	assert.Equal(t, encodeNodesMap(nodesConfig.leavingMap), preview.Leaving)
+
+	leavingKey := hex.EncodeToString(validatorsInfo[0][1].PublicKey)
+	leavingNode := findNodeInPreview(preview, leavingKey)
+	require.NotNil(t, leavingNode)
+	assert.Equal(t, string(common.LeavingList), leavingNode.List)
+	assert.Equal(t, string(common.LeavingList), leavingNode.PreviousList)
+
+	newNodes := createUniqueNodesMap(2, 0, "new")
+	for _, newNode := range newNodes[0] {
+		node := findNodeInPreview(preview, hex.EncodeToString(newNode.PubKey()))
+		require.NotNil(t, node)
+		assert.Equal(t, string(common.NewList), node.PreviousList)
+		assert.Equal(t, string(common.WaitingList), node.List)
+	}
+}
+
+func TestNodesShufflingPreviewer_PreviewShufflingWhatIf(t *testing.T) {
+	t.Parallel()
+
+	ihnc, validatorsInfo := createShufflingPreviewSetup(t)
+	previewer, _ := NewNodesShufflingPreviewer(createMockArgsNodesShufflingPreviewer(ihnc, validatorsInfo))
+
+	stakedKey := hex.EncodeToString([]byte("hypothetical staked key"))
+	unstakedKey := hex.EncodeToString(validatorsInfo[core.MetachainShardId][0].PublicKey)
+	request := &common.NodesShufflingPreviewRequest{
+		Stake:      []string{stakedKey},
+		Unstake:    []string{unstakedKey},
+		Randomness: hex.EncodeToString([]byte("other randomness")),
+	}
+	preview, err := previewer.PreviewShuffling(request)
+	require.Nil(t, err)
+	assert.True(t, preview.WhatIf)
+	assert.Equal(t, request.Randomness, preview.Randomness)
+
+	stakedNode := findNodeInPreview(preview, stakedKey)
+	require.NotNil(t, stakedNode)
+	assert.Equal(t, string(common.NewList), stakedNode.PreviousList)
+	assert.Equal(t, string(common.WaitingList), stakedNode.List)
+
+	unstakedNode := findNodeInPreview(preview, unstakedKey)
+	require.NotNil(t, unstakedNode)
+	assert.Equal(t, string(common.EligibleList), unstakedNode.PreviousList)
+	assert.Equal(t, core.MetachainShardId, unstakedNode.PreviousShardID)
+	assert.Contains(t, preview.Leaving[core.MetachainShardId], unstakedKey)
+
+	// the hypothetical sets should not alter the recorded validators info
+	assert.Equal(t, string(common.EligibleList), validatorsInfo[core.MetachainShardId][0].List)
+}
@@ -539,6 +539,12 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	createdStorers, err = psf.setupRewardsBreakdownStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -547,6 +553,31 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	return store, err
 }
 
+func (psf *StorageServiceFactory) setupRewardsBreakdownStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
+	createdStorers := make([]storage.Storer, 0)
+
+	if !psf.generalConfig.RewardsBreakdown.Enabled {
+		return createdStorers, nil
+	}
+
+	// the rewards breakdown storer is static, as the breakdowns are queried by epoch
+	rewardsBreakdownConfig := psf.generalConfig.RewardsBreakdown.RewardsBreakdownStorage
+	rewardsBreakdownDbConfig := GetDBFromConfig(rewardsBreakdownConfig.DB)
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	rewardsBreakdownDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, rewardsBreakdownConfig.DB.FilePath)
+	rewardsBreakdownUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(rewardsBreakdownConfig.Cache),
+		rewardsBreakdownDbConfig)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, rewardsBreakdownUnit)
+	chainStorer.AddStorer(dataRetriever.RewardsBreakdownUnit, rewardsBreakdownUnit)
+
+	return createdStorers, nil
+}
+
 func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
 	createdStorers := make([]storage.Storer, 0)
 
@@ -652,6 +683,23 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, esdtSuppliesUnit)
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.AccountTransactionsIndexEnabled {
+		return createdStorers, nil
+	}
+
+	// Create the accountTransactions (STATIC) storer
+	accountTransactionsConfig := psf.generalConfig.DbLookupExtensions.AccountTransactionsStorageConfig
+	accountTransactionsDbConfig := GetDBFromConfig(accountTransactionsConfig.DB)
+	accountTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, accountTransactionsConfig.DB.FilePath)
+	accountTransactionsCacherConfig := GetCacherFromConfig(accountTransactionsConfig.Cache)
+	accountTransactionsUnit, err := storageUnit.NewStorageUnitFromConf(accountTransactionsCacherConfig, accountTransactionsDbConfig)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, accountTransactionsUnit)
+	chainStorer.AddStorer(dataRetriever.AccountTransactionsUnit, accountTransactionsUnit)
+
 	return createdStorers, nil
 }
 
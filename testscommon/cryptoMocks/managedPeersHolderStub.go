@@ -0,0 +1,101 @@
+package cryptoMocks
+
+import (
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ManagedPeersHolderStub -
+type ManagedPeersHolderStub struct {
+	AddManagedPeerCalled            func(privateKeyBytes []byte) error
+	GetPrivateKeyCalled             func(pkBytes []byte) (crypto.PrivateKey, error)
+	GetManagedKeysCalled            func() [][]byte
+	IsKeyManagedByCurrentNodeCalled func(pkBytes []byte) bool
+	IsMultiKeyModeCalled            func() bool
+	RecordConsensusRoundCalled      func(pkBytes []byte, round int64, isLeader bool)
+	RecordProposedBlockCalled       func(pkBytes []byte, round int64)
+	RecordSignatureCalled           func(pkBytes []byte, round int64)
+	RecordHeartbeatCalled           func(pkBytes []byte)
+	GetManagedKeysStatusCalled      func() []*common.ManagedKeyStatus
+}
+
+// AddManagedPeer -
+func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error {
+	if stub.AddManagedPeerCalled != nil {
+		return stub.AddManagedPeerCalled(privateKeyBytes)
+	}
+	return nil
+}
+
+// GetPrivateKey -
+func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if stub.GetPrivateKeyCalled != nil {
+		return stub.GetPrivateKeyCalled(pkBytes)
+	}
+	return nil, nil
+}
+
+// GetManagedKeys -
+func (stub *ManagedPeersHolderStub) GetManagedKeys() [][]byte {
+	if stub.GetManagedKeysCalled != nil {
+		return stub.GetManagedKeysCalled()
+	}
+	return nil
+}
+
+// IsKeyManagedByCurrentNode -
+func (stub *ManagedPeersHolderStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if stub.IsKeyManagedByCurrentNodeCalled != nil {
+		return stub.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+	return false
+}
+
+// IsMultiKeyMode -
+func (stub *ManagedPeersHolderStub) IsMultiKeyMode() bool {
+	if stub.IsMultiKeyModeCalled != nil {
+		return stub.IsMultiKeyModeCalled()
+	}
+	return false
+}
+
+// RecordConsensusRound -
+func (stub *ManagedPeersHolderStub) RecordConsensusRound(pkBytes []byte, round int64, isLeader bool) {
+	if stub.RecordConsensusRoundCalled != nil {
+		stub.RecordConsensusRoundCalled(pkBytes, round, isLeader)
+	}
+}
+
+// RecordProposedBlock -
+func (stub *ManagedPeersHolderStub) RecordProposedBlock(pkBytes []byte, round int64) {
+	if stub.RecordProposedBlockCalled != nil {
+		stub.RecordProposedBlockCalled(pkBytes, round)
+	}
+}
+
+// RecordSignature -
+func (stub *ManagedPeersHolderStub) RecordSignature(pkBytes []byte, round int64) {
+	if stub.RecordSignatureCalled != nil {
+		stub.RecordSignatureCalled(pkBytes, round)
+	}
+}
+
+// RecordHeartbeat -
+func (stub *ManagedPeersHolderStub) RecordHeartbeat(pkBytes []byte) {
+	if stub.RecordHeartbeatCalled != nil {
+		stub.RecordHeartbeatCalled(pkBytes)
+	}
+}
+
+// GetManagedKeysStatus -
+func (stub *ManagedPeersHolderStub) GetManagedKeysStatus() []*common.ManagedKeyStatus {
+	if stub.GetManagedKeysStatusCalled != nil {
+		return stub.GetManagedKeysStatusCalled()
+	}
+	return make([]*common.ManagedKeyStatus, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ManagedPeersHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,21 @@
+package cryptoMocks
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// SigningHandlerStub -
+type SigningHandlerStub struct {
+	SignCalled func(request *common.SigningRequest) ([]byte, error)
+}
+
+// Sign -
+func (stub *SigningHandlerStub) Sign(request *common.SigningRequest) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(request)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
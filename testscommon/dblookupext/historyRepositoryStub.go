@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/accountTransactions"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
@@ -16,6 +18,8 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTNoncesSuppliesCalled        func(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error)
+	GetAccountTransactionsCalled       func(address []byte, options common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -87,6 +91,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetESDTNoncesSupplies -
+func (hp *HistoryRepositoryStub) GetESDTNoncesSupplies(collection string, page uint32, size uint32) ([]*esdtSupply.NonceSupplyESDT, uint64, error) {
+	if hp.GetESDTNoncesSuppliesCalled != nil {
+		return hp.GetESDTNoncesSuppliesCalled(collection, page, size)
+	}
+
+	return nil, 0, nil
+}
+
+// GetAccountTransactions -
+func (hp *HistoryRepositoryStub) GetAccountTransactions(address []byte, options common.AccountTransactionsQueryOptions) ([]*accountTransactions.AccountTransaction, uint64, error) {
+	if hp.GetAccountTransactionsCalled != nil {
+		return hp.GetAccountTransactionsCalled(address, options)
+	}
+
+	return nil, 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -320,6 +320,19 @@ func GetGeneralConfig() config.Config {
 				},
 			},
 		},
+		RewardsBreakdown: config.RewardsBreakdownConfig{
+			Enabled: false,
+			RewardsBreakdownStorage: config.StorageConfig{
+				Cache: getLRUCacheConfig(),
+				DB: config.DBConfig{
+					FilePath:          AddTimestampSuffix("RewardsBreakdown"),
+					Type:              string(storageUnit.MemoryDB),
+					BatchDelaySeconds: 2,
+					MaxBatchSize:      1,
+					MaxOpenFiles:      10,
+				},
+			},
+		},
 		ReceiptsStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
 			DB: config.DBConfig{
@@ -397,6 +410,15 @@ func GetGeneralConfig() config.Config {
 		PeersRatingConfig: config.PeersRatingConfig{
 			TopRatedCacheCapacity: 1000,
 			BadRatedCacheCapacity: 1000,
+			Storage: config.StorageConfig{
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 1000,
+				},
+				DB: config.DBConfig{
+					Type: string(storageUnit.MemoryDB),
+				},
+			},
 		},
 	}
 }
@@ -37,6 +37,9 @@ type MessengerStub struct {
 	SetPeerShardResolverCalled             func(peerShardResolver p2p.PeerShardResolver) error
 	SetPeerDenialEvaluatorCalled           func(handler p2p.PeerDenialEvaluator) error
 	GetConnectedPeersInfoCalled            func() *p2p.ConnectedPeersInfo
+	GetCompressionStatisticsCalled         func() map[string]p2p.CompressionStatistics
+	GetBandwidthReportCalled               func() *p2p.BandwidthReport
+	GetTopologySnapshotCalled              func() (*p2p.TopologySnapshot, error)
 	UnjoinAllTopicsCalled                  func() error
 	PortCalled                             func() int
 	WaitForConnectionsCalled               func(maxWaitingTime time.Duration, minNumOfPeers uint32)
@@ -290,6 +293,36 @@ func (ms *MessengerStub) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// GetCompressionStatistics -
+func (ms *MessengerStub) GetCompressionStatistics() map[string]p2p.CompressionStatistics {
+	if ms.GetCompressionStatisticsCalled != nil {
+		return ms.GetCompressionStatisticsCalled()
+	}
+
+	return make(map[string]p2p.CompressionStatistics)
+}
+
+// GetTopologySnapshot -
+func (ms *MessengerStub) GetTopologySnapshot() (*p2p.TopologySnapshot, error) {
+	if ms.GetTopologySnapshotCalled != nil {
+		return ms.GetTopologySnapshotCalled()
+	}
+
+	return nil, p2p.ErrTopologyCrawlerDisabled
+}
+
+// GetBandwidthReport -
+func (ms *MessengerStub) GetBandwidthReport() *p2p.BandwidthReport {
+	if ms.GetBandwidthReportCalled != nil {
+		return ms.GetBandwidthReportCalled()
+	}
+
+	return &p2p.BandwidthReport{
+		Topics: make(map[string]p2p.BandwidthStatistics),
+		Peers:  make(map[core.PeerID]p2p.BandwidthStatistics),
+	}
+}
+
 // UnjoinAllTopics -
 func (ms *MessengerStub) UnjoinAllTopics() error {
 	if ms.UnjoinAllTopicsCalled != nil {
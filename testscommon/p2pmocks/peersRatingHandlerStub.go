@@ -8,6 +8,8 @@ type PeersRatingHandlerStub struct {
 	IncreaseRatingCalled           func(pid core.PeerID)
 	DecreaseRatingCalled           func(pid core.PeerID)
 	GetTopRatedPeersFromListCalled func(peers []core.PeerID, numOfPeers int) []core.PeerID
+	GetPeersRatingsCalled          func() map[core.PeerID]int32
+	CloseCalled                    func() error
 }
 
 // AddPeer -
@@ -40,6 +42,24 @@ func (stub *PeersRatingHandlerStub) GetTopRatedPeersFromList(peers []core.PeerID
 	return peers
 }
 
+// GetPeersRatings -
+func (stub *PeersRatingHandlerStub) GetPeersRatings() map[core.PeerID]int32 {
+	if stub.GetPeersRatingsCalled != nil {
+		return stub.GetPeersRatingsCalled()
+	}
+
+	return make(map[core.PeerID]int32)
+}
+
+// Close -
+func (stub *PeersRatingHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (stub *PeersRatingHandlerStub) IsInterfaceNil() bool {
 	return stub == nil